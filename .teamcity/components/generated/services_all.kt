@@ -110,7 +110,6 @@ val services = mapOf(
     "lightsail" to ServiceSpec("Lightsail"),
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
-    "macie" to ServiceSpec("Macie Classic"),
     "macie2" to ServiceSpec("Macie"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
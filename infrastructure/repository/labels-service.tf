@@ -54,6 +54,7 @@ variable "service_labels" {
     "codecommit",
     "codeguruprofiler",
     "codegurureviewer",
+    "codegurusecurity",
     "codepipeline",
     "codestar",
     "codestarconnections",
@@ -85,6 +86,7 @@ variable "service_labels" {
     "dlm",
     "dms",
     "docdb",
+    "docdbelastic",
     "drs",
     "ds",
     "dynamodb",
@@ -214,6 +216,7 @@ variable "service_labels" {
     "opsworks",
     "opsworkscm",
     "organizations",
+    "osis",
     "outposts",
     "panorama",
     "personalize",
@@ -223,6 +226,7 @@ variable "service_labels" {
     "pinpoint",
     "pinpointemail",
     "pinpointsmsvoice",
+    "pipes",
     "polly",
     "pricing",
     "proton",
@@ -244,6 +248,7 @@ variable "service_labels" {
     "rolesanywhere",
     "route53",
     "route53domains",
+    "route53profiles",
     "route53recoverycluster",
     "route53recoverycontrolconfig",
     "route53recoveryreadiness",
@@ -289,6 +294,7 @@ variable "service_labels" {
     "swf",
     "synthetics",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
     "transcribe",
@@ -0,0 +1,57 @@
+package acctest
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Estimated hourly costs, in USD, of resources that are expensive to keep running during acceptance testing.
+// Estimates are based on us-west-2 on-demand pricing for the smallest configuration used by the tests
+// and are intended only as a rough guide for skipping tests; see PreCheckHourlyCost.
+const (
+	// Multi-AZ file system with 128 MBps throughput capacity and 1024 GiB of SSD storage.
+	HourlyCostFSxONTAPFileSystem = 1.00
+
+	// Multiplex with two pipelines, charged while running.
+	HourlyCostMediaLiveMultiplex = 2.00
+
+	// Provisioned cluster with three kafka.m5.large brokers.
+	HourlyCostMSKCluster = 0.65
+)
+
+// PreCheckHourlyCost skips the test if its estimated hourly cost, in USD, exceeds the budget
+// set in the TF_ACC_MAX_HOURLY_COST environment variable. No tests are skipped if the
+// environment variable is not set.
+func PreCheckHourlyCost(t *testing.T, estimatedHourlyCost float64) {
+	t.Helper()
+
+	budget, ok, err := maxHourlyCost()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok && estimatedHourlyCost > budget {
+		t.Skipf("skipping test; estimated hourly cost (%.2f USD) exceeds %s (%.2f USD)", estimatedHourlyCost, envvar.AccMaxHourlyCost, budget)
+	}
+}
+
+func maxHourlyCost() (float64, bool, error) {
+	v := os.Getenv(envvar.AccMaxHourlyCost)
+
+	if v == "" {
+		return 0, false, nil
+	}
+
+	budget, err := strconv.ParseFloat(v, 64)
+
+	if err != nil || budget < 0 {
+		return 0, false, fmt.Errorf("%s must be a non-negative number, got: %s", envvar.AccMaxHourlyCost, v)
+	}
+
+	return budget, true, nil
+}
@@ -0,0 +1,90 @@
+package acctest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestMaxHourlyCost(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectBudget  float64
+		ExpectOK      bool
+		ExpectedError bool
+	}{
+		{
+			Name: "not set",
+		},
+		{
+			Name:         "zero",
+			Value:        "0",
+			ExpectBudget: 0,
+			ExpectOK:     true,
+		},
+		{
+			Name:         "decimal",
+			Value:        "1.25",
+			ExpectBudget: 1.25,
+			ExpectOK:     true,
+		},
+		{
+			Name:          "negative",
+			Value:         "-1",
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid",
+			Value:         "cheap",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(envvar.AccMaxHourlyCost, testCase.Value)
+
+			budget, ok, err := maxHourlyCost()
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ok != testCase.ExpectOK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.ExpectOK)
+			}
+
+			if budget != testCase.ExpectBudget {
+				t.Errorf("got budget %f, expected %f", budget, testCase.ExpectBudget)
+			}
+		})
+	}
+}
+
+func TestPreCheckHourlyCost(t *testing.T) {
+	t.Setenv(envvar.AccMaxHourlyCost, "1")
+
+	t.Run("within budget", func(t *testing.T) {
+		PreCheckHourlyCost(t, HourlyCostMSKCluster)
+
+		if t.Skipped() {
+			t.Error("expected test not to be skipped")
+		}
+	})
+
+	t.Run("exceeds budget", func(t *testing.T) {
+		defer func() {
+			if !t.Skipped() {
+				t.Error("expected test to be skipped")
+			}
+		}()
+
+		PreCheckHourlyCost(t, HourlyCostMediaLiveMultiplex)
+	})
+}
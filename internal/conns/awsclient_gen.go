@@ -19,7 +19,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
 	"github.com/aws/aws-sdk-go/service/amplifyuibuilder"
@@ -73,6 +72,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
 	"github.com/aws/aws-sdk-go/service/codegurureviewer"
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/aws/aws-sdk-go/service/codestar"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
@@ -102,6 +102,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/dlm"
 	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
 	"github.com/aws/aws-sdk-go/service/drs"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
@@ -143,7 +144,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/aws/aws-sdk-go/service/healthlake"
-	"github.com/aws/aws-sdk-go/service/honeycode"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
@@ -187,7 +187,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -208,7 +207,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
 	"github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces"
 	"github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations"
-	"github.com/aws/aws-sdk-go/service/mobile"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mturk"
 	"github.com/aws/aws-sdk-go/service/mwaa"
@@ -220,6 +218,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/osis"
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/aws/aws-sdk-go/service/panorama"
 	"github.com/aws/aws-sdk-go/service/personalize"
@@ -229,6 +228,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/pinpointemail"
 	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/pipes"
 	"github.com/aws/aws-sdk-go/service/polly"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -249,6 +249,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/robomaker"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
 	"github.com/aws/aws-sdk-go/service/route53recoverycluster"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
@@ -290,6 +291,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
 	"github.com/aws/aws-sdk-go/service/timestreamquery"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
@@ -307,6 +309,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/workspacesweb"
 	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/internal/aws-sdk-go/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -317,8 +320,10 @@ type AWSClient struct {
 	DefaultTagsConfig         *tftags.DefaultConfig
 	DNSSuffix                 string
 	IgnoreTagsConfig          *tftags.IgnoreConfig
+	MacieConn                 *macie.Macie
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
+	ProtectResources          []ProtectResourcesPattern
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
@@ -335,7 +340,6 @@ type AWSClient struct {
 	APIGatewayV2Conn                 *apigatewayv2.ApiGatewayV2
 	AccessAnalyzerConn               *accessanalyzer.AccessAnalyzer
 	AccountConn                      *account.Account
-	AlexaForBusinessConn             *alexaforbusiness.AlexaForBusiness
 	AmplifyConn                      *amplify.Amplify
 	AmplifyBackendConn               *amplifybackend.AmplifyBackend
 	AmplifyUIBuilderConn             *amplifyuibuilder.AmplifyUIBuilder
@@ -381,6 +385,7 @@ type AWSClient struct {
 	CodeCommitConn                   *codecommit.CodeCommit
 	CodeGuruProfilerConn             *codeguruprofiler.CodeGuruProfiler
 	CodeGuruReviewerConn             *codegurureviewer.CodeGuruReviewer
+	CodeGuruSecurityConn             *codegurusecurity.CodeGuruSecurity
 	CodePipelineConn                 *codepipeline.CodePipeline
 	CodeStarConn                     *codestar.CodeStar
 	CodeStarConnectionsConn          *codestarconnections.CodeStarConnections
@@ -413,6 +418,7 @@ type AWSClient struct {
 	DirectConnectConn                *directconnect.DirectConnect
 	DiscoveryConn                    *applicationdiscoveryservice.ApplicationDiscoveryService
 	DocDBConn                        *docdb.DocDB
+	DocDBElasticConn                 *docdbelastic.DocDBElastic
 	DynamoDBConn                     *dynamodb.DynamoDB
 	DynamoDBStreamsConn              *dynamodbstreams.DynamoDBStreams
 	EBSConn                          *ebs.EBS
@@ -455,7 +461,6 @@ type AWSClient struct {
 	GuardDutyConn                    *guardduty.GuardDuty
 	HealthConn                       *health.Health
 	HealthLakeConn                   *healthlake.HealthLake
-	HoneycodeConn                    *honeycode.Honeycode
 	IAMConn                          *iam.IAM
 	IVSConn                          *ivs.IVS
 	IdentityStoreConn                *identitystore.Client
@@ -506,7 +511,6 @@ type AWSClient struct {
 	MTurkConn                        *mturk.MTurk
 	MWAAConn                         *mwaa.MWAA
 	MachineLearningConn              *machinelearning.MachineLearning
-	MacieConn                        *macie.Macie
 	Macie2Conn                       *macie2.Macie2
 	ManagedBlockchainConn            *managedblockchain.ManagedBlockchain
 	MarketplaceCatalogConn           *marketplacecatalog.MarketplaceCatalog
@@ -527,12 +531,12 @@ type AWSClient struct {
 	MigrationHubConfigConn           *migrationhubconfig.MigrationHubConfig
 	MigrationHubRefactorSpacesConn   *migrationhubrefactorspaces.MigrationHubRefactorSpaces
 	MigrationHubStrategyConn         *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations
-	MobileConn                       *mobile.Mobile
 	NeptuneConn                      *neptune.Neptune
 	NetworkFirewallConn              *networkfirewall.NetworkFirewall
 	NetworkManagerConn               *networkmanager.NetworkManager
 	NimbleConn                       *nimblestudio.NimbleStudio
 	OpenSearchConn                   *opensearchservice.OpenSearchService
+	OpenSearchIngestionConn          *osis.OSIS
 	OpsWorksConn                     *opsworks.OpsWorks
 	OpsWorksCMConn                   *opsworkscm.OpsWorksCM
 	OrganizationsConn                *organizations.Organizations
@@ -545,6 +549,7 @@ type AWSClient struct {
 	PinpointConn                     *pinpoint.Pinpoint
 	PinpointEmailConn                *pinpointemail.PinpointEmail
 	PinpointSMSVoiceConn             *pinpointsmsvoice.PinpointSMSVoice
+	PipesConn                        *pipes.Pipes
 	PollyConn                        *polly.Polly
 	PricingConn                      *pricing.Pricing
 	ProtonConn                       *proton.Proton
@@ -567,6 +572,7 @@ type AWSClient struct {
 	RolesAnywhereConn                *rolesanywhere.Client
 	Route53Conn                      *route53.Route53
 	Route53DomainsConn               *route53domains.Client
+	Route53ProfilesConn              *route53profiles.Route53Profiles
 	Route53RecoveryClusterConn       *route53recoverycluster.Route53RecoveryCluster
 	Route53RecoveryControlConfigConn *route53recoverycontrolconfig.Route53RecoveryControlConfig
 	Route53RecoveryReadinessConn     *route53recoveryreadiness.Route53RecoveryReadiness
@@ -611,6 +617,7 @@ type AWSClient struct {
 	SupportConn                      *support.Support
 	SyntheticsConn                   *synthetics.Synthetics
 	TextractConn                     *textract.Textract
+	TimestreamInfluxDBConn           *timestreaminfluxdb.TimestreamInfluxDB
 	TimestreamQueryConn              *timestreamquery.TimestreamQuery
 	TimestreamWriteConn              *timestreamwrite.TimestreamWrite
 	TranscribeConn                   *transcribe.Client
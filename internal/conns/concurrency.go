@@ -0,0 +1,84 @@
+package conns
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const serviceConcurrencyHandlerName = "terraform-provider-aws.ServiceConcurrency"
+
+// configureServiceConcurrency limits the number of in-flight API requests made by each service's
+// AWS SDK for Go v1 client.
+// The limits are keyed by provider package name (e.g. "route53") and apply across all resources.
+func configureServiceConcurrency(awsClient *AWSClient, limits map[string]int) error {
+	v := reflect.ValueOf(awsClient).Elem()
+
+	for pkg, limit := range limits {
+		if limit < 1 {
+			return fmt.Errorf("service_concurrency (%s): limit must be at least 1, got %d", pkg, limit)
+		}
+
+		providerNameUpper, err := names.ProviderNameUpper(pkg)
+
+		if err != nil {
+			return fmt.Errorf("service_concurrency (%s): %w", pkg, err)
+		}
+
+		c, ok := serviceClientV1(v.FieldByName(providerNameUpper + "Conn"))
+
+		if !ok {
+			return fmt.Errorf("service_concurrency (%s): service does not use AWS SDK for Go v1 and is not supported", pkg)
+		}
+
+		if !limitRequestConcurrency(&c.Handlers, limit) {
+			return fmt.Errorf("service_concurrency (%s): unable to install request handler", pkg)
+		}
+	}
+
+	return nil
+}
+
+// serviceClientV1 returns the AWS SDK for Go v1 client embedded in the specified service client.
+func serviceClientV1(v reflect.Value) (*client.Client, bool) {
+	if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	f := v.Elem().FieldByName("Client")
+
+	if !f.IsValid() {
+		return nil, false
+	}
+
+	c, ok := f.Interface().(*client.Client)
+
+	return c, ok && c != nil
+}
+
+// limitRequestConcurrency wraps the handler that sends HTTP requests so that at most limit requests are sent concurrently.
+// Only the send of each attempt holds the semaphore; retry delays do not.
+func limitRequestConcurrency(handlers *request.Handlers, limit int) bool {
+	sem := make(chan struct{}, limit)
+
+	return handlers.Send.Swap(corehandlers.SendHandler.Name, request.NamedHandler{
+		Name: serviceConcurrencyHandlerName,
+		Fn: func(r *request.Request) {
+			select {
+			case sem <- struct{}{}:
+			case <-r.Context().Done():
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting to send", r.Context().Err())
+				return
+			}
+
+			defer func() { <-sem }()
+
+			corehandlers.SendHandler.Fn(r)
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func TestConfigureServiceConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(50 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(`<ListHostedZonesResponse><HostedZones></HostedZones><IsTruncated>false</IsTruncated><MaxItems>100</MaxItems></ListHostedZonesResponse>`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(ts.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	}))
+
+	client := &AWSClient{
+		Route53Conn: route53.New(sess),
+	}
+
+	if err := configureServiceConcurrency(client, map[string]int{"route53": 2}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := client.Route53Conn.ListHostedZones(&route53.ListHostedZonesInput{}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&maxInFlight), int32(2); got > want {
+		t.Errorf("got %d concurrent requests, want at most %d", got, want)
+	}
+}
+
+func TestConfigureServiceConcurrency_errors(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	}))
+
+	testCases := []struct {
+		Name   string
+		Limits map[string]int
+	}{
+		{
+			Name:   "zero limit",
+			Limits: map[string]int{"sqs": 0},
+		},
+		{
+			Name:   "unknown service",
+			Limits: map[string]int{"notaservice": 1},
+		},
+		{
+			Name:   "unconfigured client",
+			Limits: map[string]int{"route53": 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &AWSClient{
+				SQSConn: sqs.New(sess),
+			}
+
+			if err := configureServiceConcurrency(client, testCase.Limits); err == nil {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
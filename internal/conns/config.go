@@ -49,6 +49,7 @@ import (
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/aws-sdk-go/service/macie"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -70,9 +71,11 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	ProtectResources               []string
 	Region                         string
 	S3UsePathStyle                 bool
 	SecretKey                      string
+	ServiceConcurrency             map[string]int
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -89,6 +92,11 @@ type Config struct {
 
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
+	protectResources, err := CompileProtectResourcesPatterns(c.ProtectResources)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		APNInfo:                       StdUserAgentProducts(c.TerraformVersion),
@@ -196,6 +204,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
+	client.ProtectResources = protectResources
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
@@ -281,6 +290,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.STSConn = sts.New(sess.Copy(stsConfig))
 
+	// Macie Classic has been retired by AWS and its client is no longer part of the AWS SDK for Go.
+	client.MacieConn = macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[macie.EndpointsID])}))
+
 	// "Global" services that require customizations
 	globalAcceleratorConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints[names.GlobalAccelerator]),
@@ -591,6 +603,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	})
 
+	if len(c.ServiceConcurrency) > 0 {
+		if err := configureServiceConcurrency(client, c.ServiceConcurrency); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
 		if err != nil {
@@ -0,0 +1,60 @@
+package conns
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ProtectResourcesPattern is a compiled protect_resources pattern.
+type ProtectResourcesPattern struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// String returns the pattern as configured.
+func (p ProtectResourcesPattern) String() string {
+	return p.pattern
+}
+
+// MatchString returns whether the pattern matches the whole of s.
+func (p ProtectResourcesPattern) MatchString(s string) bool {
+	return p.re.MatchString(s)
+}
+
+// CompileProtectResourcesPatterns compiles the provider's protect_resources patterns.
+// Patterns may contain the wildcards '*' (any sequence of characters) and '?' (any single character).
+func CompileProtectResourcesPatterns(patterns []string) ([]ProtectResourcesPattern, error) {
+	var compiled []ProtectResourcesPattern
+
+	for _, pattern := range patterns {
+		if pattern == "" {
+			return nil, fmt.Errorf("protect_resources: patterns cannot be empty")
+		}
+
+		var sb strings.Builder
+
+		sb.WriteString("^")
+		for _, r := range pattern {
+			switch r {
+			case '*':
+				sb.WriteString(".*")
+			case '?':
+				sb.WriteString(".")
+			default:
+				sb.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		}
+		sb.WriteString("$")
+
+		re, err := regexp.Compile(sb.String())
+
+		if err != nil {
+			return nil, fmt.Errorf("protect_resources: invalid pattern %q: %w", pattern, err)
+		}
+
+		compiled = append(compiled, ProtectResourcesPattern{pattern: pattern, re: re})
+	}
+
+	return compiled, nil
+}
@@ -0,0 +1,58 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestCompileProtectResourcesPatterns(t *testing.T) {
+	testCases := []struct {
+		name          string
+		patterns      []string
+		expectedError bool
+	}{
+		{
+			name: "no patterns",
+		},
+		{
+			name:     "wildcards",
+			patterns: []string{"aws_rds_*", "arn:aws:s3:::prod-*", "prod-?"},
+		},
+		{
+			name:     "regular expression metacharacters",
+			patterns: []string{"prod.db[1]", "(a|b)+"},
+		},
+		{
+			name:          "empty pattern",
+			patterns:      []string{"aws_db_instance", ""},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			compiled, err := CompileProtectResourcesPatterns(testCase.patterns)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := len(compiled), len(testCase.patterns); got != want {
+				t.Fatalf("compiled %d patterns, want %d", got, want)
+			}
+
+			for i, pattern := range compiled {
+				if got, want := pattern.String(), testCase.patterns[i]; got != want {
+					t.Errorf("pattern = %q, want %q", got, want)
+				}
+
+				if !pattern.MatchString(testCase.patterns[i]) {
+					t.Errorf("pattern %q does not match itself", testCase.patterns[i])
+				}
+			}
+		})
+	}
+}
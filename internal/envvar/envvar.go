@@ -54,6 +54,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For limiting acceptance testing spend, the maximum estimated hourly cost (in USD) of a test
+	// Tests annotated with a higher estimated hourly cost are skipped
+	AccMaxHourlyCost = "TF_ACC_MAX_HOURLY_COST"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
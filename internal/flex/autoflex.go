@@ -0,0 +1,599 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AutoFlex maps between Terraform Plugin SDK v2 values and AWS SDK for Go
+// API structures using reflection, so that expanders and flatteners for
+// structures that follow the provider's naming conventions need not be written
+// by hand.
+//
+// An API structure field is matched to the Terraform attribute whose name is the
+// field name in snake case (e.g. IPSetReferenceStatement is matched to
+// ip_set_reference_statement). Repeated blocks are named in the singular, so a
+// trailing "s" is dropped for lists of nested structures (e.g. TextTransformations
+// is matched to text_transformation). Fields that do not follow the conventions
+// can be renamed with WithFieldNameOverride or skipped with WithIgnoredFieldName.
+//
+// Nested structures are represented as blocks, i.e. lists (or sets) of maps.
+// When expanding, empty strings are treated as unset. When flattening, nil
+// fields and fields without a corresponding attribute in the schema are omitted.
+
+var timeType = reflect.TypeOf(time.Time{})
+
+type autoFlexOptions struct {
+	fieldNames    map[string]string
+	ignoredFields map[string]struct{}
+}
+
+// AutoFlexOptionsFunc configures the behavior of Expand and Flatten.
+type AutoFlexOptionsFunc func(*autoFlexOptions)
+
+// WithFieldNameOverride matches the API structure field fieldName to the
+// Terraform attribute attributeName at any level of nesting.
+func WithFieldNameOverride(fieldName, attributeName string) AutoFlexOptionsFunc {
+	return func(o *autoFlexOptions) {
+		o.fieldNames[fieldName] = attributeName
+	}
+}
+
+// WithIgnoredFieldName skips the API structure field fieldName at any level of nesting.
+func WithIgnoredFieldName(fieldName string) AutoFlexOptionsFunc {
+	return func(o *autoFlexOptions) {
+		o.ignoredFields[fieldName] = struct{}{}
+	}
+}
+
+func newAutoFlexOptions(optFns []AutoFlexOptionsFunc) *autoFlexOptions {
+	o := &autoFlexOptions{
+		fieldNames:    make(map[string]string),
+		ignoredFields: make(map[string]struct{}),
+	}
+
+	for _, optFn := range optFns {
+		optFn(o)
+	}
+
+	return o
+}
+
+// Expand populates the value pointed to by apiObject from the Terraform value tfValue.
+// apiObject is typically a pointer to a structure pointer (for a block) or to a slice
+// of structures or structure pointers (for a repeated block), and tfValue the corresponding
+// map[string]interface{}, []interface{} or *schema.Set.
+func Expand(tfValue interface{}, apiObject interface{}, optFns ...AutoFlexOptionsFunc) error {
+	to := reflect.ValueOf(apiObject)
+
+	if to.Kind() != reflect.Pointer || to.IsNil() {
+		return fmt.Errorf("expanding: target must be a non-nil pointer, got %T", apiObject)
+	}
+
+	return newAutoFlexOptions(optFns).expand(tfValue, to.Elem())
+}
+
+// Flatten returns the Terraform value for the attribute described by tfSchema from apiObject.
+// A nil apiObject is flattened to an empty list for blocks and to nil otherwise.
+func Flatten(apiObject interface{}, tfSchema *schema.Schema, optFns ...AutoFlexOptionsFunc) (interface{}, error) {
+	from := reflect.ValueOf(apiObject)
+
+	if !from.IsValid() {
+		return flattenedZero(tfSchema), nil
+	}
+
+	tfValue, ok, err := newAutoFlexOptions(optFns).flatten(from, tfSchema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return flattenedZero(tfSchema), nil
+	}
+
+	return tfValue, nil
+}
+
+func flattenedZero(tfSchema *schema.Schema) interface{} {
+	switch tfSchema.Type {
+	case schema.TypeList, schema.TypeSet:
+		return []interface{}{}
+	default:
+		return nil
+	}
+}
+
+func (o *autoFlexOptions) attributeName(field reflect.StructField) string {
+	if v, ok := o.fieldNames[field.Name]; ok {
+		return v
+	}
+
+	name := snakeCase(field.Name)
+
+	if isStructSlice(field.Type) {
+		name = singular(name)
+	}
+
+	return name
+}
+
+func (o *autoFlexOptions) ignored(field reflect.StructField) bool {
+	if !field.IsExported() {
+		return true
+	}
+
+	_, ok := o.ignoredFields[field.Name]
+
+	return ok
+}
+
+func (o *autoFlexOptions) expand(tfValue interface{}, to reflect.Value) error {
+	if tfValue == nil {
+		return nil
+	}
+
+	switch to.Kind() {
+	case reflect.Pointer:
+		elemType := to.Type().Elem()
+
+		if isStruct(elemType) {
+			// A block is a list with at most one element.
+			tfList, err := asList(tfValue)
+
+			if err != nil {
+				return err
+			}
+
+			if len(tfList) == 0 {
+				return nil
+			}
+
+			v := reflect.New(elemType)
+
+			if err := o.expand(tfList[0], v.Elem()); err != nil {
+				return err
+			}
+
+			to.Set(v)
+
+			return nil
+		}
+
+		v := reflect.New(elemType)
+		ok, err := o.expand1(tfValue, v.Elem())
+
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			to.Set(v)
+		}
+
+		return nil
+
+	case reflect.Struct:
+		if to.Type() == timeType {
+			_, err := o.expand1(tfValue, to)
+
+			return err
+		}
+
+		tfMap, ok := tfValue.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("expanding %s: expected map[string]interface{}, got %T", to.Type(), tfValue)
+		}
+
+		for i := 0; i < to.NumField(); i++ {
+			field := to.Type().Field(i)
+
+			if o.ignored(field) {
+				continue
+			}
+
+			name := o.attributeName(field)
+			v, ok := tfMap[name]
+
+			if !ok {
+				continue
+			}
+
+			if err := o.expand(v, to.Field(i)); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+
+		return nil
+
+	case reflect.Slice:
+		if to.Type().Elem().Kind() == reflect.Uint8 {
+			_, err := o.expand1(tfValue, to)
+
+			return err
+		}
+
+		tfList, err := asList(tfValue)
+
+		if err != nil {
+			return err
+		}
+
+		if len(tfList) == 0 {
+			return nil
+		}
+
+		elemType := to.Type().Elem()
+		s := reflect.MakeSlice(to.Type(), 0, len(tfList))
+
+		for _, tfElem := range tfList {
+			if elemType.Kind() == reflect.Pointer && isStruct(elemType.Elem()) {
+				v := reflect.New(elemType.Elem())
+
+				if err := o.expand(tfElem, v.Elem()); err != nil {
+					return err
+				}
+
+				s = reflect.Append(s, v)
+
+				continue
+			}
+
+			v := reflect.New(elemType).Elem()
+
+			if err := o.expand(tfElem, v); err != nil {
+				return err
+			}
+
+			if !v.IsZero() {
+				s = reflect.Append(s, v)
+			}
+		}
+
+		to.Set(s)
+
+		return nil
+
+	case reflect.Map:
+		if to.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("expanding %s: unsupported map key type", to.Type())
+		}
+
+		tfMap, ok := tfValue.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("expanding %s: expected map[string]interface{}, got %T", to.Type(), tfValue)
+		}
+
+		if len(tfMap) == 0 {
+			return nil
+		}
+
+		m := reflect.MakeMapWithSize(to.Type(), len(tfMap))
+
+		for k, tfElem := range tfMap {
+			v := reflect.New(to.Type().Elem()).Elem()
+
+			if err := o.expand(tfElem, v); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+
+			m.SetMapIndex(reflect.ValueOf(k), v)
+		}
+
+		to.Set(m)
+
+		return nil
+
+	default:
+		_, err := o.expand1(tfValue, to)
+
+		return err
+	}
+}
+
+// expand1 sets the scalar to from tfValue, reporting whether a value was set.
+func (o *autoFlexOptions) expand1(tfValue interface{}, to reflect.Value) (bool, error) {
+	from := reflect.ValueOf(tfValue)
+
+	switch to.Kind() {
+	case reflect.String:
+		if from.Kind() != reflect.String {
+			break
+		}
+
+		if from.String() == "" {
+			return false, nil
+		}
+
+		to.SetString(from.String())
+
+		return true, nil
+
+	case reflect.Bool:
+		if from.Kind() != reflect.Bool {
+			break
+		}
+
+		to.SetBool(from.Bool())
+
+		return true, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !from.CanInt() {
+			break
+		}
+
+		to.SetInt(from.Int())
+
+		return true, nil
+
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case from.CanFloat():
+			to.SetFloat(from.Float())
+		case from.CanInt():
+			to.SetFloat(float64(from.Int()))
+		default:
+			return false, fmt.Errorf("expanding %s: unsupported value type %T", to.Type(), tfValue)
+		}
+
+		return true, nil
+
+	case reflect.Slice:
+		// Blobs.
+		if from.Kind() != reflect.String {
+			break
+		}
+
+		if from.String() == "" {
+			return false, nil
+		}
+
+		to.SetBytes([]byte(from.String()))
+
+		return true, nil
+
+	case reflect.Struct:
+		if to.Type() != timeType || from.Kind() != reflect.String {
+			break
+		}
+
+		if from.String() == "" {
+			return false, nil
+		}
+
+		t, err := time.Parse(time.RFC3339, from.String())
+
+		if err != nil {
+			return false, fmt.Errorf("expanding %s: %w", to.Type(), err)
+		}
+
+		to.Set(reflect.ValueOf(t))
+
+		return true, nil
+	}
+
+	return false, fmt.Errorf("expanding %s: unsupported value type %T", to.Type(), tfValue)
+}
+
+func (o *autoFlexOptions) flatten(from reflect.Value, tfSchema *schema.Schema) (interface{}, bool, error) {
+	switch from.Kind() {
+	case reflect.Pointer:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		if isStruct(from.Type().Elem()) {
+			tfMap, err := o.flattenStruct(from.Elem(), tfSchema)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []interface{}{tfMap}, true, nil
+		}
+
+		return o.flatten(from.Elem(), tfSchema)
+
+	case reflect.Interface:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		return o.flatten(from.Elem(), tfSchema)
+
+	case reflect.Slice:
+		if from.Len() == 0 {
+			return nil, false, nil
+		}
+
+		if from.Type().Elem().Kind() == reflect.Uint8 {
+			return string(from.Bytes()), true, nil
+		}
+
+		tfList := make([]interface{}, 0, from.Len())
+
+		for i := 0; i < from.Len(); i++ {
+			elem := from.Index(i)
+
+			if elem.Kind() == reflect.Pointer && !elem.IsNil() && isStruct(elem.Type().Elem()) {
+				elem = elem.Elem()
+			}
+
+			if isStruct(elem.Type()) {
+				tfMap, err := o.flattenStruct(elem, tfSchema)
+
+				if err != nil {
+					return nil, false, err
+				}
+
+				tfList = append(tfList, tfMap)
+
+				continue
+			}
+
+			v, ok, err := o.flatten(elem, nil)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				tfList = append(tfList, v)
+			}
+		}
+
+		return tfList, true, nil
+
+	case reflect.Map:
+		if from.Len() == 0 {
+			return nil, false, nil
+		}
+
+		tfMap := make(map[string]interface{}, from.Len())
+		iter := from.MapRange()
+
+		for iter.Next() {
+			v, ok, err := o.flatten(iter.Value(), nil)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				tfMap[iter.Key().String()] = v
+			}
+		}
+
+		return tfMap, true, nil
+
+	case reflect.String:
+		return from.String(), true, nil
+
+	case reflect.Bool:
+		return from.Bool(), true, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(from.Int()), true, nil
+
+	case reflect.Float32, reflect.Float64:
+		return from.Float(), true, nil
+
+	case reflect.Struct:
+		if from.Type() == timeType {
+			return from.Interface().(time.Time).Format(time.RFC3339), true, nil
+		}
+	}
+
+	return nil, false, fmt.Errorf("flattening %s: unsupported type", from.Type())
+}
+
+func (o *autoFlexOptions) flattenStruct(from reflect.Value, tfSchema *schema.Schema) (map[string]interface{}, error) {
+	if tfSchema == nil {
+		return nil, fmt.Errorf("flattening %s: no schema", from.Type())
+	}
+
+	r, ok := tfSchema.Elem.(*schema.Resource)
+
+	if !ok {
+		return nil, fmt.Errorf("flattening %s: schema is not a block", from.Type())
+	}
+
+	tfMap := make(map[string]interface{})
+
+	for i := 0; i < from.NumField(); i++ {
+		field := from.Type().Field(i)
+
+		if o.ignored(field) {
+			continue
+		}
+
+		name := o.attributeName(field)
+		attrSchema, ok := r.Schema[name]
+
+		if !ok {
+			continue
+		}
+
+		v, ok, err := o.flatten(from.Field(i), attrSchema)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if ok {
+			tfMap[name] = v
+		}
+	}
+
+	return tfMap, nil
+}
+
+func asList(tfValue interface{}) ([]interface{}, error) {
+	switch v := tfValue.(type) {
+	case []interface{}:
+		return v, nil
+	case *schema.Set:
+		return v.List(), nil
+	default:
+		return nil, fmt.Errorf("expected []interface{} or *schema.Set, got %T", tfValue)
+	}
+}
+
+func isStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+func isStructSlice(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice {
+		return false
+	}
+
+	elemType := t.Elem()
+
+	if elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+
+	return isStruct(elemType)
+}
+
+// snakeCase converts a Go identifier to snake case, keeping acronyms together
+// (e.g. IPSetForwardedIPConfig becomes ip_set_forwarded_ip_config).
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+					b.WriteRune('_')
+				}
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func singular(s string) string {
+	if strings.HasSuffix(s, "ss") {
+		return s
+	}
+
+	return strings.TrimSuffix(s, "s")
+}
@@ -0,0 +1,284 @@
+package flex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type autoFlexTestHeader struct {
+	_ struct{} `type:"structure"`
+
+	Name  *string
+	Value *string
+}
+
+type autoFlexTestConfig struct {
+	_ struct{} `type:"structure"`
+
+	CloudWatchEnabled *bool
+	CreatedAt         *time.Time
+	Headers           []*autoFlexTestHeader
+	IPAddress         *string
+	Ignored           *string
+	Labels            map[string]*string
+	MaxSize           *int64
+	Payload           []byte
+	Ratio             *float64
+	Values            []*string
+}
+
+func autoFlexTestSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cloudwatch_enabled": {Type: schema.TypeBool},
+				"created_at":         {Type: schema.TypeString},
+				"header": {
+					Type: schema.TypeSet,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name":  {Type: schema.TypeString},
+							"value": {Type: schema.TypeString},
+						},
+					},
+				},
+				"ip_address": {Type: schema.TypeString},
+				"labels":     {Type: schema.TypeMap, Elem: &schema.Schema{Type: schema.TypeString}},
+				"max_size":   {Type: schema.TypeInt},
+				"payload":    {Type: schema.TypeString},
+				"ratio":      {Type: schema.TypeFloat},
+				"values":     {Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+		},
+	}
+}
+
+func autoFlexTestOptions() []AutoFlexOptionsFunc {
+	return []AutoFlexOptionsFunc{
+		WithFieldNameOverride("CloudWatchEnabled", "cloudwatch_enabled"),
+		WithIgnoredFieldName("Ignored"),
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"Name":                     "name",
+		"ARN":                      "arn",
+		"IPSetReferenceStatement":  "ip_set_reference_statement",
+		"IPSetForwardedIPConfig":   "ip_set_forwarded_ip_config",
+		"CloudWatchMetricsEnabled": "cloud_watch_metrics_enabled",
+		"DBInstanceIdentifier":     "db_instance_identifier",
+		"Ec2InstanceId":            "ec2_instance_id",
+	}
+
+	for input, expected := range testCases {
+		if got := snakeCase(input); got != expected {
+			t.Errorf("snakeCase(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	createdAt := time.Date(2022, 11, 1, 10, 30, 0, 0, time.UTC)
+	h := schema.HashResource(autoFlexTestSchema().Elem.(*schema.Resource).Schema["header"].Elem.(*schema.Resource))
+
+	testCases := []struct {
+		Name     string
+		TFValue  interface{}
+		Expected *autoFlexTestConfig
+	}{
+		{
+			Name:     "empty list",
+			TFValue:  []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name:     "empty block",
+			TFValue:  []interface{}{nil},
+			Expected: &autoFlexTestConfig{},
+		},
+		{
+			Name: "empty values",
+			TFValue: []interface{}{map[string]interface{}{
+				"cloudwatch_enabled": false,
+				"created_at":         "",
+				"header":             schema.NewSet(h, []interface{}{}),
+				"ip_address":         "",
+				"ignored":            "ignored",
+				"labels":             map[string]interface{}{},
+				"max_size":           0,
+				"payload":            "",
+				"ratio":              0.0,
+				"values":             []interface{}{},
+			}},
+			Expected: &autoFlexTestConfig{
+				CloudWatchEnabled: aws.Bool(false),
+				MaxSize:           aws.Int64(0),
+				Ratio:             aws.Float64(0),
+			},
+		},
+		{
+			Name: "full",
+			TFValue: []interface{}{map[string]interface{}{
+				"cloudwatch_enabled": true,
+				"created_at":         "2022-11-01T10:30:00Z",
+				"header": schema.NewSet(h, []interface{}{
+					map[string]interface{}{"name": "x-test", "value": "v1"},
+				}),
+				"ip_address": "10.0.0.1",
+				"ignored":    "ignored",
+				"labels":     map[string]interface{}{"k1": "v1"},
+				"max_size":   42,
+				"payload":    "data",
+				"ratio":      0.5,
+				"values":     []interface{}{"a", "", "b"},
+			}},
+			Expected: &autoFlexTestConfig{
+				CloudWatchEnabled: aws.Bool(true),
+				CreatedAt:         aws.Time(createdAt),
+				Headers: []*autoFlexTestHeader{
+					{Name: aws.String("x-test"), Value: aws.String("v1")},
+				},
+				IPAddress: aws.String("10.0.0.1"),
+				Labels:    map[string]*string{"k1": aws.String("v1")},
+				MaxSize:   aws.Int64(42),
+				Payload:   []byte("data"),
+				Ratio:     aws.Float64(0.5),
+				Values:    []*string{aws.String("a"), aws.String("b")},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got *autoFlexTestConfig
+
+			if err := Expand(testCase.TFValue, &got, autoFlexTestOptions()...); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	var got *autoFlexTestConfig
+
+	if err := Expand([]interface{}{map[string]interface{}{"max_size": "42"}}, &got); err == nil {
+		t.Error("expected error for mismatched type, got none")
+	}
+
+	if err := Expand([]interface{}{}, got); err == nil {
+		t.Error("expected error for non-pointer target, got none")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	createdAt := time.Date(2022, 11, 1, 10, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name      string
+		APIObject *autoFlexTestConfig
+		Expected  interface{}
+	}{
+		{
+			Name:      "nil",
+			APIObject: nil,
+			Expected:  []interface{}{},
+		},
+		{
+			Name:      "empty",
+			APIObject: &autoFlexTestConfig{},
+			Expected:  []interface{}{map[string]interface{}{}},
+		},
+		{
+			Name: "full",
+			APIObject: &autoFlexTestConfig{
+				CloudWatchEnabled: aws.Bool(true),
+				CreatedAt:         aws.Time(createdAt),
+				Headers: []*autoFlexTestHeader{
+					{Name: aws.String("x-test"), Value: aws.String("v1")},
+				},
+				IPAddress: aws.String("10.0.0.1"),
+				Ignored:   aws.String("ignored"),
+				Labels:    map[string]*string{"k1": aws.String("v1")},
+				MaxSize:   aws.Int64(42),
+				Payload:   []byte("data"),
+				Ratio:     aws.Float64(0.5),
+				Values:    []*string{aws.String("a"), aws.String("b")},
+			},
+			Expected: []interface{}{map[string]interface{}{
+				"cloudwatch_enabled": true,
+				"created_at":         "2022-11-01T10:30:00Z",
+				"header": []interface{}{
+					map[string]interface{}{"name": "x-test", "value": "v1"},
+				},
+				"ip_address": "10.0.0.1",
+				"labels":     map[string]interface{}{"k1": "v1"},
+				"max_size":   42,
+				"payload":    "data",
+				"ratio":      0.5,
+				"values":     []interface{}{"a", "b"},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := Flatten(testCase.APIObject, autoFlexTestSchema(), autoFlexTestOptions()...)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestValueStructSlice(t *testing.T) {
+	tfSchema := autoFlexTestSchema().Elem.(*schema.Resource).Schema["header"]
+	tfValue := []interface{}{
+		map[string]interface{}{"name": "x-test", "value": "v1"},
+		map[string]interface{}{"name": "x-other", "value": ""},
+	}
+	apiObjects := []autoFlexTestHeader{
+		{Name: aws.String("x-test"), Value: aws.String("v1")},
+		{Name: aws.String("x-other")},
+	}
+
+	var got []autoFlexTestHeader
+
+	if err := Expand(tfValue, &got); err != nil {
+		t.Fatalf("unexpected error expanding: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, apiObjects) {
+		t.Errorf("Expand got:\n\n%#v\n\nExpected:\n\n%#v\n", got, apiObjects)
+	}
+
+	flattened, err := Flatten(apiObjects, tfSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error flattening: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"name": "x-test", "value": "v1"},
+		map[string]interface{}{"name": "x-other"},
+	}
+
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Errorf("Flatten got:\n\n%#v\n\nExpected:\n\n%#v\n", flattened, expected)
+	}
+}
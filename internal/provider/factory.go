@@ -29,5 +29,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, err
 	}
 
-	return muxServer.ProviderServer, nil
+	return func() tfprotov5.ProviderServer {
+		return newProtectResourcesProviderServer(muxServer.ProviderServer(), primary.Meta)
+	}, nil
 }
@@ -87,6 +87,11 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"protect_resources": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Resource types, ARNs or IDs (which may contain '*' and '?' wildcards) of resources\nthat must not be destroyed or replaced.",
+			},
 			"region": {
 				Type:        types.StringType,
 				Optional:    true,
@@ -108,6 +113,11 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency": {
+				Type:        types.MapType{ElemType: types.Int64Type},
+				Optional:    true,
+				Description: "Maximum number of concurrent API requests per AWS service, keyed by service name\n(e.g. route53 or ec2).",
+			},
 			"shared_config_files": {
 				Type:        types.ListType{ElemType: types.StringType},
 				Optional:    true,
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// protectResources wraps each resource so that destruction of any resource matched by the
+// provider's protect_resources configuration fails.
+// Replacements are rejected at plan time by a CustomizeDiff interceptor.
+// Terraform does not call CustomizeDiff when planning a destroy, so destroys are rejected at plan time
+// by protectResourcesProviderServer and, for Terraform versions that do not plan destroys with the provider,
+// when the resource's Delete function is called.
+func protectResources(resources map[string]*schema.Resource) {
+	for typeName, r := range resources {
+		protectResource(typeName, r)
+	}
+}
+
+func protectResource(typeName string, r *schema.Resource) {
+	protectCustomizeDiff := func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		if !resourceDiffRequiresReplacement(r.Schema, d) {
+			return nil
+		}
+
+		var arn string
+		if _, ok := r.Schema["arn"]; ok {
+			o, _ := d.GetChange("arn")
+			arn, _ = o.(string)
+		}
+
+		return protectedResourceError(meta, typeName, arn, d.Id(), "replaced")
+	}
+
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = protectCustomizeDiff
+	} else {
+		r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, protectCustomizeDiff)
+	}
+
+	arn := func(d *schema.ResourceData) string {
+		if _, ok := r.Schema["arn"]; !ok {
+			return ""
+		}
+
+		return d.Get("arn").(string)
+	}
+
+	if f := r.Delete; f != nil { //nolint:staticcheck // Resources still using the deprecated Delete function must be protected too.
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			if err := protectedResourceError(meta, typeName, arn(d), d.Id(), "destroyed"); err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := protectedResourceError(meta, typeName, arn(d), d.Id(), "destroyed"); err != nil {
+				return diag.FromErr(err)
+			}
+
+			return f(ctx, d, meta)
+		}
+	}
+
+	if f := r.DeleteWithoutTimeout; f != nil {
+		r.DeleteWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := protectedResourceError(meta, typeName, arn(d), d.Id(), "destroyed"); err != nil {
+				return diag.FromErr(err)
+			}
+
+			return f(ctx, d, meta)
+		}
+	}
+}
+
+// protectResourcesProviderServer wraps a provider server so that plans to destroy any resource matched by the
+// provider's protect_resources configuration fail.
+// It advertises the PlanDestroy server capability, so Terraform v1.3 and later call PlanResourceChange for destroys.
+type protectResourcesProviderServer struct {
+	tfprotov5.ProviderServer
+
+	meta func() interface{}
+
+	mu      sync.Mutex
+	schemas map[string]*tfprotov5.Schema
+}
+
+func newProtectResourcesProviderServer(server tfprotov5.ProviderServer, meta func() interface{}) tfprotov5.ProviderServer {
+	return &protectResourcesProviderServer{
+		ProviderServer: server,
+		meta:           meta,
+	}
+}
+
+func (s *protectResourcesProviderServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	s.mu.Lock()
+	s.schemas = resp.ResourceSchemas
+	s.mu.Unlock()
+
+	if resp.ServerCapabilities == nil {
+		resp.ServerCapabilities = &tfprotov5.ServerCapabilities{}
+	}
+	resp.ServerCapabilities.PlanDestroy = true
+
+	return resp, nil
+}
+
+func (s *protectResourcesProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if err := s.protectDestroy(req); err != nil {
+		return &tfprotov5.PlanResourceChangeResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	return s.ProviderServer.PlanResourceChange(ctx, req)
+}
+
+// protectDestroy returns an error if the request is a plan to destroy a protected resource.
+func (s *protectResourcesProviderServer) protectDestroy(req *tfprotov5.PlanResourceChangeRequest) error {
+	s.mu.Lock()
+	resourceSchema, ok := s.schemas[req.TypeName]
+	s.mu.Unlock()
+
+	if !ok || req.ProposedNewState == nil || req.PriorState == nil {
+		return nil
+	}
+
+	typ := resourceSchema.ValueType()
+
+	proposedNewState, err := req.ProposedNewState.Unmarshal(typ)
+
+	if err != nil || !proposedNewState.IsNull() {
+		return nil
+	}
+
+	priorState, err := req.PriorState.Unmarshal(typ)
+
+	if err != nil || priorState.IsNull() {
+		return nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := priorState.As(&attributes); err != nil {
+		return nil
+	}
+
+	stringAttribute := func(name string) string {
+		var v string
+
+		if a, ok := attributes[name]; ok && a.IsKnown() && !a.IsNull() {
+			_ = a.As(&v)
+		}
+
+		return v
+	}
+
+	return protectedResourceError(s.meta(), req.TypeName, stringAttribute("arn"), stringAttribute("id"), "destroyed")
+}
+
+// protectedResourceError returns an error if the resource matches any of the configured protect_resources patterns.
+func protectedResourceError(meta interface{}, typeName, arn, id, action string) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || client == nil {
+		return nil
+	}
+
+	pattern, ok := matchProtectResources(client.ProtectResources, typeName, arn, id)
+
+	if !ok {
+		return nil
+	}
+
+	return fmt.Errorf("%s (%s) matches provider protect_resources pattern %q and cannot be %s; remove the pattern from the provider configuration to allow this", typeName, id, pattern, action)
+}
+
+// matchProtectResources returns the first pattern that matches the resource type name, ARN or ID.
+func matchProtectResources(patterns []conns.ProtectResourcesPattern, typeName, arn, id string) (string, bool) {
+	for _, pattern := range patterns {
+		for _, v := range []string{typeName, arn, id} {
+			if v != "" && pattern.MatchString(v) {
+				return pattern.String(), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// resourceDiffRequiresReplacement returns whether any changed attribute is ForceNew.
+// Replacements forced from another CustomizeDiff function via ResourceDiff.ForceNew are not detected.
+func resourceDiffRequiresReplacement(s map[string]*schema.Schema, d *schema.ResourceDiff) bool {
+	for _, k := range d.GetChangedKeysPrefix("") {
+		if schemaPathForceNew(s, strings.Split(k, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// schemaPathForceNew returns whether the attribute at the specified flatmap path, or any of its ancestors, is ForceNew.
+func schemaPathForceNew(s map[string]*schema.Schema, path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+
+	v, ok := s[path[0]]
+
+	if !ok {
+		return false
+	}
+
+	if v.ForceNew {
+		return true
+	}
+
+	elem, ok := v.Elem.(*schema.Resource)
+
+	if !ok || len(path) < 3 {
+		return false
+	}
+
+	// Skip the list index or set hash.
+	if _, err := strconv.Atoi(path[1]); err != nil {
+		return false
+	}
+
+	return schemaPathForceNew(elem.Schema, path[2:])
+}
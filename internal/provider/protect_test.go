@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestMatchProtectResources(t *testing.T) {
+	testCases := []struct {
+		name            string
+		patterns        []string
+		typeName        string
+		arn             string
+		id              string
+		expectedMatch   bool
+		expectedPattern string
+	}{
+		{
+			name:     "no patterns",
+			typeName: "aws_db_instance",
+			id:       "prod",
+		},
+		{
+			name:            "resource type",
+			patterns:        []string{"aws_s3_bucket", "aws_db_instance"},
+			typeName:        "aws_db_instance",
+			id:              "prod",
+			expectedMatch:   true,
+			expectedPattern: "aws_db_instance",
+		},
+		{
+			name:            "resource type wildcard",
+			patterns:        []string{"aws_rds_*"},
+			typeName:        "aws_rds_cluster",
+			id:              "prod",
+			expectedMatch:   true,
+			expectedPattern: "aws_rds_*",
+		},
+		{
+			name:            "ARN wildcard",
+			patterns:        []string{"arn:aws:s3:::prod-*"},
+			typeName:        "aws_s3_bucket",
+			arn:             "arn:aws:s3:::prod-logs",
+			id:              "prod-logs",
+			expectedMatch:   true,
+			expectedPattern: "arn:aws:s3:::prod-*",
+		},
+		{
+			name:     "ARN no match",
+			patterns: []string{"arn:aws:s3:::prod-*"},
+			typeName: "aws_s3_bucket",
+			arn:      "arn:aws:s3:::test-logs",
+			id:       "test-logs",
+		},
+		{
+			name:            "ID single character wildcard",
+			patterns:        []string{"prod-?"},
+			typeName:        "aws_db_instance",
+			id:              "prod-1",
+			expectedMatch:   true,
+			expectedPattern: "prod-?",
+		},
+		{
+			name:     "regular expression metacharacters are literal",
+			patterns: []string{"prod.db"},
+			typeName: "aws_db_instance",
+			id:       "prod-db",
+		},
+		{
+			name:     "pattern is anchored",
+			patterns: []string{"aws_db_instance"},
+			typeName: "aws_db_instance_role_association",
+			id:       "prod",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pattern, ok := matchProtectResources(testProtectResourcesPatterns(t, testCase.patterns...), testCase.typeName, testCase.arn, testCase.id)
+
+			if got, want := ok, testCase.expectedMatch; got != want {
+				t.Fatalf("match = %t, want %t", got, want)
+			}
+
+			if got, want := pattern, testCase.expectedPattern; got != want {
+				t.Errorf("pattern = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSchemaPathForceNew(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"engine": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+					"size": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"rule": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"action": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		key      string
+		expected bool
+	}{
+		{"name", true},
+		{"description", false},
+		{"config.#", false},
+		{"config.0.engine", true},
+		{"config.0.size", false},
+		{"rule.1234.action", true},
+		{"unknown", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.key, func(t *testing.T) {
+			if got, want := schemaPathForceNew(s, strings.Split(testCase.key, ".")), testCase.expected; got != want {
+				t.Errorf("schemaPathForceNew(%q) = %t, want %t", testCase.key, got, want)
+			}
+		})
+	}
+}
+
+func testProtectResource() (*schema.Resource, *bool) {
+	var deleted bool
+
+	r := &schema.Resource{
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			deleted = true
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	protectResource("aws_test_resource", r)
+
+	return r, &deleted
+}
+
+func TestProtectResourceReplace(t *testing.T) {
+	testCases := []struct {
+		name          string
+		patterns      []string
+		config        map[string]interface{}
+		expectedError bool
+	}{
+		{
+			name:          "protected replacement",
+			patterns:      []string{"aws_test_*"},
+			config:        map[string]interface{}{"name": "new"},
+			expectedError: true,
+		},
+		{
+			name:     "protected update in place",
+			patterns: []string{"aws_test_*"},
+			config:   map[string]interface{}{"name": "old", "description": "updated"},
+		},
+		{
+			name:     "unprotected replacement",
+			patterns: []string{"prod-*"},
+			config:   map[string]interface{}{"name": "new"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r, _ := testProtectResource()
+			state := &terraform.InstanceState{
+				ID:         "test",
+				Attributes: map[string]string{"id": "test", "name": "old"},
+			}
+			meta := &conns.AWSClient{ProtectResources: testProtectResourcesPatterns(t, testCase.patterns...)}
+
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(testCase.config), meta)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), "cannot be replaced") {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestProtectResourceDelete(t *testing.T) {
+	r, deleted := testProtectResource()
+	d := r.TestResourceData()
+	d.SetId("prod-1")
+
+	diags := r.DeleteContext(context.Background(), d, &conns.AWSClient{ProtectResources: testProtectResourcesPatterns(t, "prod-*")})
+
+	if !diags.HasError() {
+		t.Fatal("expected error")
+	}
+
+	if *deleted {
+		t.Error("protected resource was deleted")
+	}
+
+	diags = r.DeleteContext(context.Background(), d, &conns.AWSClient{ProtectResources: testProtectResourcesPatterns(t, "test-*")})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !*deleted {
+		t.Error("unprotected resource was not deleted")
+	}
+}
+
+type testProtectProviderServer struct {
+	tfprotov5.ProviderServer
+
+	planned bool
+}
+
+var testProtectResourceSchema = &tfprotov5.Schema{
+	Block: &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "arn", Type: tftypes.String, Computed: true},
+			{Name: "id", Type: tftypes.String, Computed: true},
+			{Name: "name", Type: tftypes.String, Required: true},
+		},
+	},
+}
+
+func (s *testProtectProviderServer) GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"aws_test_resource": testProtectResourceSchema,
+		},
+	}, nil
+}
+
+func (s *testProtectProviderServer) PlanResourceChange(context.Context, *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	s.planned = true
+
+	return &tfprotov5.PlanResourceChangeResponse{}, nil
+}
+
+func TestProtectResourcesProviderServerPlanDestroy(t *testing.T) {
+	typ := testProtectResourceSchema.ValueType()
+	state := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"arn":  tftypes.NewValue(tftypes.String, "arn:aws:test:us-west-2:123456789012:resource/prod-1"),
+		"id":   tftypes.NewValue(tftypes.String, "prod-1"),
+		"name": tftypes.NewValue(tftypes.String, "prod-1"),
+	})
+
+	testCases := []struct {
+		name             string
+		patterns         []string
+		proposedNewState tftypes.Value
+		expectedError    bool
+	}{
+		{
+			name:             "protected destroy by ARN",
+			patterns:         []string{"arn:aws:test:*:resource/prod-*"},
+			proposedNewState: tftypes.NewValue(typ, nil),
+			expectedError:    true,
+		},
+		{
+			name:             "protected destroy by ID",
+			patterns:         []string{"prod-?"},
+			proposedNewState: tftypes.NewValue(typ, nil),
+			expectedError:    true,
+		},
+		{
+			name:             "unprotected destroy",
+			patterns:         []string{"test-*"},
+			proposedNewState: tftypes.NewValue(typ, nil),
+		},
+		{
+			name:             "protected update",
+			patterns:         []string{"prod-*"},
+			proposedNewState: state,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			inner := &testProtectProviderServer{}
+			meta := &conns.AWSClient{ProtectResources: testProtectResourcesPatterns(t, testCase.patterns...)}
+			server := newProtectResourcesProviderServer(inner, func() interface{} { return meta })
+
+			schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if schemaResp.ServerCapabilities == nil || !schemaResp.ServerCapabilities.PlanDestroy {
+				t.Fatal("expected PlanDestroy server capability")
+			}
+
+			priorState, err := tfprotov5.NewDynamicValue(typ, state)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			proposedNewState, err := tfprotov5.NewDynamicValue(typ, testCase.proposedNewState)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test_resource",
+				PriorState:       &priorState,
+				ProposedNewState: &proposedNewState,
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(resp.Diagnostics) > 0, testCase.expectedError; got != want {
+				t.Fatalf("error diagnostics = %v, want error %t", resp.Diagnostics, want)
+			}
+
+			if got, want := inner.planned, !testCase.expectedError; got != want {
+				t.Errorf("planned = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func testProtectResourcesPatterns(t *testing.T, patterns ...string) []conns.ProtectResourcesPattern {
+	t.Helper()
+
+	compiled, err := conns.CompileProtectResourcesPatterns(patterns)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return compiled
+}
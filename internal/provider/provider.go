@@ -53,6 +53,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codecommit"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codeguruprofiler"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codegurusecurity"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarnotifications"
@@ -73,6 +75,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -108,6 +111,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -140,8 +144,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/osis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -155,6 +161,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53profiles"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -179,11 +186,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
@@ -312,6 +322,13 @@ func New(_ context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"protect_resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Resource types, ARNs or IDs (which may contain '*' and '?' wildcards) of resources\n" +
+					"that must not be destroyed or replaced.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -341,6 +358,13 @@ func New(_ context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "Maximum number of concurrent API requests per AWS service, keyed by service name\n" +
+					"(e.g. route53 or ec2).",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -442,6 +466,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
 
+			"aws_apprunner_auto_scaling_configuration_versions": apprunner.DataSourceAutoScalingConfigurationVersions(),
+
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
@@ -467,6 +493,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_cloudfront_cache_policy":                   cloudfront.DataSourceCachePolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.DataSourceDistribution(),
+			"aws_cloudfront_field_level_encryption_config":  cloudfront.DataSourceFieldLevelEncryptionConfig(),
+			"aws_cloudfront_field_level_encryption_profile": cloudfront.DataSourceFieldLevelEncryptionProfile(),
 			"aws_cloudfront_function":                       cloudfront.DataSourceFunction(),
 			"aws_cloudfront_log_delivery_canonical_user_id": cloudfront.DataSourceLogDeliveryCanonicalUserID(),
 			"aws_cloudfront_origin_access_identities":       cloudfront.DataSourceOriginAccessIdentities(),
@@ -492,6 +520,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_codecommit_approval_rule_template": codecommit.DataSourceApprovalRuleTemplate(),
 			"aws_codecommit_repository":             codecommit.DataSourceRepository(),
 
+			"aws_codegurusecurity_findings": codegurusecurity.DataSourceFindings(),
+
 			"aws_codestarconnections_connection": codestarconnections.DataSourceConnection(),
 
 			"aws_cognito_user_pool_client":              cognitoidp.DataSourceUserPoolClient(),
@@ -588,6 +618,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_network_interface":                          ec2.DataSourceNetworkInterface(),
 			"aws_network_interfaces":                         ec2.DataSourceNetworkInterfaces(),
 			"aws_prefix_list":                                ec2.DataSourcePrefixList(),
+			"aws_prefix_list_entries":                        ec2.DataSourcePrefixListEntries(),
 			"aws_route_table":                                ec2.DataSourceRouteTable(),
 			"aws_route_tables":                               ec2.DataSourceRouteTables(),
 			"aws_route":                                      ec2.DataSourceRoute(),
@@ -609,9 +640,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
 			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
 
-			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
-			"aws_ecr_image":               ecr.DataSourceImage(),
-			"aws_ecr_repository":          ecr.DataSourceRepository(),
+			"aws_ecr_authorization_token":                ecr.DataSourceAuthorizationToken(),
+			"aws_ecr_image":                              ecr.DataSourceImage(),
+			"aws_ecr_organization_pull_policy_document":  ecr.DataSourceOrganizationPullPolicyDocument(),
+			"aws_ecr_pull_through_cache_rule_validation": ecr.DataSourcePullThroughCacheRuleValidation(),
+			"aws_ecr_repository":                         ecr.DataSourceRepository(),
 
 			"aws_ecrpublic_authorization_token": ecrpublic.DataSourceAuthorizationToken(),
 
@@ -644,6 +677,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_elasticsearch_domain": elasticsearch.DataSourceDomain(),
 
+			"aws_elastictranscoder_media_convert_job_template": elastictranscoder.DataSourceMediaConvertJobTemplate(),
+
 			"aws_elb":                 elb.DataSourceLoadBalancer(),
 			"aws_elb_hosted_zone_id":  elb.DataSourceHostedZoneID(),
 			"aws_elb_service_account": elb.DataSourceServiceAccount(),
@@ -656,6 +691,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_lb_hosted_zone_id": elbv2.DataSourceHostedZoneID(),
 			"aws_lb_listener":       elbv2.DataSourceListener(),
 			"aws_lb_target_group":   elbv2.DataSourceTargetGroup(),
+			"aws_lb_target_health":  elbv2.DataSourceTargetHealth(),
 
 			"aws_emr_release_labels": emr.DataSourceReleaseLabels(),
 
@@ -710,10 +746,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_iot_endpoint": iot.DataSourceEndpoint(),
 
-			"aws_msk_broker_nodes":  kafka.DataSourceBrokerNodes(),
-			"aws_msk_cluster":       kafka.DataSourceCluster(),
-			"aws_msk_configuration": kafka.DataSourceConfiguration(),
-			"aws_msk_kafka_version": kafka.DataSourceVersion(),
+			"aws_msk_broker_nodes":        kafka.DataSourceBrokerNodes(),
+			"aws_msk_cluster":             kafka.DataSourceCluster(),
+			"aws_msk_configuration":       kafka.DataSourceConfiguration(),
+			"aws_msk_iam_policy_document": kafka.DataSourceIAMPolicyDocument(),
+			"aws_msk_kafka_version":       kafka.DataSourceVersion(),
 
 			"aws_mskconnect_connector":            kafkaconnect.DataSourceConnector(),
 			"aws_mskconnect_custom_plugin":        kafkaconnect.DataSourceCustomPlugin(),
@@ -760,6 +797,12 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_location_tracker_association":  location.DataSourceTrackerAssociation(),
 			"aws_location_tracker_associations": location.DataSourceTrackerAssociations(),
 
+			"aws_macie2_findings": macie2.DataSourceFindings(),
+
+			"aws_medialive_offerings": medialive.DataSourceOfferings(),
+
+			"aws_media_store_containers": mediastore.DataSourceContainers(),
+
 			"aws_memorydb_acl":             memorydb.DataSourceACL(),
 			"aws_memorydb_cluster":         memorydb.DataSourceCluster(),
 			"aws_memorydb_parameter_group": memorydb.DataSourceParameterGroup(),
@@ -773,8 +816,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
 			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
 
-			"aws_networkfirewall_firewall":        networkfirewall.DataSourceFirewall(),
-			"aws_networkfirewall_firewall_policy": networkfirewall.DataSourceFirewallPolicy(),
+			"aws_networkfirewall_firewall":                 networkfirewall.DataSourceFirewall(),
+			"aws_networkfirewall_firewall_policy":          networkfirewall.DataSourceFirewallPolicy(),
+			"aws_networkfirewall_firewall_policy_analysis": networkfirewall.DataSourceFirewallPolicyAnalysis(),
 
 			"aws_networkmanager_connection":                   networkmanager.DataSourceConnection(),
 			"aws_networkmanager_connections":                  networkmanager.DataSourceConnections(),
@@ -819,17 +863,20 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_sesv2_dedicated_ip_pool": sesv2.DataSourceDedicatedIPPool(),
 
-			"aws_db_cluster_snapshot":            rds.DataSourceClusterSnapshot(),
-			"aws_db_event_categories":            rds.DataSourceEventCategories(),
-			"aws_db_instance":                    rds.DataSourceInstance(),
-			"aws_db_proxy":                       rds.DataSourceProxy(),
-			"aws_db_snapshot":                    rds.DataSourceSnapshot(),
-			"aws_db_subnet_group":                rds.DataSourceSubnetGroup(),
-			"aws_rds_certificate":                rds.DataSourceCertificate(),
-			"aws_rds_cluster":                    rds.DataSourceCluster(),
-			"aws_rds_engine_version":             rds.DataSourceEngineVersion(),
-			"aws_rds_orderable_db_instance":      rds.DataSourceOrderableInstance(),
-			"aws_rds_reserved_instance_offering": rds.DataSourceReservedOffering(),
+			"aws_db_cluster_snapshot":             rds.DataSourceClusterSnapshot(),
+			"aws_db_event_categories":             rds.DataSourceEventCategories(),
+			"aws_db_instance":                     rds.DataSourceInstance(),
+			"aws_db_proxy":                        rds.DataSourceProxy(),
+			"aws_db_snapshot":                     rds.DataSourceSnapshot(),
+			"aws_db_subnet_group":                 rds.DataSourceSubnetGroup(),
+			"aws_rds_certificate":                 rds.DataSourceCertificate(),
+			"aws_rds_certificates":                rds.DataSourceCertificates(),
+			"aws_rds_cluster":                     rds.DataSourceCluster(),
+			"aws_rds_engine_version":              rds.DataSourceEngineVersion(),
+			"aws_rds_export_task":                 rds.DataSourceExportTask(),
+			"aws_rds_orderable_db_instance":       rds.DataSourceOrderableInstance(),
+			"aws_rds_reserved_instance_offering":  rds.DataSourceReservedOffering(),
+			"aws_rds_reserved_instance_offerings": rds.DataSourceReservedOfferings(),
 
 			"aws_redshift_cluster":             redshift.DataSourceCluster(),
 			"aws_redshift_cluster_credentials": redshift.DataSourceClusterCredentials(),
@@ -877,6 +924,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_service_discovery_http_namespace": servicediscovery.DataSourceHTTPNamespace(),
 			"aws_service_discovery_service":        servicediscovery.DataSourceService(),
 
+			"aws_quota_check":                 servicequotas.DataSourceQuotaCheck(),
 			"aws_servicequotas_service":       servicequotas.DataSourceService(),
 			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
 
@@ -890,19 +938,21 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_sqs_queue": sqs.DataSourceQueue(),
 
-			"aws_ssm_document":            ssm.DataSourceDocument(),
-			"aws_ssm_instances":           ssm.DataSourceInstances(),
-			"aws_ssm_maintenance_windows": ssm.DataSourceMaintenanceWindows(),
-			"aws_ssm_parameter":           ssm.DataSourceParameter(),
-			"aws_ssm_parameters_by_path":  ssm.DataSourceParametersByPath(),
-			"aws_ssm_patch_baseline":      ssm.DataSourcePatchBaseline(),
+			"aws_ssm_association_executions": ssm.DataSourceAssociationExecutions(),
+			"aws_ssm_document":               ssm.DataSourceDocument(),
+			"aws_ssm_instances":              ssm.DataSourceInstances(),
+			"aws_ssm_maintenance_windows":    ssm.DataSourceMaintenanceWindows(),
+			"aws_ssm_parameter":              ssm.DataSourceParameter(),
+			"aws_ssm_parameters_by_path":     ssm.DataSourceParametersByPath(),
+			"aws_ssm_patch_baseline":         ssm.DataSourcePatchBaseline(),
 
 			"aws_ssoadmin_instances":      ssoadmin.DataSourceInstances(),
 			"aws_ssoadmin_permission_set": ssoadmin.DataSourcePermissionSet(),
 
 			"aws_storagegateway_local_disk": storagegateway.DataSourceLocalDisk(),
 
-			"aws_transfer_server": transfer.DataSourceServer(),
+			"aws_transfer_identity_provider_test": transfer.DataSourceIdentityProviderTest(),
+			"aws_transfer_server":                 transfer.DataSourceServer(),
 
 			"aws_waf_ipset":                 waf.DataSourceIPSet(),
 			"aws_waf_rule":                  waf.DataSourceRule(),
@@ -1005,6 +1055,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_appflow_connector_profile": appflow.ResourceConnectorProfile(),
 			"aws_appflow_flow":              appflow.ResourceFlow(),
+			"aws_appflow_flow_execution":    appflow.ResourceFlowExecution(),
 
 			"aws_appintegrations_event_integration": appintegrations.ResourceEventIntegration(),
 
@@ -1021,6 +1072,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_apprunner_observability_configuration":        apprunner.ResourceObservabilityConfiguration(),
 			"aws_apprunner_connection":                         apprunner.ResourceConnection(),
 			"aws_apprunner_custom_domain_association":          apprunner.ResourceCustomDomainAssociation(),
+			"aws_apprunner_default_auto_scaling_configuration": apprunner.ResourceDefaultAutoScalingConfiguration(),
 			"aws_apprunner_service":                            apprunner.ResourceService(),
 
 			"aws_appstream_directory_config":        appstream.ResourceDirectoryConfig(),
@@ -1039,6 +1091,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_appsync_function":                    appsync.ResourceFunction(),
 			"aws_appsync_graphql_api":                 appsync.ResourceGraphQLAPI(),
 			"aws_appsync_resolver":                    appsync.ResourceResolver(),
+			"aws_appsync_source_api_association":      appsync.ResourceSourceAPIAssociation(),
 
 			"aws_athena_database":     athena.ResourceDatabase(),
 			"aws_athena_data_catalog": athena.ResourceDataCatalog(),
@@ -1099,11 +1152,14 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_cloudformation_type":               cloudformation.ResourceType(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.ResourceCachePolicy(),
+			"aws_cloudfront_continuous_deployment_policy":   cloudfront.ResourceContinuousDeploymentPolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.ResourceDistribution(),
+			"aws_cloudfront_distribution_staging_promotion": cloudfront.ResourceDistributionStagingPromotion(),
 			"aws_cloudfront_field_level_encryption_config":  cloudfront.ResourceFieldLevelEncryptionConfig(),
 			"aws_cloudfront_field_level_encryption_profile": cloudfront.ResourceFieldLevelEncryptionProfile(),
 			"aws_cloudfront_function":                       cloudfront.ResourceFunction(),
 			"aws_cloudfront_key_group":                      cloudfront.ResourceKeyGroup(),
+			"aws_cloudfront_key_value_store":                cloudfront.ResourceKeyValueStore(),
 			"aws_cloudfront_monitoring_subscription":        cloudfront.ResourceMonitoringSubscription(),
 			"aws_cloudfront_origin_access_control":          cloudfront.ResourceOriginAccessControl(),
 			"aws_cloudfront_origin_access_identity":         cloudfront.ResourceOriginAccessIdentity(),
@@ -1131,6 +1187,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_cloudwatch_event_bus":             events.ResourceBus(),
 			"aws_cloudwatch_event_bus_policy":      events.ResourceBusPolicy(),
 			"aws_cloudwatch_event_connection":      events.ResourceConnection(),
+			"aws_cloudwatch_event_endpoint":        events.ResourceEndpoint(),
 			"aws_cloudwatch_event_permission":      events.ResourcePermission(),
 			"aws_cloudwatch_event_rule":            events.ResourceRule(),
 			"aws_cloudwatch_event_target":          events.ResourceTarget(),
@@ -1166,6 +1223,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_codedeploy_deployment_config": deploy.ResourceDeploymentConfig(),
 			"aws_codedeploy_deployment_group":  deploy.ResourceDeploymentGroup(),
 
+			"aws_codeguruprofiler_profiling_group": codeguruprofiler.ResourceProfilingGroup(),
+
+			"aws_codegurusecurity_scan": codegurusecurity.ResourceScan(),
+
 			"aws_codepipeline":                    codepipeline.ResourcePipeline(),
 			"aws_codepipeline_custom_action_type": codepipeline.ResourceCustomActionType(),
 			"aws_codepipeline_webhook":            codepipeline.ResourceWebhook(),
@@ -1291,6 +1352,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_docdb_global_cluster":          docdb.ResourceGlobalCluster(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
 
+			"aws_docdbelastic_cluster": docdbelastic.ResourceCluster(),
+
 			"aws_directory_service_conditional_forwarder":     ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":                 ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":          ds.ResourceLogSubscription(),
@@ -1303,125 +1366,129 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
-			"aws_ami":                                              ec2.ResourceAMI(),
-			"aws_ami_copy":                                         ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),
-			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                              ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                           ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                   ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                      ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
-			"aws_ec2_host":                                         ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_network_insights_analysis":                    ec2.ResourceNetworkInsightsAnalysis(),
-			"aws_ec2_network_insights_path":                        ec2.ResourceNetworkInsightsPath(),
-			"aws_ec2_serial_console_access":                        ec2.ResourceSerialConsoleAccess(),
-			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
-			"aws_ec2_tag":                                          ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_connect":                      ec2.ResourceTransitGatewayConnect(),
-			"aws_ec2_transit_gateway_connect_peer":                 ec2.ResourceTransitGatewayConnectPeer(),
-			"aws_ec2_transit_gateway_multicast_domain":             ec2.ResourceTransitGatewayMulticastDomain(),
-			"aws_ec2_transit_gateway_multicast_domain_association": ec2.ResourceTransitGatewayMulticastDomainAssociation(),
-			"aws_ec2_transit_gateway_multicast_group_member":       ec2.ResourceTransitGatewayMulticastGroupMember(),
-			"aws_ec2_transit_gateway_multicast_group_source":       ec2.ResourceTransitGatewayMulticastGroupSource(),
-			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_policy_table":                 ec2.ResourceTransitGatewayPolicyTable(),
-			"aws_ec2_transit_gateway_policy_table_association":     ec2.ResourceTransitGatewayPolicyTableAssociation(),
-			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                              ec2.ResourceEIP(),
-			"aws_eip_association":                                  ec2.ResourceEIPAssociation(),
-			"aws_flow_log":                                         ec2.ResourceFlowLog(),
-			"aws_instance":                                         ec2.ResourceInstance(),
-			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
-			"aws_internet_gateway_attachment":                      ec2.ResourceInternetGatewayAttachment(),
-			"aws_key_pair":                                         ec2.ResourceKeyPair(),
-			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),
-			"aws_nat_gateway":                                      ec2.ResourceNATGateway(),
-			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
-			"aws_network_acl_association":                          ec2.ResourceNetworkACLAssociation(),
-			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
-			"aws_route":                                            ec2.ResourceRoute(),
-			"aws_route_table":                                      ec2.ResourceRouteTable(),
-			"aws_route_table_association":                          ec2.ResourceRouteTableAssociation(),
-			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
-			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
-			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
-			"aws_subnet":                                           ec2.ResourceSubnet(),
-			"aws_volume_attachment":                                ec2.ResourceVolumeAttachment(),
-			"aws_vpc":                                              ec2.ResourceVPC(),
-			"aws_vpc_dhcp_options":                                 ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                     ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_vpc_endpoint":                                     ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_accepter":                 ec2.ResourceVPCEndpointConnectionAccepter(),
-			"aws_vpc_endpoint_connection_notification":             ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_policy":                              ec2.ResourceVPCEndpointPolicy(),
-			"aws_vpc_endpoint_route_table_association":             ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_security_group_association":          ec2.ResourceVPCEndpointSecurityGroupAssociation(),
-			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipam":                                         ec2.ResourceIPAM(),
-			"aws_vpc_ipam_organization_admin_account":              ec2.ResourceIPAMOrganizationAdminAccount(),
-			"aws_vpc_ipam_pool":                                    ec2.ResourceIPAMPool(),
-			"aws_vpc_ipam_pool_cidr_allocation":                    ec2.ResourceIPAMPoolCIDRAllocation(),
-			"aws_vpc_ipam_pool_cidr":                               ec2.ResourceIPAMPoolCIDR(),
-			"aws_vpc_ipam_preview_next_cidr":                       ec2.ResourceIPAMPreviewNextCIDR(),
-			"aws_vpc_ipam_scope":                                   ec2.ResourceIPAMScope(),
-			"aws_vpc_ipv4_cidr_block_association":                  ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpc_ipv6_cidr_block_association":                  ec2.ResourceVPCIPv6CIDRBlockAssociation(),
-			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_ami":                                ec2.ResourceAMI(),
+			"aws_ami_copy":                           ec2.ResourceAMICopy(),
+			"aws_ami_from_instance":                  ec2.ResourceAMIFromInstance(),
+			"aws_ami_launch_permission":              ec2.ResourceAMILaunchPermission(),
+			"aws_customer_gateway":                   ec2.ResourceCustomerGateway(),
+			"aws_default_network_acl":                ec2.ResourceDefaultNetworkACL(),
+			"aws_default_route_table":                ec2.ResourceDefaultRouteTable(),
+			"aws_default_security_group":             ec2.ResourceDefaultSecurityGroup(),
+			"aws_default_subnet":                     ec2.ResourceDefaultSubnet(),
+			"aws_default_vpc":                        ec2.ResourceDefaultVPC(),
+			"aws_default_vpc_dhcp_options":           ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_ebs_default_kms_key":                ec2.ResourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":          ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                       ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_copy":                  ec2.ResourceEBSSnapshotCopy(),
+			"aws_ebs_snapshot_import":                ec2.ResourceEBSSnapshotImport(),
+			"aws_ebs_volume":                         ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":        ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_reservation":           ec2.ResourceCapacityReservation(),
+			"aws_ec2_carrier_gateway":                ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":  ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_endpoint":            ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association": ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":               ec2.ResourceClientVPNRoute(),
+			"aws_ec2_fleet":                          ec2.ResourceFleet(),
+			"aws_ec2_host":                           ec2.ResourceHost(),
+			"aws_ec2_local_gateway_route":            ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table":      ec2.ResourceLocalGatewayRouteTable(),
+			"aws_ec2_local_gateway_route_table_virtual_interface_group_association": ec2.ResourceLocalGatewayRouteTableVirtualInterfaceGroupAssociation(),
+			"aws_ec2_local_gateway_route_table_vpc_association":                     ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_managed_prefix_list":                                           ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entry":                                     ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                                     ec2.ResourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                                         ec2.ResourceNetworkInsightsPath(),
+			"aws_ec2_serial_console_access":                                         ec2.ResourceSerialConsoleAccess(),
+			"aws_ec2_subnet_cidr_reservation":                                       ec2.ResourceSubnetCIDRReservation(),
+			"aws_ec2_tag":                                                           ec2.ResourceTag(),
+			"aws_ec2_traffic_mirror_filter":                                         ec2.ResourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                                    ec2.ResourceTrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                                        ec2.ResourceTrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                                         ec2.ResourceTrafficMirrorTarget(),
+			"aws_ec2_transit_gateway":                                               ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                                       ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                                  ec2.ResourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":                              ec2.ResourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association":                  ec2.ResourceTransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":                        ec2.ResourceTransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":                        ec2.ResourceTransitGatewayMulticastGroupSource(),
+			"aws_ec2_transit_gateway_peering_attachment":                            ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":                   ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_policy_table":                                  ec2.ResourceTransitGatewayPolicyTable(),
+			"aws_ec2_transit_gateway_policy_table_association":                      ec2.ResourceTransitGatewayPolicyTableAssociation(),
+			"aws_ec2_transit_gateway_prefix_list_reference":                         ec2.ResourceTransitGatewayPrefixListReference(),
+			"aws_ec2_transit_gateway_route":                                         ec2.ResourceTransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                                   ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":                       ec2.ResourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_propagation":                       ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_vpc_attachment":                                ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":                       ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
+			"aws_egress_only_internet_gateway":                                      ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                                               ec2.ResourceEIP(),
+			"aws_eip_association":                                                   ec2.ResourceEIPAssociation(),
+			"aws_flow_log":                                                          ec2.ResourceFlowLog(),
+			"aws_instance":                                                          ec2.ResourceInstance(),
+			"aws_internet_gateway":                                                  ec2.ResourceInternetGateway(),
+			"aws_internet_gateway_attachment":                                       ec2.ResourceInternetGatewayAttachment(),
+			"aws_key_pair":                                                          ec2.ResourceKeyPair(),
+			"aws_launch_template":                                                   ec2.ResourceLaunchTemplate(),
+			"aws_main_route_table_association":                                      ec2.ResourceMainRouteTableAssociation(),
+			"aws_nat_gateway":                                                       ec2.ResourceNATGateway(),
+			"aws_network_acl":                                                       ec2.ResourceNetworkACL(),
+			"aws_network_acl_association":                                           ec2.ResourceNetworkACLAssociation(),
+			"aws_network_acl_rule":                                                  ec2.ResourceNetworkACLRule(),
+			"aws_network_acl_rules":                                                 ec2.ResourceNetworkACLRules(),
+			"aws_network_interface":                                                 ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                                      ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_sg_attachment":                                   ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_placement_group":                                                   ec2.ResourcePlacementGroup(),
+			"aws_route":                                                             ec2.ResourceRoute(),
+			"aws_route_table":                                                       ec2.ResourceRouteTable(),
+			"aws_route_table_association":                                           ec2.ResourceRouteTableAssociation(),
+			"aws_security_group":                                                    ec2.ResourceSecurityGroup(),
+			"aws_security_group_rule":                                               ec2.ResourceSecurityGroupRule(),
+			"aws_snapshot_create_volume_permission":                                 ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_spot_datafeed_subscription":                                        ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_fleet_request":                                                ec2.ResourceSpotFleetRequest(),
+			"aws_spot_instance_request":                                             ec2.ResourceSpotInstanceRequest(),
+			"aws_subnet":                                                            ec2.ResourceSubnet(),
+			"aws_volume_attachment":                                                 ec2.ResourceVolumeAttachment(),
+			"aws_vpc":                                                               ec2.ResourceVPC(),
+			"aws_vpc_dhcp_options":                                                  ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_dhcp_options_association":                                      ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_endpoint":                                                      ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_accepter":                                  ec2.ResourceVPCEndpointConnectionAccepter(),
+			"aws_vpc_endpoint_connection_notification":                              ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_policy":                                               ec2.ResourceVPCEndpointPolicy(),
+			"aws_vpc_endpoint_route_table_association":                              ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_security_group_association":                           ec2.ResourceVPCEndpointSecurityGroupAssociation(),
+			"aws_vpc_endpoint_service":                                              ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":                            ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_subnet_association":                                   ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                                          ec2.ResourceIPAM(),
+			"aws_vpc_ipam_organization_admin_account":                               ec2.ResourceIPAMOrganizationAdminAccount(),
+			"aws_vpc_ipam_pool":                                                     ec2.ResourceIPAMPool(),
+			"aws_vpc_ipam_pool_cidr_allocation":                                     ec2.ResourceIPAMPoolCIDRAllocation(),
+			"aws_vpc_ipam_pool_cidr":                                                ec2.ResourceIPAMPoolCIDR(),
+			"aws_vpc_ipam_preview_next_cidr":                                        ec2.ResourceIPAMPreviewNextCIDR(),
+			"aws_vpc_ipam_scope":                                                    ec2.ResourceIPAMScope(),
+			"aws_vpc_ipv4_cidr_block_association":                                   ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                                   ec2.ResourceVPCIPv6CIDRBlockAssociation(),
+			"aws_vpc_peering_connection":                                            ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                                   ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                                    ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpn_connection":                                                    ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                                              ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                                       ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                                            ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                                     ec2.ResourceVPNGatewayRoutePropagation(),
 
 			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
 			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
@@ -1544,12 +1611,16 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_glacier_vault":      glacier.ResourceVault(),
 			"aws_glacier_vault_lock": glacier.ResourceVaultLock(),
 
-			"aws_globalaccelerator_accelerator":    globalaccelerator.ResourceAccelerator(),
-			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(),
-			"aws_globalaccelerator_listener":       globalaccelerator.ResourceListener(),
+			"aws_globalaccelerator_accelerator":                   globalaccelerator.ResourceAccelerator(),
+			"aws_globalaccelerator_custom_routing_accelerator":    globalaccelerator.ResourceCustomRoutingAccelerator(),
+			"aws_globalaccelerator_custom_routing_endpoint_group": globalaccelerator.ResourceCustomRoutingEndpointGroup(),
+			"aws_globalaccelerator_custom_routing_listener":       globalaccelerator.ResourceCustomRoutingListener(),
+			"aws_globalaccelerator_endpoint_group":                globalaccelerator.ResourceEndpointGroup(),
+			"aws_globalaccelerator_listener":                      globalaccelerator.ResourceListener(),
 
 			"aws_glue_catalog_database":                 glue.ResourceCatalogDatabase(),
 			"aws_glue_catalog_table":                    glue.ResourceCatalogTable(),
+			"aws_glue_catalog_table_optimizer":          glue.ResourceCatalogTableOptimizer(),
 			"aws_glue_classifier":                       glue.ResourceClassifier(),
 			"aws_glue_connection":                       glue.ResourceConnection(),
 			"aws_glue_crawler":                          glue.ResourceCrawler(),
@@ -1632,6 +1703,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
+			"aws_iot_dynamic_thing_group":        iot.ResourceDynamicThingGroup(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
 			"aws_iot_logging_options":            iot.ResourceLoggingOptions(),
 			"aws_iot_policy":                     iot.ResourcePolicy(),
@@ -1646,6 +1718,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_iot_topic_rule":                 iot.ResourceTopicRule(),
 			"aws_iot_topic_rule_destination":     iot.ResourceTopicRuleDestination(),
 
+			"aws_iotevents_alarm_model":    iotevents.ResourceAlarmModel(),
+			"aws_iotevents_detector_model": iotevents.ResourceDetectorModel(),
+			"aws_iotevents_input":          iotevents.ResourceInput(),
+
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
@@ -1683,11 +1759,12 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
-			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
-			"aws_lakeformation_lf_tag":             lakeformation.ResourceLFTag(),
-			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
-			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
-			"aws_lakeformation_resource_lf_tags":   lakeformation.ResourceResourceLFTags(),
+			"aws_lakeformation_data_lake_settings":        lakeformation.ResourceDataLakeSettings(),
+			"aws_lakeformation_lf_tag":                    lakeformation.ResourceLFTag(),
+			"aws_lakeformation_lf_tag_policy_permissions": lakeformation.ResourceLFTagPolicyPermissions(),
+			"aws_lakeformation_permissions":               lakeformation.ResourcePermissions(),
+			"aws_lakeformation_resource":                  lakeformation.ResourceResource(),
+			"aws_lakeformation_resource_lf_tags":          lakeformation.ResourceResourceLFTags(),
 
 			"aws_lambda_alias":                          lambda.ResourceAlias(),
 			"aws_lambda_code_signing_config":            lambda.ResourceCodeSigningConfig(),
@@ -1749,14 +1826,18 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
 
-			"aws_media_package_channel": mediapackage.ResourceChannel(),
+			"aws_media_package_channel":         mediapackage.ResourceChannel(),
+			"aws_media_package_harvest_job":     mediapackage.ResourceHarvestJob(),
+			"aws_media_package_origin_endpoint": mediapackage.ResourceOriginEndpoint(),
 
 			"aws_medialive_input":                medialive.ResourceInput(),
 			"aws_medialive_input_security_group": medialive.ResourceInputSecurityGroup(),
 			"aws_medialive_multiplex":            medialive.ResourceMultiplex(),
+			"aws_medialive_reservation":          medialive.ResourceReservation(),
 
-			"aws_media_store_container":        mediastore.ResourceContainer(),
-			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
+			"aws_media_store_container":           mediastore.ResourceContainer(),
+			"aws_media_store_container_policy":    mediastore.ResourceContainerPolicy(),
+			"aws_media_store_container_s3_export": mediastore.ResourceContainerS3Export(),
 
 			"aws_memorydb_acl":             memorydb.ResourceACL(),
 			"aws_memorydb_cluster":         memorydb.ResourceCluster(),
@@ -1776,6 +1857,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_neptune_cluster_parameter_group": neptune.ResourceClusterParameterGroup(),
 			"aws_neptune_cluster_snapshot":        neptune.ResourceClusterSnapshot(),
 			"aws_neptune_event_subscription":      neptune.ResourceEventSubscription(),
+			"aws_neptune_global_cluster":          neptune.ResourceGlobalCluster(),
 			"aws_neptune_parameter_group":         neptune.ResourceParameterGroup(),
 			"aws_neptune_subnet_group":            neptune.ResourceSubnetGroup(),
 
@@ -1828,6 +1910,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_organizations_policy":                  organizations.ResourcePolicy(),
 			"aws_organizations_policy_attachment":       organizations.ResourcePolicyAttachment(),
 
+			"aws_osis_pipeline": osis.ResourcePipeline(),
+
 			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),
 			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),
 			"aws_pinpoint_apns_sandbox_channel":      pinpoint.ResourceAPNSSandboxChannel(),
@@ -1840,6 +1924,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_pipes_pipe": pipes.ResourcePipe(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 			"aws_qldb_stream": qldb.ResourceStream(),
 
@@ -1868,43 +1954,52 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_db_snapshot":                               rds.ResourceSnapshot(),
 			"aws_db_snapshot_copy":                          rds.ResourceSnapshotCopy(),
 			"aws_db_subnet_group":                           rds.ResourceSubnetGroup(),
+			"aws_rds_certificate":                           rds.ResourceCertificate(),
 			"aws_rds_cluster":                               rds.ResourceCluster(),
 			"aws_rds_cluster_activity_stream":               rds.ResourceClusterActivityStream(),
 			"aws_rds_cluster_endpoint":                      rds.ResourceClusterEndpoint(),
 			"aws_rds_cluster_instance":                      rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
+			"aws_rds_export_task":                           rds.ResourceExportTask(),
 			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
 			"aws_rds_reserved_instance":                     rds.ResourceReservedInstance(),
 
-			"aws_redshift_authentication_profile":        redshift.ResourceAuthenticationProfile(),
-			"aws_redshift_cluster":                       redshift.ResourceCluster(),
-			"aws_redshift_cluster_iam_roles":             redshift.ResourceClusterIAMRoles(),
-			"aws_redshift_endpoint_access":               redshift.ResourceEndpointAccess(),
-			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
-			"aws_redshift_hsm_client_certificate":        redshift.ResourceHSMClientCertificate(),
-			"aws_redshift_hsm_configuration":             redshift.ResourceHSMConfiguration(),
-			"aws_redshift_parameter_group":               redshift.ResourceParameterGroup(),
-			"aws_redshift_scheduled_action":              redshift.ResourceScheduledAction(),
-			"aws_redshift_security_group":                redshift.ResourceSecurityGroup(),
-			"aws_redshift_snapshot_copy_grant":           redshift.ResourceSnapshotCopyGrant(),
-			"aws_redshift_snapshot_schedule":             redshift.ResourceSnapshotSchedule(),
-			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
-			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
-			"aws_redshift_usage_limit":                   redshift.ResourceUsageLimit(),
+			"aws_redshift_authentication_profile":          redshift.ResourceAuthenticationProfile(),
+			"aws_redshift_cluster":                         redshift.ResourceCluster(),
+			"aws_redshift_cluster_iam_roles":               redshift.ResourceClusterIAMRoles(),
+			"aws_redshift_data_share_authorization":        redshift.ResourceDataShareAuthorization(),
+			"aws_redshift_data_share_consumer_association": redshift.ResourceDataShareConsumerAssociation(),
+			"aws_redshift_endpoint_access":                 redshift.ResourceEndpointAccess(),
+			"aws_redshift_event_subscription":              redshift.ResourceEventSubscription(),
+			"aws_redshift_hsm_client_certificate":          redshift.ResourceHSMClientCertificate(),
+			"aws_redshift_hsm_configuration":               redshift.ResourceHSMConfiguration(),
+			"aws_redshift_integration":                     redshift.ResourceIntegration(),
+			"aws_redshift_parameter_group":                 redshift.ResourceParameterGroup(),
+			"aws_redshift_scheduled_action":                redshift.ResourceScheduledAction(),
+			"aws_redshift_security_group":                  redshift.ResourceSecurityGroup(),
+			"aws_redshift_snapshot_copy_grant":             redshift.ResourceSnapshotCopyGrant(),
+			"aws_redshift_snapshot_schedule":               redshift.ResourceSnapshotSchedule(),
+			"aws_redshift_snapshot_schedule_association":   redshift.ResourceSnapshotScheduleAssociation(),
+			"aws_redshift_subnet_group":                    redshift.ResourceSubnetGroup(),
+			"aws_redshift_usage_limit":                     redshift.ResourceUsageLimit(),
 
 			"aws_redshiftdata_statement": redshiftdata.ResourceStatement(),
 
-			"aws_redshiftserverless_endpoint_access": redshiftserverless.ResourceEndpointAccess(),
-			"aws_redshiftserverless_namespace":       redshiftserverless.ResourceNamespace(),
-			"aws_redshiftserverless_usage_limit":     redshiftserverless.ResourceUsageLimit(),
-			"aws_redshiftserverless_workgroup":       redshiftserverless.ResourceWorkgroup(),
+			"aws_redshiftserverless_endpoint_access":             redshiftserverless.ResourceEndpointAccess(),
+			"aws_redshiftserverless_namespace":                   redshiftserverless.ResourceNamespace(),
+			"aws_redshiftserverless_scheduled_action":            redshiftserverless.ResourceScheduledAction(),
+			"aws_redshiftserverless_snapshot_copy_configuration": redshiftserverless.ResourceSnapshotCopyConfiguration(),
+			"aws_redshiftserverless_usage_limit":                 redshiftserverless.ResourceUsageLimit(),
+			"aws_redshiftserverless_workgroup":                   redshiftserverless.ResourceWorkgroup(),
 
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
 			"aws_rolesanywhere_trust_anchor": rolesanywhere.ResourceTrustAnchor(),
 
+			"aws_route53_cidr_collection":               route53.ResourceCIDRCollection(),
+			"aws_route53_cidr_location":                 route53.ResourceCIDRLocation(),
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
 			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(),
@@ -1917,8 +2012,13 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
 
+			"aws_route53domains_domain":            route53domains.ResourceDomain(),
 			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
 
+			"aws_route53profiles_association":          route53profiles.ResourceAssociation(),
+			"aws_route53profiles_profile":              route53profiles.ResourceProfile(),
+			"aws_route53profiles_resource_association": route53profiles.ResourceResourceAssociation(),
+
 			"aws_route53recoverycontrolconfig_cluster":         route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":   route53recoverycontrolconfig.ResourceControlPanel(),
 			"aws_route53recoverycontrolconfig_routing_control": route53recoverycontrolconfig.ResourceRoutingControl(),
@@ -2062,6 +2162,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ses_identity_policy":              ses.ResourceIdentityPolicy(),
 			"aws_ses_receipt_filter":               ses.ResourceReceiptFilter(),
 			"aws_ses_receipt_rule":                 ses.ResourceReceiptRule(),
+			"aws_ses_receipt_rule_order":           ses.ResourceReceiptRuleOrder(),
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
@@ -2074,19 +2175,22 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
-			"aws_shield_protection":                          shield.ResourceProtection(),
-			"aws_shield_protection_group":                    shield.ResourceProtectionGroup(),
-			"aws_shield_protection_health_check_association": shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_application_layer_automatic_response": shield.ResourceApplicationLayerAutomaticResponse(),
+			"aws_shield_proactive_engagement":                 shield.ResourceProactiveEngagement(),
+			"aws_shield_protection":                           shield.ResourceProtection(),
+			"aws_shield_protection_group":                     shield.ResourceProtectionGroup(),
+			"aws_shield_protection_health_check_association":  shield.ResourceProtectionHealthCheckAssociation(),
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
 			"aws_signer_signing_profile_permission": signer.ResourceSigningProfilePermission(),
 
-			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
-			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
-			"aws_sns_topic":                sns.ResourceTopic(),
-			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
-			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
+			"aws_sns_platform_application":         sns.ResourcePlatformApplication(),
+			"aws_sns_sms_preferences":              sns.ResourceSMSPreferences(),
+			"aws_sns_topic":                        sns.ResourceTopic(),
+			"aws_sns_topic_data_protection_policy": sns.ResourceTopicDataProtectionPolicy(),
+			"aws_sns_topic_policy":                 sns.ResourceTopicPolicy(),
+			"aws_sns_topic_subscription":           sns.ResourceTopicSubscription(),
 
 			"aws_sqs_queue":                      sqs.ResourceQueue(),
 			"aws_sqs_queue_policy":               sqs.ResourceQueuePolicy(),
@@ -2099,12 +2203,19 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ssm_maintenance_window":        ssm.ResourceMaintenanceWindow(),
 			"aws_ssm_maintenance_window_target": ssm.ResourceMaintenanceWindowTarget(),
 			"aws_ssm_maintenance_window_task":   ssm.ResourceMaintenanceWindowTask(),
+			"aws_ssm_ops_item":                  ssm.ResourceOpsItem(),
+			"aws_ssm_ops_metadata":              ssm.ResourceOpsMetadata(),
 			"aws_ssm_parameter":                 ssm.ResourceParameter(),
 			"aws_ssm_patch_baseline":            ssm.ResourcePatchBaseline(),
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 			"aws_ssm_service_setting":           ssm.ResourceServiceSetting(),
 
+			"aws_ssmcontacts_contact":           ssmcontacts.ResourceContact(),
+			"aws_ssmcontacts_contact_channel":   ssmcontacts.ResourceContactChannel(),
+			"aws_ssmcontacts_rotation":          ssmcontacts.ResourceRotation(),
+			"aws_ssmcontacts_rotation_override": ssmcontacts.ResourceRotationOverride(),
+
 			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
 			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
 			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
@@ -2126,6 +2237,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
 
+			"aws_timestreaminfluxdb_db_instance":        timestreaminfluxdb.ResourceDBInstance(),
+			"aws_timestreaminfluxdb_db_parameter_group": timestreaminfluxdb.ResourceDBParameterGroup(),
+
+			"aws_timestreamquery_scheduled_query": timestreamquery.ResourceScheduledQuery(),
+
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
@@ -2188,6 +2304,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 		},
 	}
 
+	protectResources(provider.ResourcesMap)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return configure(ctx, provider, d)
 	}
@@ -2285,6 +2403,20 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("protect_resources"); ok && v.(*schema.Set).Len() > 0 {
+		config.ProtectResources = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("service_concurrency"); ok && len(v.(map[string]interface{})) > 0 {
+		serviceConcurrency, err := expandServiceConcurrency(v.(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.ServiceConcurrency = serviceConcurrency
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2460,6 +2592,16 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+// deprecatedEndpointsKeys are endpoint keys for services that have been retired
+// and removed from the AWS SDK for Go. They are still accepted so that existing
+// configurations continue to work.
+var deprecatedEndpointsKeys = []string{
+	"alexaforbusiness",
+	"honeycode",
+	"macie",
+	"mobile",
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2472,6 +2614,16 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	for _, serviceKey := range deprecatedEndpointsKeys {
+		endpointsAttributes[serviceKey] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Use this to override the default service endpoint URL",
+			Deprecated:  fmt.Sprintf("The %s service has been retired by AWS and this endpoint will be removed in a future version", serviceKey),
+		}
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -2603,6 +2755,22 @@ func expandIgnoreTags(tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandServiceConcurrency(tfMap map[string]interface{}) (map[string]int, error) {
+	serviceConcurrency := make(map[string]int)
+
+	for alias, v := range tfMap {
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("service_concurrency (%s): %w", alias, err)
+		}
+
+		serviceConcurrency[pkg] = v.(int)
+	}
+
+	return serviceConcurrency, nil
+}
+
 func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -2630,6 +2798,12 @@ func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 				}
 			}
 		}
+
+		for _, key := range deprecatedEndpointsKeys {
+			if v, ok := tfMap[key].(string); ok && v != "" {
+				endpoints[key] = v
+			}
+		}
 	}
 
 	for _, pkg := range names.ProviderPackages() {
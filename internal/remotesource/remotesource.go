@@ -0,0 +1,137 @@
+// Package remotesource reads resource argument values, such as lists of addresses,
+// from an S3 object or an HTTP(S) URL.
+package remotesource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	// Timeout is the maximum time allowed to read a source.
+	Timeout = 1 * time.Minute
+	// MaxSize is the maximum size, in bytes, of the contents of a source.
+	MaxSize = 10 * 1024 * 1024
+)
+
+// Source is an S3 object or a URL.
+type Source struct {
+	S3Bucket  string
+	S3Key     string
+	S3Version string
+	URL       string
+}
+
+func (s *Source) String() string {
+	if s.URL != "" {
+		return s.URL
+	}
+
+	return fmt.Sprintf("s3://%s/%s", s.S3Bucket, s.S3Key)
+}
+
+// Read returns the contents of the source.
+// The read is abandoned after Timeout and fails if the contents are larger than MaxSize.
+func (s *Source) Read(ctx context.Context, conn *s3.S3) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	if s.URL != "" {
+		return readURL(ctx, s.URL)
+	}
+
+	return readS3Object(ctx, conn, s.S3Bucket, s.S3Key, s.S3Version)
+}
+
+func readURL(ctx context.Context, url string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := cleanhttp.DefaultClient()
+	client.Timeout = Timeout
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET (%s): %w", url, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP GET (%s): unexpected status: %s", url, response.Status)
+	}
+
+	return readAll(response.Body, MaxSize)
+}
+
+func readS3Object(ctx context.Context, conn *s3.S3, bucket, key, version string) ([]byte, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if version != "" {
+		input.VersionId = aws.String(version)
+	}
+
+	output, err := conn.GetObjectWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer output.Body.Close()
+
+	return readAll(output.Body, MaxSize)
+}
+
+// readAll reads from r until EOF, failing if more than max bytes are available.
+func readAll(r io.Reader, max int64) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r, max+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(body)) > max {
+		return nil, fmt.Errorf("contents exceed the maximum size of %d bytes", max)
+	}
+
+	return body, nil
+}
+
+// Hash returns a hash of the specified values that is independent of their order.
+func Hash(values []string) string {
+	v := make([]string, len(values))
+	copy(v, values)
+	sort.Strings(v)
+
+	sum := sha256.Sum256([]byte(strings.Join(v, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckHash verifies that the hash of the values read from source at apply time matches the hash recorded at plan time.
+// The planned hash is empty if the source could not be read at plan time.
+func CheckHash(source fmt.Stringer, planned, current string) error {
+	if planned != "" && planned != current {
+		return fmt.Errorf("contents of %s changed after the plan was created (planned hash %s, current hash %s), create a new plan", source, planned, current)
+	}
+
+	return nil
+}
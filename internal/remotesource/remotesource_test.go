@@ -0,0 +1,117 @@
+package remotesource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSourceReadURL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			fmt.Fprint(w, "10.0.0.0/8\n192.0.2.1\n")
+		case "/large":
+			fmt.Fprint(w, strings.Repeat("x", MaxSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		path    string
+		want    string
+		wantErr string
+	}{
+		{
+			path: "/ok",
+			want: "10.0.0.0/8\n192.0.2.1\n",
+		},
+		{
+			path:    "/large",
+			wantErr: "maximum size",
+		},
+		{
+			path:    "/missing",
+			wantErr: "404",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.path, func(t *testing.T) {
+			t.Parallel()
+
+			source := &Source{URL: server.URL + testCase.path}
+			got, err := source.Read(context.Background(), nil)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestReadAll(t *testing.T) {
+	t.Parallel()
+
+	if _, err := readAll(strings.NewReader("abcd"), 4); err != nil {
+		t.Errorf("unexpected error at the limit: %s", err)
+	}
+
+	if _, err := readAll(strings.NewReader("abcde"), 4); err == nil {
+		t.Error("expected error above the limit")
+	}
+}
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	h1 := Hash([]string{"10.0.0.0/8", "192.0.2.1/32"})
+	h2 := Hash([]string{"192.0.2.1/32", "10.0.0.0/8"})
+	h3 := Hash([]string{"10.0.0.0/8"})
+
+	if h1 != h2 {
+		t.Errorf("hash depends on order: %s != %s", h1, h2)
+	}
+
+	if h1 == h3 {
+		t.Errorf("hash does not depend on values: %s == %s", h1, h3)
+	}
+}
+
+func TestCheckHash(t *testing.T) {
+	t.Parallel()
+
+	source := &Source{S3Bucket: "bucket", S3Key: "key"}
+
+	if err := CheckHash(source, "", "abc"); err != nil {
+		t.Errorf("unexpected error for unknown planned hash: %s", err)
+	}
+
+	if err := CheckHash(source, "abc", "abc"); err != nil {
+		t.Errorf("unexpected error for matching hash: %s", err)
+	}
+
+	if err := CheckHash(source, "abc", "def"); err == nil {
+		t.Error("expected error for changed hash")
+	}
+}
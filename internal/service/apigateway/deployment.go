@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -23,6 +24,35 @@ func ResourceDeployment() *schema.Resource {
 		Delete: resourceDeploymentDelete,
 
 		Schema: map[string]*schema.Schema{
+			"canary_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percent_traffic": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      0.0,
+							ValidateFunc: validation.FloatBetween(0.0, 100.0),
+						},
+						"stage_variable_overrides": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"use_stage_cache": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"rest_api_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -83,13 +113,19 @@ func resourceDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	// Create the gateway
 	log.Printf("[DEBUG] Creating API Gateway Deployment")
 
-	deployment, err := conn.CreateDeployment(&apigateway.CreateDeploymentInput{
+	input := &apigateway.CreateDeploymentInput{
 		RestApiId:        aws.String(d.Get("rest_api_id").(string)),
 		StageName:        aws.String(d.Get("stage_name").(string)),
 		Description:      aws.String(d.Get("description").(string)),
 		StageDescription: aws.String(d.Get("stage_description").(string)),
 		Variables:        flex.ExpandStringMap(d.Get("variables").(map[string]interface{})),
-	})
+	}
+
+	if v, ok := d.GetOk("canary_settings"); ok {
+		input.CanarySettings = expandDeploymentCanarySettings(v.([]interface{}))
+	}
+
+	deployment, err := conn.CreateDeployment(input)
 	if err != nil {
 		return fmt.Errorf("Error creating API Gateway Deployment: %w", err)
 	}
@@ -97,6 +133,19 @@ func resourceDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(aws.StringValue(deployment.Id))
 	log.Printf("[DEBUG] API Gateway Deployment ID: %s", d.Id())
 
+	// A canary deployment is added to the existing stage's canary settings.
+	if input.CanarySettings != nil {
+		stage, err := FindStageByName(conn, d.Get("rest_api_id").(string), d.Get("stage_name").(string))
+
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway Deployment (%s) stage: %w", d.Id(), err)
+		}
+
+		if err := waitStageDeploymentUpdated(conn, d.Get("rest_api_id").(string), d.Get("stage_name").(string), aws.StringValue(stage.DeploymentId), true, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for API Gateway Deployment (%s) canary: %w", d.Id(), err)
+		}
+	}
+
 	return resourceDeploymentRead(d, meta)
 }
 
@@ -185,14 +234,17 @@ func resourceDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	//  - minimum field size of 1, GetStageInput.StageName.
 	stageName := d.Get("stage_name").(string)
 	restApiId := d.Get("rest_api_id").(string)
+	var stage *apigateway.Stage
 	if stageName != "" {
-		stage, err := FindStageByName(conn, restApiId, stageName)
+		var err error
+		stage, err = FindStageByName(conn, restApiId, stageName)
 
 		if err != nil && !tfresource.NotFound(err) {
 			return fmt.Errorf("error getting referenced stage: %w", err)
 		}
 
-		if stage != nil && aws.StringValue(stage.DeploymentId) == d.Id() {
+		// A canary deployment doesn't own the stage, even after it has been promoted.
+		if stage != nil && aws.StringValue(stage.DeploymentId) == d.Id() && len(d.Get("canary_settings").([]interface{})) == 0 {
 			shouldDeleteStage = true
 		}
 	}
@@ -206,6 +258,31 @@ func resourceDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Roll back a canary deployment that the stage still routes traffic to.
+	if stage != nil && stage.CanarySettings != nil && aws.StringValue(stage.CanarySettings.DeploymentId) == d.Id() {
+		log.Printf("[DEBUG] Removing API Gateway Stage (%s) canary settings for Deployment (%s)", stageName, d.Id())
+		_, err := conn.UpdateStage(&apigateway.UpdateStageInput{
+			RestApiId: aws.String(restApiId),
+			StageName: aws.String(stageName),
+			PatchOperations: []*apigateway.PatchOperation{
+				{
+					Op:   aws.String(apigateway.OpRemove),
+					Path: aws.String("/canarySettings"),
+				},
+			},
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+			return fmt.Errorf("error removing API Gateway Stage (%s) canary settings: %w", stageName, err)
+		}
+
+		if err == nil {
+			if err := waitStageDeploymentUpdated(conn, restApiId, stageName, aws.StringValue(stage.DeploymentId), false, ""); err != nil && !tfresource.NotFound(err) {
+				return fmt.Errorf("error waiting for API Gateway Stage (%s) canary settings removal: %w", stageName, err)
+			}
+		}
+	}
+
 	_, err := conn.DeleteDeployment(&apigateway.DeleteDeploymentInput{
 		DeploymentId: aws.String(d.Id()),
 		RestApiId:    aws.String(restApiId),
@@ -221,3 +298,27 @@ func resourceDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandDeploymentCanarySettings(l []interface{}) *apigateway.DeploymentCanarySettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	canarySettings := &apigateway.DeploymentCanarySettings{}
+
+	if v, ok := m["percent_traffic"].(float64); ok {
+		canarySettings.PercentTraffic = aws.Float64(v)
+	}
+
+	if v, ok := m["stage_variable_overrides"].(map[string]interface{}); ok && len(v) > 0 {
+		canarySettings.StageVariableOverrides = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := m["use_stage_cache"].(bool); ok {
+		canarySettings.UseStageCache = aws.Bool(v)
+	}
+
+	return canarySettings
+}
@@ -252,6 +252,36 @@ func TestAccAPIGatewayDeployment_variables(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayDeployment_canarySettings(t *testing.T) {
+	var deployment apigateway.Deployment
+	var stage apigateway.Stage
+	resourceName := "aws_api_gateway_deployment.test"
+	stageResourceName := "aws_api_gateway_stage.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test-deployment")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_canarySettings(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &deployment),
+					testAccCheckStageExists(stageResourceName, &stage),
+					testAccCheckDeploymentStageCanary(&stage, &deployment),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.0.percent_traffic", "25"),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.0.stage_variable_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.0.stage_variable_overrides.version", "canary"),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.0.use_stage_cache", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDeploymentExists(n string, res *apigateway.Deployment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -337,6 +367,20 @@ func testAccCheckDeploymentRecreated(i, j *apigateway.Deployment) resource.TestC
 	}
 }
 
+func testAccCheckDeploymentStageCanary(stage *apigateway.Stage, deployment *apigateway.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if stage.CanarySettings == nil {
+			return fmt.Errorf("API Gateway Stage (%s) has no canary settings", aws.StringValue(stage.StageName))
+		}
+
+		if got, want := aws.StringValue(stage.CanarySettings.DeploymentId), aws.StringValue(deployment.Id); got != want {
+			return fmt.Errorf("API Gateway Stage (%s) canary deployment = %s, want %s", aws.StringValue(stage.StageName), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccDeploymentBaseConfig(uri string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
@@ -458,3 +502,40 @@ resource "aws_api_gateway_deployment" "test" {
 }
 `, key1, value1)
 }
+
+func testAccDeploymentConfig_canarySettings(rName string) string {
+	return testAccDeploymentBaseConfig("http://example.com") + fmt.Sprintf(`
+resource "aws_api_gateway_deployment" "stable" {
+  depends_on = [aws_api_gateway_integration.test]
+
+  rest_api_id = aws_api_gateway_rest_api.test.id
+}
+
+resource "aws_api_gateway_stage" "test" {
+  deployment_id = aws_api_gateway_deployment.stable.id
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  stage_name    = %[1]q
+
+  variables = {
+    version = "stable"
+  }
+
+  lifecycle {
+    ignore_changes = [canary_settings]
+  }
+}
+
+resource "aws_api_gateway_deployment" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  stage_name  = aws_api_gateway_stage.test.stage_name
+
+  canary_settings {
+    percent_traffic = 25
+
+    stage_variable_overrides = {
+      version = "canary"
+    }
+  }
+}
+`, rName)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -73,10 +74,16 @@ func ResourceStage() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"percent_traffic": {
-							Type:     schema.TypeFloat,
+						"deployment_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0.0,
+							Computed: true,
+						},
+						"percent_traffic": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      0.0,
+							ValidateFunc: validation.FloatBetween(0.0, 100.0),
 						},
 						"stage_variable_overrides": {
 							Type:     schema.TypeMap,
@@ -360,7 +367,8 @@ func resourceStageUpdate(d *schema.ResourceData, meta interface{}) error {
 				Value: aws.String(d.Get("deployment_id").(string)),
 			})
 
-			if _, ok := d.GetOk("canary_settings"); ok {
+			// Without an explicit canary deployment, the canary follows the stage's deployment.
+			if _, ok := d.GetOk("canary_settings"); ok && !stageCanaryDeploymentIDConfigured(d) {
 				operations = append(operations, &apigateway.PatchOperation{
 					Op:    aws.String(apigateway.OpReplace),
 					Path:  aws.String("/canarySettings/deploymentId"),
@@ -435,6 +443,18 @@ func resourceStageUpdate(d *schema.ResourceData, meta interface{}) error {
 				return fmt.Errorf("error waiting for API Gateway Stage (%s) to be updated: %w", d.Id(), err)
 			}
 		}
+
+		if d.HasChanges("canary_settings", "deployment_id") {
+			_, canary := d.GetOk("canary_settings")
+			canaryDeploymentId := ""
+			if stageCanaryDeploymentIDConfigured(d) {
+				canaryDeploymentId = d.Get("canary_settings.0.deployment_id").(string)
+			}
+
+			if err := waitStageDeploymentUpdated(conn, respApiId, stageName, d.Get("deployment_id").(string), canary, canaryDeploymentId); err != nil {
+				return fmt.Errorf("error waiting for API Gateway Stage (%s) deployment update: %w", d.Id(), err)
+			}
+		}
 	}
 
 	return resourceStageRead(d, meta)
@@ -513,6 +533,10 @@ func expandStageCanarySettings(l []interface{}, deploymentId string) *apigateway
 		DeploymentId: aws.String(deploymentId),
 	}
 
+	if v, ok := m["deployment_id"].(string); ok && v != "" {
+		canarySettings.DeploymentId = aws.String(v)
+	}
+
 	if v, ok := m["percent_traffic"].(float64); ok {
 		canarySettings.PercentTraffic = aws.Float64(v)
 	}
@@ -541,6 +565,7 @@ func flattenCanarySettings(canarySettings *apigateway.CanarySettings) []interfac
 		settings["stage_variable_overrides"] = overrides
 	}
 
+	settings["deployment_id"] = aws.StringValue(canarySettings.DeploymentId)
 	settings["percent_traffic"] = canarySettings.PercentTraffic
 	settings["use_stage_cache"] = canarySettings.UseStageCache
 
@@ -561,12 +586,21 @@ func appendCanarySettingsPatchOperations(operations []*apigateway.PatchOperation
 		oldSettings = oldCanarySettingsRaw[0].(map[string]interface{})
 	} else {
 		oldSettings = map[string]interface{}{
+			"deployment_id":            "",
 			"percent_traffic":          0.0,
 			"stage_variable_overrides": make(map[string]interface{}),
 			"use_stage_cache":          false,
 		}
 	}
 
+	if v := newSettings["deployment_id"].(string); v != "" && v != oldSettings["deployment_id"] {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String(apigateway.OpReplace),
+			Path:  aws.String("/canarySettings/deploymentId"),
+			Value: aws.String(v),
+		})
+	}
+
 	oldOverrides := oldSettings["stage_variable_overrides"].(map[string]interface{})
 	newOverrides := newSettings["stage_variable_overrides"].(map[string]interface{})
 	operations = append(operations, diffVariablesOps(oldOverrides, newOverrides, "/canarySettings/stageVariableOverrides/")...)
@@ -593,3 +627,23 @@ func appendCanarySettingsPatchOperations(operations []*apigateway.PatchOperation
 
 	return operations
 }
+
+// stageCanaryDeploymentIDConfigured returns whether the canary's deployment is set in configuration,
+// as opposed to following the stage's deployment.
+func stageCanaryDeploymentIDConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false
+	}
+
+	canarySettings := rawConfig.GetAttr("canary_settings")
+
+	if !canarySettings.IsKnown() || canarySettings.IsNull() || canarySettings.LengthInt() == 0 {
+		return false
+	}
+
+	deploymentId := canarySettings.Index(cty.NumberIntVal(0)).GetAttr("deployment_id")
+
+	return !deploymentId.IsNull()
+}
@@ -519,6 +519,44 @@ func TestAccAPIGatewayStage_canarySettings(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayStage_canaryDeploymentPromotion(t *testing.T) {
+	var conf apigateway.Stage
+	rName := sdkacctest.RandString(5)
+	resourceName := "aws_api_gateway_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_canaryDeployment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_id", "aws_api_gateway_deployment.dev", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "canary_settings.0.deployment_id", "aws_api_gateway_deployment.canary", "id"),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.0.percent_traffic", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccStageImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStageConfig_canaryDeploymentPromoted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_id", "aws_api_gateway_deployment.canary", "id"),
+					resource.TestCheckResourceAttr(resourceName, "canary_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckStageExists(n string, res *apigateway.Stage) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -925,3 +963,39 @@ resource "aws_api_gateway_stage" "test" {
 }
 `
 }
+
+func testAccStageConfig_canaryDeploymentBase(rName string) string {
+	return testAccStageConfig_base(rName) + `
+resource "aws_api_gateway_deployment" "canary" {
+  depends_on = [aws_api_gateway_integration.test]
+
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  description = "canary"
+}
+`
+}
+
+func testAccStageConfig_canaryDeployment(rName string) string {
+	return testAccStageConfig_canaryDeploymentBase(rName) + `
+resource "aws_api_gateway_stage" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  stage_name    = "prod"
+  deployment_id = aws_api_gateway_deployment.dev.id
+
+  canary_settings {
+    deployment_id   = aws_api_gateway_deployment.canary.id
+    percent_traffic = 10
+  }
+}
+`
+}
+
+func testAccStageConfig_canaryDeploymentPromoted(rName string) string {
+	return testAccStageConfig_canaryDeploymentBase(rName) + `
+resource "aws_api_gateway_stage" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  stage_name    = "prod"
+  deployment_id = aws_api_gateway_deployment.canary.id
+}
+`
+}
@@ -3,8 +3,10 @@ package apigateway
 import (
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,6 +21,9 @@ const (
 
 	// Maximum amount of time for Stage Cache to update
 	stageCacheUpdateTimeout = 30 * time.Minute
+
+	// Maximum amount of time for Stage deployment and canary changes to be reflected
+	stageDeploymentUpdateTimeout = 5 * time.Minute
 )
 
 func waitVPCLinkAvailable(conn *apigateway.APIGateway, vpcLinkId string) error {
@@ -98,3 +103,33 @@ func waitStageCacheUpdated(conn *apigateway.APIGateway, restApiId, name string)
 
 	return nil, err
 }
+
+// waitStageDeploymentUpdated waits until the stage serves the specified deployment and,
+// if canary is true, has canary settings for canaryDeploymentId (any deployment if empty).
+// If canary is false, waits until the stage's canary settings have been removed.
+func waitStageDeploymentUpdated(conn *apigateway.APIGateway, restApiId, name, deploymentId string, canary bool, canaryDeploymentId string) error {
+	return tfresource.WaitUntil(stageDeploymentUpdateTimeout, func() (bool, error) {
+		output, err := FindStageByName(conn, restApiId, name)
+
+		if err != nil {
+			return false, err
+		}
+
+		if aws.StringValue(output.DeploymentId) != deploymentId {
+			return false, nil
+		}
+
+		if !canary {
+			return output.CanarySettings == nil, nil
+		}
+
+		if output.CanarySettings == nil {
+			return false, nil
+		}
+
+		return canaryDeploymentId == "" || aws.StringValue(output.CanarySettings.DeploymentId) == canaryDeploymentId, nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                2 * time.Second,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v2"
 )
 
 func ResourceAPI() *schema.Resource {
@@ -334,6 +336,22 @@ func resourceAPIRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
 
+	// Reimporting the OpenAPI specification deletes routes and integrations it does not define,
+	// e.g. those managed by aws_apigatewayv2_route and aws_apigatewayv2_integration resources.
+	// Refuse the change rather than deleting them.
+	if o, n := d.GetChange("body"); d.HasChange("body") && n.(string) != "" {
+		unmanaged, err := findRoutesAndIntegrationsNotInOpenAPI(conn, d.Id(), o.(string))
+
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway v2 API (%s) routes and integrations: %w", d.Id(), err)
+		}
+
+		if len(unmanaged.integrations) > 0 || len(unmanaged.routes) > 0 {
+			return fmt.Errorf("API Gateway v2 API (%s) has routes or integrations that are not defined by its OpenAPI specification: %s. "+
+				"Changing body reimports the specification, which deletes them. Define them in body, or remove them before changing body", d.Id(), unmanaged)
+		}
+	}
+
 	deleteCorsConfiguration := false
 	if d.HasChange("cors_configuration") {
 		v := d.Get("cors_configuration")
@@ -463,3 +481,121 @@ func flattenCORSConfiguration(configuration *apigatewayv2.Cors) []interface{} {
 		"max_age":           int(aws.Int64Value(configuration.MaxAge)),
 	}}
 }
+
+// apiRoutesAndIntegrations holds routes and integrations that are managed outside of an API's OpenAPI specification.
+type apiRoutesAndIntegrations struct {
+	integrations []*apigatewayv2.Integration
+	routes       []*apigatewayv2.Route
+}
+
+func (v *apiRoutesAndIntegrations) String() string {
+	var items []string
+
+	for _, route := range v.routes {
+		items = append(items, fmt.Sprintf("route (%s)", aws.StringValue(route.RouteKey)))
+	}
+
+	for _, integration := range v.integrations {
+		items = append(items, fmt.Sprintf("integration (%s)", aws.StringValue(integration.IntegrationId)))
+	}
+
+	return strings.Join(items, ", ")
+}
+
+// findRoutesAndIntegrationsNotInOpenAPI returns the API's routes whose route keys are not defined in the specified
+// OpenAPI specification, along with the integrations that those routes target and any integrations not targeted by a route.
+func findRoutesAndIntegrationsNotInOpenAPI(conn *apigatewayv2.ApiGatewayV2, apiID, body string) (*apiRoutesAndIntegrations, error) {
+	routeKeys, err := openAPIRouteKeys(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := FindRoutes(conn, &apigatewayv2.GetRoutesInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	integrations, err := FindIntegrations(conn, &apigatewayv2.GetIntegrationsInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &apiRoutesAndIntegrations{}
+	targeted := make(map[string]bool)
+
+	for _, route := range routes {
+		integrationID := routeTargetIntegrationID(aws.StringValue(route.Target))
+		_, inSpec := routeKeys[aws.StringValue(route.RouteKey)]
+
+		if !inSpec {
+			output.routes = append(output.routes, route)
+		}
+
+		if integrationID == "" {
+			continue
+		}
+
+		// An integration targeted by any route outside the specification must be kept.
+		targeted[integrationID] = targeted[integrationID] || !inSpec
+	}
+
+	for _, integration := range integrations {
+		if keep, ok := targeted[aws.StringValue(integration.IntegrationId)]; !ok || keep {
+			output.integrations = append(output.integrations, integration)
+		}
+	}
+
+	return output, nil
+}
+
+// routeTargetIntegrationID returns the integration ID from a route target of the form "integrations/<IntegrationID>".
+func routeTargetIntegrationID(target string) string {
+	if id := strings.TrimPrefix(target, "integrations/"); id != target {
+		return id
+	}
+
+	return ""
+}
+
+// openAPIRouteKeys returns the route keys ("<METHOD> <path>") defined by an OpenAPI specification.
+func openAPIRouteKeys(body string) (map[string]struct{}, error) {
+	routeKeys := make(map[string]struct{})
+
+	if body == "" {
+		return routeKeys, nil
+	}
+
+	var spec struct {
+		Paths map[string]map[string]interface{} `yaml:"paths"`
+	}
+
+	// YAML is a superset of JSON.
+	if err := yaml.Unmarshal([]byte(body), &spec); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI specification: %w", err)
+	}
+
+	for path, operations := range spec.Paths {
+		if path == "$default" {
+			routeKeys[path] = struct{}{}
+			continue
+		}
+
+		for method := range operations {
+			switch method = strings.ToLower(method); method {
+			case "x-amazon-apigateway-any-method":
+				routeKeys["ANY "+path] = struct{}{}
+			case "delete", "get", "head", "options", "patch", "post", "put":
+				routeKeys[strings.ToUpper(method)+" "+path] = struct{}{}
+			}
+		}
+	}
+
+	return routeKeys, nil
+}
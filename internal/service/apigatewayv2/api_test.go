@@ -534,6 +534,60 @@ func TestAccAPIGatewayV2API_OpenAPI_failOnWarnings(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2API_OpenAPI_withRoutes(t *testing.T) {
+	var v apigatewayv2.GetApiOutput
+	var apiId string
+	var route1, route2 apigatewayv2.GetRouteOutput
+	resourceName := "aws_apigatewayv2_api.test"
+	routeResourceName := "aws_apigatewayv2_route.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIConfig_openWithRoutes(rName, "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIExists(resourceName, &v),
+					testAccCheckAPIRoutes(&v, []string{"GET /test", "GET /managed"}),
+					testAccCheckRouteExists(routeResourceName, &apiId, &route1),
+					resource.TestCheckResourceAttr(routeResourceName, "route_key", "GET /managed"),
+				),
+			},
+			{
+				Config:      testAccAPIConfig_openWithRoutes(rName, "/update"),
+				ExpectError: regexp.MustCompile(`not defined by its OpenAPI specification:\s+route\s+\(GET /managed\)`),
+			},
+			{
+				Config: testAccAPIConfig_openWithRoutes(rName, "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIExists(resourceName, &v),
+					testAccCheckAPIRoutes(&v, []string{"GET /test", "GET /managed"}),
+					testAccCheckRouteExists(routeResourceName, &apiId, &route2),
+					testAccCheckRouteNotRecreated(&route1, &route2),
+				),
+			},
+			{
+				Config:   testAccAPIConfig_openWithRoutes(rName, "/test"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRouteNotRecreated(i, j *apigatewayv2.GetRouteOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.RouteId) != aws.StringValue(j.RouteId) {
+			return fmt.Errorf("API Gateway V2 Route recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAPIRoutes(v *apigatewayv2.GetApiOutput, routes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn
@@ -1320,3 +1374,47 @@ EOF
 }
 `, rName, failOnWarnings)
 }
+
+func testAccAPIConfig_openWithRoutes(rName, path string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+  body          = <<EOF
+{
+  "openapi": "3.0.1",
+  "info": {
+    "title": "%[1]s_DIFFERENT",
+    "version": "1.0"
+  },
+  "paths": {
+    "%[2]s": {
+      "get": {
+        "x-amazon-apigateway-integration": {
+          "type": "HTTP_PROXY",
+          "httpMethod": "GET",
+          "payloadFormatVersion": "1.0",
+          "uri": "https://www.google.de"
+        }
+      }
+    }
+  }
+}
+EOF
+}
+
+resource "aws_apigatewayv2_integration" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  integration_type   = "HTTP_PROXY"
+  integration_method = "GET"
+  integration_uri    = "https://example.com"
+}
+
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /managed"
+  target    = "integrations/${aws_apigatewayv2_integration.test.id}"
+}
+`, rName, path)
+}
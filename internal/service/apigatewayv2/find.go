@@ -104,3 +104,73 @@ func FindDomainName(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDoma
 
 	return output, nil
 }
+
+// FindIntegrations returns the integrations corresponding to the specified input.
+// Returns an empty slice if no integrations are found.
+func FindIntegrations(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationsInput) ([]*apigatewayv2.Integration, error) {
+	var integrations []*apigatewayv2.Integration
+
+	err := getIntegrationsPages(conn, input, func(page *apigatewayv2.GetIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			integrations = append(integrations, item)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return integrations, nil
+}
+
+// FindRoutes returns the routes corresponding to the specified input.
+// Returns an empty slice if no routes are found.
+func FindRoutes(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRoutesInput) ([]*apigatewayv2.Route, error) {
+	var routes []*apigatewayv2.Route
+
+	err := getRoutesPages(conn, input, func(page *apigatewayv2.GetRoutesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			routes = append(routes, item)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -95,3 +95,76 @@ func FindConnectorProfileByARN(ctx context.Context, conn *appflow.Appflow, arn s
 
 	return result, nil
 }
+
+func FindFlowExecutionByID(ctx context.Context, conn *appflow.Appflow, flowName, executionID string) (*appflow.ExecutionRecord, error) {
+	in := &appflow.DescribeFlowExecutionRecordsInput{
+		FlowName: aws.String(flowName),
+	}
+	var result *appflow.ExecutionRecord
+
+	err := conn.DescribeFlowExecutionRecordsPagesWithContext(ctx, in, func(page *appflow.DescribeFlowExecutionRecordsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, execution := range page.FlowExecutions {
+			if execution == nil {
+				continue
+			}
+
+			if aws.StringValue(execution.ExecutionId) == executionID {
+				result = execution
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("No execution with id %q for flow %q", executionID, flowName),
+			LastRequest: in,
+		}
+	}
+
+	return result, nil
+}
+
+func FindFlowByName(ctx context.Context, conn *appflow.Appflow, name string) (*appflow.DescribeFlowOutput, error) {
+	in := &appflow.DescribeFlowInput{
+		FlowName: aws.String(name),
+	}
+
+	out, err := conn.DescribeFlowWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("No flow with name %q", name),
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
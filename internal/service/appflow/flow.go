@@ -679,6 +679,45 @@ func ResourceFlow() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`arn:.*:kms:.*:[0-9]+:.*`), "must be a valid ARN of a Key Management Services (KMS) key"),
 			},
+			"metadata_catalog_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_data_catalog": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]+$`), "must contain only lowercase alphanumeric and underscore (_) characters"),
+										),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"table_prefix": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 128),
+											validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]+$`), "must contain only lowercase alphanumeric and underscore (_) characters"),
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"source_flow_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -891,11 +930,39 @@ func ResourceFlow() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
-												"object": {
+												"object_path": {
 													Type:         schema.TypeString,
-													Required:     true,
+													Optional:     true,
 													ValidateFunc: validation.All(validation.StringMatch(regexp.MustCompile(`\S+`), "must not contain any whitespace characters"), validation.StringLenBetween(1, 512)),
 												},
+												"pagination_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_page_size": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10000),
+															},
+														},
+													},
+												},
+												"parallelism_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_parallelism": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -1223,6 +1290,10 @@ func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.KmsArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	if len(tags) > 0 {
@@ -1279,6 +1350,14 @@ func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	d.Set("kms_arn", out2.KmsArn)
 
+	if out2.MetadataCatalogConfig != nil {
+		if err := d.Set("metadata_catalog_config", []interface{}{flattenMetadataCatalogConfig(out2.MetadataCatalogConfig)}); err != nil {
+			return diag.Errorf("error setting metadata_catalog_config: %s", err)
+		}
+	} else {
+		d.Set("metadata_catalog_config", nil)
+	}
+
 	if out2.SourceFlowConfig != nil {
 		if err := d.Set("source_flow_config", []interface{}{flattenSourceFlowConfig(out2.SourceFlowConfig)}); err != nil {
 			return diag.Errorf("error setting source_flow_config: %s", err)
@@ -1336,6 +1415,10 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.Description = aws.String(d.Get("description").(string))
 	}
 
+	if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Updating AppFlow Flow (%s): %#v", d.Id(), in)
 	_, err := conn.UpdateFlow(in)
 
@@ -1381,6 +1464,42 @@ func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
+func expandMetadataCatalogConfig(tfMap map[string]interface{}) *appflow.MetadataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.MetadataCatalogConfig{}
+
+	if v, ok := tfMap["glue_data_catalog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.GlueDataCatalog = expandGlueDataCatalogConfig(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandGlueDataCatalogConfig(tfMap map[string]interface{}) *appflow.GlueDataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &appflow.GlueDataCatalogConfig{}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		a.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		a.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_prefix"].(string); ok && v != "" {
+		a.TablePrefix = aws.String(v)
+	}
+
+	return a
+}
+
 func expandErrorHandlingConfig(tfMap map[string]interface{}) *appflow.ErrorHandlingConfig {
 	if tfMap == nil {
 		return nil
@@ -2182,6 +2301,18 @@ func expandSAPODataSourceProperties(tfMap map[string]interface{}) *appflow.SAPOD
 		a.ObjectPath = aws.String(v)
 	}
 
+	if v, ok := tfMap["pagination_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.PaginationConfig = &appflow.SAPODataPaginationConfig{
+			MaxPageSize: aws.Int64(int64(v[0].(map[string]interface{})["max_page_size"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["parallelism_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.ParallelismConfig = &appflow.SAPODataParallelismConfig{
+			MaxParallelism: aws.Int64(int64(v[0].(map[string]interface{})["max_parallelism"].(int))),
+		}
+	}
+
 	return a
 }
 
@@ -3286,6 +3417,18 @@ func flattenSAPODataSourceProperties(sapoDataSourceProperties *appflow.SAPODataS
 		m["object_path"] = aws.StringValue(v)
 	}
 
+	if v := sapoDataSourceProperties.PaginationConfig; v != nil {
+		m["pagination_config"] = []interface{}{map[string]interface{}{
+			"max_page_size": aws.Int64Value(v.MaxPageSize),
+		}}
+	}
+
+	if v := sapoDataSourceProperties.ParallelismConfig; v != nil {
+		m["parallelism_config"] = []interface{}{map[string]interface{}{
+			"max_parallelism": aws.Int64Value(v.MaxParallelism),
+		}}
+	}
+
 	return m
 }
 
@@ -3580,3 +3723,39 @@ func flattenScheduled(scheduledTriggerProperties *appflow.ScheduledTriggerProper
 
 	return m
 }
+
+func flattenMetadataCatalogConfig(metadataCatalogConfig *appflow.MetadataCatalogConfig) map[string]interface{} {
+	if metadataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := metadataCatalogConfig.GlueDataCatalog; v != nil {
+		m["glue_data_catalog"] = []interface{}{flattenGlueDataCatalogConfig(v)}
+	}
+
+	return m
+}
+
+func flattenGlueDataCatalogConfig(glueDataCatalogConfig *appflow.GlueDataCatalogConfig) map[string]interface{} {
+	if glueDataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := glueDataCatalogConfig.DatabaseName; v != nil {
+		m["database_name"] = aws.StringValue(v)
+	}
+
+	if v := glueDataCatalogConfig.RoleArn; v != nil {
+		m["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := glueDataCatalogConfig.TablePrefix; v != nil {
+		m["table_prefix"] = aws.StringValue(v)
+	}
+
+	return m
+}
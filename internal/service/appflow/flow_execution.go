@@ -0,0 +1,214 @@
+package appflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceFlowExecution runs an on-demand flow and waits for the run to complete.
+// Changing any argument runs the flow again.
+func ResourceFlowExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowExecutionCreate,
+		ReadWithoutTimeout:   resourceFlowExecutionRead,
+		DeleteWithoutTimeout: resourceFlowExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bytes_processed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bytes_written": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flow_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records_processed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceFlowExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	flowName := d.Get("flow_name").(string)
+
+	out, err := conn.StartFlowWithContext(ctx, &appflow.StartFlowInput{
+		FlowName: aws.String(flowName),
+	})
+
+	if err != nil {
+		return diag.Errorf("starting AppFlow Flow (%s): %s", flowName, err)
+	}
+
+	if out == nil || out.ExecutionId == nil {
+		return diag.Errorf("starting AppFlow Flow (%s): empty output", flowName)
+	}
+
+	executionID := aws.StringValue(out.ExecutionId)
+	d.SetId(FlowExecutionCreateResourceID(flowName, executionID))
+
+	if _, err := FlowExecutionSucceeded(ctx, conn, flowName, executionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for AppFlow Flow (%s) execution (%s) to complete: %s", flowName, executionID, err)
+	}
+
+	return resourceFlowExecutionRead(ctx, d, meta)
+}
+
+func resourceFlowExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	flowName, executionID, err := FlowExecutionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindFlowExecutionByID(ctx, conn, flowName, executionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Execution records are only retained for a limited time. Keep the completed execution in state
+		// rather than running the flow again.
+		if _, err := FindFlowByName(ctx, conn, flowName); err == nil {
+			log.Printf("[WARN] AppFlow Flow (%s) execution (%s) record not found, keeping in state", flowName, executionID)
+			return nil
+		}
+
+		log.Printf("[WARN] AppFlow Flow (%s) execution (%s) not found, removing from state", flowName, executionID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading AppFlow Flow (%s) execution (%s): %s", flowName, executionID, err)
+	}
+
+	d.Set("execution_id", out.ExecutionId)
+	d.Set("execution_status", out.ExecutionStatus)
+	d.Set("flow_name", flowName)
+
+	if v := out.ExecutionResult; v != nil {
+		d.Set("bytes_processed", v.BytesProcessed)
+		d.Set("bytes_written", v.BytesWritten)
+		d.Set("records_processed", v.RecordsProcessed)
+	}
+
+	if v := out.LastUpdatedAt; v != nil {
+		d.Set("last_updated_at", aws.TimeValue(v).Format(time.RFC3339))
+	}
+
+	if v := out.StartedAt; v != nil {
+		d.Set("started_at", aws.TimeValue(v).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceFlowExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	flowName, executionID, err := FlowExecutionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindFlowExecutionByID(ctx, conn, flowName, executionID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading AppFlow Flow (%s) execution (%s): %s", flowName, executionID, err)
+	}
+
+	// Completed executions cannot be deleted. Only executions still in progress are canceled.
+	if aws.StringValue(out.ExecutionStatus) != appflow.ExecutionStatusInProgress {
+		return nil
+	}
+
+	log.Printf("[INFO] Canceling AppFlow Flow (%s) execution (%s)", flowName, executionID)
+	_, err = conn.CancelFlowExecutionsWithContext(ctx, &appflow.CancelFlowExecutionsInput{
+		ExecutionIds: aws.StringSlice([]string{executionID}),
+		FlowName:     aws.String(flowName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("canceling AppFlow Flow (%s) execution (%s): %s", flowName, executionID, err)
+	}
+
+	if _, err := FlowExecutionCanceled(ctx, conn, flowName, executionID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for AppFlow Flow (%s) execution (%s) to cancel: %s", flowName, executionID, err)
+	}
+
+	return nil
+}
+
+const flowExecutionResourceIDSeparator = ","
+
+func FlowExecutionCreateResourceID(flowName, executionID string) string {
+	parts := []string{flowName, executionID}
+	id := strings.Join(parts, flowExecutionResourceIDSeparator)
+
+	return id
+}
+
+func FlowExecutionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, flowExecutionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FLOW_NAME%[2]sEXECUTION_ID", id, flowExecutionResourceIDSeparator)
+}
@@ -0,0 +1,152 @@
+package appflow_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appflow"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappflow "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+)
+
+func TestAccAppFlowFlowExecution_basic(t *testing.T) {
+	var execution appflow.ExecutionRecord
+	rSourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rDestinationName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rFlowName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appflow.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowExecutionConfig_basic(rSourceName, rDestinationName, rFlowName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExecutionExists(resourceName, &execution),
+					resource.TestCheckResourceAttrSet(resourceName, "execution_id"),
+					resource.TestCheckResourceAttr(resourceName, "execution_status", appflow.ExecutionStatusSuccessful),
+					resource.TestCheckResourceAttrPair(resourceName, "flow_name", "aws_appflow_flow.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "records_processed"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+				),
+			},
+			{
+				Config: testAccFlowExecutionConfig_basic(rSourceName, rDestinationName, rFlowName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExecutionRecreated(resourceName, &execution),
+					resource.TestCheckResourceAttr(resourceName, "execution_status", appflow.ExecutionStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowExecutionExists(resourceName string, execution *appflow.ExecutionRecord) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		flowName, executionID, err := tfappflow.FlowExecutionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+		output, err := tfappflow.FindFlowExecutionByID(context.Background(), conn, flowName, executionID)
+
+		if err != nil {
+			return err
+		}
+
+		*execution = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFlowExecutionRecreated(resourceName string, before *appflow.ExecutionRecord) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var after appflow.ExecutionRecord
+
+		if err := testAccCheckFlowExecutionExists(resourceName, &after)(s); err != nil {
+			return err
+		}
+
+		if before.ExecutionId != nil && after.ExecutionId != nil && *before.ExecutionId == *after.ExecutionId {
+			return fmt.Errorf("AppFlow Flow execution (%s) was not run again", *after.ExecutionId)
+		}
+
+		return nil
+	}
+}
+
+func testAccFlowExecutionConfig_basic(rSourceName, rDestinationName, rFlowName, trigger string) string {
+	return acctest.ConfigCompose(
+		testAccFlowConfig_base(rSourceName, rDestinationName),
+		fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.test_source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.test_destination.bucket
+
+        s3_output_format_config {
+          prefix_config {
+            prefix_type = "PATH"
+          }
+        }
+      }
+    }
+  }
+
+  task {
+    source_fields     = ["testField"]
+    destination_field = "testField"
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+
+resource "aws_appflow_flow_execution" "test" {
+  flow_name = aws_appflow_flow.test.name
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, rFlowName, trigger),
+	)
+}
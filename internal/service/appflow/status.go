@@ -24,3 +24,19 @@ func FlowStatus(ctx context.Context, conn *appflow.Appflow, id string) resource.
 		return out, aws.StringValue(out.FlowStatus), nil
 	}
 }
+
+func FlowExecutionStatus(ctx context.Context, conn *appflow.Appflow, flowName, executionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindFlowExecutionByID(ctx, conn, flowName, executionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.ExecutionStatus), nil
+	}
+}
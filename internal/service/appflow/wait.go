@@ -2,10 +2,13 @@ package appflow
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appflow"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -24,3 +27,42 @@ func FlowDeleted(ctx context.Context, conn *appflow.Appflow, id string) error {
 
 	return err
 }
+
+func FlowExecutionSucceeded(ctx context.Context, conn *appflow.Appflow, flowName, executionID string, timeout time.Duration) (*appflow.ExecutionRecord, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appflow.ExecutionStatusInProgress},
+		Target:  []string{appflow.ExecutionStatusSuccessful},
+		Refresh: FlowExecutionStatus(ctx, conn, flowName, executionID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*appflow.ExecutionRecord); ok {
+		if result := out.ExecutionResult; result != nil && result.ErrorInfo != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(result.ErrorInfo.ExecutionMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func FlowExecutionCanceled(ctx context.Context, conn *appflow.Appflow, flowName, executionID string, timeout time.Duration) (*appflow.ExecutionRecord, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appflow.ExecutionStatusInProgress, appflow.ExecutionStatusCancelStarted},
+		Target:  []string{appflow.ExecutionStatusCanceled, appflow.ExecutionStatusSuccessful, appflow.ExecutionStatusError},
+		Refresh: FlowExecutionStatus(ctx, conn, flowName, executionID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*appflow.ExecutionRecord); ok {
+		return out, err
+	}
+
+	return nil, err
+}
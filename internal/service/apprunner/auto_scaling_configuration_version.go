@@ -41,6 +41,14 @@ func ResourceAutoScalingConfigurationVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"has_associated_service": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"latest": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -164,6 +172,8 @@ func resourceAutoScalingConfigurationRead(ctx context.Context, d *schema.Resourc
 	d.Set("arn", arn)
 	d.Set("auto_scaling_configuration_name", config.AutoScalingConfigurationName)
 	d.Set("auto_scaling_configuration_revision", config.AutoScalingConfigurationRevision)
+	d.Set("has_associated_service", config.HasAssociatedService)
+	d.Set("is_default", config.IsDefault)
 	d.Set("latest", config.Latest)
 	d.Set("max_concurrency", config.MaxConcurrency)
 	d.Set("max_size", config.MaxSize)
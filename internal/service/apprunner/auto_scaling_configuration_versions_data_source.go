@@ -0,0 +1,126 @@
+package apprunner
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceAutoScalingConfigurationVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAutoScalingConfigurationVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"auto_scaling_configuration_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(4, 32),
+			},
+			"auto_scaling_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_scaling_configuration_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_scaling_configuration_revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"has_associated_service": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"latest_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceAutoScalingConfigurationVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	input := &apprunner.ListAutoScalingConfigurationsInput{}
+
+	if v, ok := d.GetOk("auto_scaling_configuration_name"); ok {
+		input.AutoScalingConfigurationName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("latest_only"); ok {
+		input.LatestOnly = aws.Bool(v.(bool))
+	}
+
+	summaries, err := FindAutoScalingConfigurationSummaries(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("error listing App Runner AutoScaling Configurations: %s", err)
+	}
+
+	var arns []string
+	var configurations []interface{}
+
+	for _, v := range summaries {
+		arns = append(arns, aws.StringValue(v.AutoScalingConfigurationArn))
+		configurations = append(configurations, flattenAutoScalingConfigurationSummary(v))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+
+	if err := d.Set("auto_scaling_configurations", configurations); err != nil {
+		return diag.Errorf("error setting auto_scaling_configurations: %s", err)
+	}
+
+	return nil
+}
+
+func flattenAutoScalingConfigurationSummary(apiObject *apprunner.AutoScalingConfigurationSummary) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"arn":                                 aws.StringValue(apiObject.AutoScalingConfigurationArn),
+		"auto_scaling_configuration_name":     aws.StringValue(apiObject.AutoScalingConfigurationName),
+		"auto_scaling_configuration_revision": int(aws.Int64Value(apiObject.AutoScalingConfigurationRevision)),
+		"has_associated_service":              aws.BoolValue(apiObject.HasAssociatedService),
+		"is_default":                          aws.BoolValue(apiObject.IsDefault),
+		"status":                              aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.CreatedAt; v != nil {
+		tfMap["created_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,54 @@
+package apprunner_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfapprunner "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
+)
+
+// Serialized with the default AutoScaling Configuration tests as it reads is_default.
+func testAccAutoScalingConfigurationVersionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_apprunner_auto_scaling_configuration_versions.test"
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apprunner.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultAutoScalingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoScalingConfigurationVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configurations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_scaling_configurations.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_scaling_configurations.0.auto_scaling_configuration_name", resourceName, "auto_scaling_configuration_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configurations.0.auto_scaling_configuration_revision", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "auto_scaling_configurations.0.created_at"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configurations.0.has_associated_service", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configurations.0.is_default", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configurations.0.status", tfapprunner.AutoScalingConfigurationStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutoScalingConfigurationVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDefaultAutoScalingConfigurationConfig_basic(rName, "test1"), fmt.Sprintf(`
+data "aws_apprunner_auto_scaling_configuration_versions" "test" {
+  auto_scaling_configuration_name = "%[1]s-1"
+  latest_only                     = true
+
+  depends_on = [aws_apprunner_default_auto_scaling_configuration.test]
+}
+`, rName))
+}
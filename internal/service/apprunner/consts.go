@@ -5,3 +5,9 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// ServiceDefaultAutoScalingConfigurationName is the name of the AutoScaling Configuration
+	// that App Runner provides in every account.
+	ServiceDefaultAutoScalingConfigurationName = "DefaultConfiguration"
+)
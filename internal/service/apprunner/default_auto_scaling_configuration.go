@@ -0,0 +1,98 @@
+package apprunner
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDefaultAutoScalingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDefaultAutoScalingConfigurationPut,
+		ReadWithoutTimeout:   resourceDefaultAutoScalingConfigurationRead,
+		UpdateWithoutTimeout: resourceDefaultAutoScalingConfigurationPut,
+		DeleteWithoutTimeout: resourceDefaultAutoScalingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_configuration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARNOfService("apprunner", "autoscalingconfiguration"),
+			},
+		},
+	}
+}
+
+func resourceDefaultAutoScalingConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	arn := d.Get("auto_scaling_configuration_arn").(string)
+	input := &apprunner.UpdateDefaultAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(arn),
+	}
+
+	_, err := conn.UpdateDefaultAutoScalingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error setting App Runner default AutoScaling Configuration (%s): %s", arn, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return resourceDefaultAutoScalingConfigurationRead(ctx, d, meta)
+}
+
+func resourceDefaultAutoScalingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	summary, err := FindDefaultAutoScalingConfigurationSummary(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner default AutoScaling Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading App Runner default AutoScaling Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("auto_scaling_configuration_arn", summary.AutoScalingConfigurationArn)
+
+	return nil
+}
+
+func resourceDefaultAutoScalingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	// Restore the AutoScaling Configuration that App Runner provides as the default.
+	summary, err := FindServiceDefaultAutoScalingConfigurationSummary(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("error reading App Runner AutoScaling Configuration (%s): %s", ServiceDefaultAutoScalingConfigurationName, err)
+	}
+
+	log.Printf("[DEBUG] Resetting App Runner default AutoScaling Configuration: %s", d.Id())
+	_, err = conn.UpdateDefaultAutoScalingConfigurationWithContext(ctx, &apprunner.UpdateDefaultAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: summary.AutoScalingConfigurationArn,
+	})
+
+	if err != nil {
+		return diag.Errorf("error resetting App Runner default AutoScaling Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package apprunner_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapprunner "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
+)
+
+func TestAccAppRunnerDefaultAutoScalingConfiguration_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccDefaultAutoScalingConfiguration_basic,
+		"dataSource": testAccAutoScalingConfigurationVersionsDataSource_basic,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccDefaultAutoScalingConfiguration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_default_auto_scaling_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apprunner.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultAutoScalingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultAutoScalingConfigurationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", "aws_apprunner_auto_scaling_configuration_version.test1", "arn"),
+					testAccCheckDefaultAutoScalingConfigurationIs("aws_apprunner_auto_scaling_configuration_version.test1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultAutoScalingConfigurationConfig_basic(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", "aws_apprunner_auto_scaling_configuration_version.test2", "arn"),
+					testAccCheckDefaultAutoScalingConfigurationIs("aws_apprunner_auto_scaling_configuration_version.test2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultAutoScalingConfigurationIs(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+
+		output, err := tfapprunner.FindDefaultAutoScalingConfigurationSummary(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.StringValue(output.AutoScalingConfigurationArn), rs.Primary.ID; got != want {
+			return fmt.Errorf("App Runner default AutoScaling Configuration is %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDefaultAutoScalingConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apprunner_default_auto_scaling_configuration" {
+			continue
+		}
+
+		output, err := tfapprunner.FindDefaultAutoScalingConfigurationSummary(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if v := aws.StringValue(output.AutoScalingConfigurationName); v != tfapprunner.ServiceDefaultAutoScalingConfigurationName {
+			return fmt.Errorf("App Runner default AutoScaling Configuration is still %s", v)
+		}
+	}
+
+	return nil
+}
+
+func testAccDefaultAutoScalingConfigurationConfig_basic(rName, defaultVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test1" {
+  auto_scaling_configuration_name = "%[1]s-1"
+}
+
+resource "aws_apprunner_auto_scaling_configuration_version" "test2" {
+  auto_scaling_configuration_name = "%[1]s-2"
+  max_size                        = 10
+}
+
+resource "aws_apprunner_default_auto_scaling_configuration" "test" {
+  auto_scaling_configuration_arn = aws_apprunner_auto_scaling_configuration_version.%[2]s.arn
+}
+`, rName, defaultVersion)
+}
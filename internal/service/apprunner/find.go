@@ -2,9 +2,11 @@ package apprunner
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func FindConnectionSummaryByName(ctx context.Context, conn *apprunner.AppRunner, name string) (*apprunner.ConnectionSummary, error) {
@@ -80,3 +82,68 @@ func FindCustomDomain(ctx context.Context, conn *apprunner.AppRunner, domainName
 
 	return customDomain, nil
 }
+
+func FindAutoScalingConfigurationSummaries(ctx context.Context, conn *apprunner.AppRunner, input *apprunner.ListAutoScalingConfigurationsInput) ([]*apprunner.AutoScalingConfigurationSummary, error) {
+	var output []*apprunner.AutoScalingConfigurationSummary
+
+	err := conn.ListAutoScalingConfigurationsPagesWithContext(ctx, input, func(page *apprunner.ListAutoScalingConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AutoScalingConfigurationSummaryList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindDefaultAutoScalingConfigurationSummary(ctx context.Context, conn *apprunner.AppRunner) (*apprunner.AutoScalingConfigurationSummary, error) {
+	summaries, err := FindAutoScalingConfigurationSummaries(ctx, conn, &apprunner.ListAutoScalingConfigurationsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range summaries {
+		if aws.BoolValue(v.IsDefault) {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: "no default App Runner AutoScaling Configuration",
+	}
+}
+
+// FindServiceDefaultAutoScalingConfigurationSummary returns the AutoScaling Configuration
+// provided by App Runner, which is the account default until another is chosen.
+func FindServiceDefaultAutoScalingConfigurationSummary(ctx context.Context, conn *apprunner.AppRunner) (*apprunner.AutoScalingConfigurationSummary, error) {
+	summaries, err := FindAutoScalingConfigurationSummaries(ctx, conn, &apprunner.ListAutoScalingConfigurationsInput{
+		AutoScalingConfigurationName: aws.String(ServiceDefaultAutoScalingConfigurationName),
+		LatestOnly:                   aws.Bool(true),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range summaries {
+		if aws.StringValue(v.AutoScalingConfigurationName) == ServiceDefaultAutoScalingConfigurationName {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: fmt.Sprintf("App Runner AutoScaling Configuration (%s) not found", ServiceDefaultAutoScalingConfigurationName),
+	}
+}
@@ -135,7 +135,7 @@ func ResourceService() *schema.Resource {
 						"instance_role_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNOfService("iam", "role"),
 						},
 						"memory": {
 							Type:         schema.TypeString,
@@ -232,7 +232,7 @@ func ResourceService() *schema.Resource {
 									"access_role_arn": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNOfService("iam", "role"),
 									},
 									"connection_arn": {
 										Type:         schema.TypeString,
@@ -58,9 +58,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			"AdditionalAuthentication_awsLambda":        testAccGraphQLAPI_AdditionalAuthentication_lambda,
 			"AdditionalAuthentication_multiple":         testAccGraphQLAPI_AdditionalAuthentication_multiple,
 			"xrayEnabled":                               testAccGraphQLAPI_xrayEnabled,
+			"mergedAPI":                                 testAccGraphQLAPI_mergedAPI,
 		},
 		"Function": {
 			"basic":                   testAccFunction_basic,
+			"code":                    testAccFunction_code,
 			"disappears":              testAccFunction_disappears,
 			"description":             testAccFunction_description,
 			"responseMappingTemplate": testAccFunction_responseMappingTemplate,
@@ -76,6 +78,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			"multipleResolvers": testAccResolver_multipleResolvers,
 			"pipeline":          testAccResolver_pipeline,
 			"caching":           testAccResolver_caching,
+			"code":              testAccResolver_code,
 			"sync":              testAccResolver_syncConfig,
 		},
 		"ApiCache": {
@@ -91,6 +94,10 @@ func TestAccAppSync_serial(t *testing.T) {
 			"basic":      testAccDomainNameAPIAssociation_basic,
 			"disappears": testAccDomainNameAPIAssociation_disappears,
 		},
+		"SourceAPIAssociation": {
+			"basic":      testAccSourceAPIAssociation_basic,
+			"disappears": testAccSourceAPIAssociation_disappears,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,141 @@
+package appsync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// codeEntryPoint is the module that code is read from in a code directory.
+	codeEntryPoint = "index.js"
+
+	// codeEvaluationContext is the minimal context used to validate code at plan time.
+	codeEvaluationContext = `{"arguments":{},"source":{},"stash":{}}`
+)
+
+// customizeDiffCode reads code from code_directory and validates code against the
+// configured runtime with the EvaluateCode API, so that errors are reported at plan time.
+func customizeDiffCode(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("code_directory"); ok {
+		code, err := readCodeDirectory(v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		if code != diff.Get("code").(string) {
+			if err := diff.SetNew("code", code); err != nil {
+				return err
+			}
+		}
+	} else if !diff.NewValueKnown("code_directory") {
+		return nil
+	} else if rawCode := diff.GetRawConfig().GetAttr("code"); rawCode.IsKnown() && rawCode.IsNull() && diff.Get("code").(string) != "" {
+		// code is computed when read from a directory, so clear it once neither is configured.
+		if err := diff.SetNew("code", ""); err != nil {
+			return err
+		}
+	}
+
+	if !diff.NewValueKnown("code") || !diff.NewValueKnown("runtime") {
+		return nil
+	}
+
+	code := diff.Get("code").(string)
+	runtime := expandRuntime(diff.Get("runtime").([]interface{}))
+
+	if code == "" {
+		return nil
+	}
+
+	if runtime == nil {
+		return fmt.Errorf("runtime is required when code is set")
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("code", "runtime") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	input := &appsync.EvaluateCodeInput{
+		Code:     aws.String(code),
+		Context:  aws.String(codeEvaluationContext),
+		Function: aws.String("request"),
+		Runtime:  runtime,
+	}
+
+	output, err := conn.EvaluateCode(input)
+
+	if err != nil {
+		return fmt.Errorf("evaluating AppSync %s code: %w", aws.StringValue(runtime.Name), err)
+	}
+
+	// Errors raised while running the code against the placeholder context are expected.
+	// Only report errors found in the code itself, e.g. syntax errors or unsupported language features.
+	if output.Error == nil || len(output.Error.CodeErrors) == 0 {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, v := range output.Error.CodeErrors {
+		if v == nil {
+			continue
+		}
+
+		if v.Location != nil {
+			errs = multierror.Append(errs, fmt.Errorf("%s at line %d, column %d: %s", aws.StringValue(v.ErrorType), aws.Int64Value(v.Location.Line), aws.Int64Value(v.Location.Column), aws.StringValue(v.Value)))
+		} else {
+			errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorType), aws.StringValue(v.Value)))
+		}
+	}
+
+	return fmt.Errorf("invalid AppSync %s code: %w", aws.StringValue(runtime.Name), errs.ErrorOrNil())
+}
+
+// readCodeDirectory returns the contents of the index.js entry point in dir.
+// The entry point must already be a single module, e.g. the output of a bundler such as esbuild.
+func readCodeDirectory(dir string) (string, error) {
+	code, err := os.ReadFile(filepath.Join(dir, codeEntryPoint))
+
+	if err != nil {
+		return "", fmt.Errorf("reading code from %s: %w", dir, err)
+	}
+
+	return string(code), nil
+}
+
+func expandRuntime(l []interface{}) *appsync.AppSyncRuntime {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &appsync.AppSyncRuntime{
+		Name:           aws.String(m["name"].(string)),
+		RuntimeVersion: aws.String(m["runtime_version"].(string)),
+	}
+}
+
+func flattenRuntime(runtime *appsync.AppSyncRuntime) []interface{} {
+	if runtime == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"name":            aws.StringValue(runtime.Name),
+		"runtime_version": aws.StringValue(runtime.RuntimeVersion),
+	}
+
+	return []interface{}{m}
+}
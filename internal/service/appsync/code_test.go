@@ -0,0 +1,75 @@
+package appsync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadCodeDirectory(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Files         map[string]string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			Name: "entry point",
+			Files: map[string]string{
+				"index.js": `export function request(ctx) {
+  return {};
+}
+`,
+				"lib/keys.js": `export const PREFIX = 'item#';
+`,
+			},
+			Expected: `export function request(ctx) {
+  return {};
+}
+`,
+		},
+		{
+			Name:          "missing entry point",
+			Files:         map[string]string{"main.js": ``},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			for name, content := range testCase.Files {
+				path := filepath.Join(dir, name)
+
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := readCodeDirectory(dir)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -15,6 +15,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	functionVersion20180529 = "2018-05-29"
+)
+
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFunctionCreate,
@@ -48,11 +52,11 @@ func ResourceFunction() *schema.Resource {
 			},
 			"request_mapping_template": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"response_mapping_template": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -61,9 +65,9 @@ func ResourceFunction() *schema.Resource {
 			"function_version": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "2018-05-29",
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"2018-05-29",
+					functionVersion20180529,
 				}, true),
 			},
 			"arn": {
@@ -107,7 +111,38 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"code_directory"},
+			},
+			"code_directory": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"code"},
+			},
+			"runtime": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.RuntimeName_Values(), false),
+						},
+						"runtime_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: customizeDiffCode,
 	}
 }
 
@@ -117,17 +152,29 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	apiID := d.Get("api_id").(string)
 
 	input := &appsync.CreateFunctionInput{
-		ApiId:                  aws.String(apiID),
-		DataSourceName:         aws.String(d.Get("data_source").(string)),
-		FunctionVersion:        aws.String(d.Get("function_version").(string)),
-		Name:                   aws.String(d.Get("name").(string)),
-		RequestMappingTemplate: aws.String(d.Get("request_mapping_template").(string)),
+		ApiId:          aws.String(apiID),
+		DataSourceName: aws.String(d.Get("data_source").(string)),
+		Name:           aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("function_version"); ok {
+		input.FunctionVersion = aws.String(v.(string))
+	} else if _, ok := d.GetOk("runtime"); !ok {
+		input.FunctionVersion = aws.String(functionVersion20180529)
+	}
+
+	if v, ok := d.GetOk("request_mapping_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("response_mapping_template"); ok {
 		input.ResponseMappingTemplate = aws.String(v.(string))
 	}
@@ -136,6 +183,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		input.MaxBatchSize = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("sync_config"); ok && len(v.([]interface{})) > 0 {
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
@@ -184,6 +235,11 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("request_mapping_template", function.RequestMappingTemplate)
 	d.Set("response_mapping_template", function.ResponseMappingTemplate)
 	d.Set("max_batch_size", function.MaxBatchSize)
+	d.Set("code", function.Code)
+
+	if err := d.Set("runtime", flattenRuntime(function.Runtime)); err != nil {
+		return fmt.Errorf("error setting runtime: %w", err)
+	}
 
 	if err := d.Set("sync_config", flattenSyncConfig(function.SyncConfig)); err != nil {
 		return fmt.Errorf("error setting sync_config: %w", err)
@@ -201,18 +257,30 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	input := &appsync.UpdateFunctionInput{
-		ApiId:                  aws.String(apiID),
-		DataSourceName:         aws.String(d.Get("data_source").(string)),
-		FunctionId:             aws.String(functionID),
-		FunctionVersion:        aws.String(d.Get("function_version").(string)),
-		Name:                   aws.String(d.Get("name").(string)),
-		RequestMappingTemplate: aws.String(d.Get("request_mapping_template").(string)),
+		ApiId:          aws.String(apiID),
+		DataSourceName: aws.String(d.Get("data_source").(string)),
+		FunctionId:     aws.String(functionID),
+		Name:           aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("function_version"); ok {
+		input.FunctionVersion = aws.String(v.(string))
+	} else if _, ok := d.GetOk("runtime"); !ok {
+		input.FunctionVersion = aws.String(functionVersion20180529)
+	}
+
+	if v, ok := d.GetOk("request_mapping_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("response_mapping_template"); ok {
 		input.ResponseMappingTemplate = aws.String(v.(string))
 	}
@@ -221,6 +289,10 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.MaxBatchSize = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("sync_config"); ok && len(v.([]interface{})) > 0 {
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
@@ -149,6 +149,43 @@ func testAccFunction_responseMappingTemplate(t *testing.T) {
 	})
 }
 
+func testAccFunction_code(t *testing.T) {
+	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	resourceName := "aws_appsync_function.test"
+	var config appsync.FunctionConfiguration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_code(rName1, rName2, acctest.Region(), "return {"),
+				ExpectError: regexp.MustCompile(`invalid AppSync APPSYNC_JS code`),
+			},
+			{
+				Config: testAccFunctionConfig_code(rName1, rName2, acctest.Region(), "return {};"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &config),
+					resource.TestCheckResourceAttrSet(resourceName, "code"),
+					resource.TestCheckResourceAttr(resourceName, "request_mapping_template", ""),
+					resource.TestCheckResourceAttr(resourceName, "response_mapping_template", ""),
+					resource.TestCheckResourceAttr(resourceName, "runtime.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.name", "APPSYNC_JS"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.runtime_version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccFunction_disappears(t *testing.T) {
 	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
@@ -379,3 +416,22 @@ EOF
 }
 `, testAccDataSourceConfig_dynamoDBRegion(r1, region), r2)
 }
+
+func testAccFunctionConfig_code(r1, r2, region, requestBody string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_appsync_function" "test" {
+  api_id      = aws_appsync_graphql_api.test.id
+  data_source = aws_appsync_datasource.test.name
+  name        = %[2]q
+
+  code = "export function request(ctx) {\n  %[3]s\n}\n\nexport function response(ctx) {\n  return ctx.result;\n}\n"
+
+  runtime {
+    name            = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+}
+`, testAccDataSourceConfig_dynamoDBRegion(r1, region), r2, requestBody)
+}
@@ -138,11 +138,42 @@ func ResourceResolver() *schema.Resource {
 					},
 				},
 			},
+			"runtime": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.RuntimeName_Values(), false),
+						},
+						"runtime_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"code_directory"},
+			},
+			"code_directory": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"code"},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffCode,
 	}
 }
 
@@ -187,6 +218,14 @@ func resourceResolverCreate(d *schema.ResourceData, meta interface{}) error {
 		input.CachingConfig = expandResolverCachingConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
 	mutexKey := fmt.Sprintf("appsync-schema-%s", d.Get("api_id").(string))
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
@@ -241,6 +280,11 @@ func resourceResolverRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("response_template", resolver.ResponseMappingTemplate)
 	d.Set("kind", resolver.Kind)
 	d.Set("max_batch_size", resolver.MaxBatchSize)
+	d.Set("code", resolver.Code)
+
+	if err := d.Set("runtime", flattenRuntime(resolver.Runtime)); err != nil {
+		return fmt.Errorf("error setting runtime: %w", err)
+	}
 
 	if err := d.Set("sync_config", flattenSyncConfig(resolver.SyncConfig)); err != nil {
 		return fmt.Errorf("error setting sync_config: %w", err)
@@ -298,6 +342,14 @@ func resourceResolverUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
 	mutexKey := fmt.Sprintf("appsync-schema-%s", d.Get("api_id").(string))
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
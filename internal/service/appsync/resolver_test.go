@@ -317,6 +317,44 @@ func testAccResolver_caching(t *testing.T) {
 	})
 }
 
+func testAccResolver_code(t *testing.T) {
+	var resolver appsync.Resolver
+	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	resourceName := "aws_appsync_resolver.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResolverConfig_code(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResolverExists(resourceName, &resolver),
+					resource.TestMatchResourceAttr(resourceName, "code", regexp.MustCompile(`return ctx\.result;`)),
+					resource.TestCheckResourceAttr(resourceName, "runtime.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.name", "APPSYNC_JS"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.runtime_version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResolverConfig_codeDirectory(rName, "test-fixtures/js-resolver"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResolverExists(resourceName, &resolver),
+					resource.TestMatchResourceAttr(resourceName, "code", regexp.MustCompile(`function buildRequest\(id\)`)),
+					resource.TestCheckResourceAttr(resourceName, "code_directory", "test-fixtures/js-resolver"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckResolverDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
 	for _, rs := range s.RootModule().Resources {
@@ -853,3 +891,49 @@ EOF
 }
 `, rName)
 }
+
+func testAccResolverConfig_code(rName string) string {
+	return testAccResolverConfig_base(rName) + `
+resource "aws_appsync_resolver" "test" {
+  api_id      = aws_appsync_graphql_api.test.id
+  field       = "singlePost"
+  type        = "Query"
+  data_source = aws_appsync_datasource.test.name
+
+  code = <<EOF
+export function request(ctx) {
+  return {
+    method: 'GET',
+    resourcePath: '/',
+  };
+}
+
+export function response(ctx) {
+  return ctx.result;
+}
+EOF
+
+  runtime {
+    name            = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+}
+`
+}
+
+func testAccResolverConfig_codeDirectory(rName, directory string) string {
+	return testAccResolverConfig_base(rName) + fmt.Sprintf(`
+resource "aws_appsync_resolver" "test" {
+  api_id         = aws_appsync_graphql_api.test.id
+  field          = "singlePost"
+  type           = "Query"
+  data_source    = aws_appsync_datasource.test.name
+  code_directory = %[1]q
+
+  runtime {
+    name            = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+}
+`, directory)
+}
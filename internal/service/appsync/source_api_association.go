@@ -0,0 +1,311 @@
+package appsync
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSourceAPIAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSourceAPIAssociationCreate,
+		Read:   resourceSourceAPIAssociationRead,
+		Update: resourceSourceAPIAssociationUpdate,
+		Delete: resourceSourceAPIAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_successful_merge_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_api_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"merged_api_arn", "merged_api_id"},
+			},
+			"merged_api_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"merged_api_arn", "merged_api_id"},
+			},
+			"source_api_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"source_api_arn", "source_api_id"},
+			},
+			"source_api_association_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.MergeType_Values(), false),
+						},
+					},
+				},
+			},
+			"source_api_association_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_api_association_status_detail": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_api_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"source_api_arn", "source_api_id"},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSourceAPIAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	input := &appsync.AssociateSourceGraphqlApiInput{}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("merged_api_arn"); ok {
+		input.MergedApiIdentifier = aws.String(v.(string))
+	} else {
+		input.MergedApiIdentifier = aws.String(d.Get("merged_api_id").(string))
+	}
+
+	if v, ok := d.GetOk("source_api_arn"); ok {
+		input.SourceApiIdentifier = aws.String(v.(string))
+	} else {
+		input.SourceApiIdentifier = aws.String(d.Get("source_api_id").(string))
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok {
+		input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{}))
+	}
+
+	output, err := conn.AssociateSourceGraphqlApi(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppSync Source API Association: %w", err)
+	}
+
+	association := output.SourceApiAssociation
+	d.SetId(SourceAPIAssociationCreateResourceID(aws.StringValue(association.MergedApiId), aws.StringValue(association.AssociationId)))
+
+	if _, err := waitSourceAPIAssociationMerged(conn, aws.StringValue(association.MergedApiId), aws.StringValue(association.AssociationId)); err != nil {
+		return fmt.Errorf("error waiting for AppSync Source API Association (%s) merge: %w", d.Id(), err)
+	}
+
+	return resourceSourceAPIAssociationRead(d, meta)
+}
+
+func resourceSourceAPIAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceAPIAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindSourceAPIAssociationByTwoPartKey(conn, mergedAPIID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppSync Source API Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("description", association.Description)
+	if association.LastSuccessfulMergeDate != nil {
+		d.Set("last_successful_merge_date", aws.TimeValue(association.LastSuccessfulMergeDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_successful_merge_date", nil)
+	}
+	d.Set("merged_api_arn", association.MergedApiArn)
+	d.Set("merged_api_id", association.MergedApiId)
+	d.Set("source_api_arn", association.SourceApiArn)
+	if err := d.Set("source_api_association_config", flattenSourceAPIAssociationConfig(association.SourceApiAssociationConfig)); err != nil {
+		return fmt.Errorf("error setting source_api_association_config: %w", err)
+	}
+	d.Set("source_api_association_status", association.SourceApiAssociationStatus)
+	d.Set("source_api_association_status_detail", association.SourceApiAssociationStatusDetail)
+	d.Set("source_api_id", association.SourceApiId)
+
+	return nil
+}
+
+func resourceSourceAPIAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceAPIAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("description", "source_api_association_config") {
+		input := &appsync.UpdateSourceApiAssociationInput{
+			AssociationId:       aws.String(associationID),
+			Description:         aws.String(d.Get("description").(string)),
+			MergedApiIdentifier: aws.String(mergedAPIID),
+		}
+
+		if v, ok := d.GetOk("source_api_association_config"); ok {
+			input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{}))
+		}
+
+		if _, err := conn.UpdateSourceApiAssociation(input); err != nil {
+			return fmt.Errorf("error updating AppSync Source API Association (%s): %w", d.Id(), err)
+		}
+	}
+
+	// Changing triggers starts a merge of the source API's schema into the merged API,
+	// e.g. to pick up source API changes when the association uses manual merges.
+	if d.HasChange("triggers") {
+		input := &appsync.StartSchemaMergeInput{
+			AssociationId:       aws.String(associationID),
+			MergedApiIdentifier: aws.String(mergedAPIID),
+		}
+
+		if _, err := conn.StartSchemaMerge(input); err != nil {
+			return fmt.Errorf("error starting AppSync Source API Association (%s) schema merge: %w", d.Id(), err)
+		}
+
+		if _, err := waitSourceAPIAssociationMerged(conn, mergedAPIID, associationID); err != nil {
+			return fmt.Errorf("error waiting for AppSync Source API Association (%s) merge: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSourceAPIAssociationRead(d, meta)
+}
+
+func resourceSourceAPIAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceAPIAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppSync Source API Association: %s", d.Id())
+	_, err = conn.DisassociateSourceGraphqlApi(&appsync.DisassociateSourceGraphqlApiInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitSourceAPIAssociationDeleted(conn, mergedAPIID, associationID); err != nil {
+		return fmt.Errorf("error waiting for AppSync Source API Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const sourceAPIAssociationResourceIDSeparator = ","
+
+func SourceAPIAssociationCreateResourceID(mergedAPIID, associationID string) string {
+	parts := []string{mergedAPIID, associationID}
+	id := strings.Join(parts, sourceAPIAssociationResourceIDSeparator)
+
+	return id
+}
+
+func SourceAPIAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, sourceAPIAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MERGED-API-ID%[2]sASSOCIATION-ID", id, sourceAPIAssociationResourceIDSeparator)
+}
+
+func expandSourceAPIAssociationConfig(l []interface{}) *appsync.SourceApiAssociationConfig {
+	if len(l) < 1 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.SourceApiAssociationConfig{}
+
+	if v, ok := m["merge_type"].(string); ok && v != "" {
+		config.MergeType = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenSourceAPIAssociationConfig(config *appsync.SourceApiAssociationConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"merge_type": aws.StringValue(config.MergeType),
+	}
+
+	return []interface{}{m}
+}
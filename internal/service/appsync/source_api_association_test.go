@@ -0,0 +1,221 @@
+package appsync_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appsync"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappsync "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccSourceAPIAssociation_basic(t *testing.T) {
+	var association appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "description1", "AUTO_MERGE", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_successful_merge_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_arn", "aws_appsync_graphql_api.merged", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_id", "aws_appsync_graphql_api.merged", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_arn", "aws_appsync_graphql_api.source", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_id", "aws_appsync_graphql_api.source", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "AUTO_MERGE"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_status", "MERGE_SUCCESS"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "description2", "MANUAL_MERGE", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "MANUAL_MERGE"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_status", "MERGE_SUCCESS"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.merge", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociation_disappears(t *testing.T) {
+	var association appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "description1", "AUTO_MERGE", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(resourceName, &association),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappsync.ResourceSourceAPIAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSourceAPIAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appsync_source_api_association" {
+			continue
+		}
+
+		mergedAPIID, associationID, err := tfappsync.SourceAPIAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfappsync.FindSourceAPIAssociationByTwoPartKey(conn, mergedAPIID, associationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppSync Source API Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSourceAPIAssociationExists(n string, v *appsync.SourceApiAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppSync Source API Association ID is set")
+		}
+
+		mergedAPIID, associationID, err := tfappsync.SourceAPIAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+
+		output, err := tfappsync.FindSourceAPIAssociationByTwoPartKey(conn, mergedAPIID, associationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceAPIAssociationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appsync.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "appsync:SourceGraphQL",
+        "appsync:StartSchemaMerge",
+      ]
+      Effect = "Allow"
+      Resource = [
+        "${aws_appsync_graphql_api.source.arn}/*",
+        "${aws_appsync_graphql_api.merged.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_appsync_graphql_api" "source" {
+  authentication_type = "API_KEY"
+  name                = "%[1]s-source"
+  schema              = "type Post {\n\tid: ID!\n\ttitle: String!\n}\n\ntype Query {\n\tsinglePost(id: ID!): Post\n}\n\nschema {\n\tquery: Query\n}\n"
+}
+
+resource "aws_appsync_graphql_api" "merged" {
+  api_type                      = "MERGED"
+  authentication_type           = "API_KEY"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+  name                          = "%[1]s-merged"
+}
+`, rName)
+}
+
+func testAccSourceAPIAssociationConfig_basic(rName, description, mergeType, trigger string) string {
+	return acctest.ConfigCompose(testAccSourceAPIAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appsync_source_api_association" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  description    = %[1]q
+  merged_api_arn = aws_appsync_graphql_api.merged.arn
+  source_api_arn = aws_appsync_graphql_api.source.arn
+
+  source_api_association_config {
+    merge_type = %[2]q
+  }
+
+  triggers = {
+    merge = %[3]q
+  }
+}
+`, description, mergeType, trigger))
+}
@@ -38,3 +38,19 @@ func statusDomainNameAPIAssociation(conn *appsync.AppSync, id string) resource.S
 		return output, aws.StringValue(output.AssociationStatus), nil
 	}
 }
+
+func statusSourceAPIAssociation(conn *appsync.AppSync, mergedAPIID, associationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSourceAPIAssociationByTwoPartKey(conn, mergedAPIID, associationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SourceApiAssociationStatus), nil
+	}
+}
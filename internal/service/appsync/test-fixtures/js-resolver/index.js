@@ -0,0 +1,22 @@
+import { util } from '@aws-appsync/utils';
+
+function buildRequest(id) {
+  return {
+    method: 'GET',
+    resourcePath: '/',
+    params: {
+      query: { id: util.urlEncode(id) },
+    },
+  };
+}
+
+export function request(ctx) {
+  return buildRequest(ctx.args.id);
+}
+
+export function response(ctx) {
+  if (ctx.error) {
+    util.error(ctx.error.message, ctx.error.type);
+  }
+  return ctx.result;
+}
@@ -1,10 +1,13 @@
 package appsync
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -12,6 +15,8 @@ const (
 	apiCacheDeletedTimeout             = 60 * time.Minute
 	domainNameAPIAssociationTimeout    = 60 * time.Minute
 	domainNameAPIDisassociationTimeout = 60 * time.Minute
+	sourceAPIAssociationMergedTimeout  = 30 * time.Minute
+	sourceAPIAssociationDeletedTimeout = 30 * time.Minute
 )
 
 func waitAPICacheAvailable(conn *appsync.AppSync, id string) error {
@@ -65,3 +70,41 @@ func waitDomainNameAPIDisassociation(conn *appsync.AppSync, id string) error {
 
 	return err
 }
+
+func waitSourceAPIAssociationMerged(conn *appsync.AppSync, mergedAPIID, associationID string) (*appsync.SourceApiAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusMergeScheduled, appsync.SourceApiAssociationStatusMergeInProgress},
+		Target:  []string{appsync.SourceApiAssociationStatusMergeSuccess},
+		Refresh: statusSourceAPIAssociation(conn, mergedAPIID, associationID),
+		Timeout: sourceAPIAssociationMergedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSourceAPIAssociationDeleted(conn *appsync.AppSync, mergedAPIID, associationID string) (*appsync.SourceApiAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusDeletionScheduled, appsync.SourceApiAssociationStatusDeletionInProgress},
+		Target:  []string{},
+		Refresh: statusSourceAPIAssociation(conn, mergedAPIID, associationID),
+		Timeout: sourceAPIAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,788 @@
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ecsPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"container_properties", "eks_properties"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"task_properties": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"containers": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								MaxItems: 10,
+								Elem:     ecsTaskContainerPropertiesResource(),
+							},
+							"ephemeral_storage": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"size_in_gib": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.IntBetween(21, 200),
+										},
+									},
+								},
+							},
+							"execution_role_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: verify.ValidARNOfService("iam", "role"),
+							},
+							"ipc_mode": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice([]string{"host", "task", "none"}, false),
+							},
+							"network_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"assign_public_ip": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Computed:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(batch.AssignPublicIp_Values(), false),
+										},
+									},
+								},
+							},
+							"pid_mode": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice([]string{"host", "task"}, false),
+							},
+							"platform_version": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+								ForceNew: true,
+							},
+							"runtime_platform": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"cpu_architecture": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+											ForceNew: true,
+										},
+										"operating_system_family": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+											ForceNew: true,
+										},
+									},
+								},
+							},
+							"task_role_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: verify.ValidARNOfService("iam", "role"),
+							},
+							"volumes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"host": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"source_path": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ecsTaskContainerPropertiesResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"command": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"depends_on": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"START", "COMPLETE", "SUCCESS"}, false),
+						},
+						"container_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"environment": {
+				// The API may return environment variables in a different order.
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"essential": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_driver": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(batch.LogDriver_Values(), false),
+						},
+						"options": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"secret_option": ecsSecretsSchema(),
+					},
+				},
+			},
+			"mount_points": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"read_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"source_volume": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"privileged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"readonly_root_filesystem": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"repository_credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credentials_parameter": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"resource_requirements": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(batch.ResourceType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"secrets": ecsSecretsSchema(),
+			"ulimits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hard_limit": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"soft_limit": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func ecsSecretsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"value_from": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandECSProperties(tfMap map[string]interface{}) *batch.EcsProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EcsProperties{}
+
+	if v, ok := tfMap["task_properties"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.TaskProperties = append(apiObject.TaskProperties, expandECSTaskProperties(tfMap))
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandECSTaskProperties(tfMap map[string]interface{}) *batch.EcsTaskProperties {
+	apiObject := &batch.EcsTaskProperties{}
+
+	if v, ok := tfMap["containers"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.Containers = append(apiObject.Containers, expandECSTaskContainerProperties(tfMap))
+			}
+		}
+	}
+
+	if v, ok := tfMap["ephemeral_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EphemeralStorage = &batch.EphemeralStorage{
+			SizeInGiB: aws.Int64(int64(v[0].(map[string]interface{})["size_in_gib"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ipc_mode"].(string); ok && v != "" {
+		apiObject.IpcMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["network_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["assign_public_ip"].(string); ok && v != "" {
+			apiObject.NetworkConfiguration = &batch.NetworkConfiguration{
+				AssignPublicIp: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["pid_mode"].(string); ok && v != "" {
+		apiObject.PidMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["platform_version"].(string); ok && v != "" {
+		apiObject.PlatformVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["runtime_platform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		runtimePlatform := &batch.RuntimePlatform{}
+
+		if v, ok := tfMap["cpu_architecture"].(string); ok && v != "" {
+			runtimePlatform.CpuArchitecture = aws.String(v)
+		}
+
+		if v, ok := tfMap["operating_system_family"].(string); ok && v != "" {
+			runtimePlatform.OperatingSystemFamily = aws.String(v)
+		}
+
+		apiObject.RuntimePlatform = runtimePlatform
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["volumes"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			volume := &batch.Volume{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["host"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				volume.Host = &batch.Host{}
+
+				if v, ok := v[0].(map[string]interface{})["source_path"].(string); ok && v != "" {
+					volume.Host.SourcePath = aws.String(v)
+				}
+			}
+
+			apiObject.Volumes = append(apiObject.Volumes, volume)
+		}
+	}
+
+	return apiObject
+}
+
+func expandECSTaskContainerProperties(tfMap map[string]interface{}) *batch.TaskContainerProperties {
+	apiObject := &batch.TaskContainerProperties{
+		Image: aws.String(tfMap["image"].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			apiObject.DependsOn = append(apiObject.DependsOn, &batch.TaskContainerDependency{
+				Condition:     aws.String(tfMap["condition"].(string)),
+				ContainerName: aws.String(tfMap["container_name"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["environment"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			apiObject.Environment = append(apiObject.Environment, &batch.KeyValuePair{
+				Name:  aws.String(tfMap["name"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["essential"].(bool); ok {
+		apiObject.Essential = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		logConfiguration := &batch.LogConfiguration{
+			LogDriver: aws.String(tfMap["log_driver"].(string)),
+		}
+
+		if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+			logConfiguration.Options = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+			logConfiguration.SecretOptions = expandECSSecrets(v)
+		}
+
+		apiObject.LogConfiguration = logConfiguration
+	}
+
+	if v, ok := tfMap["mount_points"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			mountPoint := &batch.MountPoint{
+				ReadOnly: aws.Bool(tfMap["read_only"].(bool)),
+			}
+
+			if v, ok := tfMap["container_path"].(string); ok && v != "" {
+				mountPoint.ContainerPath = aws.String(v)
+			}
+
+			if v, ok := tfMap["source_volume"].(string); ok && v != "" {
+				mountPoint.SourceVolume = aws.String(v)
+			}
+
+			apiObject.MountPoints = append(apiObject.MountPoints, mountPoint)
+		}
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["privileged"].(bool); ok && v {
+		apiObject.Privileged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+		apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["repository_credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RepositoryCredentials = &batch.RepositoryCredentials{
+			CredentialsParameter: aws.String(v[0].(map[string]interface{})["credentials_parameter"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["resource_requirements"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			apiObject.ResourceRequirements = append(apiObject.ResourceRequirements, &batch.ResourceRequirement{
+				Type:  aws.String(tfMap["type"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["secrets"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Secrets = expandECSSecrets(v)
+	}
+
+	if v, ok := tfMap["ulimits"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap := tfMapRaw.(map[string]interface{})
+			apiObject.Ulimits = append(apiObject.Ulimits, &batch.Ulimit{
+				HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+				Name:      aws.String(tfMap["name"].(string)),
+				SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+			})
+		}
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandECSSecrets(tfList []interface{}) []*batch.Secret {
+	var apiObjects []*batch.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &batch.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenECSProperties(apiObject *batch.EcsProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var taskProperties []interface{}
+
+	for _, apiObject := range apiObject.TaskProperties {
+		if apiObject == nil {
+			continue
+		}
+
+		taskProperties = append(taskProperties, flattenECSTaskProperties(apiObject))
+	}
+
+	return []interface{}{map[string]interface{}{
+		"task_properties": taskProperties,
+	}}
+}
+
+func flattenECSTaskProperties(apiObject *batch.EcsTaskProperties) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"execution_role_arn": aws.StringValue(apiObject.ExecutionRoleArn),
+		"ipc_mode":           aws.StringValue(apiObject.IpcMode),
+		"pid_mode":           aws.StringValue(apiObject.PidMode),
+		"platform_version":   aws.StringValue(apiObject.PlatformVersion),
+		"task_role_arn":      aws.StringValue(apiObject.TaskRoleArn),
+	}
+
+	var containers []interface{}
+	for _, v := range apiObject.Containers {
+		if v != nil {
+			containers = append(containers, flattenECSTaskContainerProperties(v))
+		}
+	}
+	tfMap["containers"] = containers
+
+	if v := apiObject.EphemeralStorage; v != nil {
+		tfMap["ephemeral_storage"] = []interface{}{map[string]interface{}{
+			"size_in_gib": aws.Int64Value(v.SizeInGiB),
+		}}
+	}
+
+	if v := apiObject.NetworkConfiguration; v != nil {
+		tfMap["network_configuration"] = []interface{}{map[string]interface{}{
+			"assign_public_ip": aws.StringValue(v.AssignPublicIp),
+		}}
+	}
+
+	if v := apiObject.RuntimePlatform; v != nil {
+		tfMap["runtime_platform"] = []interface{}{map[string]interface{}{
+			"cpu_architecture":        aws.StringValue(v.CpuArchitecture),
+			"operating_system_family": aws.StringValue(v.OperatingSystemFamily),
+		}}
+	}
+
+	var volumes []interface{}
+	for _, v := range apiObject.Volumes {
+		if v == nil {
+			continue
+		}
+
+		volume := map[string]interface{}{
+			"name": aws.StringValue(v.Name),
+		}
+
+		if v.Host != nil {
+			volume["host"] = []interface{}{map[string]interface{}{
+				"source_path": aws.StringValue(v.Host.SourcePath),
+			}}
+		}
+
+		volumes = append(volumes, volume)
+	}
+	tfMap["volumes"] = volumes
+
+	return tfMap
+}
+
+func flattenECSTaskContainerProperties(apiObject *batch.TaskContainerProperties) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"command":                  aws.StringValueSlice(apiObject.Command),
+		"essential":                aws.BoolValue(apiObject.Essential),
+		"image":                    aws.StringValue(apiObject.Image),
+		"name":                     aws.StringValue(apiObject.Name),
+		"privileged":               aws.BoolValue(apiObject.Privileged),
+		"readonly_root_filesystem": aws.BoolValue(apiObject.ReadonlyRootFilesystem),
+		"secrets":                  flattenECSSecrets(apiObject.Secrets),
+		"user":                     aws.StringValue(apiObject.User),
+	}
+
+	var dependsOn []interface{}
+	for _, v := range apiObject.DependsOn {
+		dependsOn = append(dependsOn, map[string]interface{}{
+			"condition":      aws.StringValue(v.Condition),
+			"container_name": aws.StringValue(v.ContainerName),
+		})
+	}
+	tfMap["depends_on"] = dependsOn
+
+	var environment []interface{}
+	for _, v := range apiObject.Environment {
+		environment = append(environment, map[string]interface{}{
+			"name":  aws.StringValue(v.Name),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+	tfMap["environment"] = environment
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []interface{}{map[string]interface{}{
+			"log_driver":    aws.StringValue(v.LogDriver),
+			"options":       aws.StringValueMap(v.Options),
+			"secret_option": flattenECSSecrets(v.SecretOptions),
+		}}
+	}
+
+	var mountPoints []interface{}
+	for _, v := range apiObject.MountPoints {
+		mountPoints = append(mountPoints, map[string]interface{}{
+			"container_path": aws.StringValue(v.ContainerPath),
+			"read_only":      aws.BoolValue(v.ReadOnly),
+			"source_volume":  aws.StringValue(v.SourceVolume),
+		})
+	}
+	tfMap["mount_points"] = mountPoints
+
+	if v := apiObject.RepositoryCredentials; v != nil {
+		tfMap["repository_credentials"] = []interface{}{map[string]interface{}{
+			"credentials_parameter": aws.StringValue(v.CredentialsParameter),
+		}}
+	}
+
+	var resourceRequirements []interface{}
+	for _, v := range apiObject.ResourceRequirements {
+		resourceRequirements = append(resourceRequirements, map[string]interface{}{
+			"type":  aws.StringValue(v.Type),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+	tfMap["resource_requirements"] = resourceRequirements
+
+	var ulimits []interface{}
+	for _, v := range apiObject.Ulimits {
+		ulimits = append(ulimits, map[string]interface{}{
+			"hard_limit": aws.Int64Value(v.HardLimit),
+			"name":       aws.StringValue(v.Name),
+			"soft_limit": aws.Int64Value(v.SoftLimit),
+		})
+	}
+	tfMap["ulimits"] = ulimits
+
+	return tfMap
+}
+
+func flattenECSSecrets(apiObjects []*batch.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,682 @@
+package batch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	eksImagePullPolicyAlways       = "Always"
+	eksImagePullPolicyIfNotPresent = "IfNotPresent"
+	eksImagePullPolicyNever        = "Never"
+)
+
+func eksImagePullPolicy_Values() []string {
+	return []string{
+		eksImagePullPolicyAlways,
+		eksImagePullPolicyIfNotPresent,
+		eksImagePullPolicyNever,
+	}
+}
+
+const (
+	eksDNSPolicyDefault                 = "Default"
+	eksDNSPolicyClusterFirst            = "ClusterFirst"
+	eksDNSPolicyClusterFirstWithHostNet = "ClusterFirstWithHostNet"
+)
+
+func eksDNSPolicy_Values() []string {
+	return []string{
+		eksDNSPolicyDefault,
+		eksDNSPolicyClusterFirst,
+		eksDNSPolicyClusterFirstWithHostNet,
+	}
+}
+
+func eksPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"container_properties", "ecs_properties"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"pod_properties": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"containers": eksContainersSchema(true),
+							"dns_policy": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(eksDNSPolicy_Values(), false),
+							},
+							"host_network": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+								Default:  true,
+							},
+							"image_pull_secret": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+									},
+								},
+							},
+							"init_containers": eksContainersSchema(false),
+							"metadata": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"labels": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"service_account_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"share_process_namespace": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"volumes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"empty_dir": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"medium": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ForceNew:     true,
+														ValidateFunc: validation.StringInSlice([]string{"", "Memory"}, false),
+													},
+													"size_limit": {
+														Type:     schema.TypeString,
+														Required: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+										"host_path": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"path": {
+														Type:     schema.TypeString,
+														Required: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"secret": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"optional": {
+														Type:     schema.TypeBool,
+														Optional: true,
+														ForceNew: true,
+													},
+													"secret_name": {
+														Type:     schema.TypeString,
+														Required: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func eksContainersSchema(required bool) *schema.Schema {
+	s := &schema.Schema{
+		Type:     schema.TypeList,
+		ForceNew: true,
+		MaxItems: 10,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"args": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"env": {
+					// The API may return environment variables in a different order.
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"image_pull_policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(eksImagePullPolicy_Values(), false),
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+				},
+				"resources": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"limits": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"requests": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"security_context": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allow_privilege_escalation": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"privileged": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"read_only_root_file_system": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"run_as_group": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								ForceNew: true,
+							},
+							"run_as_non_root": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"run_as_user": {
+								Type:     schema.TypeInt,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"volume_mounts": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mount_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if required {
+		s.Required = true
+		s.MinItems = 1
+	} else {
+		s.Optional = true
+	}
+
+	return s
+}
+
+func expandEKSProperties(tfMap map[string]interface{}) *batch.EksProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksProperties{}
+
+	if v, ok := tfMap["pod_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PodProperties = expandEKSPodProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEKSPodProperties(tfMap map[string]interface{}) *batch.EksPodProperties {
+	apiObject := &batch.EksPodProperties{}
+
+	if v, ok := tfMap["containers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Containers = expandEKSContainers(v)
+	}
+
+	if v, ok := tfMap["dns_policy"].(string); ok && v != "" {
+		apiObject.DnsPolicy = aws.String(v)
+	}
+
+	if v, ok := tfMap["host_network"].(bool); ok {
+		apiObject.HostNetwork = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["image_pull_secret"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.ImagePullSecrets = append(apiObject.ImagePullSecrets, &batch.ImagePullSecret{
+					Name: aws.String(tfMap["name"].(string)),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["init_containers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InitContainers = expandEKSContainers(v)
+	}
+
+	if v, ok := tfMap["metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Metadata = &batch.EksMetadata{
+				Labels: flex.ExpandStringMap(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["service_account_name"].(string); ok && v != "" {
+		apiObject.ServiceAccountName = aws.String(v)
+	}
+
+	if v, ok := tfMap["share_process_namespace"].(bool); ok && v {
+		apiObject.ShareProcessNamespace = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["volumes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Volumes = expandEKSVolumes(v)
+	}
+
+	return apiObject
+}
+
+func expandEKSContainers(tfList []interface{}) []*batch.EksContainer {
+	var apiObjects []*batch.EksContainer
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EksContainer{
+			Image: aws.String(tfMap["image"].(string)),
+		}
+
+		if v, ok := tfMap["args"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Args = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["env"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				env := &batch.EksContainerEnvironmentVariable{
+					Name: aws.String(tfMap["name"].(string)),
+				}
+
+				if v, ok := tfMap["value"].(string); ok && v != "" {
+					env.Value = aws.String(v)
+				}
+
+				apiObject.Env = append(apiObject.Env, env)
+			}
+		}
+
+		if v, ok := tfMap["image_pull_policy"].(string); ok && v != "" {
+			apiObject.ImagePullPolicy = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["resources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			resources := &batch.EksContainerResourceRequirements{}
+
+			if v, ok := tfMap["limits"].(map[string]interface{}); ok && len(v) > 0 {
+				resources.Limits = flex.ExpandStringMap(v)
+			}
+
+			if v, ok := tfMap["requests"].(map[string]interface{}); ok && len(v) > 0 {
+				resources.Requests = flex.ExpandStringMap(v)
+			}
+
+			apiObject.Resources = resources
+		}
+
+		if v, ok := tfMap["security_context"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			securityContext := &batch.EksContainerSecurityContext{
+				AllowPrivilegeEscalation: aws.Bool(tfMap["allow_privilege_escalation"].(bool)),
+				Privileged:               aws.Bool(tfMap["privileged"].(bool)),
+				ReadOnlyRootFilesystem:   aws.Bool(tfMap["read_only_root_file_system"].(bool)),
+				RunAsNonRoot:             aws.Bool(tfMap["run_as_non_root"].(bool)),
+			}
+
+			if v, ok := tfMap["run_as_group"].(int); ok && v != 0 {
+				securityContext.RunAsGroup = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["run_as_user"].(int); ok && v != 0 {
+				securityContext.RunAsUser = aws.Int64(int64(v))
+			}
+
+			apiObject.SecurityContext = securityContext
+		}
+
+		if v, ok := tfMap["volume_mounts"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap := tfMapRaw.(map[string]interface{})
+				apiObject.VolumeMounts = append(apiObject.VolumeMounts, &batch.EksContainerVolumeMount{
+					MountPath: aws.String(tfMap["mount_path"].(string)),
+					Name:      aws.String(tfMap["name"].(string)),
+					ReadOnly:  aws.Bool(tfMap["read_only"].(bool)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEKSVolumes(tfList []interface{}) []*batch.EksVolume {
+	var apiObjects []*batch.EksVolume
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EksVolume{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["empty_dir"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.EmptyDir = &batch.EksEmptyDir{
+				SizeLimit: aws.String(tfMap["size_limit"].(string)),
+			}
+
+			if v, ok := tfMap["medium"].(string); ok {
+				apiObject.EmptyDir.Medium = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["host_path"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HostPath = &batch.EksHostPath{
+				Path: aws.String(v[0].(map[string]interface{})["path"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Secret = &batch.EksSecret{
+				Optional:   aws.Bool(tfMap["optional"].(bool)),
+				SecretName: aws.String(tfMap["secret_name"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEKSProperties(apiObject *batch.EksProperties) []interface{} {
+	if apiObject == nil || apiObject.PodProperties == nil {
+		return nil
+	}
+
+	podProperties := apiObject.PodProperties
+	tfMap := map[string]interface{}{
+		"containers":              flattenEKSContainers(podProperties.Containers),
+		"dns_policy":              aws.StringValue(podProperties.DnsPolicy),
+		"host_network":            aws.BoolValue(podProperties.HostNetwork),
+		"init_containers":         flattenEKSContainers(podProperties.InitContainers),
+		"service_account_name":    aws.StringValue(podProperties.ServiceAccountName),
+		"share_process_namespace": aws.BoolValue(podProperties.ShareProcessNamespace),
+		"volumes":                 flattenEKSVolumes(podProperties.Volumes),
+	}
+
+	var imagePullSecrets []interface{}
+	for _, v := range podProperties.ImagePullSecrets {
+		imagePullSecrets = append(imagePullSecrets, map[string]interface{}{
+			"name": aws.StringValue(v.Name),
+		})
+	}
+	tfMap["image_pull_secret"] = imagePullSecrets
+
+	if v := podProperties.Metadata; v != nil && len(v.Labels) > 0 {
+		tfMap["metadata"] = []interface{}{map[string]interface{}{
+			"labels": aws.StringValueMap(v.Labels),
+		}}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"pod_properties": []interface{}{tfMap},
+	}}
+}
+
+func flattenEKSContainers(apiObjects []*batch.EksContainer) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"args":              aws.StringValueSlice(apiObject.Args),
+			"command":           aws.StringValueSlice(apiObject.Command),
+			"image":             aws.StringValue(apiObject.Image),
+			"image_pull_policy": aws.StringValue(apiObject.ImagePullPolicy),
+			"name":              aws.StringValue(apiObject.Name),
+		}
+
+		var env []interface{}
+		for _, v := range apiObject.Env {
+			env = append(env, map[string]interface{}{
+				"name":  aws.StringValue(v.Name),
+				"value": aws.StringValue(v.Value),
+			})
+		}
+		tfMap["env"] = env
+
+		if v := apiObject.Resources; v != nil && (len(v.Limits) > 0 || len(v.Requests) > 0) {
+			tfMap["resources"] = []interface{}{map[string]interface{}{
+				"limits":   aws.StringValueMap(v.Limits),
+				"requests": aws.StringValueMap(v.Requests),
+			}}
+		}
+
+		if v := apiObject.SecurityContext; v != nil {
+			tfMap["security_context"] = []interface{}{map[string]interface{}{
+				"allow_privilege_escalation": aws.BoolValue(v.AllowPrivilegeEscalation),
+				"privileged":                 aws.BoolValue(v.Privileged),
+				"read_only_root_file_system": aws.BoolValue(v.ReadOnlyRootFilesystem),
+				"run_as_group":               aws.Int64Value(v.RunAsGroup),
+				"run_as_non_root":            aws.BoolValue(v.RunAsNonRoot),
+				"run_as_user":                aws.Int64Value(v.RunAsUser),
+			}}
+		}
+
+		var volumeMounts []interface{}
+		for _, v := range apiObject.VolumeMounts {
+			volumeMounts = append(volumeMounts, map[string]interface{}{
+				"mount_path": aws.StringValue(v.MountPath),
+				"name":       aws.StringValue(v.Name),
+				"read_only":  aws.BoolValue(v.ReadOnly),
+			})
+		}
+		tfMap["volume_mounts"] = volumeMounts
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEKSVolumes(apiObjects []*batch.EksVolume) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.EmptyDir; v != nil {
+			tfMap["empty_dir"] = []interface{}{map[string]interface{}{
+				"medium":     aws.StringValue(v.Medium),
+				"size_limit": aws.StringValue(v.SizeLimit),
+			}}
+		}
+
+		if v := apiObject.HostPath; v != nil {
+			tfMap["host_path"] = []interface{}{map[string]interface{}{
+				"path": aws.StringValue(v.Path),
+			}}
+		}
+
+		if v := apiObject.Secret; v != nil {
+			tfMap["secret"] = []interface{}{map[string]interface{}{
+				"optional":    aws.BoolValue(v.Optional),
+				"secret_name": aws.StringValue(v.SecretName),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -38,9 +38,10 @@ func ResourceJobDefinition() *schema.Resource {
 				ValidateFunc: validName,
 			},
 			"container_properties": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ecs_properties", "eks_properties"},
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
@@ -52,6 +53,8 @@ func ResourceJobDefinition() *schema.Resource {
 				},
 				ValidateFunc: validJobContainerProperties,
 			},
+			"ecs_properties": ecsPropertiesSchema(),
+			"eks_properties": eksPropertiesSchema(),
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -130,6 +133,11 @@ func ResourceJobDefinition() *schema.Resource {
 					},
 				},
 			},
+			"scheduling_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"propagate_tags": {
@@ -195,6 +203,14 @@ func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error
 		input.ContainerProperties = props
 	}
 
+	if v, ok := d.GetOk("ecs_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EcsProperties = expandECSProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("eks_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EksProperties = expandEKSProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandJobDefinitionParameters(v.(map[string]interface{}))
 	}
@@ -207,6 +223,10 @@ func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error
 		input.RetryStrategy = expandRetryStrategy(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("scheduling_priority"); ok {
+		input.SchedulingPriority = aws.Int64(int64(v.(int)))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -245,14 +265,26 @@ func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("arn", jobDefinition.JobDefinitionArn)
 
-	containerProperties, err := flattenContainerProperties(jobDefinition.ContainerProperties)
+	if jobDefinition.ContainerProperties != nil {
+		containerProperties, err := flattenContainerProperties(jobDefinition.ContainerProperties)
 
-	if err != nil {
-		return fmt.Errorf("error converting Batch Container Properties to JSON: %w", err)
+		if err != nil {
+			return fmt.Errorf("error converting Batch Container Properties to JSON: %w", err)
+		}
+
+		if err := d.Set("container_properties", containerProperties); err != nil {
+			return fmt.Errorf("error setting container_properties: %w", err)
+		}
+	} else {
+		d.Set("container_properties", nil)
 	}
 
-	if err := d.Set("container_properties", containerProperties); err != nil {
-		return fmt.Errorf("error setting container_properties: %w", err)
+	if err := d.Set("ecs_properties", flattenECSProperties(jobDefinition.EcsProperties)); err != nil {
+		return fmt.Errorf("error setting ecs_properties: %w", err)
+	}
+
+	if err := d.Set("eks_properties", flattenEKSProperties(jobDefinition.EksProperties)); err != nil {
+		return fmt.Errorf("error setting eks_properties: %w", err)
 	}
 
 	d.Set("name", jobDefinition.JobDefinitionName)
@@ -268,6 +300,8 @@ func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("retry_strategy", nil)
 	}
 
+	d.Set("scheduling_priority", jobDefinition.SchedulingPriority)
+
 	tags := KeyValueTags(jobDefinition.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
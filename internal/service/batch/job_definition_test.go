@@ -369,6 +369,134 @@ func TestAccBatchJobDefinition_propagateTags(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobDefinition_schedulingPriority(t *testing.T) {
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_schedulingPriority(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "scheduling_priority", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_EKSProperties_basic(t *testing.T) {
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_eksProperties(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.image", "public.ecr.aws/amazonlinux/amazonlinux:1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.env.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "eks_properties.0.pod_properties.0.containers.0.env.*", map[string]string{
+						"name":  "ENV_A",
+						"value": "a",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.resources.0.limits.cpu", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.security_context.0.run_as_user", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.host_network", "true"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.labels.environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.0.empty_dir.0.size_limit", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.evaluate_on_exit.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "container"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_ECSProperties_basic(t *testing.T) {
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_ecsProperties(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.0.name", "main"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.0.depends_on.0.condition", "START"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.1.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties.0.task_properties.0.containers.1.essential", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "ecs_properties.0.task_properties.0.execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "platform_capabilities.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_ECSProperties_conflictsWithContainerProperties(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, batch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobDefinitionConfig_ecsPropertiesConflict(rName),
+				ExpectError: regexp.MustCompile(`"ecs_properties": conflicts with container_properties`),
+			},
+		},
+	})
+}
+
 func testAccCheckJobDefinitionExists(n string, jd *batch.JobDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -749,3 +877,201 @@ resource "aws_batch_job_definition" "test" {
 }
 `, rName)
 }
+
+func testAccJobDefinitionConfig_schedulingPriority(rName string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "busybox"
+    memory  = 128
+    vcpus   = 1
+  })
+  name = %[1]q
+  type = "container"
+
+  scheduling_priority = %[2]d
+}
+`, rName, priority)
+}
+
+func testAccJobDefinitionConfig_eksProperties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  eks_properties {
+    pod_properties {
+      host_network = true
+
+      containers {
+        image   = "public.ecr.aws/amazonlinux/amazonlinux:1"
+        command = ["sleep", "60"]
+
+        env {
+          name  = "ENV_A"
+          value = "a"
+        }
+
+        env {
+          name  = "ENV_B"
+          value = "b"
+        }
+
+        resources {
+          limits = {
+            cpu    = "1"
+            memory = "1024Mi"
+          }
+        }
+
+        security_context {
+          run_as_user = 1000
+        }
+
+        volume_mounts {
+          mount_path = "/scratch"
+          name       = "scratch"
+        }
+      }
+
+      metadata {
+        labels = {
+          environment = "test"
+        }
+      }
+
+      volumes {
+        name = "scratch"
+
+        empty_dir {
+          size_limit = "1Gi"
+        }
+      }
+    }
+  }
+
+  retry_strategy {
+    attempts = 3
+
+    evaluate_on_exit {
+      action       = "RETRY"
+      on_exit_code = "137"
+    }
+
+    evaluate_on_exit {
+      action           = "EXIT"
+      on_status_reason = "*"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccJobDefinitionConfig_ecsProperties(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume_role_policy" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ecs-tasks.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role_policy.json
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  platform_capabilities = ["FARGATE"]
+
+  ecs_properties {
+    task_properties {
+      execution_role_arn = aws_iam_role.test.arn
+
+      containers {
+        name    = "main"
+        image   = "public.ecr.aws/amazonlinux/amazonlinux:1"
+        command = ["sleep", "60"]
+
+        depends_on {
+          condition      = "START"
+          container_name = "sidecar"
+        }
+
+        environment {
+          name  = "ENV_A"
+          value = "a"
+        }
+
+        resource_requirements {
+          type  = "VCPU"
+          value = "0.25"
+        }
+
+        resource_requirements {
+          type  = "MEMORY"
+          value = "512"
+        }
+      }
+
+      containers {
+        name      = "sidecar"
+        image     = "public.ecr.aws/amazonlinux/amazonlinux:1"
+        command   = ["sleep", "60"]
+        essential = false
+
+        resource_requirements {
+          type  = "VCPU"
+          value = "0.25"
+        }
+
+        resource_requirements {
+          type  = "MEMORY"
+          value = "512"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccJobDefinitionConfig_ecsPropertiesConflict(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "busybox"
+    memory  = 128
+    vcpus   = 1
+  })
+
+  ecs_properties {
+    task_properties {
+      containers {
+        image = "busybox"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,342 @@
+package cloudfront_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontContinuousDeploymentPolicy_basic(t *testing.T) {
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	var stagingDistribution, primaryDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	primaryDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckContinuousDeploymentPolicyExists(resourceName, &policy),
+					testAccCheckDistributionExists(primaryDistributionResourceName, &primaryDistribution),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.0.quantity", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "staging_distribution_dns_names.0.items.*", stagingDistributionResourceName, "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleWeight"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.01"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.#", "0"),
+					resource.TestCheckResourceAttr(stagingDistributionResourceName, "staging", "true"),
+					resource.TestCheckResourceAttrPair(primaryDistributionResourceName, "continuous_deployment_policy_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_disappears(t *testing.T) {
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_detached(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(resourceName, &policy),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudfront.ResourceContinuousDeploymentPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_trafficConfig(t *testing.T) {
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_singleWeight(true, "0.1", 300, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleWeight"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.idle_ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.maximum_ttl", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_singleWeight(false, "0.15", 600, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.15"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.idle_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.maximum_ttl", "1200"),
+				),
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_singleHeader(true, "aws-cf-cd-test", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleHeader"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.header", "aws-cf-cd-test"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.value", "test"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContinuousDeploymentPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_continuous_deployment_policy" {
+			continue
+		}
+
+		_, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.CloudFront, create.ErrActionCheckingDestroyed, tfcloudfront.ResNameContinuousDeploymentPolicy, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return testAccCheckDistributionDestroy(s)
+}
+
+func testAccCheckContinuousDeploymentPolicyExists(name string, policy *cloudfront.GetContinuousDeploymentPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameContinuousDeploymentPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameContinuousDeploymentPolicy, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+		ctx := context.Background()
+
+		output, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameContinuousDeploymentPolicy, rs.Primary.ID, err)
+		}
+
+		*policy = *output
+
+		return nil
+	}
+}
+
+func testAccContinuousDeploymentPolicyConfig_distribution(name string, staging bool, policyID string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" %[1]q {
+  enabled                         = true
+  staging                         = %[2]t
+  continuous_deployment_policy_id = %[3]s
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, name, staging, policyID)
+}
+
+func testAccContinuousDeploymentPolicyConfig_basic() string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_distribution("staging", true, "null"),
+		testAccContinuousDeploymentPolicyConfig_distribution("test", false, "aws_cloudfront_continuous_deployment_policy.test.id"),
+		`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+`)
+}
+
+func testAccContinuousDeploymentPolicyConfig_detached() string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_distribution("staging", true, "null"),
+		`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+}
+`)
+}
+
+func testAccContinuousDeploymentPolicyConfig_singleWeight(enabled bool, weight string, idleTTL, maximumTTL int) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_distribution("staging", true, "null"),
+		testAccContinuousDeploymentPolicyConfig_distribution("test", false, "aws_cloudfront_continuous_deployment_policy.test.id"),
+		fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = %[1]t
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = %[2]q
+
+      session_stickiness_config {
+        idle_ttl    = %[3]d
+        maximum_ttl = %[4]d
+      }
+    }
+  }
+}
+`, enabled, weight, idleTTL, maximumTTL))
+}
+
+func testAccContinuousDeploymentPolicyConfig_singleHeader(enabled bool, header, value string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_distribution("staging", true, "null"),
+		testAccContinuousDeploymentPolicyConfig_distribution("test", false, "aws_cloudfront_continuous_deployment_policy.test.id"),
+		fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = %[1]t
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleHeader"
+
+    single_header_config {
+      header = %[2]q
+      value  = %[3]q
+    }
+  }
+}
+`, enabled, header, value))
+}
@@ -228,6 +228,10 @@ func ResourceDistribution() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -643,6 +647,12 @@ func ResourceDistribution() *schema.Resource {
 				Default:      cloudfront.PriceClassPriceClassAll,
 				ValidateFunc: validation.StringInSlice(cloudfront.PriceClass_Values(), false),
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"restrictions": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -1048,6 +1058,8 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 			IfMatch:            getDistributionOutput.ETag,
 		}
 		updateDistributionInput.DistributionConfig.Enabled = aws.Bool(false)
+		// A continuous deployment policy must be detached before the primary distribution can be disabled.
+		updateDistributionInput.DistributionConfig.ContinuousDeploymentPolicyId = aws.String("")
 
 		log.Printf("[DEBUG] Disabling CloudFront Distribution: %s", d.Id())
 		_, err = conn.UpdateDistribution(updateDistributionInput)
@@ -1121,6 +1133,8 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 			IfMatch:            getDistributionOutput.ETag,
 		}
 		updateDistributionInput.DistributionConfig.Enabled = aws.Bool(false)
+		// A continuous deployment policy must be detached before the primary distribution can be disabled.
+		updateDistributionInput.DistributionConfig.ContinuousDeploymentPolicyId = aws.String("")
 		var updateDistributionOutput *cloudfront.UpdateDistributionOutput
 
 		log.Printf("[DEBUG] Disabling CloudFront Distribution: %s", d.Id())
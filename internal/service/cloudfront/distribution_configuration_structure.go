@@ -48,6 +48,7 @@ func expandDistributionConfig(d *schema.ResourceData) *cloudfront.DistributionCo
 		HttpVersion:          aws.String(d.Get("http_version").(string)),
 		Origins:              ExpandOrigins(d.Get("origin").(*schema.Set)),
 		PriceClass:           aws.String(d.Get("price_class").(string)),
+		Staging:              aws.Bool(d.Get("staging").(bool)),
 		WebACLId:             aws.String(d.Get("web_acl_id").(string)),
 	}
 
@@ -56,6 +57,10 @@ func expandDistributionConfig(d *schema.ResourceData) *cloudfront.DistributionCo
 		distributionConfig.CallerReference = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("continuous_deployment_policy_id"); ok {
+		distributionConfig.ContinuousDeploymentPolicyId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("logging_config"); ok {
 		distributionConfig.Logging = ExpandLoggingConfig(v.([]interface{})[0].(map[string]interface{}))
 	} else {
@@ -90,6 +95,8 @@ func flattenDistributionConfig(d *schema.ResourceData, distributionConfig *cloud
 	d.Set("enabled", distributionConfig.Enabled)
 	d.Set("is_ipv6_enabled", distributionConfig.IsIPV6Enabled)
 	d.Set("price_class", distributionConfig.PriceClass)
+	d.Set("staging", distributionConfig.Staging)
+	d.Set("continuous_deployment_policy_id", distributionConfig.ContinuousDeploymentPolicyId)
 	d.Set("hosted_zone_id", route53ZoneID)
 
 	err = d.Set("default_cache_behavior", []interface{}{flattenDefaultCacheBehavior(distributionConfig.DefaultCacheBehavior)})
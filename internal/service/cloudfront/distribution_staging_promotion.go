@@ -0,0 +1,135 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceDistributionStagingPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDistributionStagingPromotionCreate,
+		ReadWithoutTimeout:   resourceDistributionStagingPromotionRead,
+		DeleteWithoutTimeout: resourceDistributionStagingPromotionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staging_distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameDistributionStagingPromotion = "Distribution Staging Promotion"
+
+	distributionStagingPromotionIDSeparator = ","
+)
+
+func resourceDistributionStagingPromotionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+	stagingDistributionID := d.Get("staging_distribution_id").(string)
+	id := strings.Join([]string{distributionID, stagingDistributionID}, distributionStagingPromotionIDSeparator)
+
+	primary, err := FindDistributionByID(conn, distributionID)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameDistributionStagingPromotion, id, fmt.Errorf("reading primary distribution: %w", err))
+	}
+
+	staging, err := FindDistributionByID(conn, stagingDistributionID)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameDistributionStagingPromotion, id, fmt.Errorf("reading staging distribution: %w", err))
+	}
+
+	if !aws.BoolValue(staging.Distribution.DistributionConfig.Staging) {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameDistributionStagingPromotion, id, fmt.Errorf("distribution (%s) is not a staging distribution", stagingDistributionID))
+	}
+
+	in := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(distributionID),
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", aws.StringValue(primary.ETag), aws.StringValue(staging.ETag))),
+		StagingDistributionId: aws.String(stagingDistributionID),
+	}
+
+	log.Printf("[DEBUG] Promoting CloudFront staging Distribution (%s) to primary Distribution (%s)", stagingDistributionID, distributionID)
+	out, err := conn.UpdateDistributionWithStagingConfigWithContext(ctx, in)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameDistributionStagingPromotion, id, err)
+	}
+
+	d.SetId(id)
+	d.Set("etag", out.ETag)
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+		if err := DistributionWaitUntilDeployed(distributionID, meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForUpdate, ResNameDistribution, distributionID, err)
+		}
+	}
+
+	return resourceDistributionStagingPromotionRead(ctx, d, meta)
+}
+
+func resourceDistributionStagingPromotionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+
+	_, err := FindDistributionByID(conn, distributionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Distribution (%s) not found, removing staging promotion (%s) from state", distributionID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameDistributionStagingPromotion, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDistributionStagingPromotionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Removing CloudFront Distribution Staging Promotion (%s) from state; the promoted configuration remains on the primary distribution", d.Id())
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFrontDistributionStagingPromotion_basic(t *testing.T) {
+	var distribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_distribution_staging_promotion.test"
+	primaryDistributionResourceName := "aws_cloudfront_distribution.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionStagingPromotionConfig_basic("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(primaryDistributionResourceName, &distribution),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", primaryDistributionResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_distribution_id", stagingDistributionResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment", "true"),
+				),
+			},
+			{
+				Config: testAccDistributionStagingPromotionConfig_basic("2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(primaryDistributionResourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDistributionStagingPromotionConfig_basic(release string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_basic(),
+		fmt.Sprintf(`
+resource "aws_cloudfront_distribution_staging_promotion" "test" {
+  distribution_id         = aws_cloudfront_distribution.test.id
+  staging_distribution_id = aws_cloudfront_distribution.staging.id
+
+  triggers = {
+    release = %[1]q
+  }
+}
+`, release))
+}
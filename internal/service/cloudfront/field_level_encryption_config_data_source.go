@@ -0,0 +1,143 @@
+package cloudfront
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFieldLevelEncryptionConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFieldLevelEncryptionConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_type_profile_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type_profiles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"items": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"content_type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"format": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"profile_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"forward_when_content_type_is_unknown": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_arg_profile_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forward_when_query_arg_profile_is_unknown": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"query_arg_profiles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"items": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"profile_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"query_arg": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFieldLevelEncryptionConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	id := d.Get("id").(string)
+	output, err := FindFieldLevelEncryptionConfigByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Field-level Encryption Config (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	apiObject := output.FieldLevelEncryptionConfig
+	d.Set("caller_reference", apiObject.CallerReference)
+	d.Set("comment", apiObject.Comment)
+	if apiObject.ContentTypeProfileConfig != nil {
+		if err := d.Set("content_type_profile_config", []interface{}{flattenContentTypeProfileConfig(apiObject.ContentTypeProfileConfig)}); err != nil {
+			return fmt.Errorf("error setting content_type_profile_config: %w", err)
+		}
+	} else {
+		d.Set("content_type_profile_config", nil)
+	}
+	d.Set("etag", output.ETag)
+	if apiObject.QueryArgProfileConfig != nil {
+		if err := d.Set("query_arg_profile_config", []interface{}{flattenQueryArgProfileConfig(apiObject.QueryArgProfileConfig)}); err != nil {
+			return fmt.Errorf("error setting query_arg_profile_config: %w", err)
+		}
+	} else {
+		d.Set("query_arg_profile_config", nil)
+	}
+
+	return nil
+}
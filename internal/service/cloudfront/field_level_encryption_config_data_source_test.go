@@ -0,0 +1,48 @@
+package cloudfront_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFrontFieldLevelEncryptionConfigDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudfront_field_level_encryption_config.test"
+	resourceName := "aws_cloudfront_field_level_encryption_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldLevelEncryptionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldLevelEncryptionConfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "caller_reference", resourceName, "caller_reference"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "content_type_profile_config.#", resourceName, "content_type_profile_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "content_type_profile_config.0.forward_when_content_type_is_unknown", resourceName, "content_type_profile_config.0.forward_when_content_type_is_unknown"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "content_type_profile_config.0.content_type_profiles.#", resourceName, "content_type_profile_config.0.content_type_profiles.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "content_type_profile_config.0.content_type_profiles.0.items.#", resourceName, "content_type_profile_config.0.content_type_profiles.0.items.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_arg_profile_config.#", resourceName, "query_arg_profile_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_arg_profile_config.0.forward_when_query_arg_profile_is_unknown", resourceName, "query_arg_profile_config.0.forward_when_query_arg_profile_is_unknown"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFieldLevelEncryptionConfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFieldLevelEncryptionConfigConfig_basic(rName), `
+data "aws_cloudfront_field_level_encryption_config" "test" {
+  id = aws_cloudfront_field_level_encryption_config.test.id
+}
+`)
+}
@@ -0,0 +1,138 @@
+package cloudfront
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFieldLevelEncryptionProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFieldLevelEncryptionProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_entities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"items": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_patterns": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"items": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"provider_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"public_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+		},
+	}
+}
+
+func dataSourceFieldLevelEncryptionProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	var profileID string
+
+	if v, ok := d.GetOk("id"); ok {
+		profileID = v.(string)
+	} else {
+		name := d.Get("name").(string)
+		input := &cloudfront.ListFieldLevelEncryptionProfilesInput{}
+
+		err := ListFieldLevelEncryptionProfilesPages(conn, input, func(page *cloudfront.ListFieldLevelEncryptionProfilesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, profileSummary := range page.FieldLevelEncryptionProfileList.Items {
+				if aws.StringValue(profileSummary.Name) == name {
+					profileID = aws.StringValue(profileSummary.Id)
+
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudFront Field-level Encryption Profiles: %w", err)
+		}
+
+		if profileID == "" {
+			return fmt.Errorf("no matching CloudFront Field-level Encryption Profile (%s)", name)
+		}
+	}
+
+	output, err := FindFieldLevelEncryptionProfileByID(conn, profileID)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Field-level Encryption Profile (%s): %w", profileID, err)
+	}
+
+	d.SetId(profileID)
+
+	apiObject := output.FieldLevelEncryptionProfile.FieldLevelEncryptionProfileConfig
+	d.Set("caller_reference", apiObject.CallerReference)
+	d.Set("comment", apiObject.Comment)
+	if apiObject.EncryptionEntities != nil {
+		if err := d.Set("encryption_entities", []interface{}{flattenEncryptionEntities(apiObject.EncryptionEntities)}); err != nil {
+			return fmt.Errorf("error setting encryption_entities: %w", err)
+		}
+	} else {
+		d.Set("encryption_entities", nil)
+	}
+	d.Set("etag", output.ETag)
+	d.Set("name", apiObject.Name)
+
+	return nil
+}
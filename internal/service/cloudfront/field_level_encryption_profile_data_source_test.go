@@ -0,0 +1,64 @@
+package cloudfront_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFrontFieldLevelEncryptionProfileDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSource1Name := "data.aws_cloudfront_field_level_encryption_profile.by_id"
+	dataSource2Name := "data.aws_cloudfront_field_level_encryption_profile.by_name"
+	resourceName := "aws_cloudfront_field_level_encryption_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldLevelEncryptionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldLevelEncryptionProfileDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource1Name, "caller_reference", resourceName, "caller_reference"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "encryption_entities.#", resourceName, "encryption_entities.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "encryption_entities.0.items.#", resourceName, "encryption_entities.0.items.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "name", resourceName, "name"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSource1Name, "encryption_entities.0.items.*", map[string]string{
+						"provider_id":              rName,
+						"field_patterns.#":         "1",
+						"field_patterns.0.items.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(dataSource1Name, "encryption_entities.0.items.*.field_patterns.0.items.*", "DateOfBirth"),
+
+					resource.TestCheckResourceAttrPair(dataSource2Name, "caller_reference", resourceName, "caller_reference"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "encryption_entities.#", resourceName, "encryption_entities.#"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "encryption_entities.0.items.#", resourceName, "encryption_entities.0.items.#"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFieldLevelEncryptionProfileDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFieldLevelEncryptionProfileConfig_basic(rName), `
+data "aws_cloudfront_field_level_encryption_profile" "by_id" {
+  id = aws_cloudfront_field_level_encryption_profile.test.id
+}
+
+data "aws_cloudfront_field_level_encryption_profile" "by_name" {
+  name = aws_cloudfront_field_level_encryption_profile.test.name
+}
+`)
+}
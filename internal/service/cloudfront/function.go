@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceFunction() *schema.Resource {
@@ -40,6 +41,14 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"key_value_store_associations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
 			"live_stage_etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -74,8 +83,9 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	input := &cloudfront.CreateFunctionInput{
 		FunctionCode: []byte(d.Get("code").(string)),
 		FunctionConfig: &cloudfront.FunctionConfig{
-			Comment: aws.String(d.Get("comment").(string)),
-			Runtime: aws.String(d.Get("runtime").(string)),
+			Comment:                   aws.String(d.Get("comment").(string)),
+			KeyValueStoreAssociations: expandKeyValueStoreAssociations(d.Get("key_value_store_associations").(*schema.Set)),
+			Runtime:                   aws.String(d.Get("runtime").(string)),
 		},
 		Name: aws.String(functionName),
 	}
@@ -124,6 +134,9 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", describeFunctionOutput.FunctionSummary.FunctionMetadata.FunctionARN)
 	d.Set("comment", describeFunctionOutput.FunctionSummary.FunctionConfig.Comment)
 	d.Set("etag", describeFunctionOutput.ETag)
+	if err := d.Set("key_value_store_associations", flattenKeyValueStoreAssociations(describeFunctionOutput.FunctionSummary.FunctionConfig.KeyValueStoreAssociations)); err != nil {
+		return fmt.Errorf("error setting key_value_store_associations: %w", err)
+	}
 	d.Set("name", describeFunctionOutput.FunctionSummary.Name)
 	d.Set("runtime", describeFunctionOutput.FunctionSummary.FunctionConfig.Runtime)
 	d.Set("status", describeFunctionOutput.FunctionSummary.Status)
@@ -156,12 +169,13 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	etag := d.Get("etag").(string)
 
-	if d.HasChanges("code", "comment", "runtime") {
+	if d.HasChanges("code", "comment", "key_value_store_associations", "runtime") {
 		input := &cloudfront.UpdateFunctionInput{
 			FunctionCode: []byte(d.Get("code").(string)),
 			FunctionConfig: &cloudfront.FunctionConfig{
-				Comment: aws.String(d.Get("comment").(string)),
-				Runtime: aws.String(d.Get("runtime").(string)),
+				Comment:                   aws.String(d.Get("comment").(string)),
+				KeyValueStoreAssociations: expandKeyValueStoreAssociations(d.Get("key_value_store_associations").(*schema.Set)),
+				Runtime:                   aws.String(d.Get("runtime").(string)),
 			},
 			Name:    aws.String(d.Id()),
 			IfMatch: aws.String(etag),
@@ -213,3 +227,35 @@ func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandKeyValueStoreAssociations(tfSet *schema.Set) *cloudfront.KeyValueStoreAssociations {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	apiObject := &cloudfront.KeyValueStoreAssociations{
+		Quantity: aws.Int64(int64(tfSet.Len())),
+	}
+
+	for _, v := range tfSet.List() {
+		apiObject.Items = append(apiObject.Items, &cloudfront.KeyValueStoreAssociation{
+			KeyValueStoreARN: aws.String(v.(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenKeyValueStoreAssociations(apiObject *cloudfront.KeyValueStoreAssociations) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []string
+
+	for _, v := range apiObject.Items {
+		tfList = append(tfList, aws.StringValue(v.KeyValueStoreARN))
+	}
+
+	return tfList
+}
@@ -278,6 +278,37 @@ func TestAccCloudFrontFunction_Update_comment(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFunction_KeyValueStoreAssociations(t *testing.T) {
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	keyValueStoreResourceName := "aws_cloudfront_key_value_store.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_keyValueStoreAssociations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "key_value_store_associations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_value_store_associations.*", keyValueStoreResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "runtime", "cloudfront-js-2.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish"},
+			},
+		},
+	})
+}
+
 func testAccCheckFunctionDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
 
@@ -563,3 +594,34 @@ EOT
 }
 `, rName, comment)
 }
+
+func testAccFunctionConfig_keyValueStoreAssociations(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+import cf from 'cloudfront';
+
+const kvsHandle = cf.kvs();
+
+async function handler(event) {
+	var location = await kvsHandle.get('location');
+	return {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: {
+			'location': { value: location }
+		}
+	};
+}
+EOT
+
+  key_value_store_associations = [aws_cloudfront_key_value_store.test.arn]
+}
+`, rName)
+}
@@ -0,0 +1,86 @@
+package codeguruprofiler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindProfilingGroupByName(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) (*codeguruprofiler.ProfilingGroupDescription, error) {
+	input := &codeguruprofiler.DescribeProfilingGroupInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeProfilingGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProfilingGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ProfilingGroup, nil
+}
+
+func findNotificationChannelsByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) ([]*codeguruprofiler.Channel, error) {
+	input := &codeguruprofiler.GetNotificationConfigurationInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	output, err := conn.GetNotificationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NotificationConfiguration == nil {
+		return nil, nil
+	}
+
+	return output.NotificationConfiguration.Channels, nil
+}
+
+func findPolicyByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) (*codeguruprofiler.GetPolicyOutput, error) {
+	input := &codeguruprofiler.GetPolicyInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	output, err := conn.GetPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,557 @@
+package codeguruprofiler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProfilingGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProfilingGroupCreate,
+		ReadWithoutTimeout:   resourceProfilingGroupRead,
+		UpdateWithoutTimeout: resourceProfilingGroupUpdate,
+		DeleteWithoutTimeout: resourceProfilingGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"agent_orchestration_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profiling_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"agent_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principals": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_platform": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      codeguruprofiler.ComputePlatformDefault,
+				ValidateFunc: validation.StringInSlice(codeguruprofiler.ComputePlatform_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[\w-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"notification_channel": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_publishers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(codeguruprofiler.EventPublisher_Values(), false),
+							},
+						},
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceProfilingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &codeguruprofiler.CreateProfilingGroupInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		ComputePlatform:    aws.String(d.Get("compute_platform").(string)),
+		ProfilingGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("agent_orchestration_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AgentOrchestrationConfig = expandAgentOrchestrationConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CodeGuru Profiler Profiling Group: %s", input)
+	_, err := conn.CreateProfilingGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CodeGuru Profiler Profiling Group (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("notification_channel"); ok && v.(*schema.Set).Len() > 0 {
+		if err := addNotificationChannels(ctx, conn, d.Id(), expandChannels(v.(*schema.Set).List())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("agent_permissions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if err := putAgentPermissions(ctx, conn, d.Id(), flex.ExpandStringSet(tfMap["principals"].(*schema.Set))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceProfilingGroupRead(ctx, d, meta)
+}
+
+func resourceProfilingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	profilingGroup, err := FindProfilingGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeGuru Profiler Profiling Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CodeGuru Profiler Profiling Group (%s): %s", d.Id(), err)
+	}
+
+	if profilingGroup.AgentOrchestrationConfig != nil {
+		if err := d.Set("agent_orchestration_config", []interface{}{flattenAgentOrchestrationConfig(profilingGroup.AgentOrchestrationConfig)}); err != nil {
+			return diag.Errorf("setting agent_orchestration_config: %s", err)
+		}
+	} else {
+		d.Set("agent_orchestration_config", nil)
+	}
+	d.Set("arn", profilingGroup.Arn)
+	d.Set("compute_platform", profilingGroup.ComputePlatform)
+	d.Set("name", profilingGroup.Name)
+
+	channels, err := findNotificationChannelsByProfilingGroupName(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading CodeGuru Profiler Profiling Group (%s) notification configuration: %s", d.Id(), err)
+	}
+
+	if err := d.Set("notification_channel", flattenChannels(channels)); err != nil {
+		return diag.Errorf("setting notification_channel: %s", err)
+	}
+
+	principals, err := findAgentPermissionsPrincipals(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading CodeGuru Profiler Profiling Group (%s) policy: %s", d.Id(), err)
+	}
+
+	if len(principals) > 0 {
+		if err := d.Set("agent_permissions", []interface{}{map[string]interface{}{"principals": principals}}); err != nil {
+			return diag.Errorf("setting agent_permissions: %s", err)
+		}
+	} else {
+		d.Set("agent_permissions", nil)
+	}
+
+	tags := KeyValueTags(profilingGroup.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceProfilingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn
+
+	if d.HasChange("agent_orchestration_config") {
+		if v, ok := d.GetOk("agent_orchestration_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &codeguruprofiler.UpdateProfilingGroupInput{
+				AgentOrchestrationConfig: expandAgentOrchestrationConfig(v.([]interface{})[0].(map[string]interface{})),
+				ProfilingGroupName:       aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Updating CodeGuru Profiler Profiling Group: %s", input)
+			if _, err := conn.UpdateProfilingGroupWithContext(ctx, input); err != nil {
+				return diag.Errorf("updating CodeGuru Profiler Profiling Group (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("notification_channel") {
+		o, n := d.GetChange("notification_channel")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			if err := removeNotificationChannels(ctx, conn, d.Id(), expandChannels(del)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			if err := addNotificationChannels(ctx, conn, d.Id(), expandChannels(add)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("agent_permissions") {
+		if v, ok := d.GetOk("agent_permissions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if err := putAgentPermissions(ctx, conn, d.Id(), flex.ExpandStringSet(tfMap["principals"].(*schema.Set))); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := removeAgentPermissions(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating CodeGuru Profiler Profiling Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProfilingGroupRead(ctx, d, meta)
+}
+
+func resourceProfilingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn
+
+	log.Printf("[DEBUG] Deleting CodeGuru Profiler Profiling Group: %s", d.Id())
+	_, err := conn.DeleteProfilingGroupWithContext(ctx, &codeguruprofiler.DeleteProfilingGroupInput{
+		ProfilingGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CodeGuru Profiler Profiling Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func addNotificationChannels(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string, channels []*codeguruprofiler.Channel) error {
+	input := &codeguruprofiler.AddNotificationChannelsInput{
+		Channels:           channels,
+		ProfilingGroupName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Adding CodeGuru Profiler Profiling Group notification channels: %s", input)
+	if _, err := conn.AddNotificationChannelsWithContext(ctx, input); err != nil {
+		return fmt.Errorf("adding CodeGuru Profiler Profiling Group (%s) notification channels: %w", name, err)
+	}
+
+	return nil
+}
+
+// removeNotificationChannels removes the channels with matching URIs. Channel IDs are assigned by the service,
+// so they are looked up from the current notification configuration.
+func removeNotificationChannels(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string, channels []*codeguruprofiler.Channel) error {
+	current, err := findNotificationChannelsByProfilingGroupName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("reading CodeGuru Profiler Profiling Group (%s) notification configuration: %w", name, err)
+	}
+
+	for _, channel := range channels {
+		uri := aws.StringValue(channel.Uri)
+
+		for _, v := range current {
+			if v == nil || aws.StringValue(v.Uri) != uri {
+				continue
+			}
+
+			log.Printf("[DEBUG] Removing CodeGuru Profiler Profiling Group (%s) notification channel: %s", name, uri)
+			_, err := conn.RemoveNotificationChannelWithContext(ctx, &codeguruprofiler.RemoveNotificationChannelInput{
+				ChannelId:          v.Id,
+				ProfilingGroupName: aws.String(name),
+			})
+
+			if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("removing CodeGuru Profiler Profiling Group (%s) notification channel (%s): %w", name, uri, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func putAgentPermissions(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string, principals []*string) error {
+	input := &codeguruprofiler.PutPermissionInput{
+		ActionGroup:        aws.String(codeguruprofiler.ActionGroupAgentPermissions),
+		Principals:         principals,
+		ProfilingGroupName: aws.String(name),
+	}
+
+	// The current policy revision is required to replace an existing policy.
+	policy, err := findPolicyByProfilingGroupName(ctx, conn, name)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("reading CodeGuru Profiler Profiling Group (%s) policy: %w", name, err)
+	}
+
+	if policy != nil && aws.StringValue(policy.Policy) != "" {
+		input.RevisionId = policy.RevisionId
+	}
+
+	log.Printf("[DEBUG] Putting CodeGuru Profiler Profiling Group agent permissions: %s", input)
+	if _, err := conn.PutPermissionWithContext(ctx, input); err != nil {
+		return fmt.Errorf("putting CodeGuru Profiler Profiling Group (%s) agent permissions: %w", name, err)
+	}
+
+	return nil
+}
+
+func removeAgentPermissions(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) error {
+	policy, err := findPolicyByProfilingGroupName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading CodeGuru Profiler Profiling Group (%s) policy: %w", name, err)
+	}
+
+	if aws.StringValue(policy.Policy) == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing CodeGuru Profiler Profiling Group (%s) agent permissions", name)
+	_, err = conn.RemovePermissionWithContext(ctx, &codeguruprofiler.RemovePermissionInput{
+		ActionGroup:        aws.String(codeguruprofiler.ActionGroupAgentPermissions),
+		ProfilingGroupName: aws.String(name),
+		RevisionId:         policy.RevisionId,
+	})
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing CodeGuru Profiler Profiling Group (%s) agent permissions: %w", name, err)
+	}
+
+	return nil
+}
+
+// agentPermissionsAction is granted by the agentPermissions action group and identifies its policy statements.
+const agentPermissionsAction = "codeguru-profiler:PostAgentProfile"
+
+type profilingGroupPolicy struct {
+	Statement []profilingGroupPolicyStatement `json:"Statement"`
+}
+
+type profilingGroupPolicyStatement struct {
+	Action    interface{} `json:"Action"`
+	Principal struct {
+		AWS interface{} `json:"AWS"`
+	} `json:"Principal"`
+}
+
+// findAgentPermissionsPrincipals returns the principals granted the agentPermissions action group.
+func findAgentPermissionsPrincipals(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) ([]string, error) {
+	output, err := findPolicyByProfilingGroupName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(output.Policy) == "" {
+		return nil, nil
+	}
+
+	var policy profilingGroupPolicy
+
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), &policy); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	var principals []string
+
+	for _, statement := range policy.Statement {
+		granted := false
+		for _, action := range stringOrSlice(statement.Action) {
+			if action == agentPermissionsAction {
+				granted = true
+				break
+			}
+		}
+
+		if granted {
+			principals = append(principals, stringOrSlice(statement.Principal.AWS)...)
+		}
+	}
+
+	return principals, nil
+}
+
+func stringOrSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var s []string
+		for _, e := range v {
+			if e, ok := e.(string); ok {
+				s = append(s, e)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+func expandAgentOrchestrationConfig(tfMap map[string]interface{}) *codeguruprofiler.AgentOrchestrationConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codeguruprofiler.AgentOrchestrationConfig{}
+
+	if v, ok := tfMap["profiling_enabled"].(bool); ok {
+		apiObject.ProfilingEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandChannels(tfList []interface{}) []*codeguruprofiler.Channel {
+	var apiObjects []*codeguruprofiler.Channel
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codeguruprofiler.Channel{}
+
+		if v, ok := tfMap["event_publishers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.EventPublishers = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["uri"].(string); ok && v != "" {
+			apiObject.Uri = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAgentOrchestrationConfig(apiObject *codeguruprofiler.AgentOrchestrationConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"profiling_enabled": aws.BoolValue(apiObject.ProfilingEnabled),
+	}
+
+	return tfMap
+}
+
+func flattenChannels(apiObjects []*codeguruprofiler.Channel) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"event_publishers": aws.StringValueSlice(apiObject.EventPublishers),
+			"uri":              aws.StringValue(apiObject.Uri),
+		})
+	}
+
+	return tfList
+}
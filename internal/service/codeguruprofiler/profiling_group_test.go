@@ -0,0 +1,323 @@
+package codeguruprofiler_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeguruprofiler "github.com/hashicorp/terraform-provider-aws/internal/service/codeguruprofiler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCodeGuruProfilerProfilingGroup_basic(t *testing.T) {
+	var profilingGroup codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.0.profiling_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "codeguru-profiler", regexp.MustCompile(`profilingGroup/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Default"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_disappears(t *testing.T) {
+	var profilingGroup codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcodeguruprofiler.ResourceProfilingGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_tags(t *testing.T) {
+	var profilingGroup codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccProfilingGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_notificationsAndPermissions(t *testing.T) {
+	var profilingGroup codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_notificationsAndPermissions(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.0.profiling_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.0.principals.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "agent_permissions.0.principals.*", "aws_iam_role.test1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_channel.*", map[string]string{
+						"event_publishers.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_channel.*.uri", "aws_sns_topic.test1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_notificationsAndPermissions(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.0.profiling_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.0.principals.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "2"),
+				),
+			},
+			{
+				Config: testAccProfilingGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(resourceName, &profilingGroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProfilingGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codeguruprofiler_profiling_group" {
+			continue
+		}
+
+		_, err := tfcodeguruprofiler.FindProfilingGroupByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CodeGuru Profiler Profiling Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckProfilingGroupExists(n string, v *codeguruprofiler.ProfilingGroupDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeGuru Profiler Profiling Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerConn
+
+		output, err := tfcodeguruprofiler.FindProfilingGroupByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfilingGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName)
+}
+
+func testAccProfilingGroupConfig_notificationsAndPermissions(rName string, update bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test1" {
+  name = "%[1]s-1"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    }]
+  })
+}
+
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = aws_iam_role.test1.assume_role_policy
+}
+
+resource "aws_sns_topic" "test1" {
+  name = "%[1]s-1"
+}
+
+resource "aws_sns_topic" "test2" {
+  name = "%[1]s-2"
+}
+
+locals {
+  update = %[2]t
+}
+
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = local.update
+  }
+
+  agent_permissions {
+    principals = local.update ? [aws_iam_role.test1.arn, aws_iam_role.test2.arn] : [aws_iam_role.test1.arn]
+  }
+
+  notification_channel {
+    event_publishers = ["AnomalyDetection"]
+    uri              = aws_sns_topic.test1.arn
+  }
+
+  dynamic "notification_channel" {
+    for_each = local.update ? [aws_sns_topic.test2.arn] : []
+
+    content {
+      event_publishers = ["AnomalyDetection"]
+      uri              = notification_channel.value
+    }
+  }
+}
+`, rName, update)
+}
+
+func testAccProfilingGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccProfilingGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
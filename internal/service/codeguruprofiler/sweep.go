@@ -0,0 +1,69 @@
+//go:build sweep
+// +build sweep
+
+package codeguruprofiler
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_codeguruprofiler_profiling_group", &resource.Sweeper{
+		Name: "aws_codeguruprofiler_profiling_group",
+		F:    sweepProfilingGroups,
+	})
+}
+
+func sweepProfilingGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).CodeGuruProfilerConn
+	sweepResources := make([]sweep.Sweepable, 0)
+	ctx := context.Background()
+	input := &codeguruprofiler.ListProfilingGroupsInput{}
+
+	err = conn.ListProfilingGroupsPagesWithContext(ctx, input, func(page *codeguruprofiler.ListProfilingGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProfilingGroupNames {
+			r := ResourceProfilingGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeGuru Profiler Profiling Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CodeGuru Profiler Profiling Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeGuru Profiler Profiling Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package codegurusecurity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindScanByTwoPartKey(ctx context.Context, conn *codegurusecurity.CodeGuruSecurity, scanName, runID string) (*codegurusecurity.GetScanOutput, error) {
+	input := &codegurusecurity.GetScanInput{
+		RunId:    aws.String(runID),
+		ScanName: aws.String(scanName),
+	}
+
+	output, err := conn.GetScanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, codegurusecurity.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findFindings(ctx context.Context, conn *codegurusecurity.CodeGuruSecurity, input *codegurusecurity.GetFindingsInput) ([]*codegurusecurity.Finding, error) {
+	var output []*codegurusecurity.Finding
+
+	err := conn.GetFindingsPagesWithContext(ctx, input, func(page *codegurusecurity.GetFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Findings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, codegurusecurity.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
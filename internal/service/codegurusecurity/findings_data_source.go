@@ -0,0 +1,176 @@
+package codegurusecurity
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"critical_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detector_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detector_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_line": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"file_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_line": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"high_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"info_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"low_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"medium_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"scan_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      codegurusecurity.StatusOpen,
+				ValidateFunc: validation.StringInSlice(codegurusecurity.Status_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruSecurityConn
+
+	scanName := d.Get("scan_name").(string)
+	input := &codegurusecurity.GetFindingsInput{
+		ScanName: aws.String(scanName),
+		Status:   aws.String(d.Get("status").(string)),
+	}
+
+	findings, err := findFindings(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("reading CodeGuru Security Scan (%s) findings: %s", scanName, err)
+	}
+
+	counts := make(map[string]int)
+	var tfList []interface{}
+
+	for _, finding := range findings {
+		counts[aws.StringValue(finding.Severity)]++
+		tfList = append(tfList, flattenFinding(finding))
+	}
+
+	d.SetId(scanName)
+
+	if err := d.Set("findings", tfList); err != nil {
+		return diag.Errorf("setting findings: %s", err)
+	}
+
+	d.Set("critical_count", counts[codegurusecurity.SeverityCritical])
+	d.Set("high_count", counts[codegurusecurity.SeverityHigh])
+	d.Set("info_count", counts[codegurusecurity.SeverityInfo])
+	d.Set("low_count", counts[codegurusecurity.SeverityLow])
+	d.Set("medium_count", counts[codegurusecurity.SeverityMedium])
+
+	return nil
+}
+
+func flattenFinding(apiObject *codegurusecurity.Finding) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"description":   aws.StringValue(apiObject.Description),
+		"detector_id":   aws.StringValue(apiObject.DetectorId),
+		"detector_name": aws.StringValue(apiObject.DetectorName),
+		"id":            aws.StringValue(apiObject.Id),
+		"rule_id":       aws.StringValue(apiObject.RuleId),
+		"severity":      aws.StringValue(apiObject.Severity),
+		"status":        aws.StringValue(apiObject.Status),
+		"title":         aws.StringValue(apiObject.Title),
+		"type":          aws.StringValue(apiObject.Type),
+	}
+
+	if v := apiObject.CreatedAt; v != nil {
+		tfMap["created_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Vulnerability; v != nil && v.FilePath != nil {
+		tfMap["end_line"] = int(aws.Int64Value(v.FilePath.EndLine))
+		tfMap["file_path"] = aws.StringValue(v.FilePath.Path)
+		tfMap["start_line"] = int(aws.Int64Value(v.FilePath.StartLine))
+	}
+
+	return tfMap
+}
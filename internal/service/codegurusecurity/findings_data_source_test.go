@@ -0,0 +1,45 @@
+package codegurusecurity_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCodeGuruSecurityFindingsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codegurusecurity_findings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codegurusecurity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "critical_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "high_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "info_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "low_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "medium_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scan_name", "aws_codegurusecurity_scan.test", "scan_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Open"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccScanConfig_basic(rName), `
+data "aws_codegurusecurity_findings" "test" {
+  scan_name = aws_codegurusecurity_scan.test.scan_name
+}
+`)
+}
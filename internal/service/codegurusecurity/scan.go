@@ -0,0 +1,278 @@
+package codegurusecurity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceScan uploads a zip archive of source code and runs a CodeGuru Security scan of it.
+// Changing the source or any scan argument runs a new scan.
+func ResourceScan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScanCreate,
+		ReadWithoutTimeout:   resourceScanRead,
+		UpdateWithoutTimeout: resourceScanUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"analysis_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      codegurusecurity.AnalysisTypeSecurity,
+				ValidateFunc: validation.StringInSlice(codegurusecurity.AnalysisType_Values(), false),
+			},
+			"code_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 140),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_$:.]*$`), "must contain only alphanumeric characters and -_$:."),
+				),
+			},
+			"scan_name_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      codegurusecurity.ScanTypeStandard,
+				ValidateFunc: validation.StringInSlice(codegurusecurity.ScanType_Values(), false),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceScanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruSecurityConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	scanName := d.Get("scan_name").(string)
+
+	codeArtifactID, err := uploadScanSource(ctx, conn, scanName, d.Get("source").(string))
+
+	if err != nil {
+		return diag.Errorf("uploading CodeGuru Security Scan (%s) source: %s", scanName, err)
+	}
+
+	input := &codegurusecurity.CreateScanInput{
+		AnalysisType: aws.String(d.Get("analysis_type").(string)),
+		ClientToken:  aws.String(resource.UniqueId()),
+		ResourceId: &codegurusecurity.ResourceId{
+			CodeArtifactId: aws.String(codeArtifactID),
+		},
+		ScanName: aws.String(scanName),
+		ScanType: aws.String(d.Get("scan_type").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CodeGuru Security Scan: %s", input)
+	output, err := conn.CreateScanWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CodeGuru Security Scan (%s): %s", scanName, err)
+	}
+
+	runID := aws.StringValue(output.RunId)
+	d.SetId(ScanCreateResourceID(scanName, runID))
+	d.Set("code_artifact_id", codeArtifactID)
+
+	if _, err := waitScanSuccessful(ctx, conn, scanName, runID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for CodeGuru Security Scan (%s) run (%s) to complete: %s", scanName, runID, err)
+	}
+
+	return resourceScanRead(ctx, d, meta)
+}
+
+func resourceScanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruSecurityConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	scanName, runID, err := ScanParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	scan, err := FindScanByTwoPartKey(ctx, conn, scanName, runID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeGuru Security Scan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CodeGuru Security Scan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("analysis_type", scan.AnalysisType)
+	d.Set("created_at", aws.TimeValue(scan.CreatedAt).Format(time.RFC3339))
+	d.Set("run_id", scan.RunId)
+	d.Set("scan_name", scan.ScanName)
+	d.Set("scan_name_arn", scan.ScanNameArn)
+	d.Set("scan_state", scan.ScanState)
+
+	tags, err := ListTagsWithContext(ctx, conn, aws.StringValue(scan.ScanNameArn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for CodeGuru Security Scan (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceScanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeGuruSecurityConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("scan_name_arn").(string), o, n); err != nil {
+			return diag.Errorf("updating CodeGuru Security Scan (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceScanRead(ctx, d, meta)
+}
+
+// uploadScanSource uploads the zip archive at path to a CodeGuru Security upload URL
+// and returns the ID of the resulting code artifact.
+func uploadScanSource(ctx context.Context, conn *codegurusecurity.CodeGuruSecurity, scanName, path string) (string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+
+	if err != nil {
+		return "", err
+	}
+
+	output, err := conn.CreateUploadUrlWithContext(ctx, &codegurusecurity.CreateUploadUrlInput{
+		ScanName: aws.String(scanName),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("creating upload URL: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, aws.StringValue(output.S3Url), file)
+
+	if err != nil {
+		return "", err
+	}
+
+	request.ContentLength = info.Size()
+
+	for k, v := range output.RequestHeaders {
+		request.Header.Set(k, aws.StringValue(v))
+	}
+
+	response, err := http.DefaultClient.Do(request)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected HTTP status: %s", response.Status)
+	}
+
+	return aws.StringValue(output.CodeArtifactId), nil
+}
+
+const scanResourceIDSeparator = ","
+
+func ScanCreateResourceID(scanName, runID string) string {
+	parts := []string{scanName, runID}
+	id := strings.Join(parts, scanResourceIDSeparator)
+
+	return id
+}
+
+func ScanParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, scanResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SCAN_NAME%[2]sRUN_ID", id, scanResourceIDSeparator)
+}
@@ -0,0 +1,134 @@
+package codegurusecurity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodegurusecurity "github.com/hashicorp/terraform-provider-aws/internal/service/codegurusecurity"
+)
+
+func TestAccCodeGuruSecurityScan_basic(t *testing.T) {
+	var scan codegurusecurity.GetScanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codegurusecurity_scan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codegurusecurity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(resourceName, &scan),
+					resource.TestCheckResourceAttr(resourceName, "analysis_type", "Security"),
+					resource.TestCheckResourceAttrSet(resourceName, "code_artifact_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "run_id"),
+					resource.TestCheckResourceAttr(resourceName, "scan_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "scan_name_arn"),
+					resource.TestCheckResourceAttr(resourceName, "scan_state", "Successful"),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruSecurityScan_rescan(t *testing.T) {
+	var scan1, scan2 codegurusecurity.GetScanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codegurusecurity_scan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codegurusecurity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScanConfig_sourceHash(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(resourceName, &scan1),
+				),
+			},
+			{
+				Config: testAccScanConfig_sourceHash(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(resourceName, &scan2),
+					testAccCheckScanRecreated(&scan1, &scan2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScanExists(n string, v *codegurusecurity.GetScanOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeGuru Security Scan ID is set")
+		}
+
+		scanName, runID, err := tfcodegurusecurity.ScanParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruSecurityConn
+
+		output, err := tfcodegurusecurity.FindScanByTwoPartKey(context.Background(), conn, scanName, runID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckScanRecreated(i, j *codegurusecurity.GetScanOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if i.RunId != nil && j.RunId != nil && *i.RunId == *j.RunId {
+			return fmt.Errorf("CodeGuru Security Scan (%s) was not run again", *i.ScanName)
+		}
+
+		return nil
+	}
+}
+
+func testAccScanConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codegurusecurity_scan" "test" {
+  scan_name = %[1]q
+  source    = "test-fixtures/scan.zip"
+}
+`, rName)
+}
+
+func testAccScanConfig_sourceHash(rName, sourceHash string) string {
+	return fmt.Sprintf(`
+resource "aws_codegurusecurity_scan" "test" {
+  scan_name   = %[1]q
+  scan_type   = "Express"
+  source      = "test-fixtures/scan.zip"
+  source_hash = %[2]q
+}
+`, rName, sourceHash)
+}
@@ -0,0 +1,26 @@
+package codegurusecurity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusScan(ctx context.Context, conn *codegurusecurity.CodeGuruSecurity, scanName, runID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindScanByTwoPartKey(ctx, conn, scanName, runID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ScanState), nil
+	}
+}
@@ -0,0 +1,35 @@
+package codegurusecurity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codegurusecurity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitScanSuccessful(ctx context.Context, conn *codegurusecurity.CodeGuruSecurity, scanName, runID string, timeout time.Duration) (*codegurusecurity.GetScanOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{codegurusecurity.ScanStateInProgress},
+		Target:     []string{codegurusecurity.ScanStateSuccessful},
+		Refresh:    statusScan(ctx, conn, scanName, runID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codegurusecurity.GetScanOutput); ok {
+		if message := output.ErrorMessage; message != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
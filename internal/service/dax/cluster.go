@@ -1,6 +1,7 @@
 package dax
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -46,15 +48,8 @@ func ResourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
+				Default:      dax.ClusterEndpointEncryptionTypeNone,
 				ValidateFunc: validation.StringInSlice(dax.ClusterEndpointEncryptionType_Values(), false),
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// API returns "NONE" by default.
-					if old == dax.ClusterEndpointEncryptionTypeNone && new == "" {
-						return true
-					}
-
-					return old == new
-				},
 			},
 			"cluster_name": {
 				Type:     schema.TypeString,
@@ -75,7 +70,7 @@ func ResourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfService("iam", "role"),
 			},
 			"node_type": {
 				Type:     schema.TypeString,
@@ -126,11 +121,10 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				MaxItems: 1,
+				// Omitting the block is the same as disabling server-side encryption.
+				// Removing the block from an encrypted cluster is caught by customizeDiffServerSideEncryption.
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if old == "1" && new == "0" {
-						return true
-					}
-					return false
+					return old == "1" && new == "0"
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -189,8 +183,34 @@ func ResourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffServerSideEncryption,
+		),
+	}
+}
+
+// Server-side encryption cannot be changed on an existing cluster.
+func customizeDiffServerSideEncryption(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	o, n := diff.GetChange("server_side_encryption")
+
+	if serverSideEncryptionEnabled(o.([]interface{})) != serverSideEncryptionEnabled(n.([]interface{})) {
+		return diff.ForceNew("server_side_encryption")
+	}
+
+	return nil
+}
+
+func serverSideEncryptionEnabled(tfList []interface{}) bool {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return false
 	}
+
+	return tfList[0].(map[string]interface{})["enabled"].(bool)
 }
 
 func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
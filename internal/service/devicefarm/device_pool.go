@@ -1,6 +1,7 @@
 package devicefarm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -80,10 +82,29 @@ func ResourceDevicePool() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDevicePoolCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceDevicePoolCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	for _, r := range diff.Get("rule").(*schema.Set).List() {
+		tfMap := r.(map[string]interface{})
+
+		if err := validDevicePoolRule(tfMap["attribute"].(string), tfMap["operator"].(string), tfMap["value"].(string)); err != nil {
+			return fmt.Errorf("invalid rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceDevicePoolCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DeviceFarmConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -3,6 +3,9 @@ package devicefarm
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
@@ -24,6 +27,10 @@ func ResourceUpload() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -53,6 +60,20 @@ func ResourceUpload() *schema.Resource {
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -89,6 +110,18 @@ func resourceUploadCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Successsfully Created DeviceFarm Upload: %s", arn)
 	d.SetId(arn)
 
+	if v, ok := d.GetOk("source"); ok {
+		contentType := aws.StringValue(out.Upload.ContentType)
+
+		if err := putUploadSource(aws.StringValue(out.Upload.Url), contentType, v.(string)); err != nil {
+			return fmt.Errorf("error uploading DeviceFarm Upload (%s) source: %w", d.Id(), err)
+		}
+
+		if _, err := waitUploadSucceeded(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for DeviceFarm Upload (%s) to be processed: %w", d.Id(), err)
+		}
+	}
+
 	return resourceUploadRead(d, meta)
 }
 
@@ -114,6 +147,7 @@ func resourceUploadRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("url", upload.Url)
 	d.Set("category", upload.Category)
 	d.Set("metadata", upload.Metadata)
+	d.Set("status", upload.Status)
 	d.Set("arn", arn)
 
 	projectArn, err := decodeProjectARN(arn, "upload", meta)
@@ -168,3 +202,49 @@ func resourceUploadDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// putUploadSource sends the file at path to an upload's pre-signed URL.
+// The Content-Type header must match the content type the upload was created with.
+func putUploadSource(url, contentType, path string) error {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPut, url, file)
+
+	if err != nil {
+		return err
+	}
+
+	request.ContentLength = info.Size()
+
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	request.Header.Set("Content-Type", contentType)
+
+	response, err := http.DefaultClient.Do(request)
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status: %s", response.Status)
+	}
+
+	return nil
+}
@@ -66,6 +66,42 @@ func TestAccDeviceFarmUpload_basic(t *testing.T) {
 	})
 }
 
+func TestAccDeviceFarmUpload_source(t *testing.T) {
+	var proj devicefarm.Upload
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devicefarm_upload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(devicefarm.EndpointsID, t)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devicefarm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUploadDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUploadConfig_source(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUploadExists(resourceName, &proj),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source", "test-fixtures/testspec.yml"),
+					resource.TestCheckResourceAttr(resourceName, "status", devicefarm.UploadStatusSucceeded),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "source_hash", "url"},
+			},
+		},
+	})
+}
+
 func TestAccDeviceFarmUpload_disappears(t *testing.T) {
 	var proj devicefarm.Upload
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -189,3 +225,19 @@ resource "aws_devicefarm_upload" "test" {
 }
 `, rName)
 }
+
+func testAccUploadConfig_source(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_devicefarm_project" "test" {
+  name = %[1]q
+}
+
+resource "aws_devicefarm_upload" "test" {
+  name        = %[1]q
+  project_arn = aws_devicefarm_project.test.arn
+  type        = "APPIUM_JAVA_TESTNG_TEST_SPEC"
+  source      = "test-fixtures/testspec.yml"
+  source_hash = filemd5("test-fixtures/testspec.yml")
+}
+`, rName)
+}
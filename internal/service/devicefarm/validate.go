@@ -0,0 +1,96 @@
+package devicefarm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+)
+
+// devicePoolRuleOperators lists the operators supported by each device pool rule attribute.
+// Attributes not listed accept any operator.
+var devicePoolRuleOperators = map[string][]string{
+	devicefarm.DeviceAttributeArn:                 {devicefarm.RuleOperatorEquals, devicefarm.RuleOperatorIn, devicefarm.RuleOperatorNotIn},
+	devicefarm.DeviceAttributeAvailability:        {devicefarm.RuleOperatorEquals},
+	devicefarm.DeviceAttributeFleetType:           {devicefarm.RuleOperatorEquals},
+	devicefarm.DeviceAttributeFormFactor:          {devicefarm.RuleOperatorEquals},
+	devicefarm.DeviceAttributeInstanceArn:         {devicefarm.RuleOperatorEquals, devicefarm.RuleOperatorIn, devicefarm.RuleOperatorNotIn},
+	devicefarm.DeviceAttributeInstanceLabels:      {devicefarm.RuleOperatorContains},
+	devicefarm.DeviceAttributeManufacturer:        {devicefarm.RuleOperatorEquals, devicefarm.RuleOperatorIn, devicefarm.RuleOperatorNotIn},
+	devicefarm.DeviceAttributeModel:               {devicefarm.RuleOperatorContains, devicefarm.RuleOperatorEquals, devicefarm.RuleOperatorIn, devicefarm.RuleOperatorNotIn},
+	devicefarm.DeviceAttributePlatform:            {devicefarm.RuleOperatorEquals},
+	devicefarm.DeviceAttributeRemoteAccessEnabled: {devicefarm.RuleOperatorEquals},
+	devicefarm.DeviceAttributeRemoteDebugEnabled:  {devicefarm.RuleOperatorEquals},
+}
+
+// devicePoolRuleValues lists the values allowed for device pool rule attributes with a fixed set of values.
+var devicePoolRuleValues = map[string][]string{
+	devicefarm.DeviceAttributeAvailability: devicefarm.DeviceAvailability_Values(),
+	devicefarm.DeviceAttributeFleetType:    {"PUBLIC", "PRIVATE"},
+	devicefarm.DeviceAttributeFormFactor:   devicefarm.DeviceFormFactor_Values(),
+	devicefarm.DeviceAttributePlatform:     devicefarm.DevicePlatform_Values(),
+}
+
+// validDevicePoolRule checks that a device pool rule's operator is supported for its attribute
+// and that its JSON-encoded value has the type expected for the attribute and operator.
+func validDevicePoolRule(attribute, operator, value string) error {
+	if attribute == "" || operator == "" || value == "" {
+		return nil
+	}
+
+	if operators, ok := devicePoolRuleOperators[attribute]; ok && !stringInSlice(operator, operators) {
+		return fmt.Errorf("operator %q is not supported for attribute %q, expected one of %q", operator, attribute, operators)
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return fmt.Errorf("value for attribute %q must be valid JSON: %w", attribute, err)
+	}
+
+	var values []interface{}
+
+	switch operator {
+	case devicefarm.RuleOperatorIn, devicefarm.RuleOperatorNotIn:
+		list, ok := v.([]interface{})
+
+		if !ok {
+			return fmt.Errorf("value for attribute %q with operator %q must be a JSON array", attribute, operator)
+		}
+
+		values = list
+	default:
+		values = []interface{}{v}
+	}
+
+	for _, v := range values {
+		switch attribute {
+		case devicefarm.DeviceAttributeRemoteAccessEnabled, devicefarm.DeviceAttributeRemoteDebugEnabled:
+			if _, ok := v.(bool); !ok {
+				return fmt.Errorf("value for attribute %q must be a JSON boolean", attribute)
+			}
+		default:
+			s, ok := v.(string)
+
+			if !ok {
+				return fmt.Errorf("value for attribute %q must be a JSON string", attribute)
+			}
+
+			if allowed, ok := devicePoolRuleValues[attribute]; ok && !stringInSlice(s, allowed) {
+				return fmt.Errorf("value %q is not valid for attribute %q, expected one of %q", s, attribute, allowed)
+			}
+		}
+	}
+
+	return nil
+}
+
+func stringInSlice(s string, l []string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
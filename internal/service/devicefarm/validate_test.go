@@ -0,0 +1,39 @@
+package devicefarm
+
+import (
+	"testing"
+)
+
+func TestValidDevicePoolRule(t *testing.T) {
+	testCases := []struct {
+		Attribute string
+		Operator  string
+		Value     string
+		ErrCount  int
+	}{
+		{Attribute: "ARN", Operator: "IN", Value: `["arn:aws:devicefarm:us-west-2::device:A","arn:aws:devicefarm:us-west-2::device:B"]`, ErrCount: 0},
+		{Attribute: "ARN", Operator: "IN", Value: `"arn:aws:devicefarm:us-west-2::device:A"`, ErrCount: 1},
+		{Attribute: "ARN", Operator: "CONTAINS", Value: `"arn:aws:devicefarm:us-west-2::device:A"`, ErrCount: 1},
+		{Attribute: "AVAILABILITY", Operator: "EQUALS", Value: `"HIGHLY_AVAILABLE"`, ErrCount: 0},
+		{Attribute: "AVAILABILITY", Operator: "EQUALS", Value: `"MAYBE"`, ErrCount: 1},
+		{Attribute: "FORM_FACTOR", Operator: "EQUALS", Value: `PHONE`, ErrCount: 1},
+		{Attribute: "OS_VERSION", Operator: "GREATER_THAN_OR_EQUALS", Value: `"10"`, ErrCount: 0},
+		{Attribute: "PLATFORM", Operator: "EQUALS", Value: `"ANDROID"`, ErrCount: 0},
+		{Attribute: "REMOTE_ACCESS_ENABLED", Operator: "EQUALS", Value: `true`, ErrCount: 0},
+		{Attribute: "REMOTE_ACCESS_ENABLED", Operator: "EQUALS", Value: `"true"`, ErrCount: 1},
+		{Attribute: "INSTANCE_LABELS", Operator: "CONTAINS", Value: `"label"`, ErrCount: 0},
+		{Attribute: "MODEL", Operator: "NOT_IN", Value: `["Pixel 4", 5]`, ErrCount: 1},
+	}
+
+	for _, tc := range testCases {
+		err := validDevicePoolRule(tc.Attribute, tc.Operator, tc.Value)
+
+		if tc.ErrCount == 0 && err != nil {
+			t.Errorf("expected %s %s %s to be valid, got: %s", tc.Attribute, tc.Operator, tc.Value, err)
+		}
+
+		if tc.ErrCount > 0 && err == nil {
+			t.Errorf("expected %s %s %s to be invalid", tc.Attribute, tc.Operator, tc.Value)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package devicefarm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitUploadSucceeded(conn *devicefarm.DeviceFarm, arn string, timeout time.Duration) (*devicefarm.Upload, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{devicefarm.UploadStatusInitialized, devicefarm.UploadStatusProcessing},
+		Target:  []string{devicefarm.UploadStatusSucceeded},
+		Refresh: statusUpload(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*devicefarm.Upload); ok {
+		if status := aws.StringValue(output.Status); status == devicefarm.UploadStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
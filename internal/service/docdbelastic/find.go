@@ -0,0 +1,36 @@
+package docdbelastic
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindClusterByARN(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string) (*docdbelastic.Cluster, error) {
+	input := &docdbelastic.GetClusterInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.GetClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, docdbelastic.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package docdbelastic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
+		Name: "aws_docdbelastic_cluster",
+		F:    sweepClusters,
+	})
+}
+
+func sweepClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).DocDBElasticConn
+	input := &docdbelastic.ListClustersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListClustersPages(input, func(page *docdbelastic.ListClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Clusters {
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ClusterArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping DocumentDB Elastic Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DocumentDB Elastic Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocumentDB Elastic Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
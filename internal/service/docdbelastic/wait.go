@@ -0,0 +1,55 @@
+package docdbelastic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitClusterActive(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			docdbelastic.StatusCreating,
+			docdbelastic.StatusMerging,
+			docdbelastic.StatusModifying,
+			docdbelastic.StatusSplitting,
+			docdbelastic.StatusUpdating,
+		},
+		Target:     []string{docdbelastic.StatusActive},
+		Refresh:    statusCluster(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("DocDB Elastic Cluster (%s) to become active", arn))
+
+	if output, ok := outputRaw.(*docdbelastic.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{docdbelastic.StatusActive, docdbelastic.StatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCluster(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("DocDB Elastic Cluster (%s) deletion", arn))
+
+	if output, ok := outputRaw.(*docdbelastic.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
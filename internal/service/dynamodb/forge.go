@@ -14,6 +14,7 @@ func stripCapacityAttributes(in map[string]interface{}) (map[string]interface{},
 
 	delete(m, "write_capacity")
 	delete(m, "read_capacity")
+	delete(m, "on_demand_throughput")
 
 	return m, nil
 }
@@ -28,6 +28,7 @@ import (
 )
 
 const (
+	onDemandThroughputUnlimited   = -1
 	provisionedThroughputMinValue = 1
 	ResNameTable                  = "Table"
 )
@@ -156,6 +157,7 @@ func ResourceTable() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"on_demand_throughput": onDemandThroughputSchema(),
 						"projection_type": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -182,6 +184,77 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1),
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -224,6 +297,7 @@ func ResourceTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"on_demand_throughput": onDemandThroughputSchema(),
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -253,6 +327,24 @@ func ResourceTable() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"global_secondary_index": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"read_capacity_auto_scaling": replicaAutoScalingSchema(),
+									"read_capacity_override": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
 						"kms_key_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -269,10 +361,21 @@ func ResourceTable() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"read_capacity_auto_scaling": replicaAutoScalingSchema(),
+						"read_capacity_override": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 						"region_name": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"table_class_override": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.TableClass_Values(), false),
+						},
 					},
 				},
 			},
@@ -375,6 +478,67 @@ func ResourceTable() *schema.Resource {
 	}
 }
 
+func onDemandThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_read_request_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_write_request_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func replicaAutoScalingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disable_scale_in": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"max_capacity": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"min_capacity": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"scale_in_cooldown": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"scale_out_cooldown": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"target_value": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatBetween(20, 90),
+				},
+			},
+		},
+	}
+}
+
 func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DynamoDBConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -421,6 +585,10 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OnDemandThroughputOverride = expandOnDemandThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("local_secondary_index"); ok {
 			lsiSet := v.(*schema.Set)
 			input.LocalSecondaryIndexOverride = expandLocalSecondaryIndexes(lsiSet.List(), keySchemaMap)
@@ -477,6 +645,71 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		if output == nil || output.TableDescription == nil {
 			return errors.New("error creating DynamoDB Table: empty response")
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if _, ok := d.GetOk("local_secondary_index"); ok {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Get("name").(string), errors.New("local_secondary_index cannot be configured when importing from S3"))
+		}
+
+		billingMode := d.Get("billing_mode").(string)
+
+		input := expandImportTable(v.([]interface{})[0].(map[string]interface{}))
+		input.TableCreationParameters = &dynamodb.TableCreationParameters{
+			TableName:   aws.String(d.Get("name").(string)),
+			BillingMode: aws.String(billingMode),
+			KeySchema:   expandKeySchema(keySchemaMap),
+		}
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		input.TableCreationParameters.ProvisionedThroughput = expandProvisionedThroughput(capacityMap, billingMode)
+
+		if v, ok := d.GetOk("on_demand_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.TableCreationParameters.OnDemandThroughput = expandOnDemandThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			input.TableCreationParameters.AttributeDefinitions = expandAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Get("name").(string), err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			input.TableCreationParameters.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.TableCreationParameters.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		output, err := conn.ImportTable(input)
+
+		if err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Get("name").(string), err)
+		}
+
+		if output == nil || output.ImportTableDescription == nil {
+			return errors.New("error creating DynamoDB Table: empty response")
+		}
+
+		importARN := aws.StringValue(output.ImportTableDescription.ImportArn)
+
+		if _, err := waitImportComplete(conn, importARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Get("name").(string), fmt.Errorf("importing from S3 (%s): %w", importARN, err))
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			TableName:   aws.String(d.Get("name").(string)),
@@ -497,6 +730,10 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 
 		input.ProvisionedThroughput = expandProvisionedThroughput(capacityMap, billingMode)
 
+		if v, ok := d.GetOk("on_demand_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OnDemandThroughput = expandOnDemandThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("attribute"); ok {
 			aSet := v.(*schema.Set)
 			input.AttributeDefinitions = expandAttributes(aSet.List())
@@ -579,6 +816,13 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Id(), err)
 	}
 
+	if _, ok := d.GetOk("import_table"); ok {
+		// Streams, table class and tags cannot be specified when importing from S3.
+		if err := updateImportedTable(conn, d, aws.StringValue(output.TableArn), tags); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("global_secondary_index"); ok {
 		gsiSet := v.(*schema.Set)
 
@@ -608,6 +852,10 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), fmt.Errorf("replicas: %w", err))
 		}
 
+		if err := updateReplicaAutoScaling(conn, d.Id(), nil, v.List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), fmt.Errorf("replica auto scaling: %w", err))
+		}
+
 		if err := updateReplicaTags(conn, aws.StringValue(output.TableArn), v.List(), tags, meta.(*conns.AWSClient).TerraformVersion); err != nil {
 			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), fmt.Errorf("replica tags: %w", err))
 		}
@@ -658,6 +906,10 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
 	}
 
+	if err := d.Set("on_demand_throughput", flattenOnDemandThroughput(table.OnDemandThroughput)); err != nil {
+		return create.SettingError(names.DynamoDB, ResNameTable, d.Id(), "on_demand_throughput", err)
+	}
+
 	if err := d.Set("attribute", flattenTableAttributeDefinitions(table.AttributeDefinitions)); err != nil {
 		return create.SettingError(names.DynamoDB, ResNameTable, d.Id(), "attribute", err)
 	}
@@ -702,6 +954,13 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	replicas = addReplicaTagPropagates(d.Get("replica").(*schema.Set), replicas)
+	replicas = removeReplicaDefaultTableClasses(d.Get("replica").(*schema.Set), replicas, table.TableClassSummary)
+
+	if replicasHaveAutoScaling(d.Get("replica").(*schema.Set).List()) {
+		if replicas, err = addReplicaAutoScaling(conn, d.Id(), replicas); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionReading, ResNameTable, d.Id(), err)
+		}
+	}
 
 	if err := d.Set("replica", replicas); err != nil {
 		return create.SettingError(names.DynamoDB, ResNameTable, d.Id(), "replica", err)
@@ -855,15 +1114,29 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Cannot create or delete index while updating table ProvisionedThroughput
 	// Must skip all index updates when switching BillingMode from PROVISIONED to PAY_PER_REQUEST
 	// Must update all indexes when switching BillingMode from PAY_PER_REQUEST to PROVISIONED
-	if billingMode == dynamodb.BillingModeProvisioned {
-		for _, gsiUpdate := range gsiUpdates {
-			if gsiUpdate.Update == nil {
-				continue
-			}
+	// Index on-demand throughput limits are only updated when BillingMode is PAY_PER_REQUEST
+	for _, gsiUpdate := range gsiUpdates {
+		if gsiUpdate.Update == nil {
+			continue
+		}
+
+		if billingMode == dynamodb.BillingModePayPerRequest {
+			gsiUpdate.Update.ProvisionedThroughput = nil
+		} else {
+			gsiUpdate.Update.OnDemandThroughput = nil
+		}
 
-			hasTableUpdate = true
-			input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
+		if gsiUpdate.Update.ProvisionedThroughput == nil && gsiUpdate.Update.OnDemandThroughput == nil {
+			continue
 		}
+
+		hasTableUpdate = true
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, gsiUpdate)
+	}
+
+	if d.HasChange("on_demand_throughput") && billingMode == dynamodb.BillingModePayPerRequest {
+		hasTableUpdate = true
+		input.OnDemandThroughput = expandOnDemandThroughputUpdate(d.Get("on_demand_throughput").([]interface{}))
 	}
 
 	if d.HasChange("table_class") {
@@ -1054,6 +1327,45 @@ func cycleStreamEnabled(conn *dynamodb.DynamoDB, id string, streamViewType strin
 	return nil
 }
 
+// updateImportedTable applies the settings that cannot be specified when importing a table from S3
+func updateImportedTable(conn *dynamodb.DynamoDB, d *schema.ResourceData, tableARN string, tags tftags.KeyValueTags) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(d.Id()),
+	}
+	hasTableUpdate := false
+
+	if d.Get("stream_enabled").(bool) {
+		hasTableUpdate = true
+		input.StreamSpecification = &dynamodb.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("table_class"); ok && v.(string) != dynamodb.TableClassStandard {
+		hasTableUpdate = true
+		input.TableClass = aws.String(v.(string))
+	}
+
+	if hasTableUpdate {
+		if _, err := conn.UpdateTable(input); err != nil {
+			return fmt.Errorf("updating imported table: %w", err)
+		}
+
+		if _, err := waitTableActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("waiting for imported table update: %w", err)
+		}
+	}
+
+	if len(tags) > 0 {
+		if err := UpdateTags(conn, tableARN, nil, tags); err != nil {
+			return fmt.Errorf("adding tags: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func createReplicas(conn *dynamodb.DynamoDB, tableName string, tfList []interface{}, tfVersion string, create bool, timeout time.Duration) error {
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -1072,6 +1384,20 @@ func createReplicas(conn *dynamodb.DynamoDB, tableName string, tfList []interfac
 			replicaInput.KMSMasterKeyId = aws.String(v)
 		}
 
+		if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+			replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.List())
+		}
+
+		if v, ok := tfMap["read_capacity_override"].(int); ok && v != 0 && !hasReplicaAutoScaling(tfMap) {
+			replicaInput.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(v)),
+			}
+		}
+
+		if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+			replicaInput.TableClassOverride = aws.String(v)
+		}
+
 		input := &dynamodb.UpdateTableInput{
 			TableName: aws.String(tableName),
 			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
@@ -1092,6 +1418,20 @@ func createReplicas(conn *dynamodb.DynamoDB, tableName string, tfList []interfac
 				replicaInput.KMSMasterKeyId = aws.String(v)
 			}
 
+			if v, ok := tfMap["global_secondary_index"].(*schema.Set); ok && v.Len() > 0 {
+				replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.List())
+			}
+
+			if v, ok := tfMap["read_capacity_override"].(int); ok && v != 0 && !hasReplicaAutoScaling(tfMap) {
+				replicaInput.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+					ReadCapacityUnits: aws.Int64(int64(v)),
+				}
+			}
+
+			if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+				replicaInput.TableClassOverride = aws.String(v)
+			}
+
 			input = &dynamodb.UpdateTableInput{
 				TableName: aws.String(tableName),
 				ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{
@@ -1282,6 +1622,10 @@ func updateReplica(d *schema.ResourceData, conn *dynamodb.DynamoDB, tfVersion st
 		if err := createReplicas(conn, d.Id(), added, tfVersion, true, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("updating replicas, while creating: %w", err)
 		}
+
+		if err := updateReplicaAutoScaling(conn, d.Id(), o.List(), added, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("updating replicas, while updating auto scaling: %w", err)
+		}
 	}
 
 	if len(removed) > 0 {
@@ -1293,6 +1637,143 @@ func updateReplica(d *schema.ResourceData, conn *dynamodb.DynamoDB, tfVersion st
 	return nil
 }
 
+// updateReplicaAutoScaling applies replica read capacity auto scaling settings that differ
+// from those of the replica in the same region in oldList. Settings removed from the
+// configuration disable auto scaling.
+func updateReplicaAutoScaling(conn *dynamodb.DynamoDB, tableName string, oldList, newList []interface{}, timeout time.Duration) error {
+	oldReplicas := make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range oldList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			oldReplicas[tfMap["region_name"].(string)] = tfMap
+		}
+	}
+
+	var updates []*dynamodb.ReplicaAutoScalingUpdate
+
+	for _, tfMapRaw := range newList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		region := tfMap["region_name"].(string)
+		oldMap := oldReplicas[region]
+		hasUpdate := false
+		update := &dynamodb.ReplicaAutoScalingUpdate{
+			RegionName: aws.String(region),
+		}
+
+		if v := expandAutoScalingSettingsUpdate(tfMap["read_capacity_auto_scaling"], oldMap["read_capacity_auto_scaling"]); v != nil {
+			hasUpdate = true
+			update.ReplicaProvisionedReadCapacityAutoScalingUpdate = v
+		}
+
+		newIndexes := replicaGlobalSecondaryIndexesByName(tfMap["global_secondary_index"])
+		oldIndexes := replicaGlobalSecondaryIndexesByName(oldMap["global_secondary_index"])
+
+		// Indexes removed from the replica have their auto scaling disabled.
+		for name := range oldIndexes {
+			if _, ok := newIndexes[name]; !ok {
+				newIndexes[name] = map[string]interface{}{"name": name}
+			}
+		}
+
+		for name, newIndex := range newIndexes {
+			if v := expandAutoScalingSettingsUpdate(newIndex["read_capacity_auto_scaling"], oldIndexes[name]["read_capacity_auto_scaling"]); v != nil {
+				hasUpdate = true
+				update.ReplicaGlobalSecondaryIndexUpdates = append(update.ReplicaGlobalSecondaryIndexUpdates, &dynamodb.ReplicaGlobalSecondaryIndexAutoScalingUpdate{
+					IndexName:                                aws.String(name),
+					ProvisionedReadCapacityAutoScalingUpdate: v,
+				})
+			}
+		}
+
+		if hasUpdate {
+			updates = append(updates, update)
+		}
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	input := &dynamodb.UpdateTableReplicaAutoScalingInput{
+		ReplicaUpdates: updates,
+		TableName:      aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Updating DynamoDB Table (%s) replica auto scaling: %s", tableName, input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(maxDuration(replicaUpdateTimeout, timeout), func() (interface{}, error) {
+		return conn.UpdateTableReplicaAutoScaling(input)
+	}, dynamodb.ErrCodeResourceInUseException, "ThrottlingException")
+
+	if err != nil {
+		return err
+	}
+
+	for _, update := range updates {
+		region := aws.StringValue(update.RegionName)
+
+		if err := waitReplicaActive(conn, tableName, region, timeout); err != nil {
+			return fmt.Errorf("waiting for replica (%s) auto scaling update: %w", region, err)
+		}
+	}
+
+	return nil
+}
+
+func replicaGlobalSecondaryIndexesByName(v interface{}) map[string]map[string]interface{} {
+	indexes := make(map[string]map[string]interface{})
+
+	var tfList []interface{}
+
+	switch v := v.(type) {
+	case *schema.Set:
+		tfList = v.List()
+	case []interface{}:
+		tfList = v
+	}
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			indexes[tfMap["name"].(string)] = tfMap
+		}
+	}
+
+	return indexes
+}
+
+func replicasHaveAutoScaling(tfList []interface{}) bool {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if hasReplicaAutoScaling(tfMap) {
+			return true
+		}
+
+		for _, index := range replicaGlobalSecondaryIndexesByName(tfMap["global_secondary_index"]) {
+			if hasReplicaAutoScaling(index) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasReplicaAutoScaling returns whether a replica or replica index has read capacity auto scaling configured.
+func hasReplicaAutoScaling(tfMap map[string]interface{}) bool {
+	v, ok := tfMap["read_capacity_auto_scaling"].([]interface{})
+
+	return ok && len(v) > 0
+}
+
 func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) (ops []*dynamodb.GlobalSecondaryIndexUpdate, e error) {
 	// Transform slices into maps
 	oldGsis := make(map[string]interface{})
@@ -1322,6 +1803,7 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) (ops []*dyn
 				Create: &dynamodb.CreateGlobalSecondaryIndexAction{
 					IndexName:             aws.String(idxName),
 					KeySchema:             expandKeySchema(m),
+					OnDemandThroughput:    expandGSIOnDemandThroughput(m),
 					ProvisionedThroughput: expandProvisionedThroughput(m, billingMode),
 					Projection:            expandProjection(m),
 				},
@@ -1341,6 +1823,7 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) (ops []*dyn
 			oldWriteCapacity, oldReadCapacity := oldMap["write_capacity"].(int), oldMap["read_capacity"].(int)
 			newWriteCapacity, newReadCapacity := newMap["write_capacity"].(int), newMap["read_capacity"].(int)
 			capacityChanged := (oldWriteCapacity != newWriteCapacity || oldReadCapacity != newReadCapacity)
+			onDemandThroughputChanged := !reflect.DeepEqual(expandGSIOnDemandThroughput(oldMap), expandGSIOnDemandThroughput(newMap))
 
 			// pluck non_key_attributes from oldAttributes and newAttributes as reflect.DeepEquals will compare
 			// ordinal of elements in its equality (which we actually don't care about)
@@ -1364,13 +1847,19 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) (ops []*dyn
 			}
 			otherAttributesChanged := nonKeyAttributesChanged || !reflect.DeepEqual(oldAttributes, newAttributes)
 
-			if capacityChanged && !otherAttributesChanged {
+			if (capacityChanged || onDemandThroughputChanged) && !otherAttributesChanged {
 				update := &dynamodb.GlobalSecondaryIndexUpdate{
 					Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
-						IndexName:             aws.String(idxName),
-						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
+						IndexName: aws.String(idxName),
 					},
 				}
+				if capacityChanged {
+					update.Update.ProvisionedThroughput = expandProvisionedThroughput(newMap, billingMode)
+				}
+				if onDemandThroughputChanged {
+					v, _ := newMap["on_demand_throughput"].([]interface{})
+					update.Update.OnDemandThroughput = expandOnDemandThroughputUpdate(v)
+				}
 				ops = append(ops, update)
 			} else if otherAttributesChanged {
 				// Other attributes cannot be updated
@@ -1384,6 +1873,7 @@ func UpdateDiffGSI(oldGsi, newGsi []interface{}, billingMode string) (ops []*dyn
 					Create: &dynamodb.CreateGlobalSecondaryIndexAction{
 						IndexName:             aws.String(idxName),
 						KeySchema:             expandKeySchema(newMap),
+						OnDemandThroughput:    expandGSIOnDemandThroughput(newMap),
 						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
 						Projection:            expandProjection(newMap),
 					},
@@ -1591,6 +2081,107 @@ func addReplicaTagPropagates(configReplicas *schema.Set, replicas []interface{})
 	return replicas
 }
 
+// removeReplicaDefaultTableClasses drops a replica's table class when it matches the table's
+// and no override is configured for the replica, as every replica reports its table class.
+func removeReplicaDefaultTableClasses(configReplicas *schema.Set, replicas []interface{}, summary *dynamodb.TableClassSummary) []interface{} {
+	tableClass := dynamodb.TableClassStandard
+
+	if summary != nil && summary.TableClass != nil {
+		tableClass = aws.StringValue(summary.TableClass)
+	}
+
+	configured := make(map[string]bool)
+
+	for _, tfMapRaw := range configReplicas.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+			configured[tfMap["region_name"].(string)] = true
+		}
+	}
+
+	for i, replicaRaw := range replicas {
+		replica := replicaRaw.(map[string]interface{})
+
+		if v, ok := replica["table_class_override"].(string); ok && v == tableClass && !configured[replica["region_name"].(string)] {
+			delete(replica, "table_class_override")
+		}
+
+		replicas[i] = replica
+	}
+
+	return replicas
+}
+
+// addReplicaAutoScaling adds replica and replica GSI read capacity auto scaling settings.
+// Read capacity overrides managed by auto scaling are not reported.
+func addReplicaAutoScaling(conn *dynamodb.DynamoDB, tableName string, replicas []interface{}) ([]interface{}, error) {
+	output, err := conn.DescribeTableReplicaAutoScaling(&dynamodb.DescribeTableReplicaAutoScalingInput{
+		TableName: aws.String(tableName),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("describing replica auto scaling: %w", err)
+	}
+
+	if output == nil || output.TableAutoScalingDescription == nil {
+		return replicas, nil
+	}
+
+	descriptions := make(map[string]*dynamodb.ReplicaAutoScalingDescription)
+
+	for _, apiObject := range output.TableAutoScalingDescription.Replicas {
+		if apiObject != nil {
+			descriptions[aws.StringValue(apiObject.RegionName)] = apiObject
+		}
+	}
+
+	for i, replicaRaw := range replicas {
+		replica := replicaRaw.(map[string]interface{})
+		apiObject, ok := descriptions[replica["region_name"].(string)]
+
+		if !ok {
+			continue
+		}
+
+		if v := flattenAutoScalingSettingsDescription(apiObject.ReplicaProvisionedReadCapacityAutoScalingSettings); len(v) > 0 {
+			replica["read_capacity_auto_scaling"] = v
+			delete(replica, "read_capacity_override")
+		}
+
+		indexes := replicaGlobalSecondaryIndexesByName(replica["global_secondary_index"])
+
+		for _, index := range apiObject.GlobalSecondaryIndexes {
+			if index == nil {
+				continue
+			}
+
+			v := flattenAutoScalingSettingsDescription(index.ProvisionedReadCapacityAutoScalingSettings)
+
+			if len(v) == 0 {
+				continue
+			}
+
+			name := aws.StringValue(index.IndexName)
+			indexes[name] = map[string]interface{}{
+				"name":                       name,
+				"read_capacity_auto_scaling": v,
+			}
+		}
+
+		var tfList []interface{}
+
+		for _, index := range indexes {
+			tfList = append(tfList, index)
+		}
+
+		replica["global_secondary_index"] = tfList
+		replicas[i] = replica
+	}
+
+	return replicas, nil
+}
+
 // flatteners, expanders
 
 func flattenTableAttributeDefinitions(definitions []*dynamodb.AttributeDefinition) []interface{} {
@@ -1691,6 +2282,8 @@ func flattenTableGlobalSecondaryIndex(gsi []*dynamodb.GlobalSecondaryIndexDescri
 			gsi["non_key_attributes"] = aws.StringValueSlice(g.Projection.NonKeyAttributes)
 		}
 
+		gsi["on_demand_throughput"] = flattenOnDemandThroughput(g.OnDemandThroughput)
+
 		output = append(output, gsi)
 	}
 
@@ -1737,9 +2330,134 @@ func flattenReplicaDescription(apiObject *dynamodb.ReplicaDescription) map[strin
 		tfMap["region_name"] = aws.StringValue(apiObject.RegionName)
 	}
 
+	if v := apiObject.ProvisionedThroughputOverride; v != nil && v.ReadCapacityUnits != nil {
+		tfMap["read_capacity_override"] = aws.Int64Value(v.ReadCapacityUnits)
+	}
+
+	if v := apiObject.ReplicaTableClassSummary; v != nil && v.TableClass != nil {
+		tfMap["table_class_override"] = aws.StringValue(v.TableClass)
+	}
+
+	var indexes []interface{}
+
+	for _, index := range apiObject.GlobalSecondaryIndexes {
+		if index == nil || index.ProvisionedThroughputOverride == nil || index.ProvisionedThroughputOverride.ReadCapacityUnits == nil {
+			continue
+		}
+
+		indexes = append(indexes, map[string]interface{}{
+			"name":                   aws.StringValue(index.IndexName),
+			"read_capacity_override": aws.Int64Value(index.ProvisionedThroughputOverride.ReadCapacityUnits),
+		})
+	}
+
+	tfMap["global_secondary_index"] = indexes
+
 	return tfMap
 }
 
+func expandReplicaGlobalSecondaryIndexes(tfList []interface{}) []*dynamodb.ReplicaGlobalSecondaryIndex {
+	var apiObjects []*dynamodb.ReplicaGlobalSecondaryIndex
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &dynamodb.ReplicaGlobalSecondaryIndex{
+			IndexName: aws.String(tfMap["name"].(string)),
+		}
+
+		// Capacity of indexes with auto scaling is managed by UpdateTableReplicaAutoScaling.
+		if hasReplicaAutoScaling(tfMap) {
+			continue
+		}
+
+		if v, ok := tfMap["read_capacity_override"].(int); ok && v != 0 {
+			apiObject.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(v)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandAutoScalingSettingsUpdate returns the auto scaling settings to send when newRaw differs from oldRaw.
+// Settings present only in oldRaw disable auto scaling.
+func expandAutoScalingSettingsUpdate(newRaw, oldRaw interface{}) *dynamodb.AutoScalingSettingsUpdate {
+	newList, _ := newRaw.([]interface{})
+	oldList, _ := oldRaw.([]interface{})
+
+	if reflect.DeepEqual(newList, oldList) {
+		return nil
+	}
+
+	if len(newList) == 0 || newList[0] == nil {
+		if len(oldList) == 0 {
+			return nil
+		}
+
+		return &dynamodb.AutoScalingSettingsUpdate{
+			AutoScalingDisabled: aws.Bool(true),
+		}
+	}
+
+	tfMap := newList[0].(map[string]interface{})
+	config := &dynamodb.AutoScalingTargetTrackingScalingPolicyConfigurationUpdate{
+		DisableScaleIn: aws.Bool(tfMap["disable_scale_in"].(bool)),
+		TargetValue:    aws.Float64(tfMap["target_value"].(float64)),
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok && v != 0 {
+		config.ScaleInCooldown = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok && v != 0 {
+		config.ScaleOutCooldown = aws.Int64(int64(v))
+	}
+
+	return &dynamodb.AutoScalingSettingsUpdate{
+		AutoScalingDisabled: aws.Bool(false),
+		MaximumUnits:        aws.Int64(int64(tfMap["max_capacity"].(int))),
+		MinimumUnits:        aws.Int64(int64(tfMap["min_capacity"].(int))),
+		ScalingPolicyUpdate: &dynamodb.AutoScalingPolicyUpdate{
+			TargetTrackingScalingPolicyConfiguration: config,
+		},
+	}
+}
+
+func flattenAutoScalingSettingsDescription(apiObject *dynamodb.AutoScalingSettingsDescription) []interface{} {
+	if apiObject == nil || aws.BoolValue(apiObject.AutoScalingDisabled) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"max_capacity": aws.Int64Value(apiObject.MaximumUnits),
+		"min_capacity": aws.Int64Value(apiObject.MinimumUnits),
+	}
+
+	for _, policy := range apiObject.ScalingPolicies {
+		if policy == nil || policy.TargetTrackingScalingPolicyConfiguration == nil {
+			continue
+		}
+
+		config := policy.TargetTrackingScalingPolicyConfiguration
+		tfMap["disable_scale_in"] = aws.BoolValue(config.DisableScaleIn)
+		tfMap["scale_in_cooldown"] = aws.Int64Value(config.ScaleInCooldown)
+		tfMap["scale_out_cooldown"] = aws.Int64Value(config.ScaleOutCooldown)
+		tfMap["target_value"] = aws.Float64Value(config.TargetValue)
+
+		break
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenReplicaDescriptions(apiObjects []*dynamodb.ReplicaDescription) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -1821,11 +2539,80 @@ func expandGlobalSecondaryIndex(data map[string]interface{}, billingMode string)
 	return &dynamodb.GlobalSecondaryIndex{
 		IndexName:             aws.String(data["name"].(string)),
 		KeySchema:             expandKeySchema(data),
+		OnDemandThroughput:    expandGSIOnDemandThroughput(data),
 		Projection:            expandProjection(data),
 		ProvisionedThroughput: expandProvisionedThroughput(data, billingMode),
 	}
 }
 
+func expandGSIOnDemandThroughput(data map[string]interface{}) *dynamodb.OnDemandThroughput {
+	if v, ok := data["on_demand_throughput"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return expandOnDemandThroughput(v[0].(map[string]interface{}))
+	}
+
+	return nil
+}
+
+func expandOnDemandThroughput(tfMap map[string]interface{}) *dynamodb.OnDemandThroughput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dynamodb.OnDemandThroughput{}
+
+	if v, ok := tfMap["max_read_request_units"].(int); ok && v != 0 {
+		apiObject.MaxReadRequestUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_write_request_units"].(int); ok && v != 0 {
+		apiObject.MaxWriteRequestUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+// expandOnDemandThroughputUpdate returns the on-demand throughput to send in an update.
+// Limits that are no longer configured are removed by setting them to -1.
+func expandOnDemandThroughputUpdate(tfList []interface{}) *dynamodb.OnDemandThroughput {
+	apiObject := &dynamodb.OnDemandThroughput{}
+
+	if len(tfList) > 0 && tfList[0] != nil {
+		apiObject = expandOnDemandThroughput(tfList[0].(map[string]interface{}))
+	}
+
+	if apiObject.MaxReadRequestUnits == nil {
+		apiObject.MaxReadRequestUnits = aws.Int64(onDemandThroughputUnlimited)
+	}
+
+	if apiObject.MaxWriteRequestUnits == nil {
+		apiObject.MaxWriteRequestUnits = aws.Int64(onDemandThroughputUnlimited)
+	}
+
+	return apiObject
+}
+
+func flattenOnDemandThroughput(apiObject *dynamodb.OnDemandThroughput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := aws.Int64Value(apiObject.MaxReadRequestUnits); v > 0 {
+		tfMap["max_read_request_units"] = v
+	}
+
+	if v := aws.Int64Value(apiObject.MaxWriteRequestUnits); v > 0 {
+		tfMap["max_write_request_units"] = v
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandProvisionedThroughput(data map[string]interface{}, billingMode string) *dynamodb.ProvisionedThroughput {
 	return expandProvisionedThroughputUpdate("", data, billingMode, "")
 }
@@ -1907,6 +2694,63 @@ func expandEncryptAtRestOptions(vOptions []interface{}) *dynamodb.SSESpecificati
 	return options
 }
 
+func expandImportTable(tfMap map[string]interface{}) *dynamodb.ImportTableInput {
+	input := &dynamodb.ImportTableInput{
+		InputFormat: aws.String(tfMap["input_format"].(string)),
+	}
+
+	if v, ok := tfMap["input_compression_type"].(string); ok && v != "" {
+		input.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		input.InputFormatOptions = expandInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		input.S3BucketSource = expandS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return input
+}
+
+func expandInputFormatOptions(tfMap map[string]interface{}) *dynamodb.InputFormatOptions {
+	options := &dynamodb.InputFormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfCSV := v[0].(map[string]interface{})
+		csv := &dynamodb.CsvOptions{}
+
+		if v, ok := tfCSV["delimiter"].(string); ok && v != "" {
+			csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := tfCSV["header_list"].([]interface{}); ok && len(v) > 0 {
+			csv.HeaderList = flex.ExpandStringList(v)
+		}
+
+		options.Csv = csv
+	}
+
+	return options
+}
+
+func expandS3BucketSource(tfMap map[string]interface{}) *dynamodb.S3BucketSource {
+	source := &dynamodb.S3BucketSource{
+		S3Bucket: aws.String(tfMap["bucket"].(string)),
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		source.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		source.S3KeyPrefix = aws.String(v)
+	}
+
+	return source
+}
+
 // validators
 
 func validateTableAttributes(d *schema.ResourceDiff) error {
@@ -82,6 +82,7 @@ func DataSourceTable() *schema.Resource {
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"on_demand_throughput": onDemandThroughputSchemaComputed(),
 					},
 				},
 			},
@@ -170,14 +171,38 @@ func DataSourceTable() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"global_secondary_index": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"read_capacity_override": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"kms_key_arn": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"read_capacity_override": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"region_name": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"table_class_override": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -203,6 +228,7 @@ func DataSourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"on_demand_throughput": onDemandThroughputSchemaComputed(),
 			"point_in_time_recovery": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -263,6 +289,10 @@ func dataSourceTableRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting attribute: %w", err)
 	}
 
+	if err := d.Set("on_demand_throughput", flattenOnDemandThroughput(table.OnDemandThroughput)); err != nil {
+		return fmt.Errorf("error setting on_demand_throughput: %w", err)
+	}
+
 	for _, attribute := range table.KeySchema {
 		if aws.StringValue(attribute.KeyType) == dynamodb.KeyTypeHash {
 			d.Set("hash_key", attribute.AttributeName)
@@ -342,3 +372,22 @@ func dataSourceTableRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func onDemandThroughputSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_read_request_units": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_write_request_units": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
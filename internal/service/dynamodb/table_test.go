@@ -81,6 +81,49 @@ func TestUpdateDiffGSI(t *testing.T) {
 			ExpectedUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{},
 		},
 
+		{ // Update on-demand throughput only
+			Old: []interface{}{
+				map[string]interface{}{
+					"name":            "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"on_demand_throughput": []interface{}{
+						map[string]interface{}{
+							"max_read_request_units":  10,
+							"max_write_request_units": 10,
+						},
+					},
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					"name":            "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"on_demand_throughput": []interface{}{
+						map[string]interface{}{
+							"max_read_request_units":  5,
+							"max_write_request_units": 0,
+						},
+					},
+				},
+			},
+			ExpectedUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{
+				{
+					Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						OnDemandThroughput: &dynamodb.OnDemandThroughput{
+							MaxReadRequestUnits:  aws.Int64(5),
+							MaxWriteRequestUnits: aws.Int64(-1),
+						},
+					},
+				},
+			},
+		},
 		{ // Creation
 			Old: []interface{}{
 				map[string]interface{}{
@@ -757,6 +800,61 @@ func TestAccDynamoDBTable_BillingModeGSI_provisionedToPayPerRequest(t *testing.T
 	})
 }
 
+func TestAccDynamoDBTable_onDemandThroughput(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_onDemandThroughput(rName, 10, 20, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_read_request_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_write_request_units", "20"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   "TestTableGSI",
+						"on_demand_throughput.#": "1",
+						"on_demand_throughput.0.max_read_request_units": "5",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_onDemandThroughput(rName, 15, 25, 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_read_request_units", "15"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.0.max_write_request_units", "25"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   "TestTableGSI",
+						"on_demand_throughput.#": "1",
+						"on_demand_throughput.0.max_read_request_units": "8",
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_billingPayPerRequestGSI(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_throughput.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_streamSpecification(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 	resourceName := "aws_dynamodb_table.test"
@@ -1592,6 +1690,115 @@ func TestAccDynamoDBTable_Replica_single(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_Replica_overrides(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(t, 3), // 3 due to shared test configuration
+		CheckDestroy:             testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_replicaOverrides(rName, 2, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"global_secondary_index.#": "1",
+						"read_capacity_override":   "2",
+						"table_class_override":     dynamodb.TableClassStandardInfrequentAccess,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*.global_secondary_index.*", map[string]string{
+						"name":                   "TestTableGSI",
+						"read_capacity_override": "3",
+					}),
+				),
+			},
+			{
+				Config:            testAccTableConfig_replicaOverrides(rName, 2, 3),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_replicaOverrides(rName, 4, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"read_capacity_override": "4",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*.global_secondary_index.*", map[string]string{
+						"name":                   "TestTableGSI",
+						"read_capacity_override": "5",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_Replica_autoScaling(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(t, 3), // 3 due to shared test configuration
+		CheckDestroy:             testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_replicaAutoScaling(rName, 5, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*.read_capacity_auto_scaling.*", map[string]string{
+						"max_capacity": "5",
+						"min_capacity": "1",
+						"target_value": "50",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*.global_secondary_index.*", map[string]string{
+						"name": "TestTableGSI",
+						"read_capacity_auto_scaling.0.max_capacity":      "5",
+						"read_capacity_auto_scaling.0.target_value":      "50",
+						"read_capacity_auto_scaling.0.min_capacity":      "1",
+						"read_capacity_auto_scaling.0.scale_in_cooldown": "60",
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_replicaAutoScaling(rName, 10, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*.read_capacity_auto_scaling.*", map[string]string{
+						"max_capacity": "10",
+						"target_value": "70",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_Replica_singleWithCMK(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1989,6 +2196,53 @@ func TestAccDynamoDBTable_tableClassInfrequentAccess(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_importTable(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var table1, table2 dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_importTable(rName, "data/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &table1),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "stream_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "stream_view_type", "KEYS_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_table"},
+			},
+			{
+				// The import source is only used when the table is created, so changing it replaces the table.
+				Config: testAccTableConfig_importTable(rName, "data/items"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &table2),
+					testAccCheckTableRecreated(&table1, &table2),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.s3_bucket_source.0.key_prefix", "data/items"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_backupEncryption(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2126,6 +2380,16 @@ func testAccCheckInitialTableExists(n string, table *dynamodb.DescribeTableOutpu
 	}
 }
 
+func testAccCheckTableRecreated(i, j *dynamodb.DescribeTableOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.TimeValue(i.Table.CreationDateTime).Equal(aws.TimeValue(j.Table.CreationDateTime)) {
+			return fmt.Errorf("DynamoDB table was not recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckReplicaHasTags(n string, region string, count int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2263,6 +2527,41 @@ resource "aws_dynamodb_table" "test" {
 `, rName)
 }
 
+func testAccTableConfig_onDemandThroughput(rName string, maxRead, maxWrite, gsiMaxRead int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  on_demand_throughput {
+    max_read_request_units  = %[2]d
+    max_write_request_units = %[3]d
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+
+    on_demand_throughput {
+      max_read_request_units = %[4]d
+    }
+  }
+}
+`, rName, maxRead, maxWrite, gsiMaxRead)
+}
+
 func testAccTableConfig_billingPayPerRequestIgnoreChanges(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -3081,6 +3380,118 @@ resource "aws_dynamodb_table" "test" {
 `, rName))
 }
 
+func testAccTableConfig_replicaOverrides(rName string, readOverride, gsiReadOverride int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PROVISIONED"
+  read_capacity    = 1
+  write_capacity   = 1
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+    read_capacity   = 1
+    write_capacity  = 1
+  }
+
+  replica {
+    region_name            = data.aws_region.alternate.name
+    read_capacity_override = %[2]d
+    table_class_override   = "STANDARD_INFREQUENT_ACCESS"
+
+    global_secondary_index {
+      name                   = "TestTableGSI"
+      read_capacity_override = %[3]d
+    }
+  }
+}
+`, rName, readOverride, gsiReadOverride))
+}
+
+func testAccTableConfig_replicaAutoScaling(rName string, maxCapacity, targetValue int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PROVISIONED"
+  read_capacity    = 1
+  write_capacity   = 1
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TestTableGSI"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+    read_capacity   = 1
+    write_capacity  = 1
+  }
+
+  replica {
+    region_name = data.aws_region.alternate.name
+
+    read_capacity_auto_scaling {
+      min_capacity = 1
+      max_capacity = %[2]d
+      target_value = %[3]d
+    }
+
+    global_secondary_index {
+      name = "TestTableGSI"
+
+      read_capacity_auto_scaling {
+        min_capacity      = 1
+        max_capacity      = %[2]d
+        target_value      = %[3]d
+        scale_in_cooldown = 60
+      }
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [read_capacity, global_secondary_index]
+  }
+}
+`, rName, maxCapacity, targetValue))
+}
+
 func testAccTableConfig_replicaCMK(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
@@ -3601,3 +4012,52 @@ resource "aws_dynamodb_table" "test" {
 }
 `, rName)
 }
+
+func testAccTableConfig_importTable(rName, keyPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "data/items.csv"
+  content = "id,value\nitem1,one\nitem2,two\n"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  billing_mode     = "PAY_PER_REQUEST"
+  hash_key         = "id"
+  stream_enabled   = true
+  stream_view_type = "KEYS_ONLY"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  import_table {
+    input_format = "CSV"
+
+    input_format_options {
+      csv {
+        delimiter = ","
+      }
+    }
+
+    s3_bucket_source {
+      bucket     = aws_s3_bucket.test.id
+      key_prefix = %[2]q
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, keyPrefix)
+}
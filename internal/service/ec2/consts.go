@@ -85,6 +85,23 @@ const (
 	CustomerGatewayStatePending   = "pending"
 )
 
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_LocalGatewayRouteTable.html#API_LocalGatewayRouteTable_Contents.
+const (
+	localGatewayRouteTableStateAvailable = "available"
+	localGatewayRouteTableStateDeleted   = "deleted"
+	localGatewayRouteTableStateDeleting  = "deleting"
+	localGatewayRouteTableStatePending   = "pending"
+)
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_LocalGatewayRouteTableVirtualInterfaceGroupAssociation.html#API_LocalGatewayRouteTableVirtualInterfaceGroupAssociation_Contents.
+const (
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStateAssociated     = "associated"
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStateAssociating    = "associating"
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociated  = "disassociated"
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociating = "disassociating"
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStatePending        = "pending"
+)
+
 const (
 	managedPrefixListAddressFamilyIPv4 = "IPv4"
 	managedPrefixListAddressFamilyIPv6 = "IPv6"
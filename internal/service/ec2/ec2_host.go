@@ -35,6 +35,12 @@ func ResourceHost() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"asset_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"auto_placement": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -46,6 +52,12 @@ func ResourceHost() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"host_maintenance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.HostMaintenance_Values(), false),
+			},
 			"host_recovery": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -62,6 +74,10 @@ func ResourceHost() *schema.Resource {
 				Optional:     true,
 				ExactlyOneOf: []string{"instance_family", "instance_type"},
 			},
+			"member_of_service_linked_resource_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"outpost_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -90,6 +106,14 @@ func resourceHostCreate(d *schema.ResourceData, meta interface{}) error {
 		Quantity:         aws.Int64(1),
 	}
 
+	if v, ok := d.GetOk("asset_id"); ok {
+		input.AssetIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if v, ok := d.GetOk("host_maintenance"); ok {
+		input.HostMaintenance = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("instance_family"); ok {
 		input.InstanceFamily = aws.String(v.(string))
 	}
@@ -146,11 +170,14 @@ func resourceHostRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
 	}.String()
 	d.Set("arn", arn)
+	d.Set("asset_id", host.AssetId)
 	d.Set("auto_placement", host.AutoPlacement)
 	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
+	d.Set("member_of_service_linked_resource_group", host.MemberOfServiceLinkedResourceGroup)
 	d.Set("outpost_arn", host.OutpostArn)
 	d.Set("owner_id", host.OwnerId)
 
@@ -180,6 +207,10 @@ func resourceHostUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.AutoPlacement = aws.String(d.Get("auto_placement").(string))
 		}
 
+		if d.HasChange("host_maintenance") {
+			input.HostMaintenance = aws.String(d.Get("host_maintenance").(string))
+		}
+
 		if d.HasChange("host_recovery") {
 			input.HostRecovery = aws.String(d.Get("host_recovery").(string))
 		}
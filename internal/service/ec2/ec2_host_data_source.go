@@ -44,6 +44,10 @@ func DataSourceHost() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"host_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"host_recovery": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -56,6 +60,10 @@ func DataSourceHost() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"member_of_service_linked_resource_group": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"outpost_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -101,9 +109,11 @@ func dataSourceHostRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("availability_zone", host.AvailabilityZone)
 	d.Set("cores", host.HostProperties.Cores)
 	d.Set("host_id", host.HostId)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
+	d.Set("member_of_service_linked_resource_group", host.MemberOfServiceLinkedResourceGroup)
 	d.Set("outpost_arn", host.OutpostArn)
 	d.Set("owner_id", host.OwnerId)
 	d.Set("sockets", host.HostProperties.Sockets)
@@ -48,6 +48,7 @@ const (
 	errCodeInvalidLaunchTemplateIdNotFound                = "InvalidLaunchTemplateId.NotFound"
 	errCodeInvalidLaunchTemplateIdVersionNotFound         = "InvalidLaunchTemplateId.VersionNotFound"
 	errCodeInvalidLaunchTemplateNameNotFoundException     = "InvalidLaunchTemplateName.NotFoundException"
+	errCodeInvalidLocalGatewayRouteTableIDNotFound        = "InvalidLocalGatewayRouteTableID.NotFound"
 	errCodeInvalidNetworkACLEntryNotFound                 = "InvalidNetworkAclEntry.NotFound"
 	errCodeInvalidNetworkACLIDNotFound                    = "InvalidNetworkAclID.NotFound"
 	errCodeInvalidNetworkInterfaceIDNotFound              = "InvalidNetworkInterfaceID.NotFound"
@@ -101,6 +102,10 @@ const (
 	errCodeVolumeInUse                                    = "VolumeInUse"
 )
 
+const (
+	errCodeInvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationIDNotFound = "InvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationID.NotFound"
+)
+
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
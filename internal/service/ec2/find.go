@@ -1193,6 +1193,111 @@ func FindLocalGatewayRouteTable(conn *ec2.EC2, input *ec2.DescribeLocalGatewayRo
 	return output[0], nil
 }
 
+func FindLocalGatewayRouteTableByID(conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTable, error) {
+	input := &ec2.DescribeLocalGatewayRouteTablesInput{
+		LocalGatewayRouteTableIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindLocalGatewayRouteTable(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == localGatewayRouteTableStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.LocalGatewayRouteTableId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindLocalGatewayRouteTableVirtualInterfaceGroupAssociations(conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput) ([]*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	var output []*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation
+
+	err := conn.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsPages(input, func(page *ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocalGatewayRouteTableVirtualInterfaceGroupAssociations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindLocalGatewayRouteTableVirtualInterfaceGroupAssociation(conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	output, err := FindLocalGatewayRouteTableVirtualInterfaceGroupAssociations(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	input := &ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput{
+		LocalGatewayRouteTableVirtualInterfaceGroupAssociationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindLocalGatewayRouteTableVirtualInterfaceGroupAssociation(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.LocalGatewayRouteTableVirtualInterfaceGroupAssociationId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindLocalGatewayVirtualInterfaceGroups(conn *ec2.EC2, input *ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput) ([]*ec2.LocalGatewayVirtualInterfaceGroup, error) {
 	var output []*ec2.LocalGatewayVirtualInterfaceGroup
 
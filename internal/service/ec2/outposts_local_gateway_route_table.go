@@ -0,0 +1,169 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLocalGatewayRouteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLocalGatewayRouteTableCreate,
+		Read:   resourceLocalGatewayRouteTableRead,
+		Update: resourceLocalGatewayRouteTableUpdate,
+		Delete: resourceLocalGatewayRouteTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.LocalGatewayRouteTableMode_Values(), false),
+			},
+			"outpost_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceLocalGatewayRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateLocalGatewayRouteTableInput{
+		LocalGatewayId:    aws.String(d.Get("local_gateway_id").(string)),
+		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeLocalGatewayRouteTable),
+	}
+
+	if v, ok := d.GetOk("mode"); ok {
+		input.Mode = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Local Gateway Route Table: %s", input)
+	output, err := conn.CreateLocalGatewayRouteTable(input)
+
+	if err != nil {
+		return fmt.Errorf("creating EC2 Local Gateway Route Table: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocalGatewayRouteTable.LocalGatewayRouteTableId))
+
+	if _, err := WaitLocalGatewayRouteTableCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("waiting for EC2 Local Gateway Route Table (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceLocalGatewayRouteTableRead(d, meta)
+}
+
+func resourceLocalGatewayRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	localGatewayRouteTable, err := FindLocalGatewayRouteTableByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Local Gateway Route Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Local Gateway Route Table (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", localGatewayRouteTable.LocalGatewayRouteTableArn)
+	d.Set("local_gateway_id", localGatewayRouteTable.LocalGatewayId)
+	d.Set("mode", localGatewayRouteTable.Mode)
+	d.Set("outpost_arn", localGatewayRouteTable.OutpostArn)
+	d.Set("owner_id", localGatewayRouteTable.OwnerId)
+	d.Set("state", localGatewayRouteTable.State)
+
+	tags := KeyValueTags(localGatewayRouteTable.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLocalGatewayRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating EC2 Local Gateway Route Table (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceLocalGatewayRouteTableRead(d, meta)
+}
+
+func resourceLocalGatewayRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Local Gateway Route Table: %s", d.Id())
+	_, err := conn.DeleteLocalGatewayRouteTable(&ec2.DeleteLocalGatewayRouteTableInput{
+		LocalGatewayRouteTableId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Local Gateway Route Table (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitLocalGatewayRouteTableDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("waiting for EC2 Local Gateway Route Table (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
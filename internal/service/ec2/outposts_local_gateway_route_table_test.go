@@ -0,0 +1,178 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_basic(t *testing.T) {
+	var v ec2.LocalGatewayRouteTable
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_mode("direct-vpc-routing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_id", "data.aws_ec2_local_gateways.test", "ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "direct-vpc-routing"),
+					resource.TestCheckResourceAttrSet(resourceName, "outpost_arn"),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_disappears(t *testing.T) {
+	var v ec2.LocalGatewayRouteTable
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_mode("direct-vpc-routing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceLocalGatewayRouteTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_tags(t *testing.T) {
+	var v ec2.LocalGatewayRouteTable
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLocalGatewayRouteTableExists(n string, v *ec2.LocalGatewayRouteTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Local Gateway Route Table ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindLocalGatewayRouteTableByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLocalGatewayRouteTableDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_local_gateway_route_table" {
+			continue
+		}
+
+		_, err := tfec2.FindLocalGatewayRouteTableByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Local Gateway Route Table %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOutpostsLocalGatewayRouteTableConfig_mode(mode string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_local_gateways" "test" {}
+
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+  mode             = %[1]q
+}
+`, mode)
+}
+
+func testAccOutpostsLocalGatewayRouteTableConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_local_gateways" "test" {}
+
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+  mode             = "direct-vpc-routing"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
@@ -0,0 +1,158 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLocalGatewayRouteTableVirtualInterfaceGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreate,
+		Read:   resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead,
+		Update: resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationUpdate,
+		Delete: resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"local_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_route_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_gateway_virtual_interface_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput{
+		LocalGatewayRouteTableId:            aws.String(d.Get("local_gateway_route_table_id").(string)),
+		LocalGatewayVirtualInterfaceGroupId: aws.String(d.Get("local_gateway_virtual_interface_group_id").(string)),
+		TagSpecifications:                   tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeLocalGatewayRouteTableVirtualInterfaceGroupAssociation),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Local Gateway Route Table Virtual Interface Group Association: %s", input)
+	output, err := conn.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("creating EC2 Local Gateway Route Table Virtual Interface Group Association: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocalGatewayRouteTableVirtualInterfaceGroupAssociation.LocalGatewayRouteTableVirtualInterfaceGroupAssociationId))
+
+	if _, err := WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("waiting for EC2 Local Gateway Route Table Virtual Interface Group Association (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead(d, meta)
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	association, err := FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Local Gateway Route Table Virtual Interface Group Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Local Gateway Route Table Virtual Interface Group Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("local_gateway_id", association.LocalGatewayId)
+	d.Set("local_gateway_route_table_arn", association.LocalGatewayRouteTableArn)
+	d.Set("local_gateway_route_table_id", association.LocalGatewayRouteTableId)
+	d.Set("local_gateway_virtual_interface_group_id", association.LocalGatewayVirtualInterfaceGroupId)
+	d.Set("owner_id", association.OwnerId)
+
+	tags := KeyValueTags(association.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating EC2 Local Gateway Route Table Virtual Interface Group Association (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead(d, meta)
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Local Gateway Route Table Virtual Interface Group Association: %s", d.Id())
+	_, err := conn.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociation(&ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput{
+		LocalGatewayRouteTableVirtualInterfaceGroupAssociationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Local Gateway Route Table Virtual Interface Group Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("waiting for EC2 Local Gateway Route Table Virtual Interface Group Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
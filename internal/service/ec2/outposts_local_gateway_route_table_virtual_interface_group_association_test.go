@@ -0,0 +1,138 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2OutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociation_basic(t *testing.T) {
+	var v ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation
+	resourceName := "aws_ec2_local_gateway_route_table_virtual_interface_group_association.test"
+	routeTableResourceName := "aws_ec2_local_gateway_route_table.test"
+	vifGroupDataSourceName := "data.aws_ec2_local_gateway_virtual_interface_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_id", routeTableResourceName, "local_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_route_table_arn", routeTableResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_route_table_id", routeTableResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_virtual_interface_group_id", vifGroupDataSourceName, "id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociation_disappears(t *testing.T) {
+	var v ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation
+	resourceName := "aws_ec2_local_gateway_route_table_virtual_interface_group_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceLocalGatewayRouteTableVirtualInterfaceGroupAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationExists(n string, v *ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Local Gateway Route Table Virtual Interface Group Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_local_gateway_route_table_virtual_interface_group_association" {
+			continue
+		}
+
+		_, err := tfec2.FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Local Gateway Route Table Virtual Interface Group Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociationConfig_basic() string {
+	return `
+data "aws_ec2_local_gateways" "test" {}
+
+data "aws_ec2_local_gateway_virtual_interface_group" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+}
+
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+  mode             = "direct-vpc-routing"
+}
+
+resource "aws_ec2_local_gateway_route_table_virtual_interface_group_association" "test" {
+  local_gateway_route_table_id             = aws_ec2_local_gateway_route_table.test.id
+  local_gateway_virtual_interface_group_id = data.aws_ec2_local_gateway_virtual_interface_group.test.id
+}
+`
+}
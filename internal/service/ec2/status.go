@@ -1315,3 +1315,35 @@ func StatusIPAMPoolCIDRState(conn *ec2.EC2, cidrBlock, poolID string) resource.S
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func StatusLocalGatewayRouteTableState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLocalGatewayRouteTableByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func StatusLocalGatewayRouteTableVirtualInterfaceGroupAssociationState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
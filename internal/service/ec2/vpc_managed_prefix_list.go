@@ -1,9 +1,14 @@
 package ec2
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
 	"log"
+	"net"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -13,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/remotesource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -30,8 +36,9 @@ func ResourceManagedPrefixList() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			resourceManagedPrefixListEntriesSourceCustomizeDiff,
 			customdiff.ComputedIf("version", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange("entry")
+				return diff.HasChange("entry") || diff.HasChange("entries_source_hash")
 			}),
 			verify.SetTagsDiff,
 		),
@@ -47,10 +54,45 @@ func ResourceManagedPrefixList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"entry": {
-				Type:     schema.TypeSet,
-				Optional: true,
+			"entries_source": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"entry"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"entries_source.0.csv", "entries_source.0.s3_bucket"},
+						},
+						"s3_bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"entries_source.0.s3_key"},
+						},
+						"s3_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"entries_source.0.s3_bucket"},
+						},
+						"s3_object_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"entries_source.0.s3_bucket"},
+						},
+					},
+				},
+			},
+			"entries_source_hash": {
+				Type:     schema.TypeString,
 				Computed: true,
+			},
+			"entry": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"entries_source"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cidr": {
@@ -105,6 +147,21 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 		input.Entries = expandAddPrefixListEntries(v.(*schema.Set).List())
 	}
 
+	if source := expandManagedPrefixListEntriesSource(d.Get("entries_source").([]interface{})); source != nil {
+		entries, hash, err := source.read(ctx, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := checkManagedPrefixListEntriesSourceHash(d, source, hash); err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.Entries = entries
+		d.Set("entries_source_hash", hash)
+	}
+
 	if v, ok := d.GetOk("max_entries"); ok {
 		input.MaxEntries = aws.Int64(int64(v.(int)))
 	}
@@ -203,7 +260,25 @@ func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	if d.HasChangesExcept("tags", "tags_all", "max_entries") {
+	if source := expandManagedPrefixListEntriesSource(d.Get("entries_source").([]interface{})); source != nil && d.HasChange("entries_source_hash") {
+		entries, hash, err := source.read(ctx, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := checkManagedPrefixListEntriesSourceHash(d, source, hash); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := syncManagedPrefixListEntries(ctx, conn, d.Id(), entries); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("entries_source_hash", hash)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all", "max_entries", "entries_source", "entries_source_hash") {
 		input := &ec2.ModifyManagedPrefixListInput{
 			PrefixListId: aws.String(d.Id()),
 		}
@@ -212,20 +287,23 @@ func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData
 		currentVersion := int64(d.Get("version").(int))
 		wait := false
 
-		oldAttr, newAttr := d.GetChange("entry")
-		os := oldAttr.(*schema.Set)
-		ns := newAttr.(*schema.Set)
+		// Entries read from an external source have already been synchronized.
+		if len(d.Get("entries_source").([]interface{})) == 0 {
+			oldAttr, newAttr := d.GetChange("entry")
+			os := oldAttr.(*schema.Set)
+			ns := newAttr.(*schema.Set)
 
-		if addEntries := ns.Difference(os); addEntries.Len() > 0 {
-			input.AddEntries = expandAddPrefixListEntries(addEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
-		}
+			if addEntries := ns.Difference(os); addEntries.Len() > 0 {
+				input.AddEntries = expandAddPrefixListEntries(addEntries.List())
+				input.CurrentVersion = aws.Int64(currentVersion)
+				wait = true
+			}
 
-		if removeEntries := os.Difference(ns); removeEntries.Len() > 0 {
-			input.RemoveEntries = expandRemovePrefixListEntries(removeEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
+			if removeEntries := os.Difference(ns); removeEntries.Len() > 0 {
+				input.RemoveEntries = expandRemovePrefixListEntries(removeEntries.List())
+				input.CurrentVersion = aws.Int64(currentVersion)
+				wait = true
+			}
 		}
 
 		// Prevent the following error on description-only updates:
@@ -478,3 +556,286 @@ func flattenPrefixListEntries(apiObjects []*ec2.PrefixListEntry) []interface{} {
 
 	return tfList
 }
+
+// resourceManagedPrefixListEntriesSourceCustomizeDiff reads any external entries source at plan time
+// so that changes to its contents are detected as drift.
+func resourceManagedPrefixListEntriesSourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	source := expandManagedPrefixListEntriesSource(diff.Get("entries_source").([]interface{}))
+
+	if source == nil {
+		if diff.Get("entries_source_hash").(string) != "" {
+			return diff.SetNew("entries_source_hash", "")
+		}
+
+		return nil
+	}
+
+	if !diff.NewValueKnown("entries_source") {
+		if err := diff.SetNewComputed("entries_source_hash"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("entry")
+	}
+
+	entries, hash, err := source.read(ctx, meta)
+
+	if err != nil {
+		return err
+	}
+
+	if n, maxEntries := len(entries), diff.Get("max_entries").(int); diff.NewValueKnown("max_entries") && n > maxEntries {
+		return fmt.Errorf("EC2 Managed Prefix List entries source (%s) contains %d entries, max_entries is %d", source, n, maxEntries)
+	}
+
+	if hash != diff.Get("entries_source_hash").(string) {
+		if err := diff.SetNew("entries_source_hash", hash); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("entry")
+	}
+
+	return nil
+}
+
+// syncManagedPrefixListEntries makes the prefix list's entries match the specified entries.
+// The modification is made against the version read, so it fails rather than
+// overwriting any concurrent modification, and produces a single new version
+// unless existing entries' descriptions change.
+func syncManagedPrefixListEntries(ctx context.Context, conn *ec2.EC2, id string, entries []*ec2.AddPrefixListEntry) error {
+	pl, err := FindManagedPrefixListByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Managed Prefix List (%s): %w", id, err)
+	}
+
+	existing, err := FindManagedPrefixListEntries(ctx, conn, &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId:  aws.String(id),
+		TargetVersion: pl.Version,
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Managed Prefix List (%s) Entries: %w", id, err)
+	}
+
+	existingByCIDR := make(map[string]*ec2.PrefixListEntry, len(existing))
+	for _, v := range existing {
+		existingByCIDR[aws.StringValue(v.Cidr)] = v
+	}
+
+	var add []*ec2.AddPrefixListEntry
+	var remove, descriptionChanges []*ec2.RemovePrefixListEntry
+
+	for _, v := range entries {
+		cidr := aws.StringValue(v.Cidr)
+		old, ok := existingByCIDR[cidr]
+
+		switch {
+		case !ok:
+			add = append(add, v)
+		case aws.StringValue(old.Description) != aws.StringValue(v.Description):
+			descriptionChanges = append(descriptionChanges, &ec2.RemovePrefixListEntry{Cidr: v.Cidr})
+			add = append(add, v)
+		}
+
+		delete(existingByCIDR, cidr)
+	}
+
+	for cidr := range existingByCIDR {
+		remove = append(remove, &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+	}
+
+	version := pl.Version
+
+	// A CIDR cannot be both removed and added in the same request.
+	if len(descriptionChanges) > 0 {
+		_, err := conn.ModifyManagedPrefixListWithContext(ctx, &ec2.ModifyManagedPrefixListInput{
+			CurrentVersion: version,
+			PrefixListId:   aws.String(id),
+			RemoveEntries:  descriptionChanges,
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating EC2 Managed Prefix List (%s): %w", id, err)
+		}
+
+		pl, err := WaitManagedPrefixListModified(ctx, conn, id)
+
+		if err != nil {
+			return fmt.Errorf("waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+		}
+
+		version = pl.Version
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	input := &ec2.ModifyManagedPrefixListInput{
+		AddEntries:     add,
+		CurrentVersion: version,
+		PrefixListId:   aws.String(id),
+		RemoveEntries:  remove,
+	}
+
+	log.Printf("[DEBUG] Updating EC2 Managed Prefix List (%s) entries: %d to add, %d to remove", id, len(add), len(remove))
+	_, err = conn.ModifyManagedPrefixListWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating EC2 Managed Prefix List (%s): %w", id, err)
+	}
+
+	if _, err := WaitManagedPrefixListModified(ctx, conn, id); err != nil {
+		return fmt.Errorf("waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+	}
+
+	return nil
+}
+
+// managedPrefixListEntriesSource is inline CSV or an S3 object containing CSV.
+type managedPrefixListEntriesSource struct {
+	CSV string
+	S3  *remotesource.Source
+}
+
+func expandManagedPrefixListEntriesSource(tfList []interface{}) *managedPrefixListEntriesSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	source := &managedPrefixListEntriesSource{
+		CSV: tfMap["csv"].(string),
+	}
+
+	if v := tfMap["s3_bucket"].(string); v != "" {
+		source.S3 = &remotesource.Source{
+			S3Bucket:  v,
+			S3Key:     tfMap["s3_key"].(string),
+			S3Version: tfMap["s3_object_version"].(string),
+		}
+	}
+
+	return source
+}
+
+func (s *managedPrefixListEntriesSource) String() string {
+	if s.S3 == nil {
+		return "csv"
+	}
+
+	return s.S3.String()
+}
+
+// read returns the entries listed in the source and their hash.
+func (s *managedPrefixListEntriesSource) read(ctx context.Context, meta interface{}) ([]*ec2.AddPrefixListEntry, string, error) {
+	body := []byte(s.CSV)
+
+	if s.S3 != nil {
+		var err error
+		body, err = s.S3.Read(ctx, meta.(*conns.AWSClient).S3Conn)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("reading EC2 Managed Prefix List entries source (%s): %w", s, err)
+		}
+	}
+
+	entries, err := parseManagedPrefixListEntries(body)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing EC2 Managed Prefix List entries source (%s): %w", s, err)
+	}
+
+	return entries, managedPrefixListEntriesHash(entries), nil
+}
+
+// parseManagedPrefixListEntries parses CSV records of the form "cidr[,description]".
+// An optional header record starting with "cidr" and lines starting with '#' are ignored.
+// Entries are returned sorted by CIDR.
+func parseManagedPrefixListEntries(body []byte) ([]*ec2.AddPrefixListEntry, error) {
+	r := csv.NewReader(bytes.NewReader(body))
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]*ec2.AddPrefixListEntry)
+
+	for i, record := range records {
+		cidr := strings.TrimSpace(record[0])
+
+		if i == 0 && strings.EqualFold(cidr, "cidr") {
+			continue
+		}
+
+		if cidr == "" {
+			continue
+		}
+
+		if len(record) > 2 {
+			return nil, fmt.Errorf("record %d: expected at most 2 fields, got %d", i+1, len(record))
+		}
+
+		_, ipNet, err := net.ParseCIDR(cidr)
+
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i+1, err)
+		}
+
+		if ipNet.String() != cidr {
+			return nil, fmt.Errorf("record %d: %q is not a network address, did you mean %q?", i+1, cidr, ipNet.String())
+		}
+
+		if _, ok := seen[cidr]; ok {
+			return nil, fmt.Errorf("record %d: duplicate CIDR %q", i+1, cidr)
+		}
+
+		entry := &ec2.AddPrefixListEntry{
+			Cidr: aws.String(cidr),
+		}
+
+		if len(record) == 2 {
+			if description := strings.TrimSpace(record[1]); description != "" {
+				entry.Description = aws.String(description)
+			}
+		}
+
+		seen[cidr] = entry
+	}
+
+	entries := make([]*ec2.AddPrefixListEntry, 0, len(seen))
+
+	for _, v := range seen {
+		entries = append(entries, v)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return aws.StringValue(entries[i].Cidr) < aws.StringValue(entries[j].Cidr)
+	})
+
+	return entries, nil
+}
+
+// managedPrefixListEntriesHash returns a hash of the specified entries.
+func managedPrefixListEntriesHash(entries []*ec2.AddPrefixListEntry) string {
+	values := make([]string, 0, len(entries))
+
+	for _, v := range entries {
+		values = append(values, fmt.Sprintf("%s,%s", aws.StringValue(v.Cidr), aws.StringValue(v.Description)))
+	}
+
+	return remotesource.Hash(values)
+}
+
+// checkManagedPrefixListEntriesSourceHash verifies that the entries read from the source at apply time match those read at plan time.
+func checkManagedPrefixListEntriesSourceHash(d *schema.ResourceData, source *managedPrefixListEntriesSource, hash string) error {
+	return remotesource.CheckHash(source, d.Get("entries_source_hash").(string), hash)
+}
@@ -165,6 +165,53 @@ func TestAccVPCManagedPrefixList_Entry_cidr(t *testing.T) {
 	})
 }
 
+func TestAccVPCManagedPrefixList_entriesSource(t *testing.T) {
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListConfig_entriesSource(rName, "cidr,description\n1.0.0.0/8,Test1\n2.0.0.0/8,Test2\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "1.0.0.0/8",
+						"description": "Test1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "2.0.0.0/8",
+						"description": "Test2",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "entries_source_hash"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_entriesSource(rName, "1.0.0.0/8,Test1 updated\n3.0.0.0/8,Test3\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "1.0.0.0/8",
+						"description": "Test1 updated",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":        "3.0.0.0/8",
+						"description": "Test3",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "version", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCManagedPrefixList_Entry_description(t *testing.T) {
 	resourceName := "aws_ec2_managed_prefix_list.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -455,6 +502,20 @@ resource "aws_ec2_managed_prefix_list" "test" {
 `, rName)
 }
 
+func testAccVPCManagedPrefixListConfig_entriesSource(rName, csv string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 5
+  name           = %[1]q
+
+  entries_source {
+    csv = "%[2]s"
+  }
+}
+`, rName, csv)
+}
+
 func testAccVPCManagedPrefixListConfig_entryDescription(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "test" {
@@ -0,0 +1,704 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkACLRules() *schema.Resource {
+	networkACLRulesListNestedBlock := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: networkACLRulesMaxItems,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:     schema.TypeString,
+					Required: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return strings.EqualFold(old, new)
+					},
+					ValidateFunc: validation.StringInSlice(ec2.RuleAction_Values(), true),
+				},
+				"cidr_block": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				},
+				"from_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"icmp_code": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"icmp_type": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"ipv6_cidr_block": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						o, err := networkACLProtocolNumber(old)
+
+						if err != nil {
+							return false
+						}
+
+						n, err := networkACLProtocolNumber(new)
+
+						if err != nil {
+							return false
+						}
+
+						return o == n
+					},
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						_, err := networkACLProtocolNumber(v.(string))
+
+						if err != nil {
+							errors = append(errors, fmt.Errorf("%q : %w", k, err))
+						}
+
+						return
+					},
+				},
+				"rule_no": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, networkACLRulesMaxRuleNumber),
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceNetworkACLRulesCreate,
+		Read:   resourceNetworkACLRulesRead,
+		Update: resourceNetworkACLRulesUpdate,
+		Delete: resourceNetworkACLRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("network_acl_id", d.Id())
+				d.Set("rule_number_increment", networkACLRulesDefaultRuleNumberIncrement)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"egress":  networkACLRulesListNestedBlock,
+			"ingress": networkACLRulesListNestedBlock,
+			"network_acl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_number_increment": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      networkACLRulesDefaultRuleNumberIncrement,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+		},
+
+		CustomizeDiff: resourceNetworkACLRulesCustomizeDiff,
+	}
+}
+
+const (
+	networkACLRulesDefaultRuleNumberIncrement = 10
+	networkACLRulesMaxItems                   = 40
+	networkACLRulesMaxRuleNumber              = 32766
+)
+
+func resourceNetworkACLRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	naclID := d.Get("network_acl_id").(string)
+	d.SetId(naclID)
+
+	if err := syncNetworkACLRules(conn, d); err != nil {
+		return err
+	}
+
+	return resourceNetworkACLRulesRead(d, meta)
+}
+
+func resourceNetworkACLRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	nacl, err := FindNetworkACLByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network ACL %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network ACL (%s): %w", d.Id(), err)
+	}
+
+	d.Set("network_acl_id", nacl.NetworkAclId)
+
+	increment := d.Get("rule_number_increment").(int)
+
+	for _, egress := range []bool{false, true} {
+		key := networkACLRulesKey(egress)
+
+		// Rules whose numbers were assigned by the provider are recorded without a number.
+		existing := networkACLRulesEntries(nacl, egress)
+		autoRuleNumbers := make(map[int64]bool)
+		if entries, err := expandNetworkACLRulesEntries(d.Get(key).([]interface{}), egress); err == nil {
+			auto := networkACLRulesAutoNumbered(entries)
+
+			if err := networkACLRulesAssignRuleNumbers(entries, existing, increment); err == nil {
+				for i, v := range entries {
+					if auto[i] {
+						autoRuleNumbers[aws.Int64Value(v.RuleNumber)] = true
+					}
+				}
+			}
+		}
+
+		var tfList []interface{}
+
+		for _, apiObject := range existing {
+			tfMap := flattenNetworkACLEntry(apiObject)
+
+			if tfMap == nil {
+				continue
+			}
+
+			if autoRuleNumbers[aws.Int64Value(apiObject.RuleNumber)] {
+				tfMap["rule_no"] = 0
+			}
+
+			tfList = append(tfList, tfMap)
+		}
+
+		if err := d.Set(key, tfList); err != nil {
+			return fmt.Errorf("error setting %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkACLRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("egress", "ingress", "rule_number_increment") {
+		if err := syncNetworkACLRules(conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetworkACLRulesRead(d, meta)
+}
+
+func resourceNetworkACLRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	nacl, err := FindNetworkACLByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network ACL (%s): %w", d.Id(), err)
+	}
+
+	for _, egress := range []bool{false, true} {
+		if err := deleteNetworkACLEntries(conn, d.Id(), networkACLRulesEntries(nacl, egress)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkACLRulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	increment := diff.Get("rule_number_increment").(int)
+
+	for _, egress := range []bool{false, true} {
+		key := networkACLRulesKey(egress)
+
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		entries, err := expandNetworkACLRulesEntries(diff.Get(key).([]interface{}), egress)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		if err := networkACLRulesAssignRuleNumbers(entries, nil, increment); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// syncNetworkACLRules makes the NACL's entries match the configured rules.
+// Entries are created and replaced in place before any are deleted, so that
+// traffic matching a rule that is kept is never left without a rule.
+func syncNetworkACLRules(conn *ec2.EC2, d *schema.ResourceData) error {
+	nacl, err := FindNetworkACLByID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Network ACL (%s): %w", d.Id(), err)
+	}
+
+	increment := d.Get("rule_number_increment").(int)
+
+	for _, egress := range []bool{false, true} {
+		key := networkACLRulesKey(egress)
+		existing := networkACLRulesEntries(nacl, egress)
+		want, err := expandNetworkACLRulesEntries(d.Get(key).([]interface{}), egress)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		if err := networkACLRulesAssignRuleNumbers(want, existing, increment); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		creates, replaces, deletes := networkACLRulesChanges(existing, want)
+
+		for _, naclEntry := range creates {
+			input := &ec2.CreateNetworkAclEntryInput{
+				CidrBlock:     naclEntry.CidrBlock,
+				Egress:        naclEntry.Egress,
+				IcmpTypeCode:  naclEntry.IcmpTypeCode,
+				Ipv6CidrBlock: naclEntry.Ipv6CidrBlock,
+				NetworkAclId:  aws.String(d.Id()),
+				PortRange:     naclEntry.PortRange,
+				Protocol:      naclEntry.Protocol,
+				RuleAction:    naclEntry.RuleAction,
+				RuleNumber:    naclEntry.RuleNumber,
+			}
+
+			log.Printf("[INFO] Creating EC2 Network ACL Entry: %s", input)
+			_, err := conn.CreateNetworkAclEntry(input)
+
+			if err != nil {
+				return fmt.Errorf("error creating EC2 Network ACL (%s) Entry: %w", d.Id(), err)
+			}
+		}
+
+		for _, naclEntry := range replaces {
+			input := &ec2.ReplaceNetworkAclEntryInput{
+				CidrBlock:     naclEntry.CidrBlock,
+				Egress:        naclEntry.Egress,
+				IcmpTypeCode:  naclEntry.IcmpTypeCode,
+				Ipv6CidrBlock: naclEntry.Ipv6CidrBlock,
+				NetworkAclId:  aws.String(d.Id()),
+				PortRange:     naclEntry.PortRange,
+				Protocol:      naclEntry.Protocol,
+				RuleAction:    naclEntry.RuleAction,
+				RuleNumber:    naclEntry.RuleNumber,
+			}
+
+			log.Printf("[INFO] Replacing EC2 Network ACL Entry: %s", input)
+			_, err := conn.ReplaceNetworkAclEntry(input)
+
+			if err != nil {
+				return fmt.Errorf("error replacing EC2 Network ACL (%s) Entry: %w", d.Id(), err)
+			}
+		}
+
+		if err := deleteNetworkACLEntries(conn, d.Id(), deletes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func networkACLRulesKey(egress bool) string {
+	if egress {
+		return "egress"
+	}
+
+	return "ingress"
+}
+
+// networkACLRulesEntries returns the NACL's user-defined entries in the specified direction, ordered by rule number.
+func networkACLRulesEntries(nacl *ec2.NetworkAcl, egress bool) []*ec2.NetworkAclEntry {
+	var apiObjects []*ec2.NetworkAclEntry
+
+	for _, v := range nacl.Entries {
+		if aws.BoolValue(v.Egress) != egress {
+			continue
+		}
+
+		// Skip the default rules added by AWS. They can be neither
+		// configured or deleted by users.
+		if v := aws.Int64Value(v.RuleNumber); v == defaultACLRuleNumberIPv4 || v == defaultACLRuleNumberIPv6 {
+			continue
+		}
+
+		apiObjects = append(apiObjects, v)
+	}
+
+	sort.Slice(apiObjects, func(i, j int) bool {
+		return aws.Int64Value(apiObjects[i].RuleNumber) < aws.Int64Value(apiObjects[j].RuleNumber)
+	})
+
+	return apiObjects
+}
+
+func expandNetworkACLRulesEntries(tfList []interface{}, egress bool) ([]*ec2.NetworkAclEntry, error) {
+	var apiObjects []*ec2.NetworkAclEntry
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandNetworkACLEntry(tfMap, egress)
+
+		if apiObject == nil {
+			continue
+		}
+
+		if aws.StringValue(apiObject.Protocol) == "-1" {
+			// Protocol -1 rules don't store ports in AWS.
+			if from, to := aws.Int64Value(apiObject.PortRange.From), aws.Int64Value(apiObject.PortRange.To); from != 0 || to != 0 {
+				return nil, fmt.Errorf("rule %d: to_port (%d) and from_port (%d) must both be 0 to use the 'all' \"-1\" protocol", i, to, from)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// networkACLRulesAutoNumbered returns whether each entry is without a configured rule number.
+func networkACLRulesAutoNumbered(entries []*ec2.NetworkAclEntry) []bool {
+	auto := make([]bool, len(entries))
+
+	for i, v := range entries {
+		auto[i] = aws.Int64Value(v.RuleNumber) == 0
+	}
+
+	return auto
+}
+
+// networkACLRulesAssignRuleNumbers assigns a rule number to each entry without one.
+// Entries are evaluated in list order, so rule numbers must be strictly increasing.
+// To minimize the changes made to the NACL, an entry first keeps the number of an existing
+// entry with the same content, for as many entries as the list order allows.
+// The remaining entries reuse the numbers of existing entries that are no longer kept, so that
+// they are replaced in place, or else are numbered at multiples of increment, or else evenly
+// between their neighbors.
+func networkACLRulesAssignRuleNumbers(entries, existing []*ec2.NetworkAclEntry, increment int) error {
+	n := len(entries)
+	auto := networkACLRulesAutoNumbered(entries)
+	configured := make(map[int64]bool)
+	previous := int64(0)
+
+	for i, v := range entries {
+		if auto[i] {
+			continue
+		}
+
+		ruleNumber := aws.Int64Value(v.RuleNumber)
+
+		if ruleNumber <= previous {
+			return fmt.Errorf("rule %d: rule_no (%d) must be greater than the previous rule's number (%d)", i, ruleNumber, previous)
+		}
+
+		configured[ruleNumber] = true
+		previous = ruleNumber
+	}
+
+	// The upper bound on each entry's number is the next configured rule number.
+	upper := make([]int64, n)
+	next := int64(networkACLRulesMaxRuleNumber + 1)
+
+	for i := n - 1; i >= 0; i-- {
+		upper[i] = next
+
+		if !auto[i] {
+			next = aws.Int64Value(entries[i].RuleNumber)
+		}
+	}
+
+	// Match entries without a number to existing entries with the same content.
+	candidates := make([]int64, n)
+	matched := make(map[int64]bool)
+	lower := int64(0)
+
+	for i, v := range entries {
+		if !auto[i] {
+			lower = aws.Int64Value(v.RuleNumber)
+			continue
+		}
+
+		for _, e := range existing {
+			ruleNumber := aws.Int64Value(e.RuleNumber)
+
+			if configured[ruleNumber] || matched[ruleNumber] || ruleNumber <= lower || ruleNumber >= upper[i] {
+				continue
+			}
+
+			if networkACLEntriesEqual(e, v) {
+				candidates[i] = ruleNumber
+				matched[ruleNumber] = true
+				break
+			}
+		}
+	}
+
+	keep := networkACLRulesLongestIncreasing(candidates)
+
+	for {
+		ruleNumbers := make([]int64, n)
+		used := make(map[int64]bool)
+
+		for i, v := range entries {
+			switch {
+			case !auto[i]:
+				ruleNumbers[i] = aws.Int64Value(v.RuleNumber)
+			case keep[i]:
+				ruleNumbers[i] = candidates[i]
+			}
+
+			used[ruleNumbers[i]] = true
+		}
+
+		var reusable []int64
+
+		for _, e := range existing {
+			if ruleNumber := aws.Int64Value(e.RuleNumber); !used[ruleNumber] {
+				reusable = append(reusable, ruleNumber)
+			}
+		}
+
+		j, err := networkACLRulesFillRuleNumbers(ruleNumbers, reusable, int64(increment))
+
+		if err == nil {
+			for i, v := range entries {
+				v.RuleNumber = aws.Int64(ruleNumbers[i])
+			}
+
+			return nil
+		}
+
+		// Give up the kept number bounding a run of entries that cannot be numbered, and try again.
+		if j < 0 || !auto[j] || !keep[j] {
+			return err
+		}
+
+		keep[j] = false
+	}
+}
+
+// networkACLRulesLongestIncreasing returns the longest strictly increasing subsequence of the non-zero values.
+func networkACLRulesLongestIncreasing(values []int64) []bool {
+	n := len(values)
+	length := make([]int, n)
+	prev := make([]int, n)
+	best := -1
+
+	for i, v := range values {
+		prev[i] = -1
+
+		if v == 0 {
+			continue
+		}
+
+		length[i] = 1
+
+		for j := 0; j < i; j++ {
+			if values[j] != 0 && values[j] < v && length[j]+1 > length[i] {
+				length[i] = length[j] + 1
+				prev[i] = j
+			}
+		}
+
+		if best < 0 || length[i] > length[best] {
+			best = i
+		}
+	}
+
+	keep := make([]bool, n)
+
+	for i := best; i >= 0; i = prev[i] {
+		keep[i] = true
+	}
+
+	return keep
+}
+
+// networkACLRulesFillRuleNumbers assigns numbers to each run of zero values in ruleNumbers.
+// If a run cannot be numbered, the index of the entry bounding the run from above is returned (-1 if none).
+func networkACLRulesFillRuleNumbers(ruleNumbers, reusable []int64, increment int64) (int, error) {
+	n := len(ruleNumbers)
+	sort.Slice(reusable, func(i, j int) bool { return reusable[i] < reusable[j] })
+	consumed := make(map[int64]bool)
+
+	for start := 0; start < n; {
+		if ruleNumbers[start] != 0 {
+			start++
+			continue
+		}
+
+		end := start
+		for end < n && ruleNumbers[end] == 0 {
+			end++
+		}
+
+		count := int64(end - start)
+		lower, upper, bound := int64(0), int64(networkACLRulesMaxRuleNumber+1), -1
+
+		if start > 0 {
+			lower = ruleNumbers[start-1]
+		}
+
+		if end < n {
+			upper, bound = ruleNumbers[end], end
+		}
+
+		var pool []int64
+
+		for _, v := range reusable {
+			if v > lower && v < upper && !consumed[v] {
+				pool = append(pool, v)
+			}
+		}
+
+		switch first := (lower/increment + 1) * increment; {
+		case int64(len(pool)) >= count:
+			for k := int64(0); k < count; k++ {
+				ruleNumbers[start+int(k)] = pool[k]
+				consumed[pool[k]] = true
+			}
+		case first+(count-1)*increment < upper:
+			for k := int64(0); k < count; k++ {
+				ruleNumbers[start+int(k)] = first + k*increment
+			}
+		case upper-lower-1 >= count:
+			step := (upper - lower) / (count + 1)
+
+			for k := int64(0); k < count; k++ {
+				ruleNumbers[start+int(k)] = lower + (k+1)*step
+			}
+		default:
+			return bound, fmt.Errorf("not enough rule numbers between %d and %d for rules %d to %d", lower, upper, start, end-1)
+		}
+
+		start = end
+	}
+
+	return -1, nil
+}
+
+// networkACLRulesChanges returns the entries to create, replace and delete
+// to go from the existing entries to the wanted entries.
+func networkACLRulesChanges(existing, want []*ec2.NetworkAclEntry) (creates, replaces, deletes []*ec2.NetworkAclEntry) {
+	existingByNumber := make(map[int64]*ec2.NetworkAclEntry, len(existing))
+
+	for _, v := range existing {
+		existingByNumber[aws.Int64Value(v.RuleNumber)] = v
+	}
+
+	for _, v := range want {
+		ruleNumber := aws.Int64Value(v.RuleNumber)
+		old, ok := existingByNumber[ruleNumber]
+
+		switch {
+		case !ok:
+			creates = append(creates, v)
+		case !networkACLEntriesEqual(old, v):
+			replaces = append(replaces, v)
+		}
+
+		delete(existingByNumber, ruleNumber)
+	}
+
+	for _, v := range existing {
+		if _, ok := existingByNumber[aws.Int64Value(v.RuleNumber)]; ok {
+			deletes = append(deletes, v)
+		}
+	}
+
+	return creates, replaces, deletes
+}
+
+func networkACLEntriesEqual(a, b *ec2.NetworkAclEntry) bool {
+	if !strings.EqualFold(aws.StringValue(a.RuleAction), aws.StringValue(b.RuleAction)) {
+		return false
+	}
+
+	if aws.StringValue(a.CidrBlock) != aws.StringValue(b.CidrBlock) || aws.StringValue(a.Ipv6CidrBlock) != aws.StringValue(b.Ipv6CidrBlock) {
+		return false
+	}
+
+	protocolA, errA := networkACLProtocolNumber(aws.StringValue(a.Protocol))
+	protocolB, errB := networkACLProtocolNumber(aws.StringValue(b.Protocol))
+
+	if errA != nil || errB != nil || protocolA != protocolB {
+		return false
+	}
+
+	switch protocolA {
+	case -1:
+		return true
+	case 1, 58:
+		var codeA, typeA, codeB, typeB int64
+		if v := a.IcmpTypeCode; v != nil {
+			codeA, typeA = aws.Int64Value(v.Code), aws.Int64Value(v.Type)
+		}
+		if v := b.IcmpTypeCode; v != nil {
+			codeB, typeB = aws.Int64Value(v.Code), aws.Int64Value(v.Type)
+		}
+
+		return codeA == codeB && typeA == typeB
+	}
+
+	var fromA, toA, fromB, toB int64
+	if v := a.PortRange; v != nil {
+		fromA, toA = aws.Int64Value(v.From), aws.Int64Value(v.To)
+	}
+	if v := b.PortRange; v != nil {
+		fromB, toB = aws.Int64Value(v.From), aws.Int64Value(v.To)
+	}
+
+	return fromA == fromB && toA == toB
+}
@@ -0,0 +1,210 @@
+package ec2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func testNetworkACLRulesEntry(ruleNumber int64, port int64) *ec2.NetworkAclEntry {
+	return &ec2.NetworkAclEntry{
+		CidrBlock:  aws.String("10.0.0.0/16"),
+		Egress:     aws.Bool(false),
+		PortRange:  &ec2.PortRange{From: aws.Int64(port), To: aws.Int64(port)},
+		Protocol:   aws.String("6"),
+		RuleAction: aws.String("allow"),
+		RuleNumber: aws.Int64(ruleNumber),
+	}
+}
+
+func TestNetworkACLRulesAssignRuleNumbers(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Want          []*ec2.NetworkAclEntry
+		Existing      []*ec2.NetworkAclEntry
+		Increment     int
+		Expected      []int64
+		ExpectedError bool
+	}{
+		{
+			Name: "new",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(0, 443),
+				testNetworkACLRulesEntry(0, 80),
+				testNetworkACLRulesEntry(100, 22),
+			},
+			Increment: 10,
+			Expected:  []int64{10, 20, 100},
+		},
+		{
+			Name: "insert at start",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(0, 8443),
+				testNetworkACLRulesEntry(0, 443),
+				testNetworkACLRulesEntry(0, 80),
+			},
+			Existing: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(10, 443),
+				testNetworkACLRulesEntry(20, 80),
+			},
+			Increment: 10,
+			Expected:  []int64{5, 10, 20},
+		},
+		{
+			Name: "insert in middle",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(0, 443),
+				testNetworkACLRulesEntry(0, 8443),
+				testNetworkACLRulesEntry(0, 80),
+				testNetworkACLRulesEntry(0, 22),
+			},
+			Existing: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(10, 443),
+				testNetworkACLRulesEntry(20, 80),
+				testNetworkACLRulesEntry(30, 22),
+			},
+			Increment: 10,
+			Expected:  []int64{10, 15, 20, 30},
+		},
+		{
+			Name: "modify in place",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(0, 443),
+				testNetworkACLRulesEntry(0, 8080),
+				testNetworkACLRulesEntry(0, 22),
+			},
+			Existing: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(10, 443),
+				testNetworkACLRulesEntry(20, 80),
+				testNetworkACLRulesEntry(30, 22),
+			},
+			Increment: 10,
+			Expected:  []int64{10, 20, 30},
+		},
+		{
+			Name: "remove",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(0, 443),
+				testNetworkACLRulesEntry(0, 22),
+			},
+			Existing: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(10, 443),
+				testNetworkACLRulesEntry(20, 80),
+				testNetworkACLRulesEntry(30, 22),
+			},
+			Increment: 10,
+			Expected:  []int64{10, 30},
+		},
+		{
+			Name: "move",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(0, 80),
+				testNetworkACLRulesEntry(0, 443),
+				testNetworkACLRulesEntry(0, 22),
+			},
+			Existing: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(10, 443),
+				testNetworkACLRulesEntry(20, 80),
+				testNetworkACLRulesEntry(30, 22),
+			},
+			Increment: 10,
+			Expected:  []int64{20, 25, 30},
+		},
+		{
+			Name: "no room between kept numbers",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(0, 443),
+				testNetworkACLRulesEntry(0, 8443),
+				testNetworkACLRulesEntry(0, 80),
+			},
+			Existing: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(10, 443),
+				testNetworkACLRulesEntry(11, 80),
+			},
+			Increment: 10,
+			Expected:  []int64{10, 20, 30},
+		},
+		{
+			Name: "no room between configured numbers",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(10, 443),
+				testNetworkACLRulesEntry(0, 8443),
+				testNetworkACLRulesEntry(11, 80),
+			},
+			Increment:     10,
+			ExpectedError: true,
+		},
+		{
+			Name: "out of order",
+			Want: []*ec2.NetworkAclEntry{
+				testNetworkACLRulesEntry(200, 443),
+				testNetworkACLRulesEntry(100, 80),
+			},
+			Increment:     10,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := networkACLRulesAssignRuleNumbers(testCase.Want, testCase.Existing, testCase.Increment)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError {
+				return
+			}
+
+			var got []int64
+			for _, v := range testCase.Want {
+				got = append(got, aws.Int64Value(v.RuleNumber))
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestNetworkACLRulesChanges(t *testing.T) {
+	existing := []*ec2.NetworkAclEntry{
+		testNetworkACLRulesEntry(10, 443),
+		testNetworkACLRulesEntry(20, 80),
+		testNetworkACLRulesEntry(30, 22),
+	}
+	want := []*ec2.NetworkAclEntry{
+		testNetworkACLRulesEntry(0, 8443),
+		testNetworkACLRulesEntry(0, 443),
+		testNetworkACLRulesEntry(0, 8080),
+	}
+
+	if err := networkACLRulesAssignRuleNumbers(want, existing, 10); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	creates, replaces, deletes := networkACLRulesChanges(existing, want)
+
+	if len(creates) != 1 || aws.Int64Value(creates[0].RuleNumber) != 5 {
+		t.Errorf("expected rule 5 to be created, got %v", creates)
+	}
+
+	if len(replaces) != 1 || aws.Int64Value(replaces[0].RuleNumber) != 20 {
+		t.Errorf("expected rule 20 to be replaced, got %v", replaces)
+	}
+
+	if len(deletes) != 1 || aws.Int64Value(deletes[0].RuleNumber) != 30 {
+		t.Errorf("expected rule 30 to be deleted, got %v", deletes)
+	}
+}
@@ -0,0 +1,355 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCNetworkACLRules_basic(t *testing.T) {
+	var v ec2.NetworkAcl
+	resourceName := "aws_network_acl_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkACLExists("aws_network_acl.test", &v),
+					resource.TestCheckResourceAttrPair(resourceName, "network_acl_id", "aws_network_acl.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule_number_increment", "10"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.rule_no", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.rule_no", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.2.rule_no", "100"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.2.action", "deny"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress.0.protocol", "-1"),
+					testAccCheckNetworkACLRuleNumbers(&v, false, 10, 20, 100),
+					testAccCheckNetworkACLRuleNumbers(&v, true, 10),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"egress", "ingress"},
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkACLRules_update(t *testing.T) {
+	var v ec2.NetworkAcl
+	resourceName := "aws_network_acl_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkACLExists("aws_network_acl.test", &v),
+					testAccCheckNetworkACLRuleNumbers(&v, false, 10, 20, 100),
+				),
+			},
+			{
+				Config: testAccVPCNetworkACLRulesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkACLExists("aws_network_acl.test", &v),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.from_port", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					testAccCheckNetworkACLRuleNumbers(&v, false, 10, 20),
+					testAccCheckNetworkACLRuleNumbers(&v, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkACLRules_insert(t *testing.T) {
+	var v ec2.NetworkAcl
+	resourceName := "aws_network_acl_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkACLExists("aws_network_acl.test", &v),
+					testAccCheckNetworkACLRuleNumbers(&v, false, 10, 20, 100),
+				),
+			},
+			{
+				Config: testAccVPCNetworkACLRulesConfig_inserted(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkACLExists("aws_network_acl.test", &v),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.from_port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.2.from_port", "80"),
+					// The existing rules keep their numbers.
+					testAccCheckNetworkACLRuleNumbers(&v, false, 5, 10, 20, 100),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkACLRules_outOfOrder(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCNetworkACLRulesConfig_outOfOrder(rName),
+				ExpectError: regexp.MustCompile(`must be greater than the previous rule's number`),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkACLRulesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_network_acl_rules" {
+			continue
+		}
+
+		nacl, err := tfec2.FindNetworkACLByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range nacl.Entries {
+			if n := aws.Int64Value(v.RuleNumber); n < 32767 {
+				return fmt.Errorf("EC2 Network ACL %s still has entry %d", rs.Primary.ID, n)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkACLRuleNumbers(v *ec2.NetworkAcl, egress bool, ruleNumbers ...int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var got []int64
+
+		for _, v := range v.Entries {
+			if aws.BoolValue(v.Egress) != egress {
+				continue
+			}
+
+			if n := aws.Int64Value(v.RuleNumber); n < 32767 {
+				got = append(got, n)
+			}
+		}
+
+		if len(got) != len(ruleNumbers) {
+			return fmt.Errorf("expected rule numbers %v, got %v", ruleNumbers, got)
+		}
+
+		want := make(map[int64]bool, len(ruleNumbers))
+		for _, n := range ruleNumbers {
+			want[n] = true
+		}
+
+		for _, n := range got {
+			if !want[n] {
+				return fmt.Errorf("expected rule numbers %v, got %v", ruleNumbers, got)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCNetworkACLRulesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.3.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_acl" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCNetworkACLRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkACLRulesConfig_base(rName), `
+resource "aws_network_acl_rules" "test" {
+  network_acl_id = aws_network_acl.test.id
+
+  ingress {
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  ingress {
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 80
+    to_port    = 80
+  }
+
+  ingress {
+    protocol   = "-1"
+    rule_no    = 100
+    action     = "deny"
+    cidr_block = "0.0.0.0/0"
+  }
+
+  egress {
+    protocol   = "-1"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+  }
+}
+`)
+}
+
+func testAccVPCNetworkACLRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkACLRulesConfig_base(rName), `
+resource "aws_network_acl_rules" "test" {
+  network_acl_id = aws_network_acl.test.id
+
+  ingress {
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 8443
+    to_port    = 8443
+  }
+
+  ingress {
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 80
+    to_port    = 80
+  }
+}
+`)
+}
+
+func testAccVPCNetworkACLRulesConfig_inserted(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkACLRulesConfig_base(rName), `
+resource "aws_network_acl_rules" "test" {
+  network_acl_id = aws_network_acl.test.id
+
+  ingress {
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 22
+    to_port    = 22
+  }
+
+  ingress {
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  ingress {
+    protocol   = "tcp"
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 80
+    to_port    = 80
+  }
+
+  ingress {
+    protocol   = "-1"
+    rule_no    = 100
+    action     = "deny"
+    cidr_block = "0.0.0.0/0"
+  }
+
+  egress {
+    protocol   = "-1"
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+  }
+}
+`)
+}
+
+func testAccVPCNetworkACLRulesConfig_outOfOrder(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkACLRulesConfig_base(rName), `
+resource "aws_network_acl_rules" "test" {
+  network_acl_id = aws_network_acl.test.id
+
+  ingress {
+    protocol   = "tcp"
+    rule_no    = 200
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  ingress {
+    protocol   = "tcp"
+    rule_no    = 100
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 80
+    to_port    = 80
+  }
+}
+`)
+}
@@ -0,0 +1,103 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourcePrefixListEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePrefixListEntriesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"current_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePrefixListEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	prefixListID := d.Get("prefix_list_id").(string)
+	pl, err := FindManagedPrefixListByID(ctx, conn, prefixListID)
+
+	if err != nil {
+		return diag.FromErr(tfresource.SingularDataSourceFindError("EC2 Managed Prefix List", err))
+	}
+
+	// Entries are read at the requested version, or at the version just described
+	// so that the entries and version are consistent with each other.
+	version := aws.Int64Value(pl.Version)
+
+	if v, ok := d.GetOk("target_version"); ok {
+		version = int64(v.(int))
+	}
+
+	input := &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(prefixListID),
+	}
+
+	// AWS-managed prefix lists have no version.
+	if version > 0 {
+		input.TargetVersion = aws.Int64(version)
+	}
+
+	prefixListEntries, err := FindManagedPrefixListEntries(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Managed Prefix List (%s) Entries: %s", prefixListID, err)
+	}
+
+	d.SetId(prefixListID)
+	d.Set("current_version", pl.Version)
+	if err := d.Set("entries", flattenPrefixListEntries(prefixListEntries)); err != nil {
+		return diag.Errorf("setting entries: %s", err)
+	}
+	d.Set("version", version)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCPrefixListEntriesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_prefix_list_entries.current"
+	dataSourceVersion1Name := "data.aws_prefix_list_entries.version1"
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCIDR1(rName),
+			},
+			{
+				Config: testAccVPCPrefixListEntriesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "prefix_list_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+					resource.TestCheckResourceAttr(dataSourceName, "current_version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "entries.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "entries.*", map[string]string{
+						"cidr":        "3.0.0.0/8",
+						"description": "Test3",
+					}),
+					resource.TestCheckResourceAttr(dataSourceVersion1Name, "current_version", "2"),
+					resource.TestCheckResourceAttr(dataSourceVersion1Name, "version", "1"),
+					resource.TestCheckResourceAttr(dataSourceVersion1Name, "entries.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceVersion1Name, "entries.*", map[string]string{
+						"cidr":        "2.0.0.0/8",
+						"description": "Test2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCPrefixListEntriesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListConfig_entryCIDR2(rName), fmt.Sprintf(`
+data "aws_prefix_list_entries" "current" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  depends_on = [aws_ec2_managed_prefix_list.test]
+}
+
+data "aws_prefix_list_entries" "version1" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  target_version = %[1]d
+}
+`, 1))
+}
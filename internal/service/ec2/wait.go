@@ -192,6 +192,90 @@ func WaitLocalGatewayRouteTableVPCAssociationDisassociated(conn *ec2.EC2, localG
 	return nil, err
 }
 
+const (
+	LocalGatewayRouteTableCreatedTimeout = 5 * time.Minute
+	LocalGatewayRouteTableDeletedTimeout = 5 * time.Minute
+
+	LocalGatewayRouteTableVirtualInterfaceGroupAssociationCreatedTimeout = 5 * time.Minute
+	LocalGatewayRouteTableVirtualInterfaceGroupAssociationDeletedTimeout = 5 * time.Minute
+)
+
+func WaitLocalGatewayRouteTableCreated(conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{localGatewayRouteTableStatePending},
+		Target:  []string{localGatewayRouteTableStateAvailable},
+		Timeout: LocalGatewayRouteTableCreatedTimeout,
+		Refresh: StatusLocalGatewayRouteTableState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTable); ok {
+		if stateReason := output.StateReason; stateReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableDeleted(conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{localGatewayRouteTableStateAvailable, localGatewayRouteTableStateDeleting},
+		Target:  []string{},
+		Timeout: LocalGatewayRouteTableDeletedTimeout,
+		Refresh: StatusLocalGatewayRouteTableState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTable); ok {
+		if stateReason := output.StateReason; stateReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreated(conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{localGatewayRouteTableVirtualInterfaceGroupAssociationStatePending, localGatewayRouteTableVirtualInterfaceGroupAssociationStateAssociating},
+		Target:  []string{localGatewayRouteTableVirtualInterfaceGroupAssociationStateAssociated},
+		Timeout: LocalGatewayRouteTableVirtualInterfaceGroupAssociationCreatedTimeout,
+		Refresh: StatusLocalGatewayRouteTableVirtualInterfaceGroupAssociationState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationDeleted(conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{localGatewayRouteTableVirtualInterfaceGroupAssociationStateAssociated, localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociating},
+		Target:  []string{},
+		Timeout: LocalGatewayRouteTableVirtualInterfaceGroupAssociationDeletedTimeout,
+		Refresh: StatusLocalGatewayRouteTableVirtualInterfaceGroupAssociationState(conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	ClientVPNEndpointDeletedTimeout          = 5 * time.Minute
 	ClientVPNEndpointAttributeUpdatedTimeout = 5 * time.Minute
@@ -5,3 +5,49 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	lifecyclePolicyRuleActionTypeExpire = "expire"
+)
+
+func lifecyclePolicyRuleActionType_Values() []string {
+	return []string{
+		lifecyclePolicyRuleActionTypeExpire,
+	}
+}
+
+const (
+	lifecyclePolicyRuleCountTypeImageCountMoreThan = "imageCountMoreThan"
+	lifecyclePolicyRuleCountTypeSinceImagePushed   = "sinceImagePushed"
+)
+
+func lifecyclePolicyRuleCountType_Values() []string {
+	return []string{
+		lifecyclePolicyRuleCountTypeImageCountMoreThan,
+		lifecyclePolicyRuleCountTypeSinceImagePushed,
+	}
+}
+
+const (
+	lifecyclePolicyRuleCountUnitDays = "days"
+)
+
+func lifecyclePolicyRuleCountUnit_Values() []string {
+	return []string{
+		lifecyclePolicyRuleCountUnitDays,
+	}
+}
+
+const (
+	lifecyclePolicyRuleTagStatusAny      = "any"
+	lifecyclePolicyRuleTagStatusTagged   = "tagged"
+	lifecyclePolicyRuleTagStatusUntagged = "untagged"
+)
+
+func lifecyclePolicyRuleTagStatus_Values() []string {
+	return []string{
+		lifecyclePolicyRuleTagStatusAny,
+		lifecyclePolicyRuleTagStatusTagged,
+		lifecyclePolicyRuleTagStatusUntagged,
+	}
+}
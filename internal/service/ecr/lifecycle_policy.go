@@ -2,6 +2,7 @@ package ecr
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,11 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -38,8 +41,10 @@ func ResourceLifecyclePolicy() *schema.Resource {
 			},
 			"policy": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
+				ExactlyOneOf: []string{"policy", "rule"},
 				ValidateFunc: validation.StringIsJSON,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					equal, _ := equivalentLifecyclePolicyJSON(old, new)
@@ -55,17 +60,124 @@ func ResourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"rule": {
+				// Rules are ordered by priority, not by their position in configuration.
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"policy", "rule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyRuleActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"count_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyRuleCountType_Values(), false),
+									},
+									"count_unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyRuleCountUnit_Values(), false),
+									},
+									"tag_pattern_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 4,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"tag_status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyRuleTagStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: resourceLifecyclePolicyCustomizeDiff,
 	}
 }
 
 func resourceLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ECRConn
 
-	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	var policy string
 
-	if err != nil {
-		return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	if v, ok := d.GetOk("rule"); ok && v.(*schema.Set).Len() > 0 {
+		lp := &lifecyclePolicy{
+			Rules: expandLifecyclePolicyRules(v.(*schema.Set).List()),
+		}
+		lp.reduce()
+
+		b, err := jsonutil.BuildJSON(lp)
+
+		if err != nil {
+			return fmt.Errorf("error building ECR Lifecycle Policy JSON: %w", err)
+		}
+
+		policy = string(b)
+	} else {
+		var err error
+
+		policy, err = structure.NormalizeJsonString(d.Get("policy").(string))
+
+		if err != nil {
+			return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+		}
 	}
 
 	input := &ecr.PutLifecyclePolicyInput{
@@ -154,6 +266,18 @@ func resourceLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("policy", policyToSet)
 	}
 
+	var lp lifecyclePolicy
+
+	if err := json.Unmarshal([]byte(aws.StringValue(resp.LifecyclePolicyText)), &lp); err != nil {
+		return fmt.Errorf("error parsing ECR Lifecycle Policy (%s): %w", d.Id(), err)
+	}
+
+	lp.reduce()
+
+	if err := d.Set("rule", flattenLifecyclePolicyRules(lp.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
 	return nil
 }
 
@@ -178,16 +302,227 @@ func resourceLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceLifecyclePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Only validate a new policy document, as ECR may have accepted existing policies that fail these checks.
+	if diff.HasChange("policy") && diff.NewValueKnown("policy") {
+		if v, ok := diff.GetOk("policy"); ok {
+			if err := validateLifecyclePolicyJSON(v.(string)); err != nil {
+				return fmt.Errorf("policy: %w", err)
+			}
+		}
+	}
+
+	if !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("rule")
+
+	if !ok || v.(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	return validateLifecyclePolicyRules(expandLifecyclePolicyRules(v.(*schema.Set).List()))
+}
+
+// validateLifecyclePolicyJSON checks a JSON policy document against the same constraints as typed rules.
+func validateLifecyclePolicyJSON(policy string) error {
+	var lp lifecyclePolicy
+
+	if err := json.Unmarshal([]byte(policy), &lp); err != nil {
+		return err
+	}
+
+	if len(lp.Rules) == 0 {
+		return fmt.Errorf("at least one rule is required")
+	}
+
+	for _, rule := range lp.Rules {
+		if rule == nil {
+			return fmt.Errorf("rules cannot contain null")
+		}
+
+		priority := aws.Int64Value(rule.RulePriority)
+
+		if priority < 1 {
+			return fmt.Errorf("rule priority must be at least 1, got %d", priority)
+		}
+
+		if rule.Selection == nil || rule.Action == nil {
+			return fmt.Errorf("rule %d: selection and action are required", priority)
+		}
+	}
+
+	return validateLifecyclePolicyRules(lp.Rules)
+}
+
+// validateLifecyclePolicyRules checks the rule constraints that ECR would otherwise only report at apply time.
+func validateLifecyclePolicyRules(rules []*lifecyclePolicyRule) error {
+	var errs *multierror.Error
+	priorities := make(map[int64]bool)
+	var anyRulePriority int64
+
+	for _, rule := range rules {
+		priority := aws.Int64Value(rule.RulePriority)
+
+		if priorities[priority] {
+			errs = multierror.Append(errs, fmt.Errorf("rule priority %d is used by more than one rule", priority))
+		}
+		priorities[priority] = true
+
+		selection := rule.Selection
+
+		if selection == nil {
+			continue
+		}
+
+		switch tagStatus := aws.StringValue(selection.TagStatus); tagStatus {
+		case lifecyclePolicyRuleTagStatusTagged:
+			if len(selection.TagPrefixList) == 0 && len(selection.TagPatternList) == 0 {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: tag_prefix_list or tag_pattern_list must be set when tag_status is %q", priority, tagStatus))
+			}
+
+			if len(selection.TagPrefixList) > 0 && len(selection.TagPatternList) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: only one of tag_prefix_list or tag_pattern_list can be set", priority))
+			}
+		default:
+			if len(selection.TagPrefixList) > 0 || len(selection.TagPatternList) > 0 {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: tag_prefix_list and tag_pattern_list can only be set when tag_status is %q", priority, lifecyclePolicyRuleTagStatusTagged))
+			}
+
+			if tagStatus == lifecyclePolicyRuleTagStatusAny {
+				if anyRulePriority != 0 {
+					errs = multierror.Append(errs, fmt.Errorf("rule %d: only one rule can have tag_status %q", priority, tagStatus))
+				}
+				anyRulePriority = priority
+			}
+		}
+
+		switch countType := aws.StringValue(selection.CountType); countType {
+		case lifecyclePolicyRuleCountTypeSinceImagePushed:
+			if aws.StringValue(selection.CountUnit) == "" {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: count_unit must be set when count_type is %q", priority, countType))
+			}
+		case lifecyclePolicyRuleCountTypeImageCountMoreThan:
+			if aws.StringValue(selection.CountUnit) != "" {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: count_unit cannot be set when count_type is %q", priority, countType))
+			}
+		}
+	}
+
+	// A rule selecting any image must be evaluated last.
+	if anyRulePriority != 0 {
+		for priority := range priorities {
+			if priority > anyRulePriority {
+				errs = multierror.Append(errs, fmt.Errorf("rule %d: a rule with tag_status %q must have the highest priority", anyRulePriority, lifecyclePolicyRuleTagStatusAny))
+				break
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func expandLifecyclePolicyRules(tfList []interface{}) []*lifecyclePolicyRule {
+	var apiObjects []*lifecyclePolicyRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lifecyclePolicyRule{
+			RulePriority: aws.Int64(int64(tfMap["priority"].(int))),
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Action = &lifecyclePolicyRuleAction{
+				ActionType: aws.String(v[0].(map[string]interface{})["type"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			selection := &lifecyclePolicyRuleSelection{
+				CountNumber: aws.Int64(int64(tfMap["count_number"].(int))),
+				CountType:   aws.String(tfMap["count_type"].(string)),
+				TagStatus:   aws.String(tfMap["tag_status"].(string)),
+			}
+
+			if v, ok := tfMap["count_unit"].(string); ok && v != "" {
+				selection.CountUnit = aws.String(v)
+			}
+
+			if v, ok := tfMap["tag_pattern_list"].([]interface{}); ok && len(v) > 0 {
+				selection.TagPatternList = flex.ExpandStringList(v)
+			}
+
+			if v, ok := tfMap["tag_prefix_list"].([]interface{}); ok && len(v) > 0 {
+				selection.TagPrefixList = flex.ExpandStringList(v)
+			}
+
+			apiObject.Selection = selection
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLifecyclePolicyRules(apiObjects []*lifecyclePolicyRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"priority":    aws.Int64Value(apiObject.RulePriority),
+		}
+
+		if v := apiObject.Action; v != nil {
+			tfMap["action"] = []interface{}{map[string]interface{}{
+				"type": aws.StringValue(v.ActionType),
+			}}
+		}
+
+		if v := apiObject.Selection; v != nil {
+			tfMap["selection"] = []interface{}{map[string]interface{}{
+				"count_number":     aws.Int64Value(v.CountNumber),
+				"count_type":       aws.StringValue(v.CountType),
+				"count_unit":       aws.StringValue(v.CountUnit),
+				"tag_pattern_list": aws.StringValueSlice(v.TagPatternList),
+				"tag_prefix_list":  aws.StringValueSlice(v.TagPrefixList),
+				"tag_status":       aws.StringValue(v.TagStatus),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 type lifecyclePolicyRuleSelection struct {
-	TagStatus     *string   `locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
-	TagPrefixList []*string `locationName:"tagPrefixList" type:"list"`
-	CountType     *string   `locationName:"countType" type:"string" enum:"countType" required:"true"`
-	CountUnit     *string   `locationName:"countUnit" type:"string" enum:"countType"`
-	CountNumber   *int64    `locationName:"countNumber" min:"1" type:"integer"`
+	TagStatus      *string   `locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
+	TagPatternList []*string `locationName:"tagPatternList" type:"list"`
+	TagPrefixList  []*string `locationName:"tagPrefixList" type:"list"`
+	CountType      *string   `locationName:"countType" type:"string" enum:"countType" required:"true"`
+	CountUnit      *string   `locationName:"countUnit" type:"string" enum:"countType"`
+	CountNumber    *int64    `locationName:"countNumber" min:"1" type:"integer"`
 }
 
 type lifecyclePolicyRuleAction struct {
-	ActionType *string `locationName:"type" type:"string" required:"true"`
+	ActionType *string `json:"type" locationName:"type" type:"string" required:"true"`
 }
 
 type lifecyclePolicyRule struct {
@@ -219,6 +554,14 @@ func (lprs *lifecyclePolicyRuleSelection) reduce() {
 	if len(lprs.TagPrefixList) == 0 {
 		lprs.TagPrefixList = nil
 	}
+
+	sort.Slice(lprs.TagPatternList, func(i, j int) bool {
+		return aws.StringValue(lprs.TagPatternList[i]) < aws.StringValue(lprs.TagPatternList[j])
+	})
+
+	if len(lprs.TagPatternList) == 0 {
+		lprs.TagPatternList = nil
+	}
 }
 
 func equivalentLifecyclePolicyJSON(str1, str2 string) (bool, error) {
@@ -0,0 +1,129 @@
+package ecr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+// The policy document is built with jsonutil but read back with encoding/json,
+// so every field must decode from the key it is written with.
+func TestLifecyclePolicyRulesJSONRoundTrip(t *testing.T) {
+	tfList := []interface{}{
+		map[string]interface{}{
+			"action":      []interface{}{map[string]interface{}{"type": "expire"}},
+			"description": "Expire untagged images",
+			"priority":    1,
+			"selection": []interface{}{map[string]interface{}{
+				"count_number":     14,
+				"count_type":       "sinceImagePushed",
+				"count_unit":       "days",
+				"tag_pattern_list": []interface{}{},
+				"tag_prefix_list":  []interface{}{},
+				"tag_status":       "untagged",
+			}},
+		},
+	}
+
+	b, err := jsonutil.BuildJSON(&lifecyclePolicy{Rules: expandLifecyclePolicyRules(tfList)})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := string(b), `{"rules":[{"rulePriority":1,"description":"Expire untagged images","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`; got != want {
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	var lp lifecyclePolicy
+
+	if err := json.Unmarshal(b, &lp); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := flattenLifecyclePolicyRules(lp.Rules)
+	want := []interface{}{
+		map[string]interface{}{
+			"action":      []interface{}{map[string]interface{}{"type": "expire"}},
+			"description": "Expire untagged images",
+			"priority":    int64(1),
+			"selection": []interface{}{map[string]interface{}{
+				"count_number":     int64(14),
+				"count_type":       "sinceImagePushed",
+				"count_unit":       "days",
+				"tag_pattern_list": []string{},
+				"tag_prefix_list":  []string{},
+				"tag_status":       "untagged",
+			}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, expected %#v", got, want)
+	}
+}
+
+func TestValidateLifecyclePolicyJSON(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Policy      string
+		ExpectError bool
+	}{
+		{
+			Name:   "valid",
+			Policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"tagged","tagPrefixList":["v"],"countType":"imageCountMoreThan","countNumber":30},"action":{"type":"expire"}},{"rulePriority":2,"selection":{"tagStatus":"any","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`,
+		},
+		{
+			Name:        "no rules",
+			Policy:      `{"rules":[]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "missing priority",
+			Policy:      `{"rules":[{"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "missing action",
+			Policy:      `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1}}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "duplicate priority",
+			Policy:      `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}},{"rulePriority":1,"selection":{"tagStatus":"tagged","tagPrefixList":["v"],"countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "tagged without prefix",
+			Policy:      `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"tagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "missing count unit",
+			Policy:      `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countNumber":1},"action":{"type":"expire"}}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "any not last",
+			Policy:      `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}},{"rulePriority":2,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}]}`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateLifecyclePolicyJSON(testCase.Policy)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ecr_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -89,6 +90,84 @@ func TestAccECRLifecyclePolicy_detectDiff(t *testing.T) {
 	})
 }
 
+func TestAccECRLifecyclePolicy_rule(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_rule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"priority":                      "1",
+						"description":                   "Keep last 30 release images",
+						"action.0.type":                 "expire",
+						"selection.0.tag_status":        "tagged",
+						"selection.0.tag_prefix_list.#": "1",
+						"selection.0.tag_prefix_list.0": "v",
+						"selection.0.count_type":        "imageCountMoreThan",
+						"selection.0.count_number":      "30",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"priority":                 "2",
+						"action.0.type":            "expire",
+						"selection.0.tag_status":   "untagged",
+						"selection.0.count_type":   "sinceImagePushed",
+						"selection.0.count_unit":   "days",
+						"selection.0.count_number": "14",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"priority":               "10",
+						"selection.0.tag_status": "any",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicy_ruleValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyConfig_ruleDuplicatePriority(rName),
+				ExpectError: regexp.MustCompile(`rule priority 1 is used by more than one rule`),
+			},
+			{
+				Config:      testAccLifecyclePolicyConfig_ruleTaggedWithoutPrefix(rName),
+				ExpectError: regexp.MustCompile(`tag_prefix_list or tag_pattern_list must be set`),
+			},
+			{
+				Config:      testAccLifecyclePolicyConfig_ruleMissingCountUnit(rName),
+				ExpectError: regexp.MustCompile(`count_unit must be set when count_type is "sinceImagePushed"`),
+			},
+			{
+				Config:      testAccLifecyclePolicyConfig_ruleAnyNotLast(rName),
+				ExpectError: regexp.MustCompile(`must have the highest priority`),
+			},
+		},
+	})
+}
+
 func testAccCheckLifecyclePolicyDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
 
@@ -293,3 +372,193 @@ resource "aws_ecr_lifecycle_policy" "test" {
 }
 `, rName)
 }
+
+func testAccLifecyclePolicyConfig_rule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  rule {
+    priority = 10
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+
+  rule {
+    priority    = 1
+    description = "Keep last 30 release images"
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 30
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_number = 14
+      count_unit   = "days"
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLifecyclePolicyConfig_ruleDuplicatePriority(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 30
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLifecyclePolicyConfig_ruleTaggedWithoutPrefix(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "tagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 30
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLifecyclePolicyConfig_ruleMissingCountUnit(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_number = 14
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLifecyclePolicyConfig_ruleAnyNotLast(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,214 @@
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	organizationPullPolicyTypeRegistry   = "registry"
+	organizationPullPolicyTypeRepository = "repository"
+)
+
+func organizationPullPolicyType_Values() []string {
+	return []string{
+		organizationPullPolicyTypeRegistry,
+		organizationPullPolicyTypeRepository,
+	}
+}
+
+var (
+	// Actions needed to pull images from a repository.
+	organizationPullRepositoryActions = []string{
+		"ecr:BatchCheckLayerAvailability",
+		"ecr:BatchGetImage",
+		"ecr:GetDownloadUrlForLayer",
+	}
+	// Actions needed to pull images through pull through cache rules, which
+	// create repositories and import upstream images on first pull.
+	organizationPullRegistryActions = []string{
+		"ecr:BatchImportUpstreamImage",
+		"ecr:CreateRepository",
+	}
+)
+
+func DataSourceOrganizationPullPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOrganizationPullPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^ecr:[A-Za-z*]+$`), "must be an ECR action"),
+				},
+			},
+			"include_child_organizational_units": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[a-z0-9]{10,32}$`), "must be an AWS Organizations organization ID"),
+				ExactlyOneOf: []string{"organization_id", "organization_paths"},
+			},
+			"organization_paths": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[a-z0-9]{10,32}/r-[0-9a-z]{4,32}(/ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})*/?$`), "must be an AWS Organizations entity path, e.g. o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"),
+				},
+				ExactlyOneOf: []string{"organization_id", "organization_paths"},
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      organizationPullPolicyTypeRepository,
+				ValidateFunc: validation.StringInSlice(organizationPullPolicyType_Values(), false),
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"sid": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "OrganizationPull",
+			},
+		},
+	}
+}
+
+type organizationPullPolicyDocument struct {
+	Version   string
+	Statement []*organizationPullPolicyStatement
+}
+
+type organizationPullPolicyStatement struct {
+	Sid       string                         `json:",omitempty"`
+	Effect    string                         ``
+	Principal map[string]string              ``
+	Action    []string                       ``
+	Resource  string                         `json:",omitempty"`
+	Condition map[string]map[string][]string ``
+}
+
+func dataSourceOrganizationPullPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policyType := d.Get("policy_type").(string)
+	statement := &organizationPullPolicyStatement{
+		Sid:    d.Get("sid").(string),
+		Effect: "Allow",
+		Principal: map[string]string{
+			"AWS": "*",
+		},
+	}
+
+	if v, ok := d.GetOk("actions"); ok && v.(*schema.Set).Len() > 0 {
+		statement.Action = flex.ExpandStringValueSet(v.(*schema.Set))
+	} else if policyType == organizationPullPolicyTypeRegistry {
+		statement.Action = organizationPullRegistryActions
+	} else {
+		statement.Action = organizationPullRepositoryActions
+	}
+	sort.Strings(statement.Action)
+
+	// Registry policies apply to repositories in the registry and must name them.
+	// Repository policies apply to the repository they are attached to.
+	if policyType == organizationPullPolicyTypeRegistry {
+		client := meta.(*conns.AWSClient)
+		accountID := client.AccountID
+
+		if v, ok := d.GetOk("registry_id"); ok {
+			accountID = v.(string)
+		}
+
+		statement.Resource = arn.ARN{
+			Partition: client.Partition,
+			Service:   "ecr",
+			Region:    client.Region,
+			AccountID: accountID,
+			Resource:  "repository/*",
+		}.String()
+	}
+
+	if v, ok := d.GetOk("organization_id"); ok {
+		statement.Condition = map[string]map[string][]string{
+			"StringEquals": {
+				"aws:PrincipalOrgID": {v.(string)},
+			},
+		}
+	} else {
+		statement.Condition = map[string]map[string][]string{
+			"ForAnyValue:StringLike": {
+				"aws:PrincipalOrgPaths": expandOrganizationPaths(d.Get("organization_paths").(*schema.Set).List(), d.Get("include_child_organizational_units").(bool)),
+			},
+		}
+	}
+
+	policy := &organizationPullPolicyDocument{
+		Version:   "2012-10-17",
+		Statement: []*organizationPullPolicyStatement{statement},
+	}
+
+	jsonDoc, err := json.MarshalIndent(policy, "", "  ")
+
+	if err != nil {
+		return diag.Errorf("writing ECR organization pull policy document: %s", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+
+	return nil
+}
+
+// expandOrganizationPaths returns the aws:PrincipalOrgPaths condition values for
+// the given entity paths. Principal paths always end with "/", so a path must too
+// to match an organizational unit exactly, and a trailing "*" also matches
+// every organizational unit beneath it.
+func expandOrganizationPaths(tfList []interface{}, includeChildren bool) []string {
+	var paths []string
+
+	for _, v := range tfList {
+		path := v.(string)
+
+		if !strings.HasSuffix(path, "/") {
+			path += "/"
+		}
+
+		if includeChildren {
+			path += "*"
+		}
+
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
@@ -0,0 +1,172 @@
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECROrganizationPullPolicyDocumentDataSource_organizationID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_organization_pull_policy_document.test"
+	resourceName := "aws_ecr_repository_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationPullPolicyDocumentDataSourceConfig_organizationID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "OrganizationPull",
+    "Effect": "Allow",
+    "Principal": {"AWS": "*"},
+    "Action": [
+      "ecr:BatchCheckLayerAvailability",
+      "ecr:BatchGetImage",
+      "ecr:GetDownloadUrlForLayer"
+    ],
+    "Condition": {"StringEquals": {"aws:PrincipalOrgID": ["o-a1b2c3d4e5"]}}
+  }]
+}`),
+					resource.TestCheckResourceAttrPair(resourceName, "policy", dataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECROrganizationPullPolicyDocumentDataSource_organizationPaths(t *testing.T) {
+	dataSourceName := "data.aws_ecr_organization_pull_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationPullPolicyDocumentDataSourceConfig_organizationPaths(true),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "OrganizationPull",
+    "Effect": "Allow",
+    "Principal": {"AWS": "*"},
+    "Action": [
+      "ecr:BatchCheckLayerAvailability",
+      "ecr:BatchGetImage",
+      "ecr:GetDownloadUrlForLayer"
+    ],
+    "Condition": {"ForAnyValue:StringLike": {"aws:PrincipalOrgPaths": [
+      "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/*",
+      "o-a1b2c3d4e5/r-ab12/ou-ab12-22222222/*"
+    ]}}
+  }]
+}`),
+				),
+			},
+			{
+				Config: testAccOrganizationPullPolicyDocumentDataSourceConfig_organizationPaths(false),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "OrganizationPull",
+    "Effect": "Allow",
+    "Principal": {"AWS": "*"},
+    "Action": [
+      "ecr:BatchCheckLayerAvailability",
+      "ecr:BatchGetImage",
+      "ecr:GetDownloadUrlForLayer"
+    ],
+    "Condition": {"ForAnyValue:StringLike": {"aws:PrincipalOrgPaths": [
+      "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/",
+      "o-a1b2c3d4e5/r-ab12/ou-ab12-22222222/"
+    ]}}
+  }]
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECROrganizationPullPolicyDocumentDataSource_registry(t *testing.T) {
+	dataSourceName := "data.aws_ecr_organization_pull_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationPullPolicyDocumentDataSourceConfig_registry,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "RegistryPull",
+    "Effect": "Allow",
+    "Principal": {"AWS": "*"},
+    "Action": [
+      "ecr:BatchImportUpstreamImage",
+      "ecr:CreateRepository"
+    ],
+    "Resource": "arn:%[1]s:ecr:%[2]s:123456789012:repository/*",
+    "Condition": {"StringEquals": {"aws:PrincipalOrgID": ["o-a1b2c3d4e5"]}}
+  }]
+}`, acctest.Partition(), acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationPullPolicyDocumentDataSourceConfig_organizationID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+data "aws_ecr_organization_pull_policy_document" "test" {
+  organization_id = "o-a1b2c3d4e5"
+}
+
+resource "aws_ecr_repository_policy" "test" {
+  repository = aws_ecr_repository.test.name
+  policy     = data.aws_ecr_organization_pull_policy_document.test.json
+}
+`, rName)
+}
+
+func testAccOrganizationPullPolicyDocumentDataSourceConfig_organizationPaths(includeChildren bool) string {
+	return fmt.Sprintf(`
+data "aws_ecr_organization_pull_policy_document" "test" {
+  organization_paths = [
+    "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111",
+    "o-a1b2c3d4e5/r-ab12/ou-ab12-22222222/",
+  ]
+
+  include_child_organizational_units = %[1]t
+}
+`, includeChildren)
+}
+
+const testAccOrganizationPullPolicyDocumentDataSourceConfig_registry = `
+data "aws_ecr_organization_pull_policy_document" "test" {
+  organization_id = "o-a1b2c3d4e5"
+  policy_type     = "registry"
+  registry_id     = "123456789012"
+  sid             = "RegistryPull"
+}
+`
@@ -9,16 +9,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourcePullThroughCacheRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePullThroughCacheRuleCreate,
 		ReadContext:   resourcePullThroughCacheRuleRead,
+		UpdateContext: resourcePullThroughCacheRuleUpdate,
 		DeleteContext: resourcePullThroughCacheRuleDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -26,15 +29,20 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"credential_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARNOfService("secretsmanager", "secret"),
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.All(
-					validation.StringLenBetween(2, 20),
+					validation.StringLenBetween(2, 30),
 					validation.StringMatch(
-						regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`),
-						"must only include alphanumeric, underscore, period, or hyphen characters"),
+						regexp.MustCompile(`^(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*$`),
+						"must only include alphanumeric, underscore, period, hyphen, or slash characters"),
 				),
 			},
 			"registry_id": {
@@ -47,6 +55,11 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		// The credential of an existing rule can be changed, but not added or removed.
+		CustomizeDiff: customdiff.ForceNewIfChange("credential_arn", func(_ context.Context, old, new, meta interface{}) bool {
+			return old.(string) == "" || new.(string) == ""
+		}),
 	}
 }
 
@@ -59,6 +72,10 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 		UpstreamRegistryUrl: aws.String(d.Get("upstream_registry_url").(string)),
 	}
 
+	if v, ok := d.GetOk("credential_arn"); ok {
+		input.CredentialArn = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Creating ECR Pull Through Cache Rule: %s", input)
 	_, err := conn.CreatePullThroughCacheRuleWithContext(ctx, input)
 
@@ -86,6 +103,7 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("error reading ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
 	}
 
+	d.Set("credential_arn", rule.CredentialArn)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
@@ -93,6 +111,25 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func resourcePullThroughCacheRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	input := &ecr.UpdatePullThroughCacheRuleInput{
+		CredentialArn:       aws.String(d.Get("credential_arn").(string)),
+		EcrRepositoryPrefix: aws.String(d.Id()),
+		RegistryId:          aws.String(d.Get("registry_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating ECR Pull Through Cache Rule: %s", input)
+	_, err := conn.UpdatePullThroughCacheRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourcePullThroughCacheRuleRead(ctx, d, meta)
+}
+
 func resourcePullThroughCacheRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECRConn
 
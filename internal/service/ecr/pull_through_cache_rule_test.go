@@ -66,6 +66,68 @@ func TestAccECRPullThroughCacheRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccECRPullThroughCacheRule_credentialARN(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialARN(rName, repositoryPrefix, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "ecr_repository_prefix", repositoryPrefix),
+					resource.TestCheckResourceAttr(resourceName, "upstream_registry_url", "registry-1.docker.io"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialARN(rName, repositoryPrefix, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRPullThroughCacheRule_repositoryPrefixWithSlash(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(4) + "/cache"
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig_basic(repositoryPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ecr_repository_prefix", repositoryPrefix),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccECRPullThroughCacheRule_failWhenAlreadyExists(t *testing.T) {
 	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
 	resourceName := "aws_ecr_pull_through_cache_rule.test"
@@ -164,3 +226,41 @@ resource "aws_ecr_pull_through_cache_rule" "duplicate" {
 }
 `, repositoryPrefix)
 }
+
+func testAccPullThroughCacheRuleConfig_credentialARN(rName, repositoryPrefix, secret string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test1" {
+  name                    = "ecr-pullthroughcache/%[1]s-1"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test1" {
+  secret_id = aws_secretsmanager_secret.test1.id
+  secret_string = jsonencode({
+    username    = "tf-test"
+    accessToken = "test1"
+  })
+}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name                    = "ecr-pullthroughcache/%[1]s-2"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id = aws_secretsmanager_secret.test2.id
+  secret_string = jsonencode({
+    username    = "tf-test"
+    accessToken = "test2"
+  })
+}
+
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[2]q
+  upstream_registry_url = "registry-1.docker.io"
+  credential_arn        = aws_secretsmanager_secret.%[3]s.arn
+
+  depends_on = [aws_secretsmanager_secret_version.test1, aws_secretsmanager_secret_version.test2]
+}
+`, rName, repositoryPrefix, secret)
+}
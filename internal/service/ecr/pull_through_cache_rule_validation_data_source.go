@@ -0,0 +1,74 @@
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourcePullThroughCacheRuleValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePullThroughCacheRuleValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"credential_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ecr_repository_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"failure": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"upstream_registry_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePullThroughCacheRuleValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	repositoryPrefix := d.Get("ecr_repository_prefix").(string)
+	input := &ecr.ValidatePullThroughCacheRuleInput{
+		EcrRepositoryPrefix: aws.String(repositoryPrefix),
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	output, err := conn.ValidatePullThroughCacheRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error validating ECR Pull Through Cache Rule (%s): %s", repositoryPrefix, err)
+	}
+
+	d.SetId(repositoryPrefix)
+	d.Set("credential_arn", output.CredentialArn)
+	d.Set("ecr_repository_prefix", output.EcrRepositoryPrefix)
+	d.Set("failure", output.Failure)
+	d.Set("is_valid", output.IsValid)
+	d.Set("registry_id", output.RegistryId)
+	d.Set("upstream_registry_url", output.UpstreamRegistryUrl)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package ecr_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRPullThroughCacheRuleValidationDataSource_basic(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_pull_through_cache_rule_validation.test"
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleValidationDataSourceConfig_basic(rName, repositoryPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "credential_arn", resourceName, "credential_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ecr_repository_prefix", resourceName, "ecr_repository_prefix"),
+					// The upstream registry rejects the placeholder credentials.
+					resource.TestCheckResourceAttr(dataSourceName, "is_valid", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "failure"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "registry_id", resourceName, "registry_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "upstream_registry_url", resourceName, "upstream_registry_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPullThroughCacheRuleValidationDataSourceConfig_basic(rName, repositoryPrefix string) string {
+	return acctest.ConfigCompose(testAccPullThroughCacheRuleConfig_credentialARN(rName, repositoryPrefix, "test1"), `
+data "aws_ecr_pull_through_cache_rule_validation" "test" {
+  ecr_repository_prefix = aws_ecr_pull_through_cache_rule.test.ecr_repository_prefix
+}
+`)
+}
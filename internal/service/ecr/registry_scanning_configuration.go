@@ -1,27 +1,34 @@
 package ecr
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	inspector2types "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 func ResourceRegistryScanningConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRegistryScanningConfigurationPut,
-		Read:   resourceRegistryScanningConfigurationRead,
-		Update: resourceRegistryScanningConfigurationPut,
-		Delete: resourceRegistryScanningConfigurationDelete,
+		CreateWithoutTimeout: resourceRegistryScanningConfigurationPut,
+		ReadWithoutTimeout:   resourceRegistryScanningConfigurationRead,
+		UpdateWithoutTimeout: resourceRegistryScanningConfigurationPut,
+		DeleteWithoutTimeout: resourceRegistryScanningConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -45,7 +52,7 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 										Required: true,
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(1, 256),
-											validation.StringMatch(regexp.MustCompile(`^[a-z0-9*](?:[._\-/a-z0-9*]?[a-z0-9*]+)*$`), "must contain only lowercase alphanumeric, dot, underscore, hyphen, wildcard, and colon characters"),
+											validation.StringMatch(regexp.MustCompile(`^[a-z0-9*](?:[._\-/a-z0-9*]?[a-z0-9*]+)*$`), "must contain only lowercase alphanumeric, dot, underscore, hyphen, slash, and wildcard characters"),
 										),
 									},
 									"filter_type": {
@@ -64,16 +71,49 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"rescan_duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[inspector2types.EcrRescanDuration](),
+			},
+			"rescan_duration_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"scan_type": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(ecr.ScanType_Values(), false),
 			},
+			"scanning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceRegistryScanningConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("scan_type").(string) != ecr.ScanTypeBasic {
+		return nil
+	}
+
+	// Continuous scanning and re-scan durations are only available with enhanced (Amazon Inspector) scanning.
+	for _, rule := range diff.Get("rule").(*schema.Set).List() {
+		if v := rule.(map[string]interface{})["scan_frequency"].(string); v == ecr.ScanFrequencyContinuousScan {
+			return fmt.Errorf("scan_frequency %q requires scan_type %q", v, ecr.ScanTypeEnhanced)
+		}
+	}
+
+	if diff.HasChange("rescan_duration") && diff.Get("rescan_duration").(string) != "" {
+		return fmt.Errorf("rescan_duration requires scan_type %q", ecr.ScanTypeEnhanced)
+	}
+
+	return nil
+}
+
+func resourceRegistryScanningConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECRConn
 
 	input := ecr.PutRegistryScanningConfigurationInput{
@@ -81,44 +121,98 @@ func resourceRegistryScanningConfigurationPut(d *schema.ResourceData, meta inter
 		Rules:    expandScanningRegistryRules(d.Get("rule").(*schema.Set).List()),
 	}
 
-	_, err := conn.PutRegistryScanningConfiguration(&input)
+	_, err := conn.PutRegistryScanningConfigurationWithContext(ctx, &input)
 
 	if err != nil {
-		return fmt.Errorf("error creating ECR Registry Scanning Configuration: %w", err)
+		return diag.Errorf("error creating ECR Registry Scanning Configuration: %s", err)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).AccountID)
 
-	return resourceRegistryScanningConfigurationRead(d, meta)
+	if v, ok := d.GetOk("rescan_duration"); ok && d.HasChange("rescan_duration") {
+		_, err := meta.(*conns.AWSClient).Inspector2Conn.UpdateConfiguration(ctx, &inspector2.UpdateConfigurationInput{
+			EcrConfiguration: &inspector2types.EcrConfiguration{
+				RescanDuration: inspector2types.EcrRescanDuration(v.(string)),
+			},
+		})
+
+		if err != nil {
+			return diag.Errorf("error updating ECR Registry Scanning Configuration (%s) re-scan duration: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRegistryScanningConfigurationRead(ctx, d, meta)
 }
 
-func resourceRegistryScanningConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceRegistryScanningConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECRConn
 
-	out, err := conn.GetRegistryScanningConfiguration(&ecr.GetRegistryScanningConfigurationInput{})
+	out, err := conn.GetRegistryScanningConfigurationWithContext(ctx, &ecr.GetRegistryScanningConfigurationInput{})
 
 	if err != nil {
-		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading ECR Registry Scanning Configuration (%s): %s", d.Id(), err)
 	}
 
+	scanType := aws.StringValue(out.ScanningConfiguration.ScanType)
+
 	d.Set("registry_id", out.RegistryId)
-	d.Set("scan_type", out.ScanningConfiguration.ScanType)
+	d.Set("scan_type", scanType)
 	d.Set("rule", flattenScanningConfigurationRules(out.ScanningConfiguration.Rules))
 
+	// Enhanced scanning is backed by Amazon Inspector; basic scanning has no Inspector state to report.
+	if scanType != ecr.ScanTypeEnhanced {
+		d.Set("rescan_duration", nil)
+		d.Set("rescan_duration_status", nil)
+		d.Set("scanning_status", nil)
+
+		return nil
+	}
+
+	inspectorConn := meta.(*conns.AWSClient).Inspector2Conn
+
+	config, err := inspectorConn.GetConfiguration(ctx, &inspector2.GetConfigurationInput{})
+
+	if err != nil {
+		return diag.Errorf("error reading ECR Registry Scanning Configuration (%s) re-scan duration: %s", d.Id(), err)
+	}
+
+	if config.EcrConfiguration != nil && config.EcrConfiguration.RescanDurationState != nil {
+		d.Set("rescan_duration", config.EcrConfiguration.RescanDurationState.RescanDuration)
+		d.Set("rescan_duration_status", config.EcrConfiguration.RescanDurationState.Status)
+	} else {
+		d.Set("rescan_duration", nil)
+		d.Set("rescan_duration_status", nil)
+	}
+
+	status, err := inspectorConn.BatchGetAccountStatus(ctx, &inspector2.BatchGetAccountStatusInput{
+		AccountIds: []string{d.Id()},
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading ECR Registry Scanning Configuration (%s) scanning status: %s", d.Id(), err)
+	}
+
+	d.Set("scanning_status", nil)
+	for _, account := range status.Accounts {
+		if account.ResourceState != nil && account.ResourceState.Ecr != nil {
+			d.Set("scanning_status", account.ResourceState.Ecr.Status)
+		}
+	}
+
 	return nil
 }
 
-func resourceRegistryScanningConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceRegistryScanningConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECRConn
 
 	log.Printf("[DEBUG] Deleting ECR Registry Scanning Configuration: (%s)", d.Id())
-	_, err := conn.PutRegistryScanningConfiguration(&ecr.PutRegistryScanningConfigurationInput{
+	_, err := conn.PutRegistryScanningConfigurationWithContext(ctx, &ecr.PutRegistryScanningConfigurationInput{
 		Rules:    []*ecr.RegistryScanningRule{},
 		ScanType: aws.String(ecr.ScanTypeBasic),
 	})
 
 	if err != nil {
-		return fmt.Errorf("error deleting ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
+		return diag.Errorf("error deleting ECR Registry Scanning Configuration (%s): %s", d.Id(), err)
 	}
 
 	return nil
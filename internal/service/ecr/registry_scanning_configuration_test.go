@@ -2,6 +2,7 @@ package ecr_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -13,8 +14,10 @@ import (
 
 func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	testFuncs := map[string]func(t *testing.T){
-		"basic":  testAccRegistryScanningConfiguration_basic,
-		"update": testAccRegistryScanningConfiguration_update,
+		"basic":                       testAccRegistryScanningConfiguration_basic,
+		"update":                      testAccRegistryScanningConfiguration_update,
+		"rescanDuration":              testAccRegistryScanningConfiguration_rescanDuration,
+		"basicWithContinuousScanning": testAccRegistryScanningConfiguration_basicWithContinuousScanning,
 	}
 
 	for name, testFunc := range testFuncs {
@@ -111,6 +114,57 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	})
 }
 
+func testAccRegistryScanningConfiguration_rescanDuration(t *testing.T) {
+	var v ecr.GetRegistryScanningConfigurationOutput
+	resourceName := "aws_ecr_registry_scanning_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningConfigurationConfig_rescanDuration("DAYS_30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegistryScanningConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rescan_duration", "DAYS_30"),
+					resource.TestCheckResourceAttrSet(resourceName, "rescan_duration_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "scanning_status"),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegistryScanningConfigurationConfig_rescanDuration("LIFETIME"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccRegistryScanningConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rescan_duration", "LIFETIME"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegistryScanningConfiguration_basicWithContinuousScanning(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccRegistryScanningConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_basicWithContinuousScanning(),
+				ExpectError: regexp.MustCompile(`scan_frequency "CONTINUOUS_SCAN" requires scan_type "ENHANCED"`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
 
@@ -198,3 +252,36 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 }
 `
 }
+
+func testAccRegistryScanningConfigurationConfig_rescanDuration(rescanDuration string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type       = "ENHANCED"
+  rescan_duration = %[1]q
+
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`, rescanDuration)
+}
+
+func testAccRegistryScanningConfigurationConfig_basicWithContinuousScanning() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
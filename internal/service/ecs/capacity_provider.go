@@ -38,7 +38,6 @@ func ResourceCapacityProvider() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"auto_scaling_group_arn": {
@@ -47,6 +46,12 @@ func ResourceCapacityProvider() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"managed_draining": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ecs.ManagedDraining_Values(), false),
+						},
 						"managed_scaling": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -302,6 +307,10 @@ func expandAutoScalingGroupProviderCreate(configured interface{}) *ecs.AutoScali
 	arn := p["auto_scaling_group_arn"].(string)
 	prov.AutoScalingGroupArn = aws.String(arn)
 
+	if v := p["managed_draining"].(string); len(v) > 0 {
+		prov.ManagedDraining = aws.String(v)
+	}
+
 	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
@@ -323,6 +332,10 @@ func expandAutoScalingGroupProviderUpdate(configured interface{}) *ecs.AutoScali
 	prov := ecs.AutoScalingGroupProviderUpdate{}
 	p := configured.([]interface{})[0].(map[string]interface{})
 
+	if v := p["managed_draining"].(string); len(v) > 0 {
+		prov.ManagedDraining = aws.String(v)
+	}
+
 	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
@@ -371,6 +384,7 @@ func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []m
 
 	p := map[string]interface{}{
 		"auto_scaling_group_arn":         aws.StringValue(provider.AutoScalingGroupArn),
+		"managed_draining":               aws.StringValue(provider.ManagedDraining),
 		"managed_termination_protection": aws.StringValue(provider.ManagedTerminationProtection),
 		"managed_scaling":                []map[string]interface{}{},
 	}
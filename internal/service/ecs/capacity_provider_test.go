@@ -34,6 +34,7 @@ func TestAccECSCapacityProvider_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_group_provider.0.auto_scaling_group_arn", "aws_autoscaling_group.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_draining", "ENABLED"),
 					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_termination_protection", "DISABLED"),
 					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.instance_warmup_period", "300"),
 					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.minimum_scaling_step_size", "1"),
@@ -75,6 +76,41 @@ func TestAccECSCapacityProvider_disappears(t *testing.T) {
 	})
 }
 
+func TestAccECSCapacityProvider_managedDraining(t *testing.T) {
+	var provider ecs.CapacityProvider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_capacity_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityProviderConfig_managedDraining(rName, ecs.ManagedDrainingDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_draining", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityProviderConfig_managedDraining(rName, ecs.ManagedDrainingEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_draining", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSCapacityProvider_managedScaling(t *testing.T) {
 	var provider ecs.CapacityProvider
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -315,6 +351,19 @@ resource "aws_ecs_capacity_provider" "test" {
 `, rName)
 }
 
+func testAccCapacityProviderConfig_managedDraining(rName, managedDraining string) string {
+	return testAccCapacityProviderBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+    managed_draining       = %[2]q
+  }
+}
+`, rName, managedDraining)
+}
+
 func testAccCapacityProviderConfig_managedScaling(rName, status string, warmup, max, min, cap int) string {
 	return testAccCapacityProviderBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_ecs_capacity_provider" "test" {
@@ -306,6 +306,149 @@ func ResourceService() *schema.Resource {
 				Default:      ecs.SchedulingStrategyReplica,
 				ValidateFunc: validation.StringInSlice(ecs.SchedulingStrategy_Values(), false),
 			},
+			"service_connect_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_option": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value_from": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_alias": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dns_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"discovery_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"ingress_port_override": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"port_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"timeout": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"idle_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 2147483647),
+												},
+												"per_request_timeout_seconds": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 2147483647),
+												},
+											},
+										},
+									},
+									"tls": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"issuer_cert_authority": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"aws_pca_authority_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARNOfService("acm-pca", "certificate-authority"),
+															},
+														},
+													},
+												},
+												"kms_key": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARNOfService("iam", "role"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -505,6 +648,10 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.PlacementConstraints = pc
 	}
 
+	if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServiceConnectConfiguration = expandServiceConnectConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	serviceRegistries := d.Get("service_registries").([]interface{})
 	if len(serviceRegistries) > 0 {
 		srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
@@ -558,7 +705,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	cluster := d.Get("cluster").(string)
 
 	if d.Get("wait_for_steady_state").(bool) {
-		if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitServiceStable(conn, d.Id(), cluster, primaryDeploymentID(output.Service), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after creation: %w", d.Id(), err)
 		}
 	} else {
@@ -697,6 +844,23 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting network_configuration for (%s): %w", d.Id(), err)
 	}
 
+	// The service connect configuration is only returned on the service's deployments.
+	// A disabled configuration is left on the deployment after the block is removed.
+	if deployment := findPrimaryDeployment(service.Deployments); deployment != nil && deployment.ServiceConnectConfiguration != nil && (aws.BoolValue(deployment.ServiceConnectConfiguration.Enabled) || len(d.Get("service_connect_configuration").([]interface{})) > 0) {
+		tfMap := flattenServiceConnectConfiguration(deployment.ServiceConnectConfiguration)
+
+		// The namespace is returned as an ARN. Keep the configured value if it was specified by name.
+		if v, ok := d.GetOk("service_connect_configuration.0.namespace"); ok && !arn.IsARN(v.(string)) && arn.IsARN(aws.StringValue(deployment.ServiceConnectConfiguration.Namespace)) {
+			tfMap["namespace"] = v.(string)
+		}
+
+		if err := d.Set("service_connect_configuration", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("error setting service_connect_configuration for (%s): %w", d.Id(), err)
+		}
+	} else {
+		d.Set("service_connect_configuration", nil)
+	}
+
 	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
 		return fmt.Errorf("error setting service_registries for (%s): %w", d.Id(), err)
 	}
@@ -941,6 +1105,256 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 	return results
 }
 
+func expandServiceConnectConfiguration(tfMap map[string]interface{}) *ecs.ServiceConnectConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.ServiceConnectConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandServiceConnectLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Services = expandServiceConnectServices(v)
+	}
+
+	return apiObject
+}
+
+func expandServiceConnectLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.SecretOptions = append(apiObject.SecretOptions, &ecs.Secret{
+				Name:      aws.String(tfMap["name"].(string)),
+				ValueFrom: aws.String(tfMap["value_from"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandServiceConnectServices(tfList []interface{}) []*ecs.ServiceConnectService {
+	var apiObjects []*ecs.ServiceConnectService
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceConnectService{
+			PortName: aws.String(tfMap["port_name"].(string)),
+		}
+
+		if v, ok := tfMap["client_alias"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				clientAlias := &ecs.ServiceConnectClientAlias{
+					Port: aws.Int64(int64(tfMap["port"].(int))),
+				}
+
+				if v, ok := tfMap["dns_name"].(string); ok && v != "" {
+					clientAlias.DnsName = aws.String(v)
+				}
+
+				apiObject.ClientAliases = append(apiObject.ClientAliases, clientAlias)
+			}
+		}
+
+		if v, ok := tfMap["discovery_name"].(string); ok && v != "" {
+			apiObject.DiscoveryName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ingress_port_override"].(int); ok && v != 0 {
+			apiObject.IngressPortOverride = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["timeout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Timeout = &ecs.TimeoutConfiguration{}
+
+			if v, ok := tfMap["idle_timeout_seconds"].(int); ok && v != 0 {
+				apiObject.Timeout.IdleTimeoutSeconds = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["per_request_timeout_seconds"].(int); ok && v != 0 {
+				apiObject.Timeout.PerRequestTimeoutSeconds = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["tls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Tls = expandServiceConnectTLSConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServiceConnectTLSConfiguration(tfMap map[string]interface{}) *ecs.ServiceConnectTlsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.ServiceConnectTlsConfiguration{}
+
+	if v, ok := tfMap["issuer_cert_authority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IssuerCertificateAuthority = &ecs.ServiceConnectTlsCertificateAuthority{
+			AwsPcaAuthorityArn: aws.String(v[0].(map[string]interface{})["aws_pca_authority_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["kms_key"].(string); ok && v != "" {
+		apiObject.KmsKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenServiceConnectConfiguration(apiObject *ecs.ServiceConnectConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":   aws.BoolValue(apiObject.Enabled),
+		"namespace": aws.StringValue(apiObject.Namespace),
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []interface{}{flattenServiceConnectLogConfiguration(v)}
+	}
+
+	if v := apiObject.Services; len(v) > 0 {
+		tfMap["service"] = flattenServiceConnectServices(v)
+	}
+
+	return tfMap
+}
+
+func flattenServiceConnectLogConfiguration(apiObject *ecs.LogConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"log_driver": aws.StringValue(apiObject.LogDriver),
+		"options":    aws.StringValueMap(apiObject.Options),
+	}
+
+	var secretOptions []interface{}
+
+	for _, apiObject := range apiObject.SecretOptions {
+		if apiObject == nil {
+			continue
+		}
+
+		secretOptions = append(secretOptions, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	tfMap["secret_option"] = secretOptions
+
+	return tfMap
+}
+
+func flattenServiceConnectServices(apiObjects []*ecs.ServiceConnectService) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"discovery_name":        aws.StringValue(apiObject.DiscoveryName),
+			"ingress_port_override": aws.Int64Value(apiObject.IngressPortOverride),
+			"port_name":             aws.StringValue(apiObject.PortName),
+		}
+
+		var clientAliases []interface{}
+
+		for _, apiObject := range apiObject.ClientAliases {
+			if apiObject == nil {
+				continue
+			}
+
+			clientAliases = append(clientAliases, map[string]interface{}{
+				"dns_name": aws.StringValue(apiObject.DnsName),
+				"port":     aws.Int64Value(apiObject.Port),
+			})
+		}
+
+		tfMap["client_alias"] = clientAliases
+
+		if v := apiObject.Timeout; v != nil {
+			tfMap["timeout"] = []interface{}{map[string]interface{}{
+				"idle_timeout_seconds":        aws.Int64Value(v.IdleTimeoutSeconds),
+				"per_request_timeout_seconds": aws.Int64Value(v.PerRequestTimeoutSeconds),
+			}}
+		}
+
+		if v := apiObject.Tls; v != nil {
+			tls := map[string]interface{}{
+				"kms_key":  aws.StringValue(v.KmsKey),
+				"role_arn": aws.StringValue(v.RoleArn),
+			}
+
+			if v := v.IssuerCertificateAuthority; v != nil {
+				tls["issuer_cert_authority"] = []interface{}{map[string]interface{}{
+					"aws_pca_authority_arn": aws.StringValue(v.AwsPcaAuthorityArn),
+				}}
+			}
+
+			tfMap["tls"] = []interface{}{tls}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ECSConn
 
@@ -1055,14 +1469,27 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.PropagateTags = aws.String(d.Get("propagate_tags").(string))
 		}
 
+		if d.HasChange("service_connect_configuration") {
+			// To turn off Service Connect, specify a disabled configuration.
+			input.ServiceConnectConfiguration = &ecs.ServiceConnectConfiguration{
+				Enabled: aws.Bool(false),
+			}
+
+			if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ServiceConnectConfiguration = expandServiceConnectConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("service_registries") {
 			input.ServiceRegistries = expandServiceRegistries(d.Get("service_registries").([]interface{}))
 		}
 
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
+		var output *ecs.UpdateServiceOutput
 		err := resource.Retry(propagationTimeout+serviceUpdateTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateService(input)
+			var err error
+			output, err = conn.UpdateService(input)
 
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
@@ -1079,7 +1506,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateService(input)
+			output, err = conn.UpdateService(input)
 		}
 
 		if err != nil {
@@ -1088,7 +1515,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		cluster := d.Get("cluster").(string)
 		if d.Get("wait_for_steady_state").(bool) {
-			if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitServiceStable(conn, d.Id(), cluster, primaryDeploymentID(output.Service), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after update: %w", d.Id(), err)
 			}
 		} else {
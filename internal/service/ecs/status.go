@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -20,6 +21,8 @@ const (
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
+	deploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
@@ -71,7 +74,9 @@ func statusServiceNoTags(conn *ecs.ECS, id, cluster string) resource.StateRefres
 	}
 }
 
-func statusServiceWaitForStable(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc {
+func statusServiceWaitForStable(conn *ecs.ECS, id, cluster, primaryDeploymentID string) resource.StateRefreshFunc {
+	stableStatus := serviceStableStatus(primaryDeploymentID)
+
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(conn, id, cluster)()
 		if err != nil {
@@ -84,16 +89,98 @@ func statusServiceWaitForStable(conn *ecs.ECS, id, cluster string) resource.Stat
 
 		service := serviceRaw.(*ecs.Service)
 
+		status, err = stableStatus(service)
+
+		if err != nil {
+			return service, "", err
+		}
+
+		return service, status, nil
+	}
+}
+
+// serviceStableStatus returns a function that computes the stability status of successive reads of an ACTIVE service.
+// primaryDeploymentID is the PRIMARY deployment returned by CreateService or UpdateService. If the deployment circuit
+// breaker marks it as failed, or it is replaced by a rollback, the service may still reach a steady state
+// but the deployment Terraform asked for did not succeed.
+// Reads straight after CreateService or UpdateService may be stale and not yet include the deployment,
+// so the service is pending until the deployment has been seen.
+func serviceStableStatus(primaryDeploymentID string) func(*ecs.Service) (string, error) {
+	var deploymentSeen bool
+
+	return func(service *ecs.Service) (string, error) {
+		if primaryDeploymentID != "" {
+			if !deploymentSeen {
+				for _, deployment := range service.Deployments {
+					if aws.StringValue(deployment.Id) == primaryDeploymentID {
+						deploymentSeen = true
+						break
+					}
+				}
+			}
+
+			if !deploymentSeen {
+				return serviceStatusPending, nil
+			}
+
+			if err := checkDeploymentNotRolledBack(service.Deployments, primaryDeploymentID); err != nil {
+				return "", err
+			}
+		}
+
 		if d, dc, rc := len(service.Deployments),
 			aws.Int64Value(service.DesiredCount),
 			aws.Int64Value(service.RunningCount); d == 1 && dc == rc {
-			status = serviceStatusStable
-		} else {
-			status = serviceStatusPending
+			return serviceStatusStable, nil
 		}
 
-		return service, status, nil
+		return serviceStatusPending, nil
+	}
+}
+
+func findPrimaryDeployment(deployments []*ecs.Deployment) *ecs.Deployment {
+	for _, deployment := range deployments {
+		if aws.StringValue(deployment.Status) == deploymentStatusPrimary {
+			return deployment
+		}
 	}
+
+	return nil
+}
+
+// primaryDeploymentID returns the ID of the service's PRIMARY deployment, or "" if there is none.
+func primaryDeploymentID(service *ecs.Service) string {
+	if service == nil {
+		return ""
+	}
+
+	if deployment := findPrimaryDeployment(service.Deployments); deployment != nil {
+		return aws.StringValue(deployment.Id)
+	}
+
+	return ""
+}
+
+// checkDeploymentNotRolledBack returns an error if the specified deployment has failed or has been
+// superseded by another primary deployment (e.g. a circuit breaker rollback).
+func checkDeploymentNotRolledBack(deployments []*ecs.Deployment, id string) error {
+	for _, deployment := range deployments {
+		if aws.StringValue(deployment.Id) != id {
+			continue
+		}
+
+		if aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+			return fmt.Errorf("deployment (%s) failed: %s", id, aws.StringValue(deployment.RolloutStateReason))
+		}
+
+		return nil
+	}
+
+	if primary := findPrimaryDeployment(deployments); primary != nil {
+		return fmt.Errorf("deployment (%s) was replaced by deployment (%s), likely due to a rollback: %s", id, aws.StringValue(primary.Id), aws.StringValue(primary.RolloutStateReason))
+	}
+
+	return nil
 }
 
 func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
@@ -0,0 +1,197 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestCheckDeploymentNotRolledBack(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Deployments []*ecs.Deployment
+		ID          string
+		ExpectError bool
+	}{
+		{
+			Name: "in progress",
+			Deployments: []*ecs.Deployment{
+				{
+					Id:           aws.String("ecs-svc/1"),
+					Status:       aws.String(deploymentStatusPrimary),
+					RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress),
+				},
+				{
+					Id:           aws.String("ecs-svc/0"),
+					Status:       aws.String("ACTIVE"),
+					RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+				},
+			},
+			ID: "ecs-svc/1",
+		},
+		{
+			Name: "completed",
+			Deployments: []*ecs.Deployment{
+				{
+					Id:           aws.String("ecs-svc/1"),
+					Status:       aws.String(deploymentStatusPrimary),
+					RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+				},
+			},
+			ID: "ecs-svc/1",
+		},
+		{
+			Name: "failed",
+			Deployments: []*ecs.Deployment{
+				{
+					Id:                 aws.String("ecs-svc/0"),
+					Status:             aws.String(deploymentStatusPrimary),
+					RolloutState:       aws.String(ecs.DeploymentRolloutStateInProgress),
+					RolloutStateReason: aws.String("ECS deployment circuit breaker: rolling back to deploymentId ecs-svc/0."),
+				},
+				{
+					Id:                 aws.String("ecs-svc/1"),
+					Status:             aws.String("ACTIVE"),
+					RolloutState:       aws.String(ecs.DeploymentRolloutStateFailed),
+					RolloutStateReason: aws.String("ECS deployment circuit breaker: task failed to start."),
+				},
+			},
+			ID:          "ecs-svc/1",
+			ExpectError: true,
+		},
+		{
+			Name: "rolled back",
+			Deployments: []*ecs.Deployment{
+				{
+					Id:           aws.String("ecs-svc/2"),
+					Status:       aws.String(deploymentStatusPrimary),
+					RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+				},
+			},
+			ID:          "ecs-svc/1",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := checkDeploymentNotRolledBack(testCase.Deployments, testCase.ID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestServiceStableStatus(t *testing.T) {
+	oldDeployment := &ecs.Deployment{
+		Id:           aws.String("ecs-svc/0"),
+		Status:       aws.String(deploymentStatusPrimary),
+		RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+	}
+	newDeployment := &ecs.Deployment{
+		Id:           aws.String("ecs-svc/1"),
+		Status:       aws.String(deploymentStatusPrimary),
+		RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress),
+	}
+	completedDeployment := &ecs.Deployment{
+		Id:           aws.String("ecs-svc/1"),
+		Status:       aws.String(deploymentStatusPrimary),
+		RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+	}
+	activeDeployment := &ecs.Deployment{
+		Id:           aws.String("ecs-svc/0"),
+		Status:       aws.String("ACTIVE"),
+		RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+	}
+	rollbackDeployment := &ecs.Deployment{
+		Id:                 aws.String("ecs-svc/2"),
+		Status:             aws.String(deploymentStatusPrimary),
+		RolloutState:       aws.String(ecs.DeploymentRolloutStateCompleted),
+		RolloutStateReason: aws.String("ECS deployment circuit breaker: rolling back to deploymentId ecs-svc/0."),
+	}
+
+	service := func(deployments ...*ecs.Deployment) *ecs.Service {
+		return &ecs.Service{
+			Deployments:  deployments,
+			DesiredCount: aws.Int64(1),
+			RunningCount: aws.Int64(1),
+		}
+	}
+
+	testCases := []struct {
+		Name           string
+		Reads          []*ecs.Service
+		ExpectedStatus string
+		ExpectError    bool
+	}{
+		{
+			Name: "stale first read",
+			Reads: []*ecs.Service{
+				service(oldDeployment),
+			},
+			ExpectedStatus: serviceStatusPending,
+		},
+		{
+			Name: "stale first read then deployment",
+			Reads: []*ecs.Service{
+				service(oldDeployment),
+				service(newDeployment, activeDeployment),
+			},
+			ExpectedStatus: serviceStatusPending,
+		},
+		{
+			Name: "stale first read then completed",
+			Reads: []*ecs.Service{
+				service(oldDeployment),
+				service(newDeployment, activeDeployment),
+				service(completedDeployment),
+			},
+			ExpectedStatus: serviceStatusStable,
+		},
+		{
+			Name: "rolled back",
+			Reads: []*ecs.Service{
+				service(newDeployment, activeDeployment),
+				service(rollbackDeployment),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			stableStatus := serviceStableStatus("ecs-svc/1")
+
+			var status string
+			var err error
+			for _, read := range testCase.Reads {
+				status, err = stableStatus(read)
+
+				if err != nil {
+					break
+				}
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && status != testCase.ExpectedStatus {
+				t.Errorf("expected status %q, got %q", testCase.ExpectedStatus, status)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ func ResourceTaskDefinition() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("arn", d.Id())
+				d.Set("registered_arn", d.Id())
 
 				idErr := fmt.Errorf("Expected ID in format of arn:PARTITION:ecs:REGION:ACCOUNTID:task-definition/FAMILY:REVISION and provided: %s", d.Id())
 				resARN, err := arn.Parse(d.Id())
@@ -106,7 +107,7 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfService("iam", "role"),
 			},
 			"family": {
 				Type:     schema.TypeString,
@@ -209,6 +210,10 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			"registered_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"requires_compatibilities": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -259,7 +264,12 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfService("iam", "role"),
+			},
+			"track_latest": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
 			},
 			"volume": {
 				Type:     schema.TypeSet,
@@ -536,6 +546,7 @@ func resourceTaskDefinitionCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(aws.StringValue(taskDefinition.Family))
 	d.Set("arn", taskDefinition.TaskDefinitionArn)
+	d.Set("registered_arn", taskDefinition.TaskDefinitionArn)
 
 	// Some partitions (i.e., ISO) may not support tag-on-create, attempt tag after create
 	if input.Tags == nil && len(tags) > 0 {
@@ -567,6 +578,13 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 		Include:        []*string{aws.String(ecs.TaskDefinitionFieldTags)},
 	}
 
+	// Describing by family returns the latest ACTIVE revision, so revisions
+	// registered outside of Terraform (e.g. by a deployment pipeline) are
+	// picked up instead of the one originally created by this resource.
+	if d.Get("track_latest").(bool) {
+		input.TaskDefinition = aws.String(d.Get("family").(string))
+	}
+
 	out, err := conn.DescribeTaskDefinition(&input)
 
 	// Some partitions (i.e., ISO) may not support tagging, giving error
@@ -597,6 +615,12 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("family", taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
 
+	// registered_arn keeps pointing at the revision this resource registered
+	// while track_latest follows newer revisions of the family.
+	if !d.Get("track_latest").(bool) {
+		d.Set("registered_arn", taskDefinition.TaskDefinitionArn)
+	}
+
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
@@ -753,14 +777,26 @@ func resourceTaskDefinitionDelete(d *schema.ResourceData, meta interface{}) erro
 
 	conn := meta.(*conns.AWSClient).ECSConn
 
+	// With track_latest, arn may be a revision registered outside of Terraform.
+	// Only ever deregister the revision this resource registered.
+	taskDefinitionARN := d.Get("registered_arn").(string)
+	if taskDefinitionARN == "" {
+		if d.Get("track_latest").(bool) {
+			log.Printf("[WARN] Retaining ECS Task Definition (%s): revision registered by Terraform is unknown", d.Id())
+			return nil
+		}
+
+		taskDefinitionARN = d.Get("arn").(string)
+	}
+
 	_, err := conn.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
-		TaskDefinition: aws.String(d.Get("arn").(string)),
+		TaskDefinition: aws.String(taskDefinitionARN),
 	})
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[DEBUG] Task definition %q deregistered.", d.Get("arn").(string))
+	log.Printf("[DEBUG] Task definition %q deregistered.", taskDefinitionARN)
 
 	return nil
 }
@@ -63,7 +63,8 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 }
 
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
-func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) { //nolint:unparam
+// primaryDeploymentID is the PRIMARY deployment returned by CreateService or UpdateService; the wait fails if it is rolled back.
+func waitServiceStable(conn *ecs.ECS, id, cluster, primaryDeploymentID string, timeout time.Duration) (*ecs.Service, error) { //nolint:unparam
 	input := &ecs.DescribeServicesInput{
 		Services: aws.StringSlice([]string{id}),
 	}
@@ -75,7 +76,7 @@ func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(conn, id, cluster),
+		Refresh: statusServiceWaitForStable(conn, id, cluster, primaryDeploymentID),
 		Timeout: timeout,
 	}
 
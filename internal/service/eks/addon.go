@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -65,6 +66,13 @@ func ResourceAddon() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validClusterName,
 			},
+			"configuration_values": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,19 +81,51 @@ func ResourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pod_identity_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARNOfService("iam", "role"),
+						},
+						"service_account": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
 			"preserve": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
 			"resolve_conflicts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				Deprecated:    `The "resolve_conflicts" attribute can't be set to "PRESERVE" on initial resource creation. Use "resolve_conflicts_on_create" and/or "resolve_conflicts_on_update" instead`,
+				ConflictsWith: []string{"resolve_conflicts_on_create", "resolve_conflicts_on_update"},
+			},
+			"resolve_conflicts_on_create": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{eks.ResolveConflictsNone, eks.ResolveConflictsOverwrite}, false),
+				ConflictsWith: []string{"resolve_conflicts"},
+			},
+			"resolve_conflicts_on_update": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(eks.ResolveConflicts_Values(), false),
+				ConflictsWith: []string{"resolve_conflicts"},
 			},
 			"service_account_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfService("iam", "role"),
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -112,7 +152,17 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.AddonVersion = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("resolve_conflicts"); ok {
+	if v, ok := d.GetOk("configuration_values"); ok {
+		input.ConfigurationValues = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pod_identity_association"); ok && v.(*schema.Set).Len() > 0 {
+		input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("resolve_conflicts_on_create"); ok {
+		input.ResolveConflicts = aws.String(v.(string))
+	} else if v, ok := d.GetOk("resolve_conflicts"); ok {
 		input.ResolveConflicts = aws.String(v.(string))
 	}
 
@@ -198,10 +248,21 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("addon_version", addon.AddonVersion)
 	d.Set("arn", addon.AddonArn)
 	d.Set("cluster_name", addon.ClusterName)
+	d.Set("configuration_values", addon.ConfigurationValues)
 	d.Set("created_at", aws.TimeValue(addon.CreatedAt).Format(time.RFC3339))
 	d.Set("modified_at", aws.TimeValue(addon.ModifiedAt).Format(time.RFC3339))
 	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
 
+	podIdentityAssociations, err := flattenAddonPodIdentityAssociations(ctx, conn, clusterName, addon.PodIdentityAssociations)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading EKS Add-On (%s) Pod Identity Associations: %w", d.Id(), err))
+	}
+
+	if err := d.Set("pod_identity_association", podIdentityAssociations); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting pod_identity_association: %w", err))
+	}
+
 	tags := KeyValueTags(addon.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -225,7 +286,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("addon_version", "service_account_role_arn") {
+	if d.HasChanges("addon_version", "configuration_values", "pod_identity_association", "service_account_role_arn") {
 		input := &eks.UpdateAddonInput{
 			AddonName:          aws.String(addonName),
 			ClientRequestToken: aws.String(resource.UniqueId()),
@@ -236,8 +297,27 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.AddonVersion = aws.String(d.Get("addon_version").(string))
 		}
 
-		if v, ok := d.GetOk("resolve_conflicts"); ok {
-			input.ResolveConflicts = aws.String(v.(string))
+		if d.HasChange("configuration_values") {
+			input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
+		}
+
+		if d.HasChange("pod_identity_association") {
+			if v, ok := d.GetOk("pod_identity_association"); ok && v.(*schema.Set).Len() > 0 {
+				input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.(*schema.Set).List())
+			} else {
+				input.PodIdentityAssociations = []*eks.AddonPodIdentityAssociations{}
+			}
+		}
+
+		var resolveConflicts string
+		if v, ok := d.GetOk("resolve_conflicts_on_update"); ok {
+			resolveConflicts = v.(string)
+		} else if v, ok := d.GetOk("resolve_conflicts"); ok {
+			resolveConflicts = v.(string)
+		}
+
+		if resolveConflicts != "" {
+			input.ResolveConflicts = aws.String(resolveConflicts)
 		}
 
 		// If service account role ARN is already provided, use it. Otherwise, the add-on uses
@@ -257,12 +337,12 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		_, err = waitAddonUpdateSuccessful(ctx, conn, clusterName, addonName, updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			if d.Get("resolve_conflicts") != eks.ResolveConflictsOverwrite {
-				// Changing addon version w/o setting resolve_conflicts to "OVERWRITE"
+			if resolveConflicts != eks.ResolveConflictsOverwrite {
+				// Changing addon version w/o setting resolve_conflicts_on_update to "OVERWRITE"
 				// might result in a failed update if there are conflicts:
 				// ConfigurationConflict	Apply failed with 1 conflict: conflict with "kubectl"...
 				return diag.FromErr(fmt.Errorf("error waiting for EKS Add-On (%s) update (%s): %w, consider setting attribute %q to %q",
-					d.Id(), updateID, err, "resolve_conflicts", eks.ResolveConflictsOverwrite))
+					d.Id(), updateID, err, "resolve_conflicts_on_update", eks.ResolveConflictsOverwrite))
 			}
 
 			return diag.FromErr(fmt.Errorf("error waiting for EKS Add-On (%s) update (%s): %w", d.Id(), updateID, err))
@@ -312,3 +392,62 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+func expandAddonPodIdentityAssociations(tfList []interface{}) []*eks.AddonPodIdentityAssociations {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*eks.AddonPodIdentityAssociations
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &eks.AddonPodIdentityAssociations{}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["service_account"].(string); ok && v != "" {
+			apiObject.ServiceAccount = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAddonPodIdentityAssociations(ctx context.Context, conn *eks.EKS, clusterName string, associationARNs []*string) ([]interface{}, error) {
+	if len(associationARNs) == 0 {
+		return nil, nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range associationARNs {
+		// DescribeAddon only returns the association ARNs, e.g.
+		// arn:aws:eks:us-west-2:123456789012:podidentityassociation/my-cluster/a-1v95i5dqqiylbo3ud.
+		// The association ID is the final path segment.
+		arn := aws.StringValue(v)
+		associationID := arn[strings.LastIndex(arn, "/")+1:]
+
+		association, err := FindPodIdentityAssociationByClusterNameAndID(ctx, conn, clusterName, associationID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"role_arn":        aws.StringValue(association.RoleArn),
+			"service_account": aws.StringValue(association.ServiceAccount),
+		})
+	}
+
+	return tfList, nil
+}
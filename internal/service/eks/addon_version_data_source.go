@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,6 +35,11 @@ func DataSourceAddonVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"version_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validAddonVersionConstraint,
+			},
 		},
 	}
 }
@@ -44,10 +52,34 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 	mostRecent := d.Get("most_recent").(bool)
 	id := addonName
 
-	versionInfo, err := FindAddonVersionByAddonNameAndKubernetesVersion(ctx, conn, id, kubernetesVersion, mostRecent)
+	var versionInfo *eks.AddonVersionInfo
+
+	if v, ok := d.GetOk("version_constraint"); ok {
+		constraints, err := version.NewConstraint(v.(string))
+
+		if err != nil {
+			return diag.Errorf("error parsing EKS Add-On version constraint (%s): %s", v.(string), err)
+		}
+
+		versions, err := FindAddonVersionsByAddonNameAndKubernetesVersion(ctx, conn, id, kubernetesVersion)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading EKS Add-On versions (%s, %s): %w", id, kubernetesVersion, err))
+		}
+
+		versionInfo = selectAddonVersion(versions, constraints, mostRecent)
+
+		if versionInfo == nil {
+			return diag.Errorf("no EKS Add-On (%s) version for Kubernetes version %s matches constraint %q", id, kubernetesVersion, v.(string))
+		}
+	} else {
+		var err error
+
+		versionInfo, err = FindAddonVersionByAddonNameAndKubernetesVersion(ctx, conn, id, kubernetesVersion, mostRecent)
 
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading EKS Add-On version info (%s, %s): %w", id, kubernetesVersion, err))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading EKS Add-On version info (%s, %s): %w", id, kubernetesVersion, err))
+		}
 	}
 
 	d.SetId(id)
@@ -59,3 +91,38 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 
 	return nil
 }
+
+// selectAddonVersion returns the add-on version matching the constraints.
+// The default version is preferred unless mostRecent is set, otherwise the highest matching version is returned.
+// Build suffixes such as "-eksbuild.1" are ignored when checking constraints.
+func selectAddonVersion(versions []*eks.AddonVersionInfo, constraints version.Constraints, mostRecent bool) *eks.AddonVersionInfo {
+	var latest *eks.AddonVersionInfo
+	var latestVersion *version.Version
+
+	for _, versionInfo := range versions {
+		v, err := version.NewVersion(aws.StringValue(versionInfo.AddonVersion))
+
+		if err != nil {
+			continue
+		}
+
+		if !constraints.Check(v.Core()) {
+			continue
+		}
+
+		if !mostRecent {
+			for _, compatibility := range versionInfo.Compatibilities {
+				if compatibility != nil && aws.BoolValue(compatibility.DefaultVersion) {
+					return versionInfo
+				}
+			}
+		}
+
+		if latestVersion == nil || v.GreaterThan(latestVersion) {
+			latest = versionInfo
+			latestVersion = v
+		}
+	}
+
+	return latest
+}
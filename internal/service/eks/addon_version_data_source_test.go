@@ -3,6 +3,7 @@ package eks_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/eks"
@@ -47,6 +48,37 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccEKSAddonVersionDataSource_versionConstraint(t *testing.T) {
+	var addon eks.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	versionDataSourceName := "data.aws_eks_addon_version.test"
+	addonDataSourceName := "data.aws_eks_addon.test"
+	addonName := "vpc-cni"
+	ctx := context.Background()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonVersionDataSourceConfig_versionConstraint(rName, addonName, ">= 1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, addonDataSourceName, &addon),
+					resource.TestCheckResourceAttrPair(versionDataSourceName, "version", addonDataSourceName, "addon_version"),
+					resource.TestMatchResourceAttr(versionDataSourceName, "version", regexp.MustCompile(`^v1\.`)),
+					resource.TestCheckResourceAttr(versionDataSourceName, "version_constraint", ">= 1.0"),
+				),
+			},
+			{
+				Config:      testAccAddonVersionDataSourceConfig_versionConstraint(rName, addonName, "< 0.1"),
+				ExpectError: regexp.MustCompile(`no EKS Add-On \(vpc-cni\) version for Kubernetes version`),
+			},
+		},
+	})
+}
+
 func testAccAddonVersionDataSourceConfig_basic(rName, addonName string, mostRecent bool) string {
 	return acctest.ConfigCompose(testAccAddonBaseConfig(rName), fmt.Sprintf(`
 data "aws_eks_addon_version" "test" {
@@ -75,3 +107,32 @@ data "aws_eks_addon" "test" {
 }
 `, rName, addonName, mostRecent))
 }
+
+func testAccAddonVersionDataSourceConfig_versionConstraint(rName, addonName, constraint string) string {
+	return acctest.ConfigCompose(testAccAddonBaseConfig(rName), fmt.Sprintf(`
+data "aws_eks_addon_version" "test" {
+  addon_name         = %[2]q
+  kubernetes_version = aws_eks_cluster.test.version
+  version_constraint = %[3]q
+}
+
+resource "aws_eks_addon" "test" {
+  addon_name    = %[2]q
+  cluster_name  = aws_eks_cluster.test.name
+  addon_version = data.aws_eks_addon_version.test.version
+
+  resolve_conflicts = "OVERWRITE"
+}
+
+data "aws_eks_addon" "test" {
+  addon_name   = %[2]q
+  cluster_name = aws_eks_cluster.test.name
+
+  depends_on = [
+    data.aws_eks_addon_version.test,
+    aws_eks_addon.test,
+    aws_eks_cluster.test,
+  ]
+}
+`, rName, addonName, constraint))
+}
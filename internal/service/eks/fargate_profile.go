@@ -63,6 +63,7 @@ func ResourceFargateProfile() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				MinItems: 1,
+				MaxItems: 5,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"labels": {
@@ -75,7 +76,7 @@ func ResourceFargateProfile() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validFargateProfileSelectorNamespace,
 						},
 					},
 				},
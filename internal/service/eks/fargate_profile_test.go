@@ -124,6 +124,38 @@ func TestAccEKSFargateProfile_Selector_labels(t *testing.T) {
 	})
 }
 
+func TestAccEKSFargateProfile_Selector_wildcard(t *testing.T) {
+	var fargateProfile1 eks.FargateProfile
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_fargate_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckFargateProfile(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFargateProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFargateProfileConfig_selectorWildcard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFargateProfileExists(resourceName, &fargateProfile1),
+					resource.TestCheckResourceAttr(resourceName, "selector.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "selector.*", map[string]string{
+						"namespace":   "prod-*",
+						"labels.%":    "1",
+						"labels.team": "data-?",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEKSFargateProfile_tags(t *testing.T) {
 	var fargateProfile1, fargateProfile2, fargateProfile3 eks.FargateProfile
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -526,6 +558,29 @@ resource "aws_eks_fargate_profile" "test" {
 `, rName, labelKey1, labelValue1)
 }
 
+func testAccFargateProfileConfig_selectorWildcard(rName string) string {
+	return testAccFargateProfileBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_eks_fargate_profile" "test" {
+  cluster_name           = aws_eks_cluster.test.name
+  fargate_profile_name   = %[1]q
+  pod_execution_role_arn = aws_iam_role.pod.arn
+  subnet_ids             = aws_subnet.private[*].id
+
+  selector {
+    labels = {
+      team = "data-?"
+    }
+    namespace = "prod-*"
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.pod-AmazonEKSFargatePodExecutionRolePolicy,
+    aws_route_table_association.private,
+  ]
+}
+`, rName)
+}
+
 func testAccFargateProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return testAccFargateProfileBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_eks_fargate_profile" "test" {
@@ -133,17 +133,7 @@ func resourceIdentityProviderConfigCreate(ctx context.Context, d *schema.Resourc
 	configName, oidc := expandOIDCIdentityProviderConfigRequest(d.Get("oidc").([]interface{})[0].(map[string]interface{}))
 	id := IdentityProviderConfigCreateResourceID(clusterName, configName)
 
-	input := &eks.AssociateIdentityProviderConfigInput{
-		ClientRequestToken: aws.String(resource.UniqueId()),
-		ClusterName:        aws.String(clusterName),
-		Oidc:               oidc,
-	}
-
-	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
-	}
-
-	_, err := conn.AssociateIdentityProviderConfig(input)
+	err := associateOIDCIdentityProviderConfig(ctx, conn, clusterName, oidc, tags, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return diag.Errorf("error associating EKS Identity Provider Config (%s): %s", id, err)
@@ -229,13 +219,65 @@ func resourceIdentityProviderConfigDelete(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] Disassociating EKS Identity Provider Config: %s", d.Id())
-	_, err = conn.DisassociateIdentityProviderConfigWithContext(ctx, &eks.DisassociateIdentityProviderConfigInput{
+	err = disassociateIdentityProviderConfig(ctx, conn, clusterName, configName, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return diag.Errorf("error disassociating EKS Identity Provider Config (%s): %s", d.Id(), err)
+	}
+
+	_, err = waitOIDCIdentityProviderConfigDeleted(ctx, conn, clusterName, configName, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return diag.Errorf("error waiting for EKS Identity Provider Config (%s) disassociation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// associateOIDCIdentityProviderConfig associates an OIDC identity provider config
+// with a cluster. A cluster processes one configuration change at a time, so the
+// request is retried while another association or disassociation is in progress.
+func associateOIDCIdentityProviderConfig(ctx context.Context, conn *eks.EKS, clusterName string, oidc *eks.OidcIdentityProviderConfigRequest, tags tftags.KeyValueTags, timeout time.Duration) error {
+	input := &eks.AssociateIdentityProviderConfigInput{
+		ClusterName: aws.String(clusterName),
+		Oidc:        oidc,
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := tfresource.RetryWhenContext(ctx, timeout,
+		func() (interface{}, error) {
+			input.ClientRequestToken = aws.String(resource.UniqueId())
+
+			return conn.AssociateIdentityProviderConfigWithContext(ctx, input)
+		},
+		isIdentityProviderConfigClusterUpdateInProgressError,
+	)
+
+	return err
+}
+
+// disassociateIdentityProviderConfig disassociates an OIDC identity provider config
+// from a cluster, retrying while another configuration change is in progress.
+func disassociateIdentityProviderConfig(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) error {
+	input := &eks.DisassociateIdentityProviderConfigInput{
 		ClusterName: aws.String(clusterName),
 		IdentityProviderConfig: &eks.IdentityProviderConfig{
 			Name: aws.String(configName),
 			Type: aws.String(IdentityProviderConfigTypeOIDC),
 		},
-	})
+	}
+
+	_, err := tfresource.RetryWhenContext(ctx, timeout,
+		func() (interface{}, error) {
+			input.ClientRequestToken = aws.String(resource.UniqueId())
+
+			return conn.DisassociateIdentityProviderConfigWithContext(ctx, input)
+		},
+		isIdentityProviderConfigClusterUpdateInProgressError,
+	)
 
 	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
 		return nil
@@ -245,17 +287,22 @@ func resourceIdentityProviderConfigDelete(ctx context.Context, d *schema.Resourc
 		return nil
 	}
 
-	if err != nil {
-		return diag.Errorf("error disassociating EKS Identity Provider Config (%s): %s", d.Id(), err)
-	}
+	return err
+}
 
-	_, err = waitOIDCIdentityProviderConfigDeleted(ctx, conn, clusterName, configName, d.Timeout(schema.TimeoutDelete))
+// isIdentityProviderConfigClusterUpdateInProgressError returns whether the error is caused by another update
+// to the cluster being in progress. Other ResourceInUseExceptions, such as for a duplicate configuration name,
+// are not retried.
+func isIdentityProviderConfigClusterUpdateInProgressError(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, eks.ErrCodeResourceInUseException, "in progress") {
+		return true, err
+	}
 
-	if err != nil {
-		return diag.Errorf("error waiting for EKS Identity Provider Config (%s) disassociation: %s", d.Id(), err)
+	if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidRequestException, "in progress") {
+		return true, err
 	}
 
-	return nil
+	return false, err
 }
 
 func expandOIDCIdentityProviderConfigRequest(tfMap map[string]interface{}) (string, *eks.OidcIdentityProviderConfigRequest) {
@@ -84,6 +84,33 @@ func TestAccEKSIdentityProviderConfig_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEKSIdentityProviderConfig_duplicateName(t *testing.T) {
+	var config eks.OidcIdentityProviderConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_identity_provider_config.test"
+	ctx := context.Background()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroyConfig,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderConfigConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityProviderExistsConfig(ctx, resourceName, &config),
+				),
+			},
+			{
+				// A second configuration with the same name on the cluster fails rather than being retried until the create timeout.
+				Config:      testAccIdentityProviderConfigConfig_duplicateName(rName),
+				ExpectError: regexp.MustCompile(`ResourceInUseException`),
+			},
+		},
+	})
+}
+
 func TestAccEKSIdentityProviderConfig_allOIDCOptions(t *testing.T) {
 	var config eks.OidcIdentityProviderConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -305,6 +332,22 @@ resource "aws_eks_identity_provider_config" "test" {
 `, rName))
 }
 
+func testAccIdentityProviderConfigConfig_duplicateName(rName string) string {
+	return acctest.ConfigCompose(testAccIdentityProviderConfigConfig_name(rName), fmt.Sprintf(`
+resource "aws_eks_identity_provider_config" "test2" {
+  cluster_name = aws_eks_cluster.test.name
+
+  oidc {
+    client_id                     = "example.org"
+    identity_provider_config_name = %[1]q
+    issuer_url                    = "https://example.org"
+  }
+
+  depends_on = [aws_eks_identity_provider_config.test]
+}
+`, rName))
+}
+
 func testAccIdentityProviderConfigConfig_issuerURL(rName, issuerUrl string) string {
 	return acctest.ConfigCompose(testAccIdentityProviderBaseConfig(rName), fmt.Sprintf(`
 resource "aws_eks_identity_provider_config" "test" {
@@ -3,6 +3,8 @@ package eks
 import (
 	"fmt"
 	"regexp"
+
+	"github.com/hashicorp/go-version"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -22,3 +24,32 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+func validAddonVersionConstraint(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := version.NewConstraint(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid version constraint: %w", k, err))
+	}
+
+	return
+}
+
+// validFargateProfileSelectorNamespace accepts Kubernetes namespace names, which may
+// include the "*" and "?" wildcards supported by Fargate profile selectors.
+func validFargateProfileSelectorNamespace(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q length must be between 1-63 characters: %q", k, value))
+	}
+
+	pattern := `^[a-z0-9*?]([-a-z0-9*?]*[a-z0-9*?])?$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
@@ -57,3 +57,83 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFargateProfileSelectorNamespace(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "kube-system",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "prod-*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "team-?-jobs",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-invalid",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Invalid",
+			ErrCount: 1,
+		},
+		{
+			Value:    "invalid_namespace",
+			ErrCount: 1,
+		},
+		{
+			Value:    ``,
+			ErrCount: 2,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(64, "abcdefghijklmnopqrstuvwxyz"),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validFargateProfileSelectorNamespace(tc.Value, "namespace")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for Fargate Profile selector namespace %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidAddonVersionConstraint(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    ">= 1.12, < 1.13",
+			ErrCount: 0,
+		},
+		{
+			Value:    "~> 1.12.0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "latest",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validAddonVersionConstraint(tc.Value, "version_constraint")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for EKS Add-On version constraint %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"golang.org/x/exp/slices"
 )
 
@@ -165,6 +166,12 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"primary_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -187,6 +194,7 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 			customizeDiffGlobalReplicationGroupEngineVersionErrorOnDowngrade,
 			customizeDiffGlobalReplicationGroupParamGroupNameRequiresMajorVersionUpgrade,
 			customdiff.ComputedIf("global_node_groups", diffHasChange("num_node_groups")),
+			customizeDiffGlobalReplicationGroupPrimaryRegionOnCreate,
 		),
 	}
 }
@@ -223,6 +231,22 @@ of the Global Replication Group and all Replication Group members. The AWS provi
 Please use the "-replace" option on the terraform plan and apply commands (see https://www.terraform.io/cli/commands/plan#replace-address).`, diff.Id())
 }
 
+// The primary replication group must be in the region the Global Replication Group is created in.
+// Secondary members are only added afterwards, so a different primary region can only be set by a later failover.
+func customizeDiffGlobalReplicationGroupPrimaryRegionOnCreate(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("primary_region"); ok {
+		if region := meta.(*conns.AWSClient).Region; v.(string) != region {
+			return fmt.Errorf("primary_region (%s) must be the provider region (%s) on creation", v.(string), region)
+		}
+	}
+
+	return nil
+}
+
 type changeDiffer interface {
 	Id() string
 	GetChange(key string) (any, any)
@@ -497,6 +521,14 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	if d.HasChange("primary_region") {
+		if v := d.Get("primary_region").(string); v != "" {
+			if err := globalReplicationGroupFailover(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("failing over ElastiCache Global Replication Group (%s) to %s: %s", d.Id(), v, err)
+			}
+		}
+	}
+
 	return resourceGlobalReplicationGroupRead(ctx, d, meta)
 }
 
@@ -549,6 +581,51 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiC
 	return nil
 }
 
+// globalReplicationGroupFailover promotes the secondary member in the specified region to primary.
+func globalReplicationGroupFailover(ctx context.Context, conn *elasticache.ElastiCache, id, region string, timeout time.Duration) error {
+	globalReplicationGroup, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout)
+	if err != nil {
+		return fmt.Errorf("waiting for availability: %w", err)
+	}
+
+	var member *elasticache.GlobalReplicationGroupMember
+	for _, v := range globalReplicationGroup.Members {
+		if aws.StringValue(v.ReplicationGroupRegion) == region {
+			member = v
+			break
+		}
+	}
+
+	if member == nil {
+		return fmt.Errorf("no member replication group in region %s", region)
+	}
+
+	if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
+		log.Printf("[DEBUG] Not failing over ElastiCache Global Replication Group (%s): %s is already primary", id, region)
+		return nil
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             aws.String(region),
+		PrimaryReplicationGroupId: member.ReplicationGroupId,
+	}
+
+	if _, err := conn.FailoverGlobalReplicationGroupWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitGlobalReplicationGroupPrimaryRegion(ctx, conn, id, region, timeout); err != nil {
+		return fmt.Errorf("waiting for promotion: %w", err)
+	}
+
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func resourceGlobalReplicationGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
@@ -646,6 +723,15 @@ func flattenGlobalNodeGroup(nodeGroup *elasticache.GlobalNodeGroup) map[string]a
 	return m
 }
 
+func flattenGlobalReplicationGroupPrimaryRegion(members []*elasticache.GlobalReplicationGroupMember) string {
+	for _, member := range members {
+		if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
+			return aws.StringValue(member.ReplicationGroupRegion)
+		}
+	}
+	return ""
+}
+
 func flattenGlobalReplicationGroupPrimaryGroupID(members []*elasticache.GlobalReplicationGroupMember) string {
 	for _, member := range members {
 		if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
@@ -2,6 +2,7 @@ package elasticache
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
@@ -112,6 +113,21 @@ func statusGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiC
 	}
 }
 
+// statusGlobalReplicationGroupPrimaryRegion fetches the Global Replication Group and whether its primary member is in the specified region
+func statusGlobalReplicationGroupPrimaryRegion(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID, region string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		grg, err := FindGlobalReplicationGroupByID(ctx, conn, globalReplicationGroupID)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return grg, strconv.FormatBool(flattenGlobalReplicationGroupPrimaryRegion(grg.Members) == region), nil
+	}
+}
+
 const (
 	GlobalReplicationGroupMemberStatusAssociated = "associated"
 )
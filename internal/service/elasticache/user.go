@@ -1,6 +1,7 @@
 package elasticache
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -26,7 +28,10 @@ func ResourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceUserCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"access_string": {
@@ -38,6 +43,37 @@ func ResourceUser() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"authentication_mode": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"no_password_required", "passwords"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"passwords": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(16, 128),
+							},
+							Sensitive: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(elasticache.InputAuthenticationType_Values(), false),
+						},
+					},
+				},
+			},
 			"engine": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -48,14 +84,16 @@ func ResourceUser() *schema.Resource {
 				},
 			},
 			"no_password_required": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"authentication_mode"},
 			},
 			"passwords": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 2,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      2,
+				ConflictsWith: []string{"authentication_mode"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
@@ -83,15 +121,20 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &elasticache.CreateUserInput{
-		AccessString:       aws.String(d.Get("access_string").(string)),
-		Engine:             aws.String(d.Get("engine").(string)),
-		NoPasswordRequired: aws.Bool(d.Get("no_password_required").(bool)),
-		UserId:             aws.String(d.Get("user_id").(string)),
-		UserName:           aws.String(d.Get("user_name").(string)),
+		AccessString: aws.String(d.Get("access_string").(string)),
+		Engine:       aws.String(d.Get("engine").(string)),
+		UserId:       aws.String(d.Get("user_id").(string)),
+		UserName:     aws.String(d.Get("user_name").(string)),
 	}
 
-	if v, ok := d.GetOk("passwords"); ok {
-		input.Passwords = flex.ExpandStringSet(v.(*schema.Set))
+	if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		input.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
+
+		if v, ok := d.GetOk("passwords"); ok {
+			input.Passwords = flex.ExpandStringSet(v.(*schema.Set))
+		}
 	}
 
 	if len(tags) > 0 {
@@ -147,6 +190,9 @@ func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("access_string", resp.AccessString)
+	if err := d.Set("authentication_mode", flattenAuthenticationMode(resp.Authentication, d.Get("authentication_mode").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting authentication_mode: %w", err)
+	}
 	d.Set("engine", resp.Engine)
 	d.Set("user_id", resp.UserId)
 	d.Set("user_name", resp.UserName)
@@ -203,6 +249,41 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 			hasChange = true
 		}
 
+		if d.HasChange("authentication_mode") {
+			if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				req.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+				hasChange = true
+			}
+		}
+
+		// Rotate passwords without an outage: first allow both the old and the new passwords,
+		// so that clients can switch over while the user is modified, then drop the old ones.
+		if hasChange {
+			if interim := userPasswordRotationInterim(d); interim != nil {
+				input := &elasticache.ModifyUserInput{
+					UserId: aws.String(d.Id()),
+				}
+
+				if req.AuthenticationMode != nil {
+					input.AuthenticationMode = &elasticache.AuthenticationMode{
+						Passwords: interim,
+						Type:      req.AuthenticationMode.Type,
+					}
+				} else {
+					input.Passwords = interim
+				}
+
+				log.Printf("[DEBUG] Adding new passwords to ElastiCache User (%s)", d.Id())
+				if _, err := conn.ModifyUser(input); err != nil {
+					return fmt.Errorf("error updating ElastiCache User (%s) passwords: %w", d.Id(), err)
+				}
+
+				if err := WaitUserActive(conn, d.Id()); err != nil {
+					return fmt.Errorf("error waiting for ElastiCache User (%s) to be modified: %w", d.Id(), err)
+				}
+			}
+		}
+
 		if hasChange {
 			_, err := conn.ModifyUser(req)
 			if err != nil {
@@ -233,6 +314,38 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceUserRead(d, meta)
 }
 
+func resourceUserCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		authenticationType := tfMap["type"].(string)
+		passwords := tfMap["passwords"].(*schema.Set).Len()
+
+		switch authenticationType {
+		case elasticache.InputAuthenticationTypePassword:
+			if passwords == 0 {
+				return fmt.Errorf("authentication_mode.0.passwords must be specified when authentication_mode.0.type is %q", authenticationType)
+			}
+		case elasticache.InputAuthenticationTypeIam:
+			if passwords > 0 {
+				return fmt.Errorf("authentication_mode.0.passwords cannot be specified when authentication_mode.0.type is %q", authenticationType)
+			}
+
+			// IAM-enabled users must have identical user ID and user name.
+			if diff.NewValueKnown("user_id") && diff.NewValueKnown("user_name") {
+				if userID, userName := diff.Get("user_id").(string), diff.Get("user_name").(string); userID != userName {
+					return fmt.Errorf("user_id (%s) and user_name (%s) must be the same when authentication_mode.0.type is %q", userID, userName, authenticationType)
+				}
+			}
+		case elasticache.InputAuthenticationTypeNoPasswordRequired:
+			if passwords > 0 {
+				return fmt.Errorf("authentication_mode.0.passwords cannot be specified when authentication_mode.0.type is %q", authenticationType)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
@@ -259,3 +372,113 @@ func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandAuthenticationMode(tfMap map[string]interface{}) *elasticache.AuthenticationMode {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elasticache.AuthenticationMode{}
+
+	if v, ok := tfMap["passwords"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Passwords = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// flattenAuthenticationMode flattens the user's authentication.
+// Passwords are never returned by the API and are taken from the existing value.
+func flattenAuthenticationMode(apiObject *elasticache.Authentication, tfList []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"password_count": aws.Int64Value(apiObject.PasswordCount),
+	}
+
+	switch v := aws.StringValue(apiObject.Type); v {
+	case elasticache.AuthenticationTypeNoPassword:
+		tfMap["type"] = elasticache.InputAuthenticationTypeNoPasswordRequired
+	default:
+		tfMap["type"] = v
+	}
+
+	if len(tfList) > 0 && tfList[0] != nil {
+		if v, ok := tfList[0].(map[string]interface{})["passwords"]; ok {
+			tfMap["passwords"] = v
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+// userPasswordRotationInterim returns the passwords to set while rotating from the old passwords to
+// the new ones, or nil if no interim step is needed or the combined passwords exceed the limit of two.
+func userPasswordRotationInterim(d *schema.ResourceData) []*string {
+	var o, n interface{}
+
+	switch {
+	case d.HasChange("authentication_mode"):
+		o, n = d.GetChange("authentication_mode")
+		o, n = userAuthenticationModePasswords(o), userAuthenticationModePasswords(n)
+
+		if oldType, newType := userAuthenticationModeType(d.GetChange("authentication_mode")); oldType != newType {
+			return nil
+		}
+	case d.HasChange("passwords"):
+		o, n = d.GetChange("passwords")
+	default:
+		return nil
+	}
+
+	if interim := userPasswordsInterim(o.(*schema.Set), n.(*schema.Set)); interim != nil {
+		return flex.ExpandStringSet(interim)
+	}
+
+	return nil
+}
+
+// userPasswordsInterim returns the union of the old and new passwords if passwords are being replaced
+// and the union fits within the limit of two passwords per user.
+func userPasswordsInterim(o, n *schema.Set) *schema.Set {
+	// Nothing to do unless old passwords are removed and new ones added.
+	if o.Difference(n).Len() == 0 || n.Difference(o).Len() == 0 {
+		return nil
+	}
+
+	interim := o.Union(n)
+
+	if interim.Len() > 2 {
+		return nil
+	}
+
+	return interim
+}
+
+func userAuthenticationModePasswords(v interface{}) *schema.Set {
+	if tfList, ok := v.([]interface{}); ok && len(tfList) > 0 && tfList[0] != nil {
+		if v, ok := tfList[0].(map[string]interface{})["passwords"].(*schema.Set); ok {
+			return v
+		}
+	}
+
+	return schema.NewSet(schema.HashString, nil)
+}
+
+func userAuthenticationModeType(o, n interface{}) (string, string) {
+	f := func(v interface{}) string {
+		if tfList, ok := v.([]interface{}); ok && len(tfList) > 0 && tfList[0] != nil {
+			return tfList[0].(map[string]interface{})["type"].(string)
+		}
+
+		return ""
+	}
+
+	return f(o), f(n)
+}
@@ -203,7 +203,11 @@ func resourceUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if hasChange {
-			_, err := conn.ModifyUserGroup(req)
+			// Membership changes are applied online by the replication groups using the user group,
+			// but are rejected while the user group or one of its replication groups is being modified.
+			_, err := tfresource.RetryWhenAWSErrCodeEquals(userGroupModifiedTimeout, func() (interface{}, error) {
+				return conn.ModifyUserGroup(req)
+			}, elasticache.ErrCodeInvalidUserGroupStateFault)
 			if err != nil {
 				return fmt.Errorf("error updating ElastiCache User Group (%q): %w", d.Id(), err)
 			}
@@ -0,0 +1,79 @@
+package elasticache
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUserPasswordsInterim(t *testing.T) {
+	testcases := []struct {
+		name     string
+		old      []interface{}
+		new      []interface{}
+		expected []string
+	}{
+		{
+			name:     "rotate single password",
+			old:      []interface{}{"password123456789"},
+			new:      []interface{}{"password987654321"},
+			expected: []string{"password123456789", "password987654321"},
+		},
+		{
+			name: "add second password",
+			old:  []interface{}{"password123456789"},
+			new:  []interface{}{"password123456789", "password987654321"},
+		},
+		{
+			name: "remove old password",
+			old:  []interface{}{"password123456789", "password987654321"},
+			new:  []interface{}{"password987654321"},
+		},
+		{
+			name: "too many passwords",
+			old:  []interface{}{"password123456789", "password987654321"},
+			new:  []interface{}{"password555555555"},
+		},
+		{
+			name: "no old passwords",
+			new:  []interface{}{"password987654321"},
+		},
+		{
+			name: "no new passwords",
+			old:  []interface{}{"password123456789"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			o := schema.NewSet(schema.HashString, testcase.old)
+			n := schema.NewSet(schema.HashString, testcase.new)
+
+			interim := userPasswordsInterim(o, n)
+
+			if testcase.expected == nil {
+				if interim != nil {
+					t.Fatalf("expected no interim passwords, got %v", interim.List())
+				}
+
+				return
+			}
+
+			if interim == nil {
+				t.Fatalf("expected interim passwords %v, got none", testcase.expected)
+			}
+
+			var got []string
+			for _, v := range interim.List() {
+				got = append(got, v.(string))
+			}
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testcase.expected) {
+				t.Errorf("expected interim passwords %v, got %v", testcase.expected, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package elasticache
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
@@ -21,6 +22,8 @@ const (
 
 	UserActiveTimeout  = 5 * time.Minute
 	UserDeletedTimeout = 5 * time.Minute
+
+	userGroupModifiedTimeout = 20 * time.Minute
 )
 
 // WaitReplicationGroupAvailable waits for a ReplicationGroup to return Available
@@ -181,6 +184,24 @@ func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.
 	return nil, err
 }
 
+// waitGlobalReplicationGroupPrimaryRegion waits for the primary member of a Global Replication Group to be in the specified region
+func waitGlobalReplicationGroupPrimaryRegion(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID, region string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{strconv.FormatBool(false)},
+		Target:     []string{strconv.FormatBool(true)},
+		Refresh:    statusGlobalReplicationGroupPrimaryRegion(ctx, conn, globalReplicationGroupID, region),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupAvailableMinTimeout,
+		Delay:      globalReplicationGroupAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+	return nil, err
+}
+
 // waitGlobalReplicationGroupDeleted waits for a Global Replication Group to be deleted
 func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
 	stateConf := &resource.StateChangeConf{
@@ -0,0 +1,500 @@
+package elastictranscoder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// mediaConvertAudioSelectorName is the name of the audio selector referenced by every converted output.
+const mediaConvertAudioSelectorName = "Audio Selector 1"
+
+func DataSourceMediaConvertJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMediaConvertJobTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"preset_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"unsupported_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMediaConvertJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticTranscoderConn
+
+	var pipeline *elastictranscoder.Pipeline
+
+	if v, ok := d.GetOk("pipeline_id"); ok {
+		output, err := conn.ReadPipeline(&elastictranscoder.ReadPipelineInput{
+			Id: aws.String(v.(string)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading Elastic Transcoder Pipeline (%s): %w", v.(string), err)
+		}
+
+		pipeline = output.Pipeline
+	}
+
+	presetIDs := flex.ExpandStringValueList(d.Get("preset_ids").([]interface{}))
+	presets := make([]*elastictranscoder.Preset, 0, len(presetIDs))
+
+	for _, id := range presetIDs {
+		output, err := conn.ReadPreset(&elastictranscoder.ReadPresetInput{
+			Id: aws.String(id),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading Elastic Transcoder Preset (%s): %w", id, err)
+		}
+
+		presets = append(presets, output.Preset)
+	}
+
+	input, unsupported := expandMediaConvertJobTemplate(pipeline, presets)
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	b, err := jsonutil.BuildJSON(input)
+
+	if err != nil {
+		return fmt.Errorf("error encoding MediaConvert Job Template: %w", err)
+	}
+
+	d.SetId(strings.Join(presetIDs, ","))
+	d.Set("json", string(b))
+	d.Set("unsupported_settings", unsupported)
+
+	return nil
+}
+
+// expandMediaConvertJobTemplate builds a MediaConvert job template equivalent to the specified
+// Elastic Transcoder presets, with one file group output per preset.
+// If a pipeline is specified its output bucket is used as the file group destination.
+// Settings that have no MediaConvert equivalent are returned as human-readable messages.
+func expandMediaConvertJobTemplate(pipeline *elastictranscoder.Pipeline, presets []*elastictranscoder.Preset) (*mediaconvert.CreateJobTemplateInput, []string) {
+	var unsupported []string
+
+	fileGroupSettings := &mediaconvert.FileGroupSettings{}
+
+	if pipeline != nil {
+		bucket := aws.StringValue(pipeline.OutputBucket)
+
+		if bucket == "" && pipeline.ContentConfig != nil {
+			bucket = aws.StringValue(pipeline.ContentConfig.Bucket)
+		}
+
+		if bucket != "" {
+			fileGroupSettings.Destination = aws.String(fmt.Sprintf("s3://%s/", bucket))
+		}
+
+		if v := aws.StringValue(pipeline.AwsKmsKeyArn); v != "" {
+			unsupported = append(unsupported, fmt.Sprintf("pipeline %s: KMS key %s must be configured as the output destination encryption settings", aws.StringValue(pipeline.Id), v))
+		}
+
+		if pipeline.Notifications != nil {
+			unsupported = append(unsupported, fmt.Sprintf("pipeline %s: notifications must be replaced by Amazon EventBridge rules for MediaConvert job state changes", aws.StringValue(pipeline.Id)))
+		}
+	}
+
+	outputGroup := &mediaconvert.OutputGroup{
+		Name: aws.String("File Group"),
+		OutputGroupSettings: &mediaconvert.OutputGroupSettings{
+			FileGroupSettings: fileGroupSettings,
+			Type:              aws.String(mediaconvert.OutputGroupTypeFileGroupSettings),
+		},
+	}
+
+	for _, preset := range presets {
+		if preset == nil {
+			continue
+		}
+
+		output, messages := expandMediaConvertOutput(preset)
+
+		outputGroup.Outputs = append(outputGroup.Outputs, output)
+		unsupported = append(unsupported, messages...)
+	}
+
+	input := &mediaconvert.CreateJobTemplateInput{
+		Settings: &mediaconvert.JobTemplateSettings{
+			Inputs: []*mediaconvert.InputTemplate{{
+				AudioSelectors: map[string]*mediaconvert.AudioSelector{
+					mediaConvertAudioSelectorName: {
+						DefaultSelection: aws.String(mediaconvert.AudioDefaultSelectionDefault),
+					},
+				},
+				TimecodeSource: aws.String(mediaconvert.InputTimecodeSourceZerobased),
+				VideoSelector:  &mediaconvert.VideoSelector{},
+			}},
+			OutputGroups: []*mediaconvert.OutputGroup{outputGroup},
+		},
+	}
+
+	return input, unsupported
+}
+
+func expandMediaConvertOutput(preset *elastictranscoder.Preset) (*mediaconvert.Output, []string) {
+	var unsupported []string
+
+	id := aws.StringValue(preset.Id)
+	unsupportedf := func(format string, a ...interface{}) {
+		unsupported = append(unsupported, fmt.Sprintf("preset %s: ", id)+fmt.Sprintf(format, a...))
+	}
+
+	output := &mediaconvert.Output{
+		NameModifier: aws.String("_" + id),
+	}
+
+	switch v := aws.StringValue(preset.Container); v {
+	case "mp4":
+		output.ContainerSettings = &mediaconvert.ContainerSettings{Container: aws.String(mediaconvert.ContainerTypeMp4)}
+	case "ts":
+		output.ContainerSettings = &mediaconvert.ContainerSettings{Container: aws.String(mediaconvert.ContainerTypeM2ts)}
+	case "webm":
+		output.ContainerSettings = &mediaconvert.ContainerSettings{Container: aws.String(mediaconvert.ContainerTypeWebm)}
+	case "flac", "mp3", "wav":
+		output.ContainerSettings = &mediaconvert.ContainerSettings{Container: aws.String(mediaconvert.ContainerTypeRaw)}
+	case "fmp4":
+		output.ContainerSettings = &mediaconvert.ContainerSettings{Container: aws.String(mediaconvert.ContainerTypeCmfc)}
+		unsupportedf("container fmp4 is converted to CMAF (CMFC) and should be moved to a CMAF output group")
+	default:
+		unsupportedf("container %s has no MediaConvert equivalent", v)
+	}
+
+	if video := preset.Video; video != nil && aws.StringValue(video.Codec) != "" {
+		videoDescription, messages := expandMediaConvertVideoDescription(video)
+
+		output.VideoDescription = videoDescription
+		for _, message := range messages {
+			unsupportedf("%s", message)
+		}
+	}
+
+	if audio := preset.Audio; audio != nil && aws.StringValue(audio.Codec) != "" {
+		audioDescription, messages := expandMediaConvertAudioDescription(audio)
+
+		if audioDescription != nil {
+			output.AudioDescriptions = []*mediaconvert.AudioDescription{audioDescription}
+		}
+		for _, message := range messages {
+			unsupportedf("%s", message)
+		}
+	}
+
+	if preset.Thumbnails != nil && aws.StringValue(preset.Thumbnails.Format) != "" {
+		unsupportedf("thumbnails must be generated by a separate frame capture output")
+	}
+
+	return output, unsupported
+}
+
+func expandMediaConvertVideoDescription(video *elastictranscoder.VideoParameters) (*mediaconvert.VideoDescription, []string) {
+	var unsupported []string
+
+	videoDescription := &mediaconvert.VideoDescription{
+		CodecSettings: &mediaconvert.VideoCodecSettings{},
+	}
+
+	width, height := parseETInt(video.MaxWidth), parseETInt(video.MaxHeight)
+
+	if v := aws.StringValue(video.Resolution); v != "" && v != "auto" {
+		if parts := strings.SplitN(v, "x", 2); len(parts) == 2 {
+			width, height = parseETInt(aws.String(parts[0])), parseETInt(aws.String(parts[1]))
+		}
+	}
+
+	videoDescription.Width = width
+	videoDescription.Height = height
+
+	switch v := aws.StringValue(video.SizingPolicy); v {
+	case "", "Keep":
+		videoDescription.ScalingBehavior = aws.String(mediaconvert.ScalingBehaviorDefault)
+	case "Fill", "ShrinkToFill":
+		videoDescription.ScalingBehavior = aws.String(mediaconvert.ScalingBehaviorFill)
+	case "Fit":
+		videoDescription.ScalingBehavior = aws.String(mediaconvert.ScalingBehaviorFit)
+	case "ShrinkToFit":
+		videoDescription.ScalingBehavior = aws.String(mediaconvert.ScalingBehaviorFitNoUpscale)
+	case "Stretch":
+		videoDescription.ScalingBehavior = aws.String(mediaconvert.ScalingBehaviorStretchToOutput)
+	}
+
+	if aws.StringValue(video.PaddingPolicy) == "Pad" {
+		unsupported = append(unsupported, "padding policy Pad is approximated by the FIT scaling behavior")
+	}
+
+	if len(video.Watermarks) > 0 {
+		unsupported = append(unsupported, "watermarks must be configured per job with the MediaConvert image inserter")
+	}
+
+	bitrate := parseETKilobits(video.BitRate)
+	if bitrate == nil {
+		unsupported = append(unsupported, "video bit rate auto has no MediaConvert equivalent, a bit rate or QVBR settings must be configured")
+	}
+
+	framerateControl, framerateNumerator, framerateDenominator := expandMediaConvertFramerate(video.FrameRate)
+	gopSize := parseETFloat(video.KeyframesMaxDist)
+
+	switch v := aws.StringValue(video.Codec); v {
+	case "H.264":
+		settings := &mediaconvert.H264Settings{
+			Bitrate:              bitrate,
+			FramerateControl:     framerateControl,
+			FramerateDenominator: framerateDenominator,
+			FramerateNumerator:   framerateNumerator,
+			GopSize:              gopSize,
+			MaxBitrate:           parseETKilobits(video.CodecOptions["MaxBitRate"]),
+			HrdBufferSize:        parseETKilobits(video.CodecOptions["BufferSize"]),
+			RateControlMode:      aws.String(mediaconvert.H264RateControlModeCbr),
+		}
+
+		if settings.MaxBitrate != nil {
+			settings.RateControlMode = aws.String(mediaconvert.H264RateControlModeVbr)
+		}
+
+		if gopSize != nil {
+			settings.GopSizeUnits = aws.String(mediaconvert.H264GopSizeUnitsFrames)
+		}
+
+		if aws.StringValue(video.FixedGOP) == "true" {
+			settings.SceneChangeDetect = aws.String(mediaconvert.H264SceneChangeDetectDisabled)
+		}
+
+		if v := aws.StringValue(video.CodecOptions["Profile"]); v != "" {
+			settings.CodecProfile = aws.String(strings.ToUpper(v))
+		}
+
+		if v := aws.StringValue(video.CodecOptions["Level"]); v != "" {
+			settings.CodecLevel = aws.String("LEVEL_" + strings.ReplaceAll(v, ".", "_"))
+		}
+
+		settings.NumberReferenceFrames = parseETInt(video.CodecOptions["MaxReferenceFrames"])
+
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecH264)
+		videoDescription.CodecSettings.H264Settings = settings
+	case "mpeg2":
+		settings := &mediaconvert.Mpeg2Settings{
+			Bitrate:              bitrate,
+			FramerateControl:     framerateControl,
+			FramerateDenominator: framerateDenominator,
+			FramerateNumerator:   framerateNumerator,
+			GopSize:              gopSize,
+			RateControlMode:      aws.String(mediaconvert.Mpeg2RateControlModeCbr),
+		}
+
+		if gopSize != nil {
+			settings.GopSizeUnits = aws.String(mediaconvert.Mpeg2GopSizeUnitsFrames)
+		}
+
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecMpeg2)
+		videoDescription.CodecSettings.Mpeg2Settings = settings
+	case "vp8":
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecVp8)
+		videoDescription.CodecSettings.Vp8Settings = &mediaconvert.Vp8Settings{
+			Bitrate:              bitrate,
+			FramerateControl:     framerateControl,
+			FramerateDenominator: framerateDenominator,
+			FramerateNumerator:   framerateNumerator,
+			GopSize:              gopSize,
+			RateControlMode:      aws.String(mediaconvert.Vp8RateControlModeVbr),
+		}
+	case "vp9":
+		videoDescription.CodecSettings.Codec = aws.String(mediaconvert.VideoCodecVp9)
+		videoDescription.CodecSettings.Vp9Settings = &mediaconvert.Vp9Settings{
+			Bitrate:              bitrate,
+			FramerateControl:     framerateControl,
+			FramerateDenominator: framerateDenominator,
+			FramerateNumerator:   framerateNumerator,
+			GopSize:              gopSize,
+			RateControlMode:      aws.String(mediaconvert.Vp9RateControlModeVbr),
+		}
+	default:
+		unsupported = append(unsupported, fmt.Sprintf("video codec %s has no MediaConvert equivalent", v))
+		videoDescription.CodecSettings = nil
+	}
+
+	return videoDescription, unsupported
+}
+
+func expandMediaConvertAudioDescription(audio *elastictranscoder.AudioParameters) (*mediaconvert.AudioDescription, []string) {
+	var unsupported []string
+
+	audioDescription := &mediaconvert.AudioDescription{
+		AudioSourceName: aws.String(mediaConvertAudioSelectorName),
+		CodecSettings:   &mediaconvert.AudioCodecSettings{},
+	}
+
+	bitrate := parseETKilobits(audio.BitRate)
+	channels := parseETInt(audio.Channels)
+	sampleRate := parseETInt(audio.SampleRate)
+
+	var bitDepth *int64
+	if audio.CodecOptions != nil {
+		bitDepth = parseETInt(audio.CodecOptions.BitDepth)
+	}
+
+	if v := aws.StringValue(audio.AudioPackingMode); v != "" && v != "SingleTrack" {
+		unsupported = append(unsupported, fmt.Sprintf("audio packing mode %s must be configured with MediaConvert audio remixing", v))
+	}
+
+	switch v := aws.StringValue(audio.Codec); v {
+	case "AAC":
+		settings := &mediaconvert.AacSettings{
+			Bitrate:         bitrate,
+			CodingMode:      aws.String(mediaconvert.AacCodingModeCodingMode20),
+			RateControlMode: aws.String(mediaconvert.AacRateControlModeCbr),
+			SampleRate:      sampleRate,
+		}
+
+		if aws.Int64Value(channels) == 1 {
+			settings.CodingMode = aws.String(mediaconvert.AacCodingModeCodingMode10)
+		}
+
+		if audio.CodecOptions != nil {
+			switch aws.StringValue(audio.CodecOptions.Profile) {
+			case "AAC-LC":
+				settings.CodecProfile = aws.String(mediaconvert.AacCodecProfileLc)
+			case "HE-AAC":
+				settings.CodecProfile = aws.String(mediaconvert.AacCodecProfileHev1)
+			case "HE-AACv2":
+				settings.CodecProfile = aws.String(mediaconvert.AacCodecProfileHev2)
+			}
+		}
+
+		audioDescription.CodecSettings.Codec = aws.String(mediaconvert.AudioCodecAac)
+		audioDescription.CodecSettings.AacSettings = settings
+	case "flac":
+		audioDescription.CodecSettings.Codec = aws.String(mediaconvert.AudioCodecFlac)
+		audioDescription.CodecSettings.FlacSettings = &mediaconvert.FlacSettings{
+			BitDepth:   bitDepth,
+			Channels:   channels,
+			SampleRate: sampleRate,
+		}
+	case "mp3":
+		audioDescription.CodecSettings.Codec = aws.String(mediaconvert.AudioCodecMp3)
+		audioDescription.CodecSettings.Mp3Settings = &mediaconvert.Mp3Settings{
+			Bitrate:         bitrate,
+			Channels:        channels,
+			RateControlMode: aws.String(mediaconvert.Mp3RateControlModeCbr),
+			SampleRate:      sampleRate,
+		}
+	case "pcm":
+		audioDescription.CodecSettings.Codec = aws.String(mediaconvert.AudioCodecWav)
+		audioDescription.CodecSettings.WavSettings = &mediaconvert.WavSettings{
+			BitDepth:   bitDepth,
+			Channels:   channels,
+			SampleRate: sampleRate,
+		}
+	case "vorbis":
+		audioDescription.CodecSettings.Codec = aws.String(mediaconvert.AudioCodecVorbis)
+		audioDescription.CodecSettings.VorbisSettings = &mediaconvert.VorbisSettings{
+			Channels:   channels,
+			SampleRate: sampleRate,
+		}
+	default:
+		return nil, append(unsupported, fmt.Sprintf("audio codec %s has no MediaConvert equivalent", v))
+	}
+
+	return audioDescription, unsupported
+}
+
+// expandMediaConvertFramerate converts an Elastic Transcoder frame rate, e.g. "29.97", into MediaConvert framerate settings.
+func expandMediaConvertFramerate(frameRate *string) (*string, *int64, *int64) {
+	switch v := aws.StringValue(frameRate); v {
+	case "", "auto":
+		return aws.String(mediaconvert.H264FramerateControlInitializeFromSource), nil, nil
+	case "23.97":
+		return aws.String(mediaconvert.H264FramerateControlSpecified), aws.Int64(24000), aws.Int64(1001)
+	case "29.97":
+		return aws.String(mediaconvert.H264FramerateControlSpecified), aws.Int64(30000), aws.Int64(1001)
+	case "59.94":
+		return aws.String(mediaconvert.H264FramerateControlSpecified), aws.Int64(60000), aws.Int64(1001)
+	default:
+		if n := parseETInt(frameRate); n != nil {
+			return aws.String(mediaconvert.H264FramerateControlSpecified), n, aws.Int64(1)
+		}
+
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return aws.String(mediaconvert.H264FramerateControlSpecified), aws.Int64(int64(f * 1000)), aws.Int64(1000)
+		}
+
+		return aws.String(mediaconvert.H264FramerateControlInitializeFromSource), nil, nil
+	}
+}
+
+// parseETInt parses an Elastic Transcoder numeric setting, returning nil for "auto" or non-numeric values.
+func parseETInt(s *string) *int64 {
+	v, err := strconv.ParseInt(aws.StringValue(s), 10, 64)
+
+	if err != nil {
+		return nil
+	}
+
+	return aws.Int64(v)
+}
+
+func parseETFloat(s *string) *float64 {
+	v, err := strconv.ParseFloat(aws.StringValue(s), 64)
+
+	if err != nil {
+		return nil
+	}
+
+	return aws.Float64(v)
+}
+
+// parseETKilobits converts an Elastic Transcoder kilobits per second setting to bits per second.
+func parseETKilobits(s *string) *int64 {
+	v := parseETInt(s)
+
+	if v == nil {
+		return nil
+	}
+
+	return aws.Int64(aws.Int64Value(v) * 1000)
+}
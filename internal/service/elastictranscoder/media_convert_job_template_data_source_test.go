@@ -0,0 +1,45 @@
+package elastictranscoder_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticTranscoderMediaConvertJobTemplateDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_elastictranscoder_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elastictranscoder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertJobTemplateDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(`"codec":"H_264"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(`"codec":"AAC"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(fmt.Sprintf(`"destination":"s3://%s/"`, rName))),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexp.MustCompile(fmt.Sprintf(`"name":%q`, rName))),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_settings.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMediaConvertJobTemplateDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_basic(rName), testAccPresetConfig_full1(rName), fmt.Sprintf(`
+data "aws_elastictranscoder_media_convert_job_template" "test" {
+  name        = %[1]q
+  pipeline_id = aws_elastictranscoder_pipeline.test.id
+  preset_ids  = [aws_elastictranscoder_preset.test.id]
+}
+`, rName))
+}
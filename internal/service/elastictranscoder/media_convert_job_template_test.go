@@ -0,0 +1,138 @@
+package elastictranscoder
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+)
+
+func TestExpandMediaConvertJobTemplate(t *testing.T) {
+	pipeline := &elastictranscoder.Pipeline{
+		Id:           aws.String("1111111111111-abcde1"),
+		OutputBucket: aws.String("example-output"),
+	}
+	presets := []*elastictranscoder.Preset{
+		{
+			Id:        aws.String("1351620000001-000010"),
+			Container: aws.String("mp4"),
+			Audio: &elastictranscoder.AudioParameters{
+				BitRate:    aws.String("160"),
+				Channels:   aws.String("2"),
+				Codec:      aws.String("AAC"),
+				SampleRate: aws.String("44100"),
+				CodecOptions: &elastictranscoder.AudioCodecOptions{
+					Profile: aws.String("AAC-LC"),
+				},
+			},
+			Video: &elastictranscoder.VideoParameters{
+				BitRate:          aws.String("5400"),
+				Codec:            aws.String("H.264"),
+				FixedGOP:         aws.String("true"),
+				FrameRate:        aws.String("29.97"),
+				KeyframesMaxDist: aws.String("90"),
+				MaxHeight:        aws.String("1080"),
+				MaxWidth:         aws.String("1920"),
+				SizingPolicy:     aws.String("ShrinkToFit"),
+				CodecOptions: map[string]*string{
+					"Level":              aws.String("4.1"),
+					"MaxReferenceFrames": aws.String("3"),
+					"Profile":            aws.String("high"),
+				},
+			},
+			Thumbnails: &elastictranscoder.Thumbnails{
+				Format: aws.String("png"),
+			},
+		},
+		{
+			Id:        aws.String("1351620000001-300040"),
+			Container: aws.String("mp3"),
+			Audio: &elastictranscoder.AudioParameters{
+				BitRate:    aws.String("128"),
+				Channels:   aws.String("auto"),
+				Codec:      aws.String("mp3"),
+				SampleRate: aws.String("auto"),
+			},
+		},
+		{
+			Id:        aws.String("1351620000001-100200"),
+			Container: aws.String("gif"),
+			Video: &elastictranscoder.VideoParameters{
+				BitRate: aws.String("auto"),
+				Codec:   aws.String("gif"),
+			},
+		},
+	}
+
+	input, unsupported := expandMediaConvertJobTemplate(pipeline, presets)
+
+	if got, want := len(input.Settings.OutputGroups), 1; got != want {
+		t.Fatalf("output groups: got %d, want %d", got, want)
+	}
+
+	outputGroup := input.Settings.OutputGroups[0]
+
+	if got, want := aws.StringValue(outputGroup.OutputGroupSettings.FileGroupSettings.Destination), "s3://example-output/"; got != want {
+		t.Errorf("destination: got %s, want %s", got, want)
+	}
+
+	if got, want := len(outputGroup.Outputs), 3; got != want {
+		t.Fatalf("outputs: got %d, want %d", got, want)
+	}
+
+	video := outputGroup.Outputs[0].VideoDescription
+	h264 := video.CodecSettings.H264Settings
+
+	if got, want := aws.StringValue(outputGroup.Outputs[0].ContainerSettings.Container), mediaconvert.ContainerTypeMp4; got != want {
+		t.Errorf("container: got %s, want %s", got, want)
+	}
+	if got, want := aws.Int64Value(video.Width), int64(1920); got != want {
+		t.Errorf("width: got %d, want %d", got, want)
+	}
+	if got, want := aws.StringValue(video.ScalingBehavior), mediaconvert.ScalingBehaviorFitNoUpscale; got != want {
+		t.Errorf("scaling behavior: got %s, want %s", got, want)
+	}
+	if got, want := aws.Int64Value(h264.Bitrate), int64(5400000); got != want {
+		t.Errorf("bitrate: got %d, want %d", got, want)
+	}
+	if got, want := aws.StringValue(h264.CodecLevel), mediaconvert.H264CodecLevelLevel41; got != want {
+		t.Errorf("codec level: got %s, want %s", got, want)
+	}
+	if got, want := aws.StringValue(h264.CodecProfile), mediaconvert.H264CodecProfileHigh; got != want {
+		t.Errorf("codec profile: got %s, want %s", got, want)
+	}
+	if got, want := aws.Int64Value(h264.FramerateNumerator), int64(30000); got != want {
+		t.Errorf("framerate numerator: got %d, want %d", got, want)
+	}
+	if got, want := aws.StringValue(h264.SceneChangeDetect), mediaconvert.H264SceneChangeDetectDisabled; got != want {
+		t.Errorf("scene change detect: got %s, want %s", got, want)
+	}
+
+	aac := outputGroup.Outputs[0].AudioDescriptions[0].CodecSettings.AacSettings
+
+	if got, want := aws.StringValue(aac.CodecProfile), mediaconvert.AacCodecProfileLc; got != want {
+		t.Errorf("AAC profile: got %s, want %s", got, want)
+	}
+	if got, want := aws.Int64Value(aac.SampleRate), int64(44100); got != want {
+		t.Errorf("AAC sample rate: got %d, want %d", got, want)
+	}
+
+	mp3 := outputGroup.Outputs[1].AudioDescriptions[0].CodecSettings.Mp3Settings
+
+	if got, want := aws.StringValue(outputGroup.Outputs[1].ContainerSettings.Container), mediaconvert.ContainerTypeRaw; got != want {
+		t.Errorf("container: got %s, want %s", got, want)
+	}
+	if mp3.Channels != nil || mp3.SampleRate != nil {
+		t.Errorf("expected auto MP3 channels and sample rate to be omitted")
+	}
+
+	if outputGroup.Outputs[2].ContainerSettings != nil {
+		t.Errorf("expected unsupported container to be omitted")
+	}
+
+	// Thumbnails, the gif container, the gif codec and its auto bit rate.
+	if got, want := len(unsupported), 4; got != want {
+		t.Errorf("unsupported settings: got %d (%v), want %d", got, unsupported, want)
+	}
+}
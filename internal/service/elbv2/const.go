@@ -5,3 +5,12 @@ const (
 
 	TagsOnCreationErrMessage = "cannot specify tags on creation"
 )
+
+const (
+	targetGroupHealthOff = "off"
+
+	targetGroupAttributeDNSFailoverMinimumHealthyTargetsCount                = "target_group_health.dns_failover.minimum_healthy_targets.count"
+	targetGroupAttributeDNSFailoverMinimumHealthyTargetsPercentage           = "target_group_health.dns_failover.minimum_healthy_targets.percentage"
+	targetGroupAttributeUnhealthyStateRoutingMinimumHealthyTargetsCount      = "target_group_health.unhealthy_state_routing.minimum_healthy_targets.count"
+	targetGroupAttributeUnhealthyStateRoutingMinimumHealthyTargetsPercentage = "target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage"
+)
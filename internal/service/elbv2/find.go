@@ -3,6 +3,9 @@ package elbv2
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindListenerByARN(conn *elbv2.ELBV2, arn string) (*elbv2.Listener, error) {
@@ -92,3 +95,32 @@ func FindTargetGroupByARN(conn *elbv2.ELBV2, arn string) (*elbv2.TargetGroup, er
 
 	return nil, nil
 }
+
+func FindTargetHealthDescriptions(conn *elbv2.ELBV2, input *elbv2.DescribeTargetHealthInput) ([]*elbv2.TargetHealthDescription, error) {
+	output, err := conn.DescribeTargetHealth(input)
+
+	if tfawserr.ErrCodeEquals(err, elbv2.ErrCodeTargetGroupNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var targetHealthDescriptions []*elbv2.TargetHealthDescription
+
+	for _, v := range output.TargetHealthDescriptions {
+		if v != nil {
+			targetHealthDescriptions = append(targetHealthDescriptions, v)
+		}
+	}
+
+	return targetHealthDescriptions, nil
+}
@@ -263,6 +263,60 @@ func ResourceTargetGroup() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(elbv2.TargetGroupIpAddressTypeEnum_Values(), false),
 			},
+			"target_group_health": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_failover": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "1",
+										ValidateFunc: validTargetGroupHealthCount,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      targetGroupHealthOff,
+										ValidateFunc: validTargetGroupHealthPercentage,
+									},
+								},
+							},
+						},
+						"unhealthy_state_routing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      targetGroupHealthOff,
+										ValidateFunc: validTargetGroupHealthPercentage,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -475,6 +529,10 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 			})
 		}
 
+		if v, ok := d.GetOk("target_group_health"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			attrs = append(attrs, expandTargetGroupHealthAttributes(v.([]interface{})[0].(map[string]interface{}))...)
+		}
+
 		if v, ok := d.Get("protocol").(string); ok && v != elbv2.ProtocolEnumGeneve {
 			if v, ok := d.GetOk("stickiness"); ok && len(v.([]interface{})) > 0 {
 				stickinessBlocks := v.([]interface{})
@@ -700,6 +758,12 @@ func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 			})
 		}
 
+		if d.HasChange("target_group_health") {
+			if v, ok := d.GetOk("target_group_health"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				attrs = append(attrs, expandTargetGroupHealthAttributes(v.([]interface{})[0].(map[string]interface{}))...)
+			}
+		}
+
 		if v, ok := d.Get("protocol").(string); ok && v != elbv2.ProtocolEnumGeneve {
 			if d.HasChange("stickiness") {
 				stickinessBlocks := d.Get("stickiness").([]interface{})
@@ -986,6 +1050,15 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 		return fmt.Errorf("setting stickiness: %w", err)
 	}
 
+	targetGroupHealthAttr, err := flattenTargetGroupHealth(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("flattening target_group_health: %w", err)
+	}
+
+	if err := d.Set("target_group_health", targetGroupHealthAttr); err != nil {
+		return fmt.Errorf("setting target_group_health: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.ErrorISOUnsupported(conn.PartitionID, err) {
@@ -1052,6 +1125,80 @@ func flattenTargetGroupStickiness(attributes []*elbv2.TargetGroupAttribute) ([]i
 	return []interface{}{m}, nil
 }
 
+func expandTargetGroupHealthAttributes(tfMap map[string]interface{}) []*elbv2.TargetGroupAttribute {
+	var attrs []*elbv2.TargetGroupAttribute
+
+	if v, ok := tfMap["dns_failover"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		attrs = append(attrs,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeDNSFailoverMinimumHealthyTargetsCount),
+				Value: aws.String(tfMap["minimum_healthy_targets_count"].(string)),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeDNSFailoverMinimumHealthyTargetsPercentage),
+				Value: aws.String(tfMap["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	if v, ok := tfMap["unhealthy_state_routing"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		attrs = append(attrs,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeUnhealthyStateRoutingMinimumHealthyTargetsCount),
+				Value: aws.String(strconv.Itoa(tfMap["minimum_healthy_targets_count"].(int))),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String(targetGroupAttributeUnhealthyStateRoutingMinimumHealthyTargetsPercentage),
+				Value: aws.String(tfMap["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	return attrs
+}
+
+// flattenTargetGroupHealth returns the target_group_health configuration block.
+// Target groups that do not support target group health settings (e.g. Lambda) return no attributes and an empty block list.
+func flattenTargetGroupHealth(attributes []*elbv2.TargetGroupAttribute) ([]interface{}, error) {
+	dnsFailover := make(map[string]interface{})
+	unhealthyStateRouting := make(map[string]interface{})
+
+	for _, attr := range attributes {
+		switch aws.StringValue(attr.Key) {
+		case targetGroupAttributeDNSFailoverMinimumHealthyTargetsCount:
+			dnsFailover["minimum_healthy_targets_count"] = aws.StringValue(attr.Value)
+		case targetGroupAttributeDNSFailoverMinimumHealthyTargetsPercentage:
+			dnsFailover["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		case targetGroupAttributeUnhealthyStateRoutingMinimumHealthyTargetsCount:
+			count, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("converting %s to int: %s", targetGroupAttributeUnhealthyStateRoutingMinimumHealthyTargetsCount, aws.StringValue(attr.Value))
+			}
+			unhealthyStateRouting["minimum_healthy_targets_count"] = count
+		case targetGroupAttributeUnhealthyStateRoutingMinimumHealthyTargetsPercentage:
+			unhealthyStateRouting["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		}
+	}
+
+	if len(dnsFailover) == 0 && len(unhealthyStateRouting) == 0 {
+		return []interface{}{}, nil
+	}
+
+	m := make(map[string]interface{})
+
+	if len(dnsFailover) > 0 {
+		m["dns_failover"] = []interface{}{dnsFailover}
+	}
+
+	if len(unhealthyStateRouting) > 0 {
+		m["unhealthy_state_routing"] = []interface{}{unhealthyStateRouting}
+	}
+
+	return []interface{}{m}, nil
+}
+
 func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	protocol := diff.Get("protocol").(string)
 
@@ -145,6 +145,46 @@ func DataSourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"target_group_health": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_failover": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"unhealthy_state_routing": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -272,6 +312,15 @@ func dataSourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting stickiness: %w", err)
 	}
 
+	targetGroupHealthAttr, err := flattenTargetGroupHealth(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("flattening target_group_health: %w", err)
+	}
+
+	if err := d.Set("target_group_health", targetGroupHealthAttr); err != nil {
+		return fmt.Errorf("setting target_group_health: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.ErrorISOUnsupported(conn.PartitionID, err) {
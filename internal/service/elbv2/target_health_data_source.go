@@ -0,0 +1,164 @@
+package elbv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceTargetHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTargetHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"target_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_health_descriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTargetHealthRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ELBV2Conn
+
+	targetGroupARN := d.Get("target_group_arn").(string)
+	input := &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	}
+
+	if v, ok := d.GetOk("target"); ok && len(v.([]interface{})) > 0 {
+		input.Targets = expandTargetDescriptions(v.([]interface{}))
+	}
+
+	targetHealthDescriptions, err := FindTargetHealthDescriptions(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading ELBv2 Target Group (%s) target health: %w", targetGroupARN, err)
+	}
+
+	d.SetId(targetGroupARN)
+
+	if err := d.Set("target_health_descriptions", flattenTargetHealthDescriptions(targetHealthDescriptions)); err != nil {
+		return fmt.Errorf("setting target_health_descriptions: %w", err)
+	}
+
+	return nil
+}
+
+func expandTargetDescriptions(tfList []interface{}) []*elbv2.TargetDescription {
+	var apiObjects []*elbv2.TargetDescription
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &elbv2.TargetDescription{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["availability_zone"].(string); ok && v != "" {
+			apiObject.AvailabilityZone = aws.String(v)
+		}
+
+		if v, ok := tfMap["port"].(int); ok && v != 0 {
+			apiObject.Port = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetHealthDescriptions(apiObjects []*elbv2.TargetHealthDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"health_check_port": aws.StringValue(apiObject.HealthCheckPort),
+		}
+
+		if v := apiObject.Target; v != nil {
+			tfMap["availability_zone"] = aws.StringValue(v.AvailabilityZone)
+			tfMap["id"] = aws.StringValue(v.Id)
+			tfMap["port"] = aws.Int64Value(v.Port)
+		}
+
+		if v := apiObject.TargetHealth; v != nil {
+			tfMap["description"] = aws.StringValue(v.Description)
+			tfMap["reason"] = aws.StringValue(v.Reason)
+			tfMap["state"] = aws.StringValue(v.State)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,65 @@
+package elbv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBV2TargetHealthDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_target_health.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetHealthDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "aws_lb_target_group.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.id", "10.0.0.10"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.state", elbv2.TargetHealthStateEnumUnused),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.reason", elbv2.TargetHealthReasonEnumTargetNotInUse),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetHealthDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name        = %[1]q
+  port        = 8080
+  protocol    = "HTTP"
+  target_type = "ip"
+  vpc_id      = aws_vpc.test.id
+}
+
+resource "aws_lb_target_group_attachment" "test" {
+  target_group_arn = aws_lb_target_group.test.arn
+  target_id        = "10.0.0.10"
+  port             = 8080
+}
+
+data "aws_lb_target_health" "test" {
+  target_group_arn = aws_lb_target_group_attachment.test.target_group_arn
+}
+`, rName)
+}
@@ -3,6 +3,7 @@ package elbv2
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -96,3 +97,29 @@ func validTargetGroupNamePrefix(v interface{}, k string) (ws []string, errors []
 	}
 	return
 }
+
+// validTargetGroupHealthCount validates a target group health minimum healthy targets count: "off" or a positive integer.
+func validTargetGroupHealthCount(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == targetGroupHealthOff {
+		return
+	}
+
+	if n, err := strconv.Atoi(value); err != nil || n < 1 {
+		errors = append(errors, fmt.Errorf("%q must be %q or an integer greater than 0: %q", k, targetGroupHealthOff, value))
+	}
+	return
+}
+
+// validTargetGroupHealthPercentage validates a target group health minimum healthy targets percentage: "off" or an integer between 1 and 100.
+func validTargetGroupHealthPercentage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == targetGroupHealthOff {
+		return
+	}
+
+	if n, err := strconv.Atoi(value); err != nil || n < 1 || n > 100 {
+		errors = append(errors, fmt.Errorf("%q must be %q or an integer between 1 and 100: %q", k, targetGroupHealthOff, value))
+	}
+	return
+}
@@ -116,3 +116,63 @@ func TestValidTargetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidTargetGroupHealthCount(t *testing.T) {
+	validValues := []string{
+		"off",
+		"1",
+		"100",
+	}
+
+	for _, s := range validValues {
+		_, errors := validTargetGroupHealthCount(s, "minimum_healthy_targets_count")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid target group health count: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"0",
+		"-1",
+		"OFF",
+		"one",
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validTargetGroupHealthCount(s, "minimum_healthy_targets_count")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid target group health count", s)
+		}
+	}
+}
+
+func TestValidTargetGroupHealthPercentage(t *testing.T) {
+	validValues := []string{
+		"off",
+		"1",
+		"50",
+		"100",
+	}
+
+	for _, s := range validValues {
+		_, errors := validTargetGroupHealthPercentage(s, "minimum_healthy_targets_percentage")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid target group health percentage: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"0",
+		"101",
+		"50%",
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validTargetGroupHealthPercentage(s, "minimum_healthy_targets_percentage")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid target group health percentage", s)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package emr
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -14,6 +15,8 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -37,7 +40,14 @@ func ResourceCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// Only instance groups can be reconfigured in place, and only from EMR release 5.21.0.
+			// Instance fleet clusters and clusters on earlier releases must be replaced.
+			customdiff.ForceNewIf("configurations_json", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return len(d.Get("master_instance_fleet").([]interface{})) > 0 || !releaseLabelSupportsReconfiguration(d.Get("release_label").(string))
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"additional_info": {
@@ -117,7 +127,6 @@ func ResourceCluster() *schema.Resource {
 			"configurations_json": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 				StateFunc: func(v interface{}) string {
@@ -1028,12 +1037,21 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("cluster_state", cluster.Status.State)
 	d.Set("arn", cluster.ClusterArn)
 
+	// Configurations applied via instance group reconfiguration are not reflected in the cluster's configurations.
+	configurations := cluster.Configurations
+
 	instanceGroups, err := fetchAllInstanceGroups(conn, d.Id())
 
 	if err == nil { // find instance group
 		coreGroup := coreInstanceGroup(instanceGroups)
 		masterGroup := findMasterGroup(instanceGroups)
 
+		if v, err := reconfiguredInstanceGroupConfigurations(instanceGroups); err != nil {
+			return fmt.Errorf("error reading EMR Cluster (%s) Instance Group configurations: %w", d.Id(), err)
+		} else if v != nil {
+			configurations = v
+		}
+
 		flattenedCoreInstanceGroup, err := flattenCoreInstanceGroup(coreGroup)
 
 		if err != nil {
@@ -1101,7 +1119,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if _, ok := d.GetOk("configurations_json"); ok {
-		configOut, err := flattenConfigurationJSON(cluster.Configurations)
+		configOut, err := flattenConfigurationJSON(configurations)
 		if err != nil {
 			return fmt.Errorf("Error reading EMR cluster configurations: %w", err)
 		}
@@ -1333,6 +1351,27 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("configurations_json") {
+		// Removing configurations_json clears the configurations.
+		configurations := []*emr.Configuration{}
+
+		if v := d.Get("configurations_json").(string); v != "" {
+			info, err := structure.NormalizeJsonString(v)
+			if err != nil {
+				return fmt.Errorf("configurations_json contains an invalid JSON: %v", err)
+			}
+
+			configurations, err = expandConfigurationJSON(info)
+			if err != nil {
+				return fmt.Errorf("Error reading EMR configurations_json: %w", err)
+			}
+		}
+
+		if err := reconfigureClusterInstanceGroups(conn, d.Id(), configurations, instanceGroupUpdateTimeout); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
@@ -1914,6 +1953,67 @@ func findMasterGroup(instanceGroups []*emr.InstanceGroup) *emr.InstanceGroup {
 	return nil
 }
 
+// reconfiguredInstanceGroupConfigurations returns the configurations applied by reconfiguring the cluster's
+// instance groups, or nil if none of them has been reconfigured.
+// configurations_json is applied to every running instance group, so the master instance group's configurations
+// are returned unless another running instance group's differ, in which case that group's are returned so that
+// the difference is reported as drift.
+func reconfiguredInstanceGroupConfigurations(instanceGroups []*emr.InstanceGroup) ([]*emr.Configuration, error) {
+	masterGroup := findMasterGroup(instanceGroups)
+
+	if masterGroup == nil {
+		return nil, nil
+	}
+
+	var reconfigured bool
+	var running []*emr.InstanceGroup
+
+	for _, instanceGroup := range instanceGroups {
+		if instanceGroup == nil || instanceGroup.Status == nil || aws.StringValue(instanceGroup.Status.State) != emr.InstanceGroupStateRunning {
+			continue
+		}
+
+		if aws.Int64Value(instanceGroup.ConfigurationsVersion) > 0 {
+			reconfigured = true
+		}
+
+		running = append(running, instanceGroup)
+	}
+
+	if !reconfigured {
+		return nil, nil
+	}
+
+	// An instance group without configurations has a nil rather than an empty list.
+	configurations := func(instanceGroup *emr.InstanceGroup) []*emr.Configuration {
+		if instanceGroup.Configurations == nil {
+			return []*emr.Configuration{}
+		}
+
+		return instanceGroup.Configurations
+	}
+
+	want, err := flattenConfigurationJSON(configurations(masterGroup))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instanceGroup := range running {
+		got, err := flattenConfigurationJSON(configurations(instanceGroup))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if got != want {
+			return configurations(instanceGroup), nil
+		}
+	}
+
+	return configurations(masterGroup), nil
+}
+
 func resourceClusterEBSHashConfig(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -1945,6 +2045,72 @@ func getCoreInstanceGroupAutoScalingPolicy(conn *emr.EMR, clusterID string) (*em
 	return coreGroup.AutoScalingPolicy, nil
 }
 
+// releaseLabelSupportsReconfiguration returns whether instance groups of a cluster with the specified
+// release label can be reconfigured while the cluster is running.
+// Release labels that are not of the form emr-x.y.z are assumed to support reconfiguration.
+func releaseLabelSupportsReconfiguration(releaseLabel string) bool {
+	if !strings.HasPrefix(releaseLabel, "emr-") {
+		return true
+	}
+
+	v, err := gversion.NewVersion(strings.TrimPrefix(releaseLabel, "emr-"))
+
+	if err != nil {
+		return true
+	}
+
+	return v.GreaterThanOrEqual(gversion.Must(gversion.NewVersion("5.21.0")))
+}
+
+// reconfigureClusterInstanceGroups applies configurations to every running instance group in a cluster
+// and waits for the reconfiguration to complete.
+func reconfigureClusterInstanceGroups(conn *emr.EMR, clusterID string, configurations []*emr.Configuration, timeout time.Duration) error {
+	instanceGroups, err := fetchAllInstanceGroups(conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("error listing EMR Cluster (%s) Instance Groups: %w", clusterID, err)
+	}
+
+	if configurations == nil {
+		configurations = []*emr.Configuration{}
+	}
+
+	var instanceGroupIDs []string
+	input := &emr.ModifyInstanceGroupsInput{
+		ClusterId: aws.String(clusterID),
+	}
+
+	for _, instanceGroup := range instanceGroups {
+		if instanceGroup == nil || instanceGroup.Status == nil || aws.StringValue(instanceGroup.Status.State) != emr.InstanceGroupStateRunning {
+			continue
+		}
+
+		instanceGroupID := aws.StringValue(instanceGroup.Id)
+		instanceGroupIDs = append(instanceGroupIDs, instanceGroupID)
+		input.InstanceGroups = append(input.InstanceGroups, &emr.InstanceGroupModifyConfig{
+			Configurations:  configurations,
+			InstanceGroupId: aws.String(instanceGroupID),
+		})
+	}
+
+	if len(input.InstanceGroups) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Reconfiguring EMR Cluster (%s) Instance Groups: %s", clusterID, input)
+	if _, err := conn.ModifyInstanceGroups(input); err != nil {
+		return fmt.Errorf("error reconfiguring EMR Cluster (%s) Instance Groups: %w", clusterID, err)
+	}
+
+	for _, instanceGroupID := range instanceGroupIDs {
+		if err := waitForInstanceGroupStateRunning(conn, clusterID, instanceGroupID, timeout); err != nil {
+			return fmt.Errorf("error waiting for EMR Cluster (%s) Instance Group (%s) reconfiguration: %w", clusterID, instanceGroupID, err)
+		}
+	}
+
+	return nil
+}
+
 func fetchAllInstanceGroups(conn *emr.EMR, clusterID string) ([]*emr.InstanceGroup, error) {
 	input := &emr.ListInstanceGroupsInput{
 		ClusterId: aws.String(clusterID),
@@ -0,0 +1,43 @@
+package emr
+
+import (
+	"testing"
+)
+
+func TestReleaseLabelSupportsReconfiguration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Expected bool
+	}{
+		{
+			Value:    "emr-4.9.6",
+			Expected: false,
+		},
+		{
+			Value:    "emr-5.20.1",
+			Expected: false,
+		},
+		{
+			Value:    "emr-5.21.0",
+			Expected: true,
+		},
+		{
+			Value:    "emr-5.36.0",
+			Expected: true,
+		},
+		{
+			Value:    "emr-6.10.0",
+			Expected: true,
+		},
+		{
+			Value:    "custom",
+			Expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := releaseLabelSupportsReconfiguration(tc.Value); got != tc.Expected {
+			t.Errorf("releaseLabelSupportsReconfiguration(%q) = %t, expected %t", tc.Value, got, tc.Expected)
+		}
+	}
+}
@@ -229,6 +229,47 @@ func TestAccEMRCluster_sJSON(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_configurationsJSONUpdate(t *testing.T) {
+	var cluster1, cluster2, cluster3 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationsJSONUpdate(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster1),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexp.MustCompile(`"dfs.replication":"1"`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationsJSONUpdate(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestMatchResourceAttr(resourceName, "configurations_json",
+						regexp.MustCompile(`"dfs.replication":"2"`)),
+				),
+			},
+			{
+				// Removing configurations_json clears the configurations in place.
+				Config: testAccClusterConfig_configurationsJSONUpdate(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster3),
+					testAccCheckClusterNotRecreated(&cluster2, &cluster3),
+					resource.TestCheckResourceAttr(resourceName, "configurations_json", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_CoreInstanceGroup_autoScalingPolicy(t *testing.T) {
 	var cluster1, cluster2, cluster3 emr.Cluster
 	autoscalingPolicy1 := `
@@ -2317,6 +2358,65 @@ EOF
 `, rName))
 }
 
+// testAccClusterConfig_configurationsJSONUpdate omits configurations_json if replication is empty.
+func testAccClusterConfig_configurationsJSONUpdate(rName, replication string) string {
+	var configurationsJSON string
+
+	if replication != "" {
+		configurationsJSON = fmt.Sprintf(`
+  configurations_json = <<EOF
+[
+  {
+    "Classification": "hdfs-site",
+    "Properties": {
+      "dfs.replication": %[1]q
+    }
+  }
+]
+EOF
+`, replication)
+	}
+
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.33.1"
+  applications  = ["Hadoop"]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+
+  master_instance_group {
+    instance_type = "m4.large"
+  }
+
+  core_instance_group {
+    instance_count = 2
+    instance_type  = "m4.large"
+  }
+
+  keep_job_flow_alive_when_no_steps = true
+  termination_protection            = false
+%[2]s
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  service_role = aws_iam_role.emr_service.arn
+}
+`, rName, configurationsJSON))
+}
+
 func testAccClusterConfig_coreInstanceGroupAutoScalingPolicy(rName, autoscalingPolicy string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
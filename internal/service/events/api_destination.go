@@ -134,25 +134,24 @@ func resourceAPIDestinationRead(d *schema.ResourceData, meta interface{}) error
 func resourceAPIDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EventsConn
 
-	input := &eventbridge.UpdateApiDestinationInput{}
+	input := &eventbridge.UpdateApiDestinationInput{
+		Name: aws.String(d.Id()),
+	}
 
-	if name, ok := d.GetOk("name"); ok {
-		input.Name = aws.String(name.(string))
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
 	}
-	if description, ok := d.GetOk("description"); ok {
-		input.Description = aws.String(description.(string))
+	if d.HasChange("invocation_endpoint") {
+		input.InvocationEndpoint = aws.String(d.Get("invocation_endpoint").(string))
 	}
-	if invocationEndpoint, ok := d.GetOk("invocation_endpoint"); ok {
-		input.InvocationEndpoint = aws.String(invocationEndpoint.(string))
+	if d.HasChange("invocation_rate_limit_per_second") {
+		input.InvocationRateLimitPerSecond = aws.Int64(int64(d.Get("invocation_rate_limit_per_second").(int)))
 	}
-	if invocationRateLimitPerSecond, ok := d.GetOk("invocation_rate_limit_per_second"); ok {
-		input.InvocationRateLimitPerSecond = aws.Int64(int64(invocationRateLimitPerSecond.(int)))
+	if d.HasChange("http_method") {
+		input.HttpMethod = aws.String(d.Get("http_method").(string))
 	}
-	if httpMethod, ok := d.GetOk("http_method"); ok {
-		input.HttpMethod = aws.String(httpMethod.(string))
-	}
-	if connectionArn, ok := d.GetOk("connection_arn"); ok {
-		input.ConnectionArn = aws.String(connectionArn.(string))
+	if d.HasChange("connection_arn") {
+		input.ConnectionArn = aws.String(d.Get("connection_arn").(string))
 	}
 
 	log.Printf("[DEBUG] Updating EventBridge API Destination: %s", input)
@@ -7,11 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceConnection() *schema.Resource {
@@ -141,11 +143,24 @@ func ResourceConnection() *schema.Resource {
 									},
 									"value": {
 										Type:      schema.TypeString,
-										Required:  true,
+										Optional:  true,
 										Sensitive: true,
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(1, 512),
 										),
+										ExactlyOneOf: []string{
+											"auth_parameters.0.api_key.0.value",
+											"auth_parameters.0.api_key.0.value_secret_arn",
+										},
+									},
+									"value_secret_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+										ExactlyOneOf: []string{
+											"auth_parameters.0.api_key.0.value",
+											"auth_parameters.0.api_key.0.value_secret_arn",
+										},
 									},
 								},
 							},
@@ -170,11 +185,24 @@ func ResourceConnection() *schema.Resource {
 									},
 									"password": {
 										Type:      schema.TypeString,
-										Required:  true,
+										Optional:  true,
 										Sensitive: true,
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(1, 512),
 										),
+										ExactlyOneOf: []string{
+											"auth_parameters.0.basic.0.password",
+											"auth_parameters.0.basic.0.password_secret_arn",
+										},
+									},
+									"password_secret_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+										ExactlyOneOf: []string{
+											"auth_parameters.0.basic.0.password",
+											"auth_parameters.0.basic.0.password_secret_arn",
+										},
 									},
 								},
 							},
@@ -223,11 +251,24 @@ func ResourceConnection() *schema.Resource {
 												},
 												"client_secret": {
 													Type:      schema.TypeString,
-													Required:  true,
+													Optional:  true,
 													Sensitive: true,
 													ValidateFunc: validation.All(
 														validation.StringLenBetween(1, 512),
 													),
+													ExactlyOneOf: []string{
+														"auth_parameters.0.oauth.0.client_parameters.0.client_secret",
+														"auth_parameters.0.oauth.0.client_parameters.0.client_secret_arn",
+													},
+												},
+												"client_secret_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+													ExactlyOneOf: []string{
+														"auth_parameters.0.oauth.0.client_parameters.0.client_secret",
+														"auth_parameters.0.oauth.0.client_parameters.0.client_secret_arn",
+													},
 												},
 											},
 										},
@@ -260,9 +301,15 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EventsConn
 
 	name := d.Get("name").(string)
+	authParameters, err := resolveConnectionAuthParametersSecrets(meta.(*conns.AWSClient).SecretsManagerConn, d.Get("auth_parameters").([]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("error creating EventBridge connection (%s): %w", name, err)
+	}
+
 	input := &eventbridge.CreateConnectionInput{
 		AuthorizationType: aws.String(d.Get("authorization_type").(string)),
-		AuthParameters:    expandCreateConnectionAuthRequestParameters(d.Get("auth_parameters").([]interface{})),
+		AuthParameters:    expandCreateConnectionAuthRequestParameters(authParameters),
 		Name:              aws.String(name),
 	}
 
@@ -270,7 +317,7 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Description = aws.String(v.(string))
 	}
 
-	_, err := conn.CreateConnection(input)
+	_, err = conn.CreateConnection(input)
 
 	if err != nil {
 		return fmt.Errorf("error creating EventBridge connection (%s): %w", name, err)
@@ -313,6 +360,8 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("auth_parameters", authParameters); err != nil {
 			return fmt.Errorf("error setting auth_parameters error: %w", err)
 		}
+	} else {
+		d.Set("auth_parameters", nil)
 	}
 
 	return nil
@@ -330,11 +379,17 @@ func resourceConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("auth_parameters"); ok {
-		input.AuthParameters = expandUpdateConnectionAuthRequestParameters(v.([]interface{}))
+		authParameters, err := resolveConnectionAuthParametersSecrets(meta.(*conns.AWSClient).SecretsManagerConn, v.([]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("error updating EventBridge connection (%s): %w", d.Id(), err)
+		}
+
+		input.AuthParameters = expandUpdateConnectionAuthRequestParameters(authParameters)
 	}
 
-	if v, ok := d.GetOk("description"); ok {
-		input.Description = aws.String(v.(string))
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
 	}
 
 	_, err := conn.UpdateConnection(input)
@@ -377,6 +432,55 @@ func resourceConnectionDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resolveConnectionAuthParametersSecrets returns the auth_parameters configuration
+// with any credentials referenced by Secrets Manager secret ARN replaced by the
+// secret's current value. Secrets are only read when the connection is created or
+// updated, so a rotated secret is not detected as drift.
+func resolveConnectionAuthParametersSecrets(conn *secretsmanager.SecretsManager, tfList []interface{}) ([]interface{}, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return tfList, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	for _, v := range []struct {
+		path      []string
+		valueKey  string
+		secretKey string
+	}{
+		{path: []string{"api_key"}, valueKey: "value", secretKey: "value_secret_arn"},
+		{path: []string{"basic"}, valueKey: "password", secretKey: "password_secret_arn"},
+		{path: []string{"oauth", "client_parameters"}, valueKey: "client_secret", secretKey: "client_secret_arn"},
+	} {
+		m := tfMap
+
+		for _, k := range v.path {
+			if l, ok := m[k].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+				m = l[0].(map[string]interface{})
+			} else {
+				m = nil
+				break
+			}
+		}
+
+		if m == nil {
+			continue
+		}
+
+		if arn, ok := m[v.secretKey].(string); ok && arn != "" {
+			value, err := findSecretStringByARN(conn, arn)
+
+			if err != nil {
+				return nil, fmt.Errorf("reading Secrets Manager secret (%s): %w", arn, err)
+			}
+
+			m[v.valueKey] = value
+		}
+	}
+
+	return tfList, nil
+}
+
 func expandCreateConnectionAuthRequestParameters(config []interface{}) *eventbridge.CreateConnectionAuthRequestParameters {
 	authParameters := &eventbridge.CreateConnectionAuthRequestParameters{}
 	for _, c := range config {
@@ -594,8 +698,16 @@ func flattenConnectionAPIKeyAuthParameters(apiKeyAuthParameters *eventbridge.Con
 		config["key"] = aws.StringValue(apiKeyAuthParameters.ApiKeyName)
 	}
 
-	if v, ok := resourceData.GetOk("auth_parameters.0.api_key.0.value"); ok {
-		config["value"] = v.(string)
+	// The API key value is never returned. Only carry it over from state while the
+	// described key name still matches, so that an out-of-band change is re-applied.
+	if aws.StringValue(apiKeyAuthParameters.ApiKeyName) == resourceData.Get("auth_parameters.0.api_key.0.key").(string) {
+		if v, ok := resourceData.GetOk("auth_parameters.0.api_key.0.value"); ok {
+			config["value"] = v.(string)
+		}
+	}
+
+	if v, ok := resourceData.GetOk("auth_parameters.0.api_key.0.value_secret_arn"); ok {
+		config["value_secret_arn"] = v.(string)
 	}
 
 	result := []map[string]interface{}{config}
@@ -612,8 +724,16 @@ func flattenConnectionBasicAuthParameters(basicAuthParameters *eventbridge.Conne
 		config["username"] = aws.StringValue(basicAuthParameters.Username)
 	}
 
-	if v, ok := resourceData.GetOk("auth_parameters.0.basic.0.password"); ok {
-		config["password"] = v.(string)
+	// The password is never returned. Only carry it over from state while the
+	// described username still matches, so that an out-of-band change is re-applied.
+	if aws.StringValue(basicAuthParameters.Username) == resourceData.Get("auth_parameters.0.basic.0.username").(string) {
+		if v, ok := resourceData.GetOk("auth_parameters.0.basic.0.password"); ok {
+			config["password"] = v.(string)
+		}
+	}
+
+	if v, ok := resourceData.GetOk("auth_parameters.0.basic.0.password_secret_arn"); ok {
+		config["password_secret_arn"] = v.(string)
 	}
 
 	result := []map[string]interface{}{config}
@@ -649,8 +769,16 @@ func flattenConnectionOAuthClientResponseParameters(oAuthClientRequestParameters
 		config["client_id"] = aws.StringValue(oAuthClientRequestParameters.ClientID)
 	}
 
-	if v, ok := resourceData.GetOk("auth_parameters.0.oauth.0.client_parameters.0.client_secret"); ok {
-		config["client_secret"] = v.(string)
+	// The client secret is never returned. Only carry it over from state while the
+	// described client ID still matches, so that an out-of-band change is re-applied.
+	if aws.StringValue(oAuthClientRequestParameters.ClientID) == resourceData.Get("auth_parameters.0.oauth.0.client_parameters.0.client_id").(string) {
+		if v, ok := resourceData.GetOk("auth_parameters.0.oauth.0.client_parameters.0.client_secret"); ok {
+			config["client_secret"] = v.(string)
+		}
+	}
+
+	if v, ok := resourceData.GetOk("auth_parameters.0.oauth.0.client_parameters.0.client_secret_arn"); ok {
+		config["client_secret_arn"] = v.(string)
 	}
 
 	result := []map[string]interface{}{config}
@@ -568,6 +568,49 @@ func TestAccEventsConnection_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEventsConnection_basicPasswordSecretARN(t *testing.T) {
+	var v1, v2 eventbridge.DescribeConnectionOutput
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	username := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	password := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	passwordModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_connection.basic"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_basicPasswordSecretARN(name, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.username", username),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_parameters.0.basic.0.password_secret_arn", "aws_secretsmanager_secret.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_parameters.0.basic.0.password_secret_arn"},
+			},
+			{
+				Config: testAccConnectionConfig_basic(name, "", "BASIC", username, passwordModified),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName, &v2),
+					testAccCheckConnectionNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password", passwordModified),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password_secret_arn", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckConnectionDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
 
@@ -671,6 +714,31 @@ resource "aws_cloudwatch_event_connection" "basic" {
 		password)
 }
 
+func testAccConnectionConfig_basicPasswordSecretARN(name, username, password string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = %[3]q
+}
+
+resource "aws_cloudwatch_event_connection" "basic" {
+  name               = %[1]q
+  authorization_type = "BASIC"
+  auth_parameters {
+    basic {
+      username            = %[2]q
+      password_secret_arn = aws_secretsmanager_secret_version.test.arn
+    }
+  }
+}
+`, name, username, password)
+}
+
 func testAccConnectionConfig_oauth(
 	name,
 	description,
@@ -0,0 +1,461 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEndpointCreate,
+		ReadWithoutTimeout:   resourceEndpointRead,
+		UpdateWithoutTimeout: resourceEndpointUpdate,
+		DeleteWithoutTimeout: resourceEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: resourceEndpointCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_bus": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_bus_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validEndpointName,
+			},
+			"replication_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      eventbridge.ReplicationStateEnabled,
+							ValidateFunc: validation.StringInSlice(eventbridge.ReplicationState_Values(), false),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"routing_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"primary": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"health_check": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARNOfService("route53", "healthcheck"),
+												},
+											},
+										},
+									},
+									"secondary": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"route": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidRegionName,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EventsConn
+
+	name := d.Get("name").(string)
+	input := &eventbridge.CreateEndpointInput{
+		EventBuses:    expandEndpointEventBuses(d.Get("event_bus").([]interface{})),
+		Name:          aws.String(name),
+		RoutingConfig: expandEndpointRoutingConfig(d.Get("routing_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("replication_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReplicationConfig = expandEndpointReplicationConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Global Endpoint: %s", input)
+	_, err := conn.CreateEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EventBridge Global Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for EventBridge Global Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EventsConn
+
+	output, err := FindEndpointByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Global Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EventBridge Global Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("endpoint_url", output.EndpointUrl)
+	if err := d.Set("event_bus", flattenEndpointEventBuses(output.EventBuses)); err != nil {
+		return diag.Errorf("setting event_bus: %s", err)
+	}
+	d.Set("name", output.Name)
+	if output.ReplicationConfig != nil {
+		if err := d.Set("replication_config", []interface{}{flattenEndpointReplicationConfig(output.ReplicationConfig)}); err != nil {
+			return diag.Errorf("setting replication_config: %s", err)
+		}
+	} else {
+		d.Set("replication_config", nil)
+	}
+	d.Set("role_arn", output.RoleArn)
+	if output.RoutingConfig != nil {
+		if err := d.Set("routing_config", []interface{}{flattenEndpointRoutingConfig(output.RoutingConfig)}); err != nil {
+			return diag.Errorf("setting routing_config: %s", err)
+		}
+	} else {
+		d.Set("routing_config", nil)
+	}
+
+	return nil
+}
+
+func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EventsConn
+
+	input := &eventbridge.UpdateEndpointInput{
+		Name: aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("event_bus") {
+		input.EventBuses = expandEndpointEventBuses(d.Get("event_bus").([]interface{}))
+	}
+
+	if d.HasChange("replication_config") {
+		if v, ok := d.GetOk("replication_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ReplicationConfig = expandEndpointReplicationConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("role_arn") {
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("routing_config") {
+		input.RoutingConfig = expandEndpointRoutingConfig(d.Get("routing_config").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating EventBridge Global Endpoint: %s", input)
+	_, err := conn.UpdateEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating EventBridge Global Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for EventBridge Global Endpoint (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EventsConn
+
+	log.Printf("[INFO] Deleting EventBridge Global Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpointWithContext(ctx, &eventbridge.DeleteEndpointInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eventbridge.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EventBridge Global Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for EventBridge Global Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceEndpointCustomizeDiff requires role_arn when event replication is enabled,
+// as EventBridge needs the role to put replicated events onto the secondary event bus.
+func resourceEndpointCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if state := d.Get("replication_config.0.state").(string); state != "" && state != eventbridge.ReplicationStateEnabled {
+		return nil
+	}
+
+	if !d.NewValueKnown("role_arn") {
+		return nil
+	}
+
+	if v := d.Get("role_arn").(string); v == "" {
+		return fmt.Errorf(`"role_arn" is required when "replication_config" state is %q`, eventbridge.ReplicationStateEnabled)
+	}
+
+	return nil
+}
+
+func expandEndpointEventBuses(tfList []interface{}) []*eventbridge.EndpointEventBus {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*eventbridge.EndpointEventBus
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &eventbridge.EndpointEventBus{}
+
+		if v, ok := tfMap["event_bus_arn"].(string); ok && v != "" {
+			apiObject.EventBusArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEndpointReplicationConfig(tfMap map[string]interface{}) *eventbridge.ReplicationConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &eventbridge.ReplicationConfig{}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEndpointRoutingConfig(tfList []interface{}) *eventbridge.RoutingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &eventbridge.RoutingConfig{}
+
+	if v, ok := tfMap["failover_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FailoverConfig = expandEndpointFailoverConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEndpointFailoverConfig(tfMap map[string]interface{}) *eventbridge.FailoverConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &eventbridge.FailoverConfig{}
+
+	if v, ok := tfMap["primary"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Primary = &eventbridge.Primary{}
+
+		if v, ok := tfMap["health_check"].(string); ok && v != "" {
+			apiObject.Primary.HealthCheck = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["secondary"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Secondary = &eventbridge.Secondary{}
+
+		if v, ok := tfMap["route"].(string); ok && v != "" {
+			apiObject.Secondary.Route = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenEndpointEventBuses(apiObjects []*eventbridge.EndpointEventBus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"event_bus_arn": aws.StringValue(apiObject.EventBusArn),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEndpointReplicationConfig(apiObject *eventbridge.ReplicationConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.State; v != nil {
+		tfMap["state"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenEndpointRoutingConfig(apiObject *eventbridge.RoutingConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FailoverConfig; v != nil {
+		tfMap["failover_config"] = []interface{}{flattenEndpointFailoverConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenEndpointFailoverConfig(apiObject *eventbridge.FailoverConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Primary; v != nil {
+		tfMap["primary"] = []interface{}{map[string]interface{}{
+			"health_check": aws.StringValue(v.HealthCheck),
+		}}
+	}
+
+	if v := apiObject.Secondary; v != nil {
+		tfMap["secondary"] = []interface{}{map[string]interface{}{
+			"route": aws.StringValue(v.Route),
+		}}
+	}
+
+	return tfMap
+}
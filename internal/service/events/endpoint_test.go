@@ -0,0 +1,303 @@
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevents "github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEventsEndpoint_basic(t *testing.T) {
+	var v eventbridge.DescribeEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("endpoint/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttr(resourceName, "event_bus.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_bus.0.event_bus_arn", "aws_cloudwatch_event_bus.primary", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_bus.1.event_bus_arn", "aws_cloudwatch_event_bus.secondary", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "replication_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_config.0.state", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "routing_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_config.0.failover_config.0.primary.0.health_check", "aws_route53_health_check.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_config.0.failover_config.0.secondary.0.route", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEventsEndpoint_disappears(t *testing.T) {
+	var v eventbridge.DescribeEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfevents.ResourceEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEventsEndpoint_replication(t *testing.T) {
+	var v eventbridge.DescribeEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_replication(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "replication_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_config.0.state", "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointConfig_replication(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "replication_config.0.state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_endpoint" {
+			continue
+		}
+
+		_, err := tfevents.FindEndpointByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EventBridge Global Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEndpointExists(n string, v *eventbridge.DescribeEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Global Endpoint ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+
+		output, err := tfevents.FindEndpointByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEndpointConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "primary" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_bus" "secondary" {
+  provider = "awsalternate"
+
+  name = %[1]q
+}
+
+resource "aws_route53_health_check" "test" {
+  fqdn              = "example.com"
+  port              = 443
+  type              = "HTTPS"
+  resource_path     = "/"
+  failure_threshold = 5
+  request_interval  = 30
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_event_endpoint" "test" {
+  name = %[1]q
+
+  event_bus {
+    event_bus_arn = aws_cloudwatch_event_bus.primary.arn
+  }
+
+  event_bus {
+    event_bus_arn = aws_cloudwatch_event_bus.secondary.arn
+  }
+
+  replication_config {
+    state = "DISABLED"
+  }
+
+  routing_config {
+    failover_config {
+      primary {
+        health_check = aws_route53_health_check.test.arn
+      }
+
+      secondary {
+        route = %[2]q
+      }
+    }
+  }
+}
+`, rName, acctest.AlternateRegion()))
+}
+
+func testAccEndpointConfig_replication(rName, description string) string {
+	return acctest.ConfigCompose(testAccEndpointConfig_base(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "events.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "events:PutEvents"
+      Effect = "Allow"
+      Resource = [
+        aws_cloudwatch_event_bus.primary.arn,
+        aws_cloudwatch_event_bus.secondary.arn,
+      ]
+    }]
+  })
+}
+
+resource "aws_cloudwatch_event_endpoint" "test" {
+  name        = %[1]q
+  description = %[3]q
+  role_arn    = aws_iam_role.test.arn
+
+  event_bus {
+    event_bus_arn = aws_cloudwatch_event_bus.primary.arn
+  }
+
+  event_bus {
+    event_bus_arn = aws_cloudwatch_event_bus.secondary.arn
+  }
+
+  replication_config {
+    state = "ENABLED"
+  }
+
+  routing_config {
+    failover_config {
+      primary {
+        health_check = aws_route53_health_check.test.arn
+      }
+
+      secondary {
+        route = %[2]q
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, acctest.AlternateRegion(), description))
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -36,6 +37,56 @@ func FindConnectionByName(conn *eventbridge.EventBridge, name string) (*eventbri
 	return output, nil
 }
 
+func FindEndpointByName(ctx context.Context, conn *eventbridge.EventBridge, name string) (*eventbridge.DescribeEndpointOutput, error) {
+	input := &eventbridge.DescribeEndpointInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeEndpointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, eventbridge.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findSecretStringByARN(conn *secretsmanager.SecretsManager, arn string) (string, error) {
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(arn),
+	}
+
+	output, err := conn.GetSecretValue(input)
+
+	if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.SecretString == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.SecretString), nil
+}
+
 func FindRuleByEventBusAndRuleNames(conn *eventbridge.EventBridge, eventBusName, ruleName string) (*eventbridge.DescribeRuleOutput, error) {
 	input := eventbridge.DescribeRuleInput{
 		Name: aws.String(ruleName),
@@ -1,6 +1,8 @@
 package events
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -22,3 +24,19 @@ func statusConnectionState(conn *eventbridge.EventBridge, name string) resource.
 		return output, aws.StringValue(output.ConnectionState), nil
 	}
 }
+
+func statusEndpointState(ctx context.Context, conn *eventbridge.EventBridge, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
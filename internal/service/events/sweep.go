@@ -48,6 +48,11 @@ func init() {
 		F:    sweepConnection,
 	})
 
+	resource.AddTestSweepers("aws_cloudwatch_event_endpoint", &resource.Sweeper{
+		Name: "aws_cloudwatch_event_endpoint",
+		F:    sweepEndpoints,
+	})
+
 	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    sweepPermissions,
@@ -261,6 +266,55 @@ func sweepConnection(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepEndpoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EventsConn
+
+	var sweeperErrs *multierror.Error
+
+	input := &eventbridge.ListEndpointsInput{
+		MaxResults: aws.Int64(100),
+	}
+	var endpoints []*eventbridge.Endpoint
+	for {
+		output, err := conn.ListEndpoints(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EventBridge Global Endpoint sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("Error retrieving EventBridge Global Endpoints: %w", err)
+		}
+
+		endpoints = append(endpoints, output.Endpoints...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	for _, endpoint := range endpoints {
+		input := &eventbridge.DeleteEndpointInput{
+			Name: endpoint.Name,
+		}
+		_, err := conn.DeleteEndpoint(input)
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge Global Endpoint (%s): %w", aws.StringValue(endpoint.Name), err))
+			continue
+		}
+	}
+
+	log.Printf("[INFO] Deleted %d EventBridge Global Endpoints", len(endpoints))
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepPermissions(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -84,6 +84,11 @@ var validArchiveName = validation.All(
 	validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+`), ""),
 )
 
+var validEndpointName = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+)
+
 var validBusNameOrARN = validation.Any(
 	verify.ValidARN,
 	validation.All(
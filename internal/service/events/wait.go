@@ -1,10 +1,14 @@
 package events
 
 import (
+	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -63,3 +67,60 @@ func waitConnectionUpdated(conn *eventbridge.EventBridge, id string) (*eventbrid
 
 	return nil, err
 }
+
+func waitEndpointCreated(ctx context.Context, conn *eventbridge.EventBridge, name string, timeout time.Duration) (*eventbridge.DescribeEndpointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.EndpointStateCreating},
+		Target:  []string{eventbridge.EndpointStateActive},
+		Refresh: statusEndpointState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointUpdated(ctx context.Context, conn *eventbridge.EventBridge, name string, timeout time.Duration) (*eventbridge.DescribeEndpointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.EndpointStateUpdating},
+		Target:  []string{eventbridge.EndpointStateActive},
+		Refresh: statusEndpointState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(ctx context.Context, conn *eventbridge.EventBridge, name string, timeout time.Duration) (*eventbridge.DescribeEndpointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.EndpointStateDeleting},
+		Target:  []string{},
+		Refresh: statusEndpointState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,655 @@
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomRoutingEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomRoutingEndpointGroupCreate,
+		ReadWithoutTimeout:   resourceCustomRoutingEndpointGroupRead,
+		UpdateWithoutTimeout: resourceCustomRoutingEndpointGroupUpdate,
+		DeleteWithoutTimeout: resourceCustomRoutingEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_configuration": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocols": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(globalaccelerator.CustomRoutingProtocol_Values(), false),
+							},
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"endpoint_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_all_traffic": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"destination_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+						"destination_ports": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IsPortNumber,
+							},
+						},
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"endpoint_group_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	input := &globalaccelerator.CreateCustomRoutingEndpointGroupInput{
+		DestinationConfigurations: expandCustomRoutingDestinationConfigurations(d.Get("destination_configuration").(*schema.Set).List()),
+		EndpointGroupRegion:       aws.String(meta.(*conns.AWSClient).Region),
+		IdempotencyToken:          aws.String(resource.UniqueId()),
+		ListenerArn:               aws.String(d.Get("listener_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("endpoint_group_region"); ok {
+		input.EndpointGroupRegion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateCustomRoutingEndpointGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Global Accelerator Custom Routing Endpoint Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.EndpointGroup.EndpointGroupArn))
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	if v, ok := d.GetOk("endpoint_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		if err := addCustomRoutingEndpoints(ctx, conn, d.Id(), acceleratorARN, v.(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCustomRoutingEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceCustomRoutingEndpointGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	endpointGroup, err := FindCustomRoutingEndpointGroupByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Custom Routing Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Global Accelerator Custom Routing Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	listenerARN, err := EndpointGroupARNToListenerARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("arn", endpointGroup.EndpointGroupArn)
+	if err := d.Set("destination_configuration", flattenCustomRoutingDestinationDescriptions(endpointGroup.DestinationDescriptions)); err != nil {
+		return diag.Errorf("setting destination_configuration: %s", err)
+	}
+	// The traffic allowed to each endpoint is only available as individual port mappings,
+	// so the configured traffic settings are carried over from state.
+	if err := d.Set("endpoint_configuration", flattenCustomRoutingEndpointDescriptions(endpointGroup.EndpointDescriptions, d.Get("endpoint_configuration").(*schema.Set).List())); err != nil {
+		return diag.Errorf("setting endpoint_configuration: %s", err)
+	}
+	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
+	d.Set("listener_arn", listenerARN)
+
+	return nil
+}
+
+func resourceCustomRoutingEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("endpoint_configuration") {
+		o, n := d.GetChange("endpoint_configuration")
+		oMap, nMap := customRoutingEndpointConfigurationsByID(o.(*schema.Set).List()), customRoutingEndpointConfigurationsByID(n.(*schema.Set).List())
+
+		var add, modify []interface{}
+
+		for id, tfMap := range nMap {
+			if old, ok := oMap[id]; !ok {
+				add = append(add, tfMap)
+			} else if !customRoutingEndpointTrafficEqual(old, tfMap) {
+				modify = append(modify, tfMap)
+			}
+		}
+
+		var endpointIDs []*string
+
+		for id := range oMap {
+			if _, ok := nMap[id]; !ok {
+				endpointIDs = append(endpointIDs, aws.String(id))
+			}
+		}
+
+		if len(endpointIDs) > 0 {
+			input := &globalaccelerator.RemoveCustomRoutingEndpointsInput{
+				EndpointGroupArn: aws.String(d.Id()),
+				EndpointIds:      endpointIDs,
+			}
+
+			_, err := conn.RemoveCustomRoutingEndpointsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("removing Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), err)
+			}
+
+			if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+			}
+		}
+
+		// Allow the new traffic first so that destinations in both the old and new settings keep receiving traffic,
+		// and then deny only the traffic that is no longer allowed.
+		if err := allowCustomRoutingTraffic(ctx, conn, d.Id(), modify); err != nil {
+			return diag.FromErr(err)
+		}
+
+		var reallow []interface{}
+
+		for _, tfMapRaw := range modify {
+			tfMap := tfMapRaw.(map[string]interface{})
+			endpointID := tfMap["endpoint_id"].(string)
+			inputs, reallowRequired := expandDenyCustomRoutingTrafficInputs(oMap[endpointID], tfMap)
+
+			for _, input := range inputs {
+				input.EndpointGroupArn = aws.String(d.Id())
+
+				_, err := conn.DenyCustomRoutingTrafficWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("denying Global Accelerator Custom Routing Endpoint Group (%s) traffic to endpoint (%s): %s", d.Id(), endpointID, err)
+				}
+			}
+
+			if reallowRequired {
+				reallow = append(reallow, tfMap)
+			}
+		}
+
+		if err := allowCustomRoutingTraffic(ctx, conn, d.Id(), reallow); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(modify) > 0 {
+			if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+			}
+		}
+
+		if len(add) > 0 {
+			if err := addCustomRoutingEndpoints(ctx, conn, d.Id(), acceleratorARN, add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceCustomRoutingEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceCustomRoutingEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Custom Routing Endpoint Group: %s", d.Id())
+	_, err := conn.DeleteCustomRoutingEndpointGroupWithContext(ctx, &globalaccelerator.DeleteCustomRoutingEndpointGroupInput{
+		EndpointGroupArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Global Accelerator Custom Routing Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	return nil
+}
+
+// addCustomRoutingEndpoints adds the specified endpoints (VPC subnets) to the endpoint group
+// and then allows the configured traffic to each of them. All traffic to a newly added endpoint is denied.
+func addCustomRoutingEndpoints(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, endpointGroupARN, acceleratorARN string, tfList []interface{}, timeout time.Duration) error {
+	input := &globalaccelerator.AddCustomRoutingEndpointsInput{
+		EndpointConfigurations: expandCustomRoutingEndpointConfigurations(tfList),
+		EndpointGroupArn:       aws.String(endpointGroupARN),
+	}
+
+	_, err := conn.AddCustomRoutingEndpointsWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %w", endpointGroupARN, err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, timeout); err != nil {
+		return fmt.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %w", acceleratorARN, err)
+	}
+
+	if err := allowCustomRoutingTraffic(ctx, conn, endpointGroupARN, tfList); err != nil {
+		return err
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, timeout); err != nil {
+		return fmt.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %w", acceleratorARN, err)
+	}
+
+	return nil
+}
+
+func allowCustomRoutingTraffic(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, endpointGroupARN string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		input := expandAllowCustomRoutingTrafficInput(tfMap)
+
+		if input == nil {
+			continue
+		}
+
+		input.EndpointGroupArn = aws.String(endpointGroupARN)
+
+		_, err := conn.AllowCustomRoutingTrafficWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("allowing Global Accelerator Custom Routing Endpoint Group (%s) traffic to endpoint (%s): %w", endpointGroupARN, aws.StringValue(input.EndpointId), err)
+		}
+	}
+
+	return nil
+}
+
+func customRoutingEndpointConfigurationsByID(tfList []interface{}) map[string]map[string]interface{} {
+	m := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		m[tfMap["endpoint_id"].(string)] = tfMap
+	}
+
+	return m
+}
+
+func customRoutingEndpointTrafficEqual(a, b map[string]interface{}) bool {
+	return a["allow_all_traffic"].(bool) == b["allow_all_traffic"].(bool) &&
+		a["destination_addresses"].(*schema.Set).Equal(b["destination_addresses"].(*schema.Set)) &&
+		a["destination_ports"].(*schema.Set).Equal(b["destination_ports"].(*schema.Set))
+}
+
+func expandCustomRoutingDestinationConfiguration(tfMap map[string]interface{}) *globalaccelerator.CustomRoutingDestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &globalaccelerator.CustomRoutingDestinationConfiguration{}
+
+	if v, ok := tfMap["from_port"].(int); ok && v != 0 {
+		apiObject.FromPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocols"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Protocols = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["to_port"].(int); ok && v != 0 {
+		apiObject.ToPort = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandCustomRoutingDestinationConfigurations(tfList []interface{}) []*globalaccelerator.CustomRoutingDestinationConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*globalaccelerator.CustomRoutingDestinationConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCustomRoutingDestinationConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCustomRoutingEndpointConfiguration(tfMap map[string]interface{}) *globalaccelerator.CustomRoutingEndpointConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &globalaccelerator.CustomRoutingEndpointConfiguration{}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCustomRoutingEndpointConfigurations(tfList []interface{}) []*globalaccelerator.CustomRoutingEndpointConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*globalaccelerator.CustomRoutingEndpointConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCustomRoutingEndpointConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandAllowCustomRoutingTrafficInput returns nil if no traffic is to be allowed to the endpoint.
+func expandAllowCustomRoutingTrafficInput(tfMap map[string]interface{}) *globalaccelerator.AllowCustomRoutingTrafficInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &globalaccelerator.AllowCustomRoutingTrafficInput{
+		EndpointId: aws.String(tfMap["endpoint_id"].(string)),
+	}
+
+	if v, ok := tfMap["allow_all_traffic"].(bool); ok && v {
+		apiObject.AllowAllTrafficToEndpoint = aws.Bool(v)
+
+		return apiObject
+	}
+
+	// Destination addresses are required unless all traffic is allowed.
+	v, ok := tfMap["destination_addresses"].(*schema.Set)
+
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	apiObject.DestinationAddresses = flex.ExpandStringSet(v)
+
+	if v, ok := tfMap["destination_ports"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DestinationPorts = flex.ExpandInt64Set(v)
+	}
+
+	return apiObject
+}
+
+// expandDenyCustomRoutingTrafficInputs returns the inputs that deny the traffic to an endpoint that is allowed by
+// the old settings, o, but not by the new ones, n.
+// Traffic can't be denied to all destinations, or all ports, except for some of them, so in those cases more traffic
+// is denied and reallow is true: the new settings must then be allowed again.
+func expandDenyCustomRoutingTrafficInputs(o, n map[string]interface{}) ([]*globalaccelerator.DenyCustomRoutingTrafficInput, bool) {
+	endpointID := aws.String(n["endpoint_id"].(string))
+
+	if n["allow_all_traffic"].(bool) {
+		return nil, false
+	}
+
+	if o["allow_all_traffic"].(bool) {
+		return []*globalaccelerator.DenyCustomRoutingTrafficInput{{
+			DenyAllTrafficToEndpoint: aws.Bool(true),
+			EndpointId:               endpointID,
+		}}, true
+	}
+
+	var apiObjects []*globalaccelerator.DenyCustomRoutingTrafficInput
+	var reallow bool
+	oldAddresses, newAddresses := o["destination_addresses"].(*schema.Set), n["destination_addresses"].(*schema.Set)
+	oldPorts, newPorts := o["destination_ports"].(*schema.Set), n["destination_ports"].(*schema.Set)
+
+	// Deny all ports on the addresses that are no longer allowed.
+	if v := oldAddresses.Difference(newAddresses); v.Len() > 0 {
+		apiObjects = append(apiObjects, &globalaccelerator.DenyCustomRoutingTrafficInput{
+			DestinationAddresses: flex.ExpandStringSet(v),
+			EndpointId:           endpointID,
+		})
+	}
+
+	// No ports means all ports.
+	if v := oldAddresses.Intersection(newAddresses); v.Len() > 0 && newPorts.Len() > 0 {
+		apiObject := &globalaccelerator.DenyCustomRoutingTrafficInput{
+			DestinationAddresses: flex.ExpandStringSet(v),
+			EndpointId:           endpointID,
+		}
+
+		if oldPorts.Len() == 0 {
+			reallow = true
+		} else if v := oldPorts.Difference(newPorts); v.Len() > 0 {
+			apiObject.DestinationPorts = flex.ExpandInt64Set(v)
+		} else {
+			apiObject = nil
+		}
+
+		if apiObject != nil {
+			apiObjects = append(apiObjects, apiObject)
+		}
+	}
+
+	return apiObjects, reallow
+}
+
+func flattenCustomRoutingDestinationDescription(apiObject *globalaccelerator.CustomRoutingDestinationDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FromPort; v != nil {
+		tfMap["from_port"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Protocols; v != nil {
+		tfMap["protocols"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ToPort; v != nil {
+		tfMap["to_port"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenCustomRoutingDestinationDescriptions(apiObjects []*globalaccelerator.CustomRoutingDestinationDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCustomRoutingDestinationDescription(apiObject))
+	}
+
+	return tfList
+}
+
+// flattenCustomRoutingEndpointDescriptions flattens the endpoint group's endpoints,
+// taking each endpoint's traffic settings from the matching element of the current configuration.
+func flattenCustomRoutingEndpointDescriptions(apiObjects []*globalaccelerator.CustomRoutingEndpointDescription, tfList []interface{}) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	configured := customRoutingEndpointConfigurationsByID(tfList)
+
+	var tfListOut []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		endpointID := aws.StringValue(apiObject.EndpointId)
+		tfMap := map[string]interface{}{
+			"allow_all_traffic": false,
+			"endpoint_id":       endpointID,
+		}
+
+		if v, ok := configured[endpointID]; ok {
+			tfMap["allow_all_traffic"] = v["allow_all_traffic"]
+			tfMap["destination_addresses"] = v["destination_addresses"]
+			tfMap["destination_ports"] = v["destination_ports"]
+		}
+
+		tfListOut = append(tfListOut, tfMap)
+	}
+
+	return tfListOut
+}
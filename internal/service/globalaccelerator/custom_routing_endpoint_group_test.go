@@ -0,0 +1,299 @@
+package globalaccelerator_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointGroup_basic(t *testing.T) {
+	var v globalaccelerator.CustomRoutingEndpointGroup
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointGroupExists(resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "globalaccelerator", regexp.MustCompile(`accelerator/[^/]+/listener/[^/]+/endpoint-group/[^/]+`)),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "destination_configuration.*", map[string]string{
+						"from_port":   "443",
+						"protocols.#": "1",
+						"to_port":     "8443",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_configuration.*.protocols.*", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_group_region", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", "aws_globalaccelerator_custom_routing_listener.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointGroup_disappears(t *testing.T) {
+	var v globalaccelerator.CustomRoutingEndpointGroup
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointGroupExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglobalaccelerator.ResourceCustomRoutingEndpointGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointGroup_endpointConfiguration(t *testing.T) {
+	var v globalaccelerator.CustomRoutingEndpointGroup
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointGroupConfig_allowAllTraffic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "endpoint_configuration.*", map[string]string{
+						"allow_all_traffic":       "true",
+						"destination_addresses.#": "0",
+						"destination_ports.#":     "0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "endpoint_configuration.*.endpoint_id", "aws_subnet.test.0", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_configuration"},
+			},
+			{
+				Config: testAccCustomRoutingEndpointGroupConfig_allowDestinations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "endpoint_configuration.*", map[string]string{
+						"allow_all_traffic":       "false",
+						"destination_addresses.#": "1",
+						"destination_ports.#":     "2",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "endpoint_configuration.*.destination_addresses.*", "10.0.0.10"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "endpoint_configuration.*.destination_ports.*", "443"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "endpoint_configuration.*.destination_ports.*", "8443"),
+				),
+			},
+			{
+				// Adds an address and removes a port on the endpoint.
+				Config: testAccCustomRoutingEndpointGroupConfig_changeDestinations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "endpoint_configuration.*", map[string]string{
+						"allow_all_traffic":       "false",
+						"destination_addresses.#": "2",
+						"destination_ports.#":     "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "endpoint_configuration.*.destination_addresses.*", "10.0.0.10"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "endpoint_configuration.*.destination_addresses.*", "10.0.0.11"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "endpoint_configuration.*.destination_ports.*", "443"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomRoutingEndpointGroupExists(name string, v *globalaccelerator.CustomRoutingEndpointGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Global Accelerator Custom Routing Endpoint Group ID is set")
+		}
+
+		endpointGroup, err := tfglobalaccelerator.FindCustomRoutingEndpointGroupByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *endpointGroup
+
+		return nil
+	}
+}
+
+func testAccCheckCustomRoutingEndpointGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_custom_routing_endpoint_group" {
+			continue
+		}
+
+		_, err := tfglobalaccelerator.FindCustomRoutingEndpointGroupByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Global Accelerator Custom Routing Endpoint Group %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCustomRoutingEndpointGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_accelerator" "test" {
+  name            = %[1]q
+  ip_address_type = "IPV4"
+  enabled         = false
+}
+
+resource "aws_globalaccelerator_custom_routing_listener" "test" {
+  accelerator_arn = aws_globalaccelerator_custom_routing_accelerator.test.id
+
+  port_range {
+    from_port = 1
+    to_port   = 65535
+  }
+}
+`, rName)
+}
+
+func testAccCustomRoutingEndpointGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointGroupConfig_base(rName), `
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 8443
+    protocols = ["TCP"]
+  }
+}
+`)
+}
+
+func testAccCustomRoutingEndpointGroupConfig_allowAllTraffic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCustomRoutingEndpointGroupConfig_base(rName),
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		`
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 8443
+    protocols = ["TCP", "UDP"]
+  }
+
+  endpoint_configuration {
+    endpoint_id       = aws_subnet.test[0].id
+    allow_all_traffic = true
+  }
+}
+`)
+}
+
+func testAccCustomRoutingEndpointGroupConfig_allowDestinations(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCustomRoutingEndpointGroupConfig_base(rName),
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		`
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 8443
+    protocols = ["TCP", "UDP"]
+  }
+
+  endpoint_configuration {
+    endpoint_id           = aws_subnet.test[0].id
+    destination_addresses = ["10.0.0.10"]
+    destination_ports     = [443, 8443]
+  }
+
+  endpoint_configuration {
+    endpoint_id = aws_subnet.test[1].id
+  }
+}
+`)
+}
+
+func testAccCustomRoutingEndpointGroupConfig_changeDestinations(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCustomRoutingEndpointGroupConfig_base(rName),
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		`
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 8443
+    protocols = ["TCP", "UDP"]
+  }
+
+  endpoint_configuration {
+    endpoint_id           = aws_subnet.test[0].id
+    destination_addresses = ["10.0.0.10", "10.0.0.11"]
+    destination_ports     = [443]
+  }
+
+  endpoint_configuration {
+    endpoint_id = aws_subnet.test[1].id
+  }
+}
+`)
+}
@@ -0,0 +1,141 @@
+package globalaccelerator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingListener_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_listener.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingListenerConfig_basic(rName, 443, 10443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingListenerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "accelerator_arn", "aws_globalaccelerator_custom_routing_accelerator.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_range.*", map[string]string{
+						"from_port": "443",
+						"to_port":   "10443",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomRoutingListenerConfig_basic(rName, 5000, 15000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_range.*", map[string]string{
+						"from_port": "5000",
+						"to_port":   "15000",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingListener_disappears(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_listener.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingListenerConfig_basic(rName, 443, 10443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingListenerExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglobalaccelerator.ResourceCustomRoutingListener(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomRoutingListenerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Global Accelerator Custom Routing Listener ID is set")
+		}
+
+		_, err := tfglobalaccelerator.FindCustomRoutingListenerByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCustomRoutingListenerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_custom_routing_listener" {
+			continue
+		}
+
+		_, err := tfglobalaccelerator.FindCustomRoutingListenerByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Global Accelerator Custom Routing Listener %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCustomRoutingListenerConfig_basic(rName string, fromPort, toPort int) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_accelerator" "test" {
+  name            = %[1]q
+  ip_address_type = "IPV4"
+  enabled         = false
+}
+
+resource "aws_globalaccelerator_custom_routing_listener" "test" {
+  accelerator_arn = aws_globalaccelerator_custom_routing_accelerator.test.id
+
+  port_range {
+    from_port = %[2]d
+    to_port   = %[3]d
+  }
+}
+`, rName, fromPort, toPort)
+}
@@ -0,0 +1,216 @@
+package glue
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCatalogTableOptimizer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCatalogTableOptimizerCreate,
+		Read:   resourceCatalogTableOptimizerRead,
+		Update: resourceCatalogTableOptimizerUpdate,
+		Delete: resourceCatalogTableOptimizerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARNOfService("iam", "role"),
+						},
+					},
+				},
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(glue.TableOptimizerType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceCatalogTableOptimizerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	optimizerType := d.Get("type").(string)
+	id := createTableOptimizerID(catalogID, dbName, tableName, optimizerType)
+
+	input := &glue.CreateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get("configuration").([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Catalog Table Optimizer: %s", input)
+	// Newly created IAM roles may not yet be assumable by Glue.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(propagationTimeout, func() (interface{}, error) {
+		return conn.CreateTableOptimizer(input)
+	}, glue.ErrCodeAccessDeniedException, "role")
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue Catalog Table Optimizer (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCatalogTableOptimizerRead(d, meta)
+}
+
+func resourceCatalogTableOptimizerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tableOptimizer, err := FindTableOptimizer(conn, catalogID, dbName, tableName, optimizerType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Catalog Table Optimizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Catalog Table Optimizer (%s): %w", d.Id(), err)
+	}
+
+	d.Set("catalog_id", catalogID)
+	if err := d.Set("configuration", flattenTableOptimizerConfiguration(tableOptimizer.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("database_name", dbName)
+	d.Set("table_name", tableName)
+	d.Set("type", tableOptimizer.Type)
+
+	return nil
+}
+
+func resourceCatalogTableOptimizerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get("configuration").([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Catalog Table Optimizer: %s", input)
+	_, err = tfresource.RetryWhenAWSErrMessageContains(propagationTimeout, func() (interface{}, error) {
+		return conn.UpdateTableOptimizer(input)
+	}, glue.ErrCodeAccessDeniedException, "role")
+
+	if err != nil {
+		return fmt.Errorf("error updating Glue Catalog Table Optimizer (%s): %w", d.Id(), err)
+	}
+
+	return resourceCatalogTableOptimizerRead(d, meta)
+}
+
+func resourceCatalogTableOptimizerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Table Optimizer: %s", d.Id())
+	_, err = conn.DeleteTableOptimizer(&glue.DeleteTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Catalog Table Optimizer (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTableOptimizerConfiguration(tfList []interface{}) *glue.TableOptimizerConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &glue.TableOptimizerConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+}
+
+func flattenTableOptimizerConfiguration(apiObject *glue.TableOptimizerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"enabled":  aws.BoolValue(apiObject.Enabled),
+		"role_arn": aws.StringValue(apiObject.RoleArn),
+	}}
+}
@@ -237,3 +237,32 @@ func FindPartitionIndexByName(conn *glue.Glue, id string) (*glue.PartitionIndexD
 
 	return result, nil
 }
+
+// FindTableOptimizer returns the Table Optimizer of the specified type for the specified Table.
+func FindTableOptimizer(conn *glue.Glue, catalogID, dbName, tableName, optimizerType string) (*glue.TableOptimizer, error) {
+	input := &glue.GetTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	}
+
+	output, err := conn.GetTableOptimizer(input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableOptimizer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TableOptimizer, nil
+}
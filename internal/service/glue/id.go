@@ -26,6 +26,14 @@ func readPartitionIndexID(id string) (catalogID, dbName, tableName, indexName st
 	return idParts[0], idParts[1], idParts[2], idParts[3], nil
 }
 
+func readTableOptimizerID(id string) (catalogID, dbName, tableName, optimizerType string, error error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 4 {
+		return "", "", "", "", fmt.Errorf("expected ID in format catalog-id:database-name:table-name:type, received: %s", id)
+	}
+	return idParts[0], idParts[1], idParts[2], idParts[3], nil
+}
+
 func createPartitionID(catalogID, dbName, tableName string, values []interface{}) string {
 	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, stringifyPartition(values))
 }
@@ -34,6 +42,10 @@ func createPartitionIndexID(catalogID, dbName, tableName, indexName string) stri
 	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, indexName)
 }
 
+func createTableOptimizerID(catalogID, dbName, tableName, optimizerType string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, optimizerType)
+}
+
 func stringifyPartition(partValues []interface{}) string {
 	var b bytes.Buffer
 	for _, val := range partValues {
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -46,7 +48,9 @@ func ResourceRole() *schema.Resource {
 			},
 			"assume_role_policy": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"assume_role_policy", "trust_policy"},
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 				StateFunc: func(v interface{}) string {
@@ -145,18 +149,33 @@ func ResourceRole() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
-			"tags":     tftags.TagsSchema(),
-			"tags_all": tftags.TagsSchemaComputed(),
+			"tags":         tftags.TagsSchema(),
+			"tags_all":     tftags.TagsSchemaComputed(),
+			"trust_policy": roleTrustPolicySchema(),
 			"unique_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRoleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceRoleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The rendered assume_role_policy is only known after apply when trust_policy changes.
+	if diff.Id() != "" && diff.HasChange("trust_policy") {
+		if v, ok := diff.GetOk("trust_policy"); ok && len(v.([]interface{})) > 0 {
+			return diff.SetNewComputed("assume_role_policy")
+		}
+	}
+
+	return nil
+}
+
 func resourceRoleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("force_detach_policies", false)
 	return []*schema.ResourceData{d}, nil
@@ -167,10 +186,10 @@ func resourceRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	assumeRolePolicy, err := structure.NormalizeJsonString(d.Get("assume_role_policy").(string))
+	assumeRolePolicy, err := roleAssumeRolePolicy(d)
 
 	if err != nil {
-		return fmt.Errorf("assume_role_policy (%s) is invalid JSON: %w", assumeRolePolicy, err)
+		return err
 	}
 
 	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
@@ -298,6 +317,27 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("assume_role_policy", policyToSet)
 
+	if v, ok := d.GetOk("trust_policy"); ok && len(v.([]interface{})) > 0 {
+		trustPolicy, err := expandRoleTrustPolicy(v.([]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("error rendering IAM Role (%s) trust_policy: %w", d.Id(), err)
+		}
+
+		// Only replace the configured trust_policy when the remote document has drifted.
+		if equivalent, err := awspolicy.PoliciesAreEquivalent(trustPolicy, assumeRolePolicy); err != nil || !equivalent {
+			tfList, err := flattenRoleTrustPolicy(assumeRolePolicy)
+
+			if err != nil {
+				return fmt.Errorf("error reading IAM Role (%s) trust policy: %w", d.Id(), err)
+			}
+
+			if err := d.Set("trust_policy", tfList); err != nil {
+				return fmt.Errorf("error setting trust_policy: %w", err)
+			}
+		}
+	}
+
 	inlinePolicies, err := readRoleInlinePolicies(aws.StringValue(role.RoleName), meta)
 	if err != nil {
 		return fmt.Errorf("reading inline policies for IAM role %s, error: %s", d.Id(), err)
@@ -337,11 +377,11 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 func resourceRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
-	if d.HasChange("assume_role_policy") {
-		assumeRolePolicy, err := structure.NormalizeJsonString(d.Get("assume_role_policy").(string))
+	if d.HasChanges("assume_role_policy", "trust_policy") {
+		assumeRolePolicy, err := roleAssumeRolePolicy(d)
 
 		if err != nil {
-			return fmt.Errorf("assume_role_policy (%s) is invalid JSON: %w", assumeRolePolicy, err)
+			return err
 		}
 
 		input := &iam.UpdateAssumeRolePolicyInput{
@@ -926,3 +966,25 @@ func inlinePoliciesEquivalent(readPolicies, configPolicies []*iam.PutRolePolicyI
 
 	return matches == len(readPolicies)
 }
+
+// roleAssumeRolePolicy returns the role's assume role policy document, rendered from
+// trust_policy when that is configured.
+func roleAssumeRolePolicy(d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("trust_policy"); ok && len(v.([]interface{})) > 0 {
+		policy, err := expandRoleTrustPolicy(v.([]interface{}))
+
+		if err != nil {
+			return "", fmt.Errorf("trust_policy is invalid: %w", err)
+		}
+
+		return policy, nil
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("assume_role_policy").(string))
+
+	if err != nil {
+		return "", fmt.Errorf("assume_role_policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	return policy, nil
+}
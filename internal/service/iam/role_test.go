@@ -45,6 +45,53 @@ func TestAccIAMRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_trustPolicy(t *testing.T) {
+	var conf iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_trustPolicy(rName, "ec2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, "assume_role_policy"),
+					resource.TestCheckResourceAttr(resourceName, "trust_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trust_policy.0.statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trust_policy.0.statement.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trust_policy.0.statement.0.principals.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"trust_policy"},
+			},
+			{
+				Config: testAccRoleConfig_trustPolicy(rName, "lambda"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "assume_role_policy", regexp.MustCompile(`lambda`)),
+				),
+			},
+			{
+				Config: testAccRoleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "assume_role_policy", regexp.MustCompile(`ec2`)),
+					resource.TestCheckResourceAttr(resourceName, "trust_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_description(t *testing.T) {
 	var conf iam.Role
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1090,6 +1137,35 @@ resource "aws_iam_role" "test" {
 `, rName)
 }
 
+func testAccRoleConfig_trustPolicy(rName, service string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  trust_policy {
+    statement {
+      actions = ["sts:AssumeRole"]
+
+      principals {
+        type        = "Service"
+        identifiers = ["%[2]s.${data.aws_partition.current.dns_suffix}"]
+      }
+
+      condition {
+        test     = "StringEquals"
+        variable = "aws:SourceAccount"
+        values   = [data.aws_caller_identity.current.account_id]
+      }
+    }
+  }
+}
+`, rName, service)
+}
+
 func testAccRoleConfig_description(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,214 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const roleTrustPolicyVersion = "2012-10-17"
+
+func roleTrustPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"assume_role_policy", "trust_policy"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"statement": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"actions": {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"condition": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"test": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"values": {
+											Type:     schema.TypeList,
+											Required: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"variable": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"effect": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "Allow",
+								ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+							},
+							"principals": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"identifiers": {
+											Type:     schema.TypeSet,
+											Required: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"type": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"sid": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandRoleTrustPolicy renders a trust_policy configuration block as an IAM policy JSON document.
+func expandRoleTrustPolicy(tfList []interface{}) (string, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return "", nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	doc := &IAMPolicyDoc{
+		Version: roleTrustPolicyVersion,
+	}
+
+	for _, tfMapRaw := range tfMap["statement"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		stmt := &IAMPolicyStatement{
+			Effect: tfMap["effect"].(string),
+			Sid:    tfMap["sid"].(string),
+		}
+
+		if v := tfMap["actions"].(*schema.Set).List(); len(v) > 0 {
+			stmt.Actions = policyDecodeConfigStringList(v)
+		}
+
+		if v := tfMap["principals"].(*schema.Set).List(); len(v) > 0 {
+			principals, err := dataSourcePolicyDocumentMakePrincipals(v, doc.Version)
+
+			if err != nil {
+				return "", fmt.Errorf("reading principals: %w", err)
+			}
+
+			stmt.Principals = principals
+		}
+
+		if v := tfMap["condition"].(*schema.Set).List(); len(v) > 0 {
+			conditions, err := dataSourcePolicyDocumentMakeConditions(v, doc.Version)
+
+			if err != nil {
+				return "", fmt.Errorf("reading condition: %w", err)
+			}
+
+			stmt.Conditions = conditions
+		}
+
+		doc.Statements = append(doc.Statements, stmt)
+	}
+
+	output, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// flattenRoleTrustPolicy converts an IAM policy JSON document to a trust_policy configuration block.
+func flattenRoleTrustPolicy(policy string) ([]interface{}, error) {
+	doc := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, fmt.Errorf("parsing trust policy: %w", err)
+	}
+
+	var tfStatements []interface{}
+
+	for _, stmt := range doc.Statements {
+		if stmt == nil {
+			continue
+		}
+
+		tfStatement := map[string]interface{}{
+			"actions": flattenRoleTrustPolicyStringOrList(stmt.Actions),
+			"effect":  stmt.Effect,
+			"sid":     stmt.Sid,
+		}
+
+		var tfPrincipals []interface{}
+		for _, principal := range stmt.Principals {
+			tfPrincipals = append(tfPrincipals, map[string]interface{}{
+				"identifiers": flattenRoleTrustPolicyStringOrList(principal.Identifiers),
+				"type":        principal.Type,
+			})
+		}
+		tfStatement["principals"] = tfPrincipals
+
+		var tfConditions []interface{}
+		for _, condition := range stmt.Conditions {
+			tfConditions = append(tfConditions, map[string]interface{}{
+				"test":     condition.Test,
+				"values":   flattenRoleTrustPolicyStringOrList(condition.Values),
+				"variable": condition.Variable,
+			})
+		}
+		tfStatement["condition"] = tfConditions
+
+		tfStatements = append(tfStatements, tfStatement)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"statement": tfStatements,
+	}}, nil
+}
+
+// flattenRoleTrustPolicyStringOrList handles policy elements that may be a single string or a list of strings.
+func flattenRoleTrustPolicyStringOrList(v interface{}) []string {
+	var s []string
+
+	switch v := v.(type) {
+	case string:
+		s = []string{v}
+	case []string:
+		s = v
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,86 @@
+package iam
+
+import (
+	"testing"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandRoleTrustPolicy(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"assume_role_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trust_policy": roleTrustPolicySchema(),
+		},
+	}
+
+	d := r.TestResourceData()
+	err := d.Set("trust_policy", []interface{}{map[string]interface{}{
+		"statement": []interface{}{
+			map[string]interface{}{
+				"actions": []interface{}{"sts:AssumeRole", "sts:TagSession"},
+				"effect":  "Allow",
+				"principals": []interface{}{map[string]interface{}{
+					"identifiers": []interface{}{"ec2.amazonaws.com"},
+					"type":        "Service",
+				}},
+				"condition": []interface{}{map[string]interface{}{
+					"test":     "StringEquals",
+					"values":   []interface{}{"123456789012"},
+					"variable": "aws:SourceAccount",
+				}},
+			},
+		},
+	}})
+
+	if err != nil {
+		t.Fatalf("setting trust_policy: %s", err)
+	}
+
+	got, err := expandRoleTrustPolicy(d.Get("trust_policy").([]interface{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["sts:TagSession", "sts:AssumeRole"],
+      "Principal": {"Service": "ec2.amazonaws.com"},
+      "Condition": {"StringEquals": {"aws:SourceAccount": "123456789012"}}
+    }
+  ]
+}`
+
+	if equivalent, err := awspolicy.PoliciesAreEquivalent(got, want); err != nil || !equivalent {
+		t.Fatalf("expected %s to be equivalent to %s (%v)", got, want, err)
+	}
+
+	// Flattening the rendered document and rendering it again must round-trip.
+	tfList, err := flattenRoleTrustPolicy(got)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := d.Set("trust_policy", tfList); err != nil {
+		t.Fatalf("setting trust_policy: %s", err)
+	}
+
+	roundTrip, err := expandRoleTrustPolicy(d.Get("trust_policy").([]interface{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if equivalent, err := awspolicy.PoliciesAreEquivalent(roundTrip, want); err != nil || !equivalent {
+		t.Fatalf("expected %s to be equivalent to %s (%v)", roundTrip, want, err)
+	}
+}
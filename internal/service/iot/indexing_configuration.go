@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 func ResourceIndexingConfiguration() *schema.Resource {
@@ -105,6 +106,38 @@ func ResourceIndexingConfiguration() *schema.Resource {
 							Default:      iot.DeviceDefenderIndexingModeOff,
 							ValidateFunc: validation.StringInSlice(iot.DeviceDefenderIndexingMode_Values(), false),
 						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"geo_location": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"order": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      iot.TargetFieldOrderLatLon,
+													ValidateFunc: validation.StringInSlice(iot.TargetFieldOrder_Values(), false),
+												},
+											},
+										},
+									},
+									"named_shadow_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
 						"managed_field": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -237,6 +270,10 @@ func flattenThingIndexingConfiguration(apiObject *iot.ThingIndexingConfiguration
 		tfMap["device_defender_indexing_mode"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{flattenIndexingFilter(v)}
+	}
+
 	if v := apiObject.ManagedFields; v != nil {
 		tfMap["managed_field"] = flattenFields(v)
 	}
@@ -256,6 +293,37 @@ func flattenThingIndexingConfiguration(apiObject *iot.ThingIndexingConfiguration
 	return tfMap
 }
 
+func flattenIndexingFilter(apiObject *iot.IndexingFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GeoLocations; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"name":  aws.StringValue(apiObject.Name),
+				"order": aws.StringValue(apiObject.Order),
+			})
+		}
+
+		tfMap["geo_location"] = tfList
+	}
+
+	if v := apiObject.NamedShadowNames; v != nil {
+		tfMap["named_shadow_names"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
 func flattenField(apiObject *iot.Field) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -329,6 +397,10 @@ func expandThingIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingIn
 		apiObject.DeviceDefenderIndexingMode = aws.String(v)
 	}
 
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandIndexingFilter(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["managed_field"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.ManagedFields = expandFields(v.List())
 	}
@@ -348,6 +420,35 @@ func expandThingIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingIn
 	return apiObject
 }
 
+func expandIndexingFilter(tfMap map[string]interface{}) *iot.IndexingFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.IndexingFilter{}
+
+	if v, ok := tfMap["geo_location"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.GeoLocations = append(apiObject.GeoLocations, &iot.GeoLocationTarget{
+				Name:  aws.String(tfMap["name"].(string)),
+				Order: aws.String(tfMap["order"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["named_shadow_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NamedShadowNames = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
 func expandField(tfMap map[string]interface{}) *iot.Field {
 	if tfMap == nil {
 		return nil
@@ -171,6 +171,10 @@ func resourceThingGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading IoT Thing Group (%s): %w", d.Id(), err)
 	}
 
+	if output.QueryString != nil {
+		return fmt.Errorf("error reading IoT Thing Group (%s): dynamic thing group found, use aws_iot_dynamic_thing_group instead", d.Id())
+	}
+
 	d.Set("arn", output.ThingGroupArn)
 	d.Set("name", output.ThingGroupName)
 
@@ -34,7 +34,7 @@ func TestAccIoTThingGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "metadata.#", "1"),
 					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.creation_date"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.0.parent_group_name", ""),
-					resource.TestCheckResourceAttr(resourceName, "metadata.0.root_to_parent_thing_groups.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.root_to_parent_groups.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "parent_group_name", ""),
 					resource.TestCheckResourceAttr(resourceName, "properties.#", "0"),
@@ -0,0 +1,512 @@
+package iotevents
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAlarmModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAlarmModelCreate,
+		ReadWithoutTimeout:   resourceAlarmModelRead,
+		UpdateWithoutTimeout: resourceAlarmModelUpdate,
+		DeleteWithoutTimeout: resourceAlarmModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarm_capabilities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acknowledge_flow": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"initialization_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_on_initialization": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alarm_event_actions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"alarm_notification": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"alarm_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison_operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(iotevents.ComparisonOperator_Values(), false),
+									},
+									"input_property": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"threshold": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAlarmModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateAlarmModelInput{
+		AlarmModelName: aws.String(name),
+		RoleArn:        aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("alarm_capabilities"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_event_actions"); ok {
+		apiObject := &iotevents.AlarmEventActions{}
+
+		if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(v.(string))); err != nil {
+			return diag.Errorf("decoding alarm_event_actions: %s", err)
+		}
+
+		input.AlarmEventActions = apiObject
+	}
+
+	if v, ok := d.GetOk("alarm_notification"); ok {
+		apiObject := &iotevents.AlarmNotification{}
+
+		if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(v.(string))); err != nil {
+			return diag.Errorf("decoding alarm_notification: %s", err)
+		}
+
+		input.AlarmNotification = apiObject
+	}
+
+	if v, ok := d.GetOk("alarm_rule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AlarmRule = expandAlarmRule(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.AlarmModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Alarm Model: %s", input)
+	_, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateAlarmModelWithContext(ctx, input)
+		},
+		iotevents.ErrCodeInvalidRequestException, "role")
+
+	if err != nil {
+		return diag.Errorf("creating IoT Events Alarm Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for IoT Events Alarm Model (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAlarmModelRead(ctx, d, meta)
+}
+
+func resourceAlarmModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAlarmModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Alarm Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.AlarmModelArn)
+	if output.AlarmCapabilities != nil {
+		if err := d.Set("alarm_capabilities", []interface{}{flattenAlarmCapabilities(output.AlarmCapabilities)}); err != nil {
+			return diag.Errorf("setting alarm_capabilities: %s", err)
+		}
+	} else {
+		d.Set("alarm_capabilities", nil)
+	}
+	if output.AlarmEventActions != nil {
+		v, err := buildNormalizedJSON(output.AlarmEventActions)
+		if err != nil {
+			return diag.Errorf("encoding alarm_event_actions: %s", err)
+		}
+		d.Set("alarm_event_actions", v)
+	} else {
+		d.Set("alarm_event_actions", nil)
+	}
+	if output.AlarmNotification != nil {
+		v, err := buildNormalizedJSON(output.AlarmNotification)
+		if err != nil {
+			return diag.Errorf("encoding alarm_notification: %s", err)
+		}
+		d.Set("alarm_notification", v)
+	} else {
+		d.Set("alarm_notification", nil)
+	}
+	if output.AlarmRule != nil {
+		if err := d.Set("alarm_rule", []interface{}{flattenAlarmRule(output.AlarmRule)}); err != nil {
+			return diag.Errorf("setting alarm_rule: %s", err)
+		}
+	} else {
+		d.Set("alarm_rule", nil)
+	}
+	d.Set("arn", arn)
+	d.Set("description", output.AlarmModelDescription)
+	d.Set("key", output.Key)
+	d.Set("name", output.AlarmModelName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("severity", output.Severity)
+	d.Set("version", output.AlarmModelVersion)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAlarmModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateAlarmModelInput{
+			AlarmModelDescription: aws.String(d.Get("description").(string)),
+			AlarmModelName:        aws.String(d.Id()),
+			RoleArn:               aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("alarm_capabilities"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("alarm_event_actions"); ok {
+			apiObject := &iotevents.AlarmEventActions{}
+
+			if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(v.(string))); err != nil {
+				return diag.Errorf("decoding alarm_event_actions: %s", err)
+			}
+
+			input.AlarmEventActions = apiObject
+		}
+
+		if v, ok := d.GetOk("alarm_notification"); ok {
+			apiObject := &iotevents.AlarmNotification{}
+
+			if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(v.(string))); err != nil {
+				return diag.Errorf("decoding alarm_notification: %s", err)
+			}
+
+			input.AlarmNotification = apiObject
+		}
+
+		if v, ok := d.GetOk("alarm_rule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AlarmRule = expandAlarmRule(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("severity"); ok {
+			input.Severity = aws.Int64(int64(v.(int)))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Alarm Model: %s", input)
+		_, err := conn.UpdateAlarmModelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for IoT Events Alarm Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT Events Alarm Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAlarmModelRead(ctx, d, meta)
+}
+
+func resourceAlarmModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+
+	log.Printf("[INFO] Deleting IoT Events Alarm Model: %s", d.Id())
+	_, err := conn.DeleteAlarmModelWithContext(ctx, &iotevents.DeleteAlarmModelInput{
+		AlarmModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAlarmModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for IoT Events Alarm Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAlarmCapabilities(tfMap map[string]interface{}) *iotevents.AlarmCapabilities {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AlarmCapabilities{}
+
+	if v, ok := tfMap["acknowledge_flow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AcknowledgeFlow = &iotevents.AcknowledgeFlow{
+			Enabled: aws.Bool(v[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["initialization_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InitializationConfiguration = &iotevents.InitializationConfiguration{
+			DisabledOnInitialization: aws.Bool(v[0].(map[string]interface{})["disabled_on_initialization"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAlarmRule(tfMap map[string]interface{}) *iotevents.AlarmRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AlarmRule{}
+
+	if v, ok := tfMap["simple_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SimpleRule = &iotevents.SimpleRule{
+			ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+			InputProperty:      aws.String(tfMap["input_property"].(string)),
+			Threshold:          aws.String(tfMap["threshold"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAlarmCapabilities(apiObject *iotevents.AlarmCapabilities) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcknowledgeFlow; v != nil {
+		tfMap["acknowledge_flow"] = []interface{}{map[string]interface{}{
+			"enabled": aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.InitializationConfiguration; v != nil {
+		tfMap["initialization_configuration"] = []interface{}{map[string]interface{}{
+			"disabled_on_initialization": aws.BoolValue(v.DisabledOnInitialization),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenAlarmRule(apiObject *iotevents.AlarmRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SimpleRule; v != nil {
+		tfMap["simple_rule"] = []interface{}{map[string]interface{}{
+			"comparison_operator": aws.StringValue(v.ComparisonOperator),
+			"input_property":      aws.StringValue(v.InputProperty),
+			"threshold":           aws.StringValue(v.Threshold),
+		}}
+	}
+
+	return tfMap
+}
+
+// buildNormalizedJSON encodes an API object using its wire-format field names.
+func buildNormalizedJSON(apiObject interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding JSON: %w", err)
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
@@ -0,0 +1,154 @@
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTEventsAlarmModel_basic(t *testing.T) {
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "30", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.acknowledge_flow.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.initialization_configuration.0.disabled_on_initialization", "false"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "30"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("alarmModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "40", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "40"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_disappears(t *testing.T) {
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "30", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotevents.ResourceAlarmModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAlarmModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_alarm_model" {
+			continue
+		}
+
+		_, err := tfiotevents.FindAlarmModelByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Alarm Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAlarmModelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Alarm Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn
+
+		_, err := tfiotevents.FindAlarmModelByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAlarmModelConfig_basic(rName, threshold string, severity int) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  key      = "sensorId"
+  role_arn = aws_iam_role.test.arn
+  severity = %[3]d
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = %[2]q
+    }
+  }
+
+  alarm_capabilities {
+    acknowledge_flow {
+      enabled = true
+    }
+
+    initialization_configuration {
+      disabled_on_initialization = false
+    }
+  }
+}
+`, rName, threshold, severity))
+}
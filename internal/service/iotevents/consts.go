@@ -0,0 +1,9 @@
+package iotevents
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,189 @@
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "key", "sensorId"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorModelConfig_basic(rName, 40),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotevents.ResourceDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		_, err := tfiotevents.FindDetectorModelByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDetectorModelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn
+
+		_, err := tfiotevents.FindDetectorModelByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "sensorId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDetectorModelConfig_basic(rName string, threshold int) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  key      = "sensorId"
+  role_arn = aws_iam_role.test.arn
+
+  definition = jsonencode({
+    initialStateName = "Normal"
+    states = [{
+      stateName = "Normal"
+      onInput = {
+        transitionEvents = [{
+          eventName = "TooHot"
+          condition = "$input.${aws_iotevents_input.test.name}.temperature > %[2]d"
+          nextState = "Hot"
+        }]
+      }
+    }, {
+      stateName = "Hot"
+      onInput = {
+        transitionEvents = [{
+          eventName = "CooledDown"
+          condition = "$input.${aws_iotevents_input.test.name}.temperature <= %[2]d"
+          nextState = "Normal"
+        }]
+      }
+    }]
+  })
+}
+`, rName, threshold))
+}
@@ -0,0 +1,58 @@
+package iotevents
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusAlarmModel(ctx context.Context, conn *iotevents.IoTEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAlarmModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func statusInput(ctx context.Context, conn *iotevents.IoTEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInputByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.InputConfiguration.Status), nil
+	}
+}
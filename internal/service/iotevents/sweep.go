@@ -0,0 +1,171 @@
+//go:build sweep
+// +build sweep
+
+package iotevents
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotevents_alarm_model", &resource.Sweeper{
+		Name: "aws_iotevents_alarm_model",
+		F:    sweepAlarmModels,
+	})
+
+	resource.AddTestSweepers("aws_iotevents_detector_model", &resource.Sweeper{
+		Name: "aws_iotevents_detector_model",
+		F:    sweepDetectorModels,
+	})
+
+	resource.AddTestSweepers("aws_iotevents_input", &resource.Sweeper{
+		Name: "aws_iotevents_input",
+		F:    sweepInputs,
+		Dependencies: []string{
+			"aws_iotevents_alarm_model",
+			"aws_iotevents_detector_model",
+		},
+	})
+}
+
+func sweepAlarmModels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTEventsConn
+	input := &iotevents.ListAlarmModelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListAlarmModels(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Alarm Model sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Events Alarm Models (%s): %w", region, err)
+		}
+
+		for _, v := range output.AlarmModelSummaries {
+			r := ResourceAlarmModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.AlarmModelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Events Alarm Models (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDetectorModels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTEventsConn
+	input := &iotevents.ListDetectorModelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListDetectorModels(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Detector Model sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Events Detector Models (%s): %w", region, err)
+		}
+
+		for _, v := range output.DetectorModelSummaries {
+			r := ResourceDetectorModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DetectorModelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Events Detector Models (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepInputs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTEventsConn
+	input := &iotevents.ListInputsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListInputs(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Input sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Events Inputs (%s): %w", region, err)
+		}
+
+		for _, v := range output.InputSummaries {
+			r := ResourceInput()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.InputName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Events Inputs (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	iamPolicyResourceTypeCluster         = "cluster"
+	iamPolicyResourceTypeGroup           = "group"
+	iamPolicyResourceTypeTopic           = "topic"
+	iamPolicyResourceTypeTransactionalID = "transactional-id"
+)
+
+var (
+	// The kafka-cluster actions that apply to each type of resource.
+	// See https://docs.aws.amazon.com/msk/latest/developerguide/iam-access-control.html#kafka-actions.
+	iamPolicyClusterActions = []string{
+		"kafka-cluster:*",
+		"kafka-cluster:AlterCluster",
+		"kafka-cluster:AlterClusterDynamicConfiguration",
+		"kafka-cluster:Connect",
+		"kafka-cluster:DescribeCluster",
+		"kafka-cluster:DescribeClusterDynamicConfiguration",
+		"kafka-cluster:WriteDataIdempotently",
+	}
+	iamPolicyGroupActions = []string{
+		"kafka-cluster:*",
+		"kafka-cluster:AlterGroup",
+		"kafka-cluster:DeleteGroup",
+		"kafka-cluster:DescribeGroup",
+	}
+	iamPolicyTopicActions = []string{
+		"kafka-cluster:*",
+		"kafka-cluster:AlterTopic",
+		"kafka-cluster:AlterTopicDynamicConfiguration",
+		"kafka-cluster:CreateTopic",
+		"kafka-cluster:DeleteTopic",
+		"kafka-cluster:DescribeTopic",
+		"kafka-cluster:DescribeTopicDynamicConfiguration",
+		"kafka-cluster:ReadData",
+		"kafka-cluster:WriteData",
+	}
+	iamPolicyTransactionalIDActions = []string{
+		"kafka-cluster:*",
+		"kafka-cluster:AlterTransactionalId",
+		"kafka-cluster:DescribeTransactionalId",
+	}
+)
+
+func DataSourceIAMPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIAMPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(iamPolicyClusterActions, false),
+				},
+			},
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"group": iamPolicyDocumentResourceSchema(iamPolicyGroupActions),
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"topic":            iamPolicyDocumentResourceSchema(iamPolicyTopicActions),
+			"transactional_id": iamPolicyDocumentResourceSchema(iamPolicyTransactionalIDActions),
+		},
+	}
+}
+
+func iamPolicyDocumentResourceSchema(actions []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"actions": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(actions, false),
+					},
+				},
+				"names": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z._\-*?]+$`), "must be a name, optionally containing * and ? wildcards"),
+					},
+				},
+			},
+		},
+	}
+}
+
+type iamPolicyDocument struct {
+	Version   string
+	Statement []*iamPolicyStatement
+}
+
+type iamPolicyStatement struct {
+	Effect   string
+	Action   []string
+	Resource []string
+}
+
+func dataSourceIAMPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	clusterARN := d.Get("cluster_arn").(string)
+	cluster, err := parseIAMPolicyClusterARN(clusterARN)
+
+	if err != nil {
+		return err
+	}
+
+	clusterStatement := &iamPolicyStatement{
+		Effect:   "Allow",
+		Action:   []string{"kafka-cluster:Connect"},
+		Resource: []string{clusterARN},
+	}
+
+	if v, ok := d.GetOk("cluster_actions"); ok && v.(*schema.Set).Len() > 0 {
+		clusterStatement.Action = flex.ExpandStringValueSet(v.(*schema.Set))
+		sort.Strings(clusterStatement.Action)
+	}
+
+	policy := &iamPolicyDocument{
+		Version:   "2012-10-17",
+		Statement: []*iamPolicyStatement{clusterStatement},
+	}
+
+	for _, v := range []struct {
+		key          string
+		resourceType string
+	}{
+		{"topic", iamPolicyResourceTypeTopic},
+		{"group", iamPolicyResourceTypeGroup},
+		{"transactional_id", iamPolicyResourceTypeTransactionalID},
+	} {
+		for _, tfMapRaw := range d.Get(v.key).([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			statement := &iamPolicyStatement{
+				Effect: "Allow",
+				Action: flex.ExpandStringValueSet(tfMap["actions"].(*schema.Set)),
+			}
+			sort.Strings(statement.Action)
+
+			for _, name := range flex.ExpandStringValueSet(tfMap["names"].(*schema.Set)) {
+				statement.Resource = append(statement.Resource, cluster.resourceARN(v.resourceType, name))
+			}
+			sort.Strings(statement.Resource)
+
+			policy.Statement = append(policy.Statement, statement)
+		}
+	}
+
+	jsonDoc, err := json.MarshalIndent(policy, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("writing MSK IAM policy document: %w", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+
+	return nil
+}
+
+// iamPolicyCluster holds the parts of a cluster ARN that are shared with the
+// ARNs of the cluster's topics, groups and transactional IDs.
+type iamPolicyCluster struct {
+	arn  arn.ARN
+	name string
+	uuid string
+}
+
+// parseIAMPolicyClusterARN parses an ARN of the form
+// arn:PARTITION:kafka:REGION:ACCOUNT:cluster/CLUSTER_NAME/CLUSTER_UUID.
+func parseIAMPolicyClusterARN(s string) (*iamPolicyCluster, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing MSK cluster ARN (%s): %w", s, err)
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if v.Service != "kafka" || len(parts) != 3 || parts[0] != iamPolicyResourceTypeCluster || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format for MSK cluster ARN (%s), expected arn:PARTITION:kafka:REGION:ACCOUNT:cluster/CLUSTER_NAME/CLUSTER_UUID", s)
+	}
+
+	return &iamPolicyCluster{
+		arn:  v,
+		name: parts[1],
+		uuid: parts[2],
+	}, nil
+}
+
+// resourceARN returns the ARN of the named topic, group or transactional ID in the cluster,
+// e.g. arn:PARTITION:kafka:REGION:ACCOUNT:topic/CLUSTER_NAME/CLUSTER_UUID/TOPIC_NAME.
+func (c *iamPolicyCluster) resourceARN(resourceType, name string) string {
+	return arn.ARN{
+		Partition: c.arn.Partition,
+		Service:   c.arn.Service,
+		Region:    c.arn.Region,
+		AccountID: c.arn.AccountID,
+		Resource:  strings.Join([]string{resourceType, c.name, c.uuid, name}, "/"),
+	}.String()
+}
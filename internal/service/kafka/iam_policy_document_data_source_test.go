@@ -0,0 +1,150 @@
+package kafka_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafka"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKafkaIAMPolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_msk_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMPolicyDocumentDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["kafka-cluster:Connect"],
+    "Resource": ["arn:aws:kafka:us-west-2:123456789012:cluster/example/0123abcd-4567-89ef-0123-456789abcdef-s1"]
+  }]
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKafkaIAMPolicyDocumentDataSource_resources(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_msk_iam_policy_document.test"
+	resourceName := "aws_iam_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMPolicyDocumentDataSourceConfig_resources(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["kafka-cluster:Connect", "kafka-cluster:DescribeCluster"],
+      "Resource": ["arn:aws:kafka:us-west-2:123456789012:cluster/example/0123abcd-4567-89ef-0123-456789abcdef-s1"]
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["kafka-cluster:DescribeTopic", "kafka-cluster:ReadData"],
+      "Resource": [
+        "arn:aws:kafka:us-west-2:123456789012:topic/example/0123abcd-4567-89ef-0123-456789abcdef-s1/orders",
+        "arn:aws:kafka:us-west-2:123456789012:topic/example/0123abcd-4567-89ef-0123-456789abcdef-s1/payments-*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["kafka-cluster:WriteData"],
+      "Resource": ["arn:aws:kafka:us-west-2:123456789012:topic/example/0123abcd-4567-89ef-0123-456789abcdef-s1/audit"]
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["kafka-cluster:AlterGroup", "kafka-cluster:DescribeGroup"],
+      "Resource": ["arn:aws:kafka:us-west-2:123456789012:group/example/0123abcd-4567-89ef-0123-456789abcdef-s1/consumer-*"]
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["kafka-cluster:AlterTransactionalId", "kafka-cluster:DescribeTransactionalId"],
+      "Resource": ["arn:aws:kafka:us-west-2:123456789012:transactional-id/example/0123abcd-4567-89ef-0123-456789abcdef-s1/*"]
+    }
+  ]
+}`),
+					resource.TestCheckResourceAttrPair(resourceName, "policy", dataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKafkaIAMPolicyDocumentDataSource_invalidClusterARN(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIAMPolicyDocumentDataSourceConfig_clusterARN("arn:aws:kafka:us-west-2:123456789012:topic/example/0123abcd-4567-89ef-0123-456789abcdef-s1/orders"),
+				ExpectError: regexp.MustCompile(`unexpected format for MSK cluster ARN`),
+			},
+		},
+	})
+}
+
+func testAccIAMPolicyDocumentDataSourceConfig_clusterARN(clusterARN string) string {
+	return fmt.Sprintf(`
+data "aws_msk_iam_policy_document" "test" {
+  cluster_arn = %[1]q
+}
+`, clusterARN)
+}
+
+func testAccIAMPolicyDocumentDataSourceConfig_basic() string {
+	return testAccIAMPolicyDocumentDataSourceConfig_clusterARN("arn:aws:kafka:us-west-2:123456789012:cluster/example/0123abcd-4567-89ef-0123-456789abcdef-s1")
+}
+
+func testAccIAMPolicyDocumentDataSourceConfig_resources(rName string) string {
+	return fmt.Sprintf(`
+data "aws_msk_iam_policy_document" "test" {
+  cluster_arn     = "arn:aws:kafka:us-west-2:123456789012:cluster/example/0123abcd-4567-89ef-0123-456789abcdef-s1"
+  cluster_actions = ["kafka-cluster:DescribeCluster", "kafka-cluster:Connect"]
+
+  topic {
+    names   = ["payments-*", "orders"]
+    actions = ["kafka-cluster:ReadData", "kafka-cluster:DescribeTopic"]
+  }
+
+  topic {
+    names   = ["audit"]
+    actions = ["kafka-cluster:WriteData"]
+  }
+
+  group {
+    names   = ["consumer-*"]
+    actions = ["kafka-cluster:DescribeGroup", "kafka-cluster:AlterGroup"]
+  }
+
+  transactional_id {
+    names   = ["*"]
+    actions = ["kafka-cluster:AlterTransactionalId", "kafka-cluster:DescribeTransactionalId"]
+  }
+}
+
+resource "aws_iam_policy" "test" {
+  name   = %[1]q
+  policy = data.aws_msk_iam_policy_document.test.json
+}
+`, rName)
+}
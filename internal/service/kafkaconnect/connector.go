@@ -2,6 +2,7 @@ package kafkaconnect
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -34,6 +36,11 @@ func ResourceConnector() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffConnectorCapacity,
+			customizeDiffConnectorLogDelivery,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -350,7 +357,7 @@ func ResourceConnector() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfService("iam", "role"),
 			},
 			"version": {
 				Type:     schema.TypeString,
@@ -501,23 +508,28 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta int
 func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KafkaConnectConn
 
-	input := &kafkaconnect.UpdateConnectorInput{
-		Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
-		ConnectorArn:   aws.String(d.Id()),
-		CurrentVersion: aws.String(d.Get("version").(string)),
-	}
+	if d.HasChange("capacity") {
+		input := &kafkaconnect.UpdateConnectorInput{
+			Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
+			ConnectorArn:   aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("version").(string)),
+		}
 
-	log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
-	_, err := conn.UpdateConnectorWithContext(ctx, input)
+		log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
+		// A connector that is still applying a previous change rejects updates.
+		_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateConnectorWithContext(ctx, input)
+		}, kafkaconnect.ErrCodeConflictException)
 
-	if err != nil {
-		return diag.Errorf("error updating MSK Connect Connector (%s): %s", d.Id(), err)
-	}
+		if err != nil {
+			return diag.Errorf("error updating MSK Connect Connector (%s): %s", d.Id(), err)
+		}
 
-	_, err = waitConnectorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = waitConnectorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 
-	if err != nil {
-		return diag.Errorf("error waiting for MSK Connect Connector (%s) update: %s", d.Id(), err)
+		if err != nil {
+			return diag.Errorf("error waiting for MSK Connect Connector (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return resourceConnectorRead(ctx, d, meta)
@@ -548,6 +560,60 @@ func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
+func customizeDiffConnectorCapacity(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("capacity.0.autoscaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if minCount, maxCount := tfMap["min_worker_count"].(int), tfMap["max_worker_count"].(int); minCount > maxCount {
+			return fmt.Errorf("capacity.0.autoscaling.0.min_worker_count (%d) must not be greater than max_worker_count (%d)", minCount, maxCount)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffConnectorLogDelivery validates that each enabled worker log destination names where to deliver logs.
+func customizeDiffConnectorLogDelivery(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("log_delivery.0.worker_log_delivery")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+
+	for _, destination := range []struct {
+		block, attribute string
+	}{
+		{"cloudwatch_logs", "log_group"},
+		{"firehose", "delivery_stream"},
+		{"s3", "bucket"},
+	} {
+		v, ok := tfMap[destination.block].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		tfMap := v[0].(map[string]interface{})
+
+		if !tfMap["enabled"].(bool) {
+			continue
+		}
+
+		// Unknown values are only known at apply time.
+		if !diff.NewValueKnown(fmt.Sprintf("log_delivery.0.worker_log_delivery.0.%s.0.%s", destination.block, destination.attribute)) {
+			continue
+		}
+
+		if v, ok := tfMap[destination.attribute].(string); !ok || v == "" {
+			return fmt.Errorf("log_delivery.0.worker_log_delivery.0.%[1]s.0.%[2]s must be set when %[1]s log delivery is enabled", destination.block, destination.attribute)
+		}
+	}
+
+	return nil
+}
+
 func expandCapacity(tfMap map[string]interface{}) *kafkaconnect.Capacity {
 	if tfMap == nil {
 		return nil
@@ -3,6 +3,7 @@ package kafkaconnect_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
@@ -222,6 +223,27 @@ func TestAccKafkaConnectConnector_update(t *testing.T) {
 	})
 }
 
+func TestAccKafkaConnectConnector_validation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kafkaconnect.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		CheckDestroy:             testAccCheckConnectorDestroy,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectorConfig_validation(rName, 3, 2, "enabled = false"),
+				ExpectError: regexp.MustCompile(`min_worker_count \(3\) must not be greater than max_worker_count \(2\)`),
+			},
+			{
+				Config:      testAccConnectorConfig_validation(rName, 1, 2, "enabled = true"),
+				ExpectError: regexp.MustCompile(`cloudwatch_logs.0.log_group must be set when cloudwatch_logs log delivery is enabled`),
+			},
+		},
+	})
+}
+
 func testAccCheckConnectorExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -629,3 +651,66 @@ resource "aws_mskconnect_connector" "test" {
 }
 `, rName))
 }
+
+func testAccConnectorConfig_validation(rName string, minWorkerCount, maxWorkerCount int, cloudWatchLogs string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_mskconnect_connector" "test" {
+  name = %[1]q
+
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    autoscaling {
+      min_worker_count = %[2]d
+      max_worker_count = %[3]d
+    }
+  }
+
+  connector_configuration = {
+    "connector.class" = "com.github.jcustenborder.kafka.connect.simulator.SimulatorSinkConnector"
+    "tasks.max"       = "1"
+    "topics"          = "t1"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = "b-1.example.kafka.us-west-2.amazonaws.com:9094"
+
+      vpc {
+        security_groups = ["sg-12345678"]
+        subnets         = ["subnet-12345678"]
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "TLS"
+  }
+
+  log_delivery {
+    worker_log_delivery {
+      cloudwatch_logs {
+        %[4]s
+      }
+    }
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = "arn:${data.aws_partition.current.partition}:kafkaconnect:us-west-2:${data.aws_caller_identity.current.account_id}:custom-plugin/%[1]s/12345678-1234-1234-1234-123456789012-1"
+      revision = 1
+    }
+  }
+
+  service_execution_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+}
+`, rName, minWorkerCount, maxWorkerCount, cloudWatchLogs)
+}
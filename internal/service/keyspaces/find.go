@@ -67,3 +67,29 @@ func FindTableByTwoPartKey(ctx context.Context, conn *keyspaces.Keyspaces, keysp
 
 	return output, nil
 }
+
+func findTableAutoScalingSettingsByTwoPartKey(ctx context.Context, conn *keyspaces.Keyspaces, keyspaceName, tableName string) (*keyspaces.GetTableAutoScalingSettingsOutput, error) {
+	input := &keyspaces.GetTableAutoScalingSettingsInput{
+		KeyspaceName: aws.String(keyspaceName),
+		TableName:    aws.String(tableName),
+	}
+
+	output, err := conn.GetTableAutoScalingSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, keyspaces.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
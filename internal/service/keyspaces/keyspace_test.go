@@ -114,6 +114,36 @@ func TestAccKeyspacesKeyspace_tags(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesKeyspace_multiRegion(t *testing.T) {
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyspaceConfig_multiRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "MULTI_REGION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckKeyspaceDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).KeyspacesConn
 
@@ -189,3 +219,16 @@ resource "aws_keyspaces_keyspace" "test" {
 }
 `, rName, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccKeyspaceConfig_multiRegion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    replication_strategy = "MULTI_REGION"
+    region_list          = [%[2]q, %[3]q]
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion())
+}
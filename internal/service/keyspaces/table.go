@@ -52,6 +52,10 @@ func ResourceTable() *schema.Resource {
 
 				return false
 			}),
+			// Client-side timestamps cannot be disabled once enabled.
+			customdiff.ForceNewIfChange("client_side_timestamps", func(_ context.Context, o, n, meta interface{}) bool {
+				return len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0
+			}),
 			verify.SetTagsDiff,
 		),
 
@@ -60,6 +64,17 @@ func ResourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_scaling_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling":  autoScalingSettingsSchema(),
+						"write_capacity_auto_scaling": autoScalingSettingsSchema(),
+					},
+				},
+			},
 			"capacity_specification": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -86,6 +101,20 @@ func ResourceTable() *schema.Resource {
 					},
 				},
 			},
+			"client_side_timestamps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(keyspaces.ClientSideTimestampsStatus_Values(), false),
+						},
+					},
+				},
+			},
 			"comment": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -294,6 +323,58 @@ func ResourceTable() *schema.Resource {
 	}
 }
 
+func autoScalingSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_scaling_disabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"maximum_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"minimum_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"target_tracking_scaling_policy_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"disable_scale_in": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"scale_in_cooldown": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"scale_out_cooldown": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"target_value": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								ValidateFunc: validation.FloatBetween(20, 90),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KeyspacesConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -307,10 +388,18 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		TableName:    aws.String(tableName),
 	}
 
+	if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoScalingSpecification = expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("capacity_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.CapacitySpecification = expandCapacitySpecification(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("client_side_timestamps"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ClientSideTimestamps = expandClientSideTimestamps(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("comment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Comment = expandComment(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -380,6 +469,19 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	d.Set("arn", table.ResourceArn)
+	if _, ok := d.GetOk("auto_scaling_specification"); ok {
+		output, err := findTableAutoScalingSettingsByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+		if err != nil {
+			return diag.Errorf("reading Keyspaces Table (%s) auto scaling settings: %s", d.Id(), err)
+		}
+
+		if err := d.Set("auto_scaling_specification", flattenAutoScalingSpecification(output.AutoScalingSpecification)); err != nil {
+			return diag.Errorf("setting auto_scaling_specification: %s", err)
+		}
+	} else {
+		d.Set("auto_scaling_specification", nil)
+	}
 	if table.CapacitySpecification != nil {
 		if err := d.Set("capacity_specification", []interface{}{flattenCapacitySpecificationSummary(table.CapacitySpecification)}); err != nil {
 			return diag.Errorf("setting capacity_specification: %s", err)
@@ -387,6 +489,13 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	} else {
 		d.Set("capacity_specification", nil)
 	}
+	if table.ClientSideTimestamps != nil {
+		if err := d.Set("client_side_timestamps", []interface{}{flattenClientSideTimestamps(table.ClientSideTimestamps)}); err != nil {
+			return diag.Errorf("setting client_side_timestamps: %s", err)
+		}
+	} else {
+		d.Set("client_side_timestamps", nil)
+	}
 	if table.Comment != nil {
 		if err := d.Set("comment", []interface{}{flattenComment(table.Comment)}); err != nil {
 			return diag.Errorf("setting comment: %s", err)
@@ -479,6 +588,54 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 
+		if d.HasChange("auto_scaling_specification") {
+			input := &keyspaces.UpdateTableInput{
+				KeyspaceName: aws.String(keyspaceName),
+				TableName:    aws.String(tableName),
+			}
+
+			if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.AutoScalingSpecification = expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.AutoScalingSpecification = &keyspaces.AutoScalingSpecification{
+					ReadCapacityAutoScaling:  &keyspaces.AutoScalingSettings{AutoScalingDisabled: aws.Bool(true)},
+					WriteCapacityAutoScaling: &keyspaces.AutoScalingSettings{AutoScalingDisabled: aws.Bool(true)},
+				}
+			}
+
+			log.Printf("[DEBUG] Updating Keyspaces Table: %s", input)
+			_, err := conn.UpdateTableWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating Keyspaces Table (%s) AutoScalingSpecification: %s", d.Id(), err)
+			}
+
+			if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for Keyspaces Table (%s) AutoScalingSpecification update: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("client_side_timestamps") {
+			if v, ok := d.GetOk("client_side_timestamps"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input := &keyspaces.UpdateTableInput{
+					ClientSideTimestamps: expandClientSideTimestamps(v.([]interface{})[0].(map[string]interface{})),
+					KeyspaceName:         aws.String(keyspaceName),
+					TableName:            aws.String(tableName),
+				}
+
+				log.Printf("[DEBUG] Updating Keyspaces Table: %s", input)
+				_, err := conn.UpdateTableWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("updating Keyspaces Table (%s) ClientSideTimestamps: %s", d.Id(), err)
+				}
+
+				if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.Errorf("waiting for Keyspaces Table (%s) ClientSideTimestamps update: %s", d.Id(), err)
+				}
+			}
+		}
+
 		if d.HasChange("default_time_to_live") {
 			input := &keyspaces.UpdateTableInput{
 				DefaultTimeToLive: aws.Int64(int64(d.Get("default_time_to_live").(int))),
@@ -727,6 +884,78 @@ func waitTableUpdated(ctx context.Context, conn *keyspaces.Keyspaces, keyspaceNa
 	return nil, err
 }
 
+func expandAutoScalingSpecification(tfMap map[string]interface{}) *keyspaces.AutoScalingSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.AutoScalingSpecification{}
+
+	if v, ok := tfMap["read_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReadCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["write_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WriteCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingSettings(tfMap map[string]interface{}) *keyspaces.AutoScalingSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.AutoScalingSettings{}
+
+	if v, ok := tfMap["auto_scaling_disabled"].(bool); ok {
+		apiObject.AutoScalingDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["maximum_units"].(int); ok && v != 0 {
+		apiObject.MaximumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum_units"].(int); ok && v != 0 {
+		apiObject.MinimumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["target_tracking_scaling_policy_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ScalingPolicy = &keyspaces.AutoScalingPolicy{
+			TargetTrackingScalingPolicyConfiguration: expandTargetTrackingScalingPolicyConfiguration(v[0].(map[string]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandTargetTrackingScalingPolicyConfiguration(tfMap map[string]interface{}) *keyspaces.TargetTrackingScalingPolicyConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.TargetTrackingScalingPolicyConfiguration{}
+
+	if v, ok := tfMap["disable_scale_in"].(bool); ok {
+		apiObject.DisableScaleIn = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok && v != 0 {
+		apiObject.ScaleInCooldown = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok && v != 0 {
+		apiObject.ScaleOutCooldown = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["target_value"].(float64); ok {
+		apiObject.TargetValue = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
 func expandCapacitySpecification(tfMap map[string]interface{}) *keyspaces.CapacitySpecification {
 	if tfMap == nil {
 		return nil
@@ -749,6 +978,20 @@ func expandCapacitySpecification(tfMap map[string]interface{}) *keyspaces.Capaci
 	return apiObject
 }
 
+func expandClientSideTimestamps(tfMap map[string]interface{}) *keyspaces.ClientSideTimestamps {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.ClientSideTimestamps{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandComment(tfMap map[string]interface{}) *keyspaces.Comment {
 	if tfMap == nil {
 		return nil
@@ -1003,6 +1246,72 @@ func expandStaticColumns(tfList []interface{}) []*keyspaces.StaticColumn {
 	return apiObjects
 }
 
+func flattenAutoScalingSpecification(apiObject *keyspaces.AutoScalingSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReadCapacityAutoScaling; v != nil {
+		tfMap["read_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	if v := apiObject.WriteCapacityAutoScaling; v != nil {
+		tfMap["write_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAutoScalingSettings(apiObject *keyspaces.AutoScalingSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"auto_scaling_disabled": aws.BoolValue(apiObject.AutoScalingDisabled),
+	}
+
+	if v := apiObject.MaximumUnits; v != nil {
+		tfMap["maximum_units"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinimumUnits; v != nil {
+		tfMap["minimum_units"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ScalingPolicy; v != nil && v.TargetTrackingScalingPolicyConfiguration != nil {
+		tfMap["target_tracking_scaling_policy_configuration"] = []interface{}{flattenTargetTrackingScalingPolicyConfiguration(v.TargetTrackingScalingPolicyConfiguration)}
+	}
+
+	return tfMap
+}
+
+func flattenTargetTrackingScalingPolicyConfiguration(apiObject *keyspaces.TargetTrackingScalingPolicyConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"disable_scale_in": aws.BoolValue(apiObject.DisableScaleIn),
+	}
+
+	if v := apiObject.ScaleInCooldown; v != nil {
+		tfMap["scale_in_cooldown"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ScaleOutCooldown; v != nil {
+		tfMap["scale_out_cooldown"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TargetValue; v != nil {
+		tfMap["target_value"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
+
 func flattenCapacitySpecificationSummary(apiObject *keyspaces.CapacitySpecificationSummary) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1025,6 +1334,20 @@ func flattenCapacitySpecificationSummary(apiObject *keyspaces.CapacitySpecificat
 	return tfMap
 }
 
+func flattenClientSideTimestamps(apiObject *keyspaces.ClientSideTimestamps) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenComment(apiObject *keyspaces.Comment) map[string]interface{} {
 	if apiObject == nil {
 		return nil
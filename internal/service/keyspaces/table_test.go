@@ -438,6 +438,92 @@ func TestAccKeyspacesTable_delColumns(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_autoScaling(t *testing.T) {
+	var v1, v2 keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_autoScaling(rName1, rName2, 5, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.maximum_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.target_tracking_scaling_policy_configuration.0.target_value", "70"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.maximum_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PROVISIONED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_scaling_specification",
+				},
+			},
+			{
+				Config: testAccTableConfig_autoScaling(rName1, rName2, 2, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(resourceName, &v2),
+					testAccCheckTableNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.minimum_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.target_tracking_scaling_policy_configuration.0.target_value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.minimum_units", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyspacesTable_clientSideTimestamps(t *testing.T) {
+	var v1, v2 keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "client_side_timestamps.#", "0"),
+				),
+			},
+			{
+				Config: testAccTableConfig_clientSideTimestamps(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(resourceName, &v2),
+					testAccCheckTableNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "client_side_timestamps.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_side_timestamps.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckTableDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).KeyspacesConn
 
@@ -828,3 +914,85 @@ resource "aws_keyspaces_table" "test" {
 }
 `, rName1, rName2)
 }
+
+func testAccTableConfig_autoScaling(rName1, rName2 string, minimumUnits int, targetValue float64) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  capacity_specification {
+    throughput_mode      = "PROVISIONED"
+    read_capacity_units  = 5
+    write_capacity_units = 5
+  }
+
+  auto_scaling_specification {
+    read_capacity_auto_scaling {
+      minimum_units = %[3]d
+      maximum_units = 10
+
+      target_tracking_scaling_policy_configuration {
+        target_value = %[4]g
+      }
+    }
+
+    write_capacity_auto_scaling {
+      minimum_units = %[3]d
+      maximum_units = 10
+
+      target_tracking_scaling_policy_configuration {
+        target_value = %[4]g
+      }
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [capacity_specification[0].read_capacity_units, capacity_specification[0].write_capacity_units]
+  }
+}
+`, rName1, rName2, minimumUnits, targetValue)
+}
+
+func testAccTableConfig_clientSideTimestamps(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  client_side_timestamps {
+    status = "ENABLED"
+  }
+}
+`, rName1, rName2)
+}
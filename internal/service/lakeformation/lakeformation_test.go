@@ -54,6 +54,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"disappears": testAccLFTag_disappears,
 			"values":     testAccLFTag_values,
 		},
+		"LFTagPolicyPermissions": {
+			"basic":      testAccLFTagPolicyPermissions_basic,
+			"disappears": testAccLFTagPolicyPermissions_disappears,
+			"principals": testAccLFTagPolicyPermissions_principals,
+		},
 		"ResourceLFTags": {
 			"basic":            testAccResourceLFTags_basic,
 			"database":         testAccResourceLFTags_database,
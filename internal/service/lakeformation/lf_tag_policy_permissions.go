@@ -0,0 +1,388 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// batchPermissionsMaxEntries is the maximum number of entries in a BatchGrantPermissions or BatchRevokePermissions request.
+	batchPermissionsMaxEntries = 20
+)
+
+// ResourceLFTagPolicyPermissions grants the same permissions on a single LF-Tag expression to many principals,
+// using the batch APIs rather than one aws_lakeformation_permissions resource per principal.
+func ResourceLFTagPolicyPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLFTagPolicyPermissionsCreate,
+		Read:   resourceLFTagPolicyPermissionsRead,
+		Update: resourceLFTagPolicyPermissionsUpdate,
+		Delete: resourceLFTagPolicyPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"expression": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 15,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateLFTagValues(),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validPrincipal,
+				},
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lakeformation.ResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceLFTagPolicyPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	lfResource := expandLFTagPolicyPermissionsResource(d)
+	principals := flex.ExpandStringValueSet(d.Get("principals").(*schema.Set))
+
+	if err := batchGrantLFTagPolicyPermissions(conn, d, lfResource, principals); err != nil {
+		return fmt.Errorf("error creating Lake Formation LF-Tag Policy Permissions: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(fmt.Sprintf("%s:%s:%s", d.Get("catalog_id").(string), lfResource.String(), strings.Join(flex.ExpandStringValueSet(d.Get("permissions").(*schema.Set)), ",")))))
+
+	return resourceLFTagPolicyPermissionsRead(d, meta)
+}
+
+func resourceLFTagPolicyPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.ListPermissionsInput{
+		Resource: expandLFTagPolicyPermissionsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	granted := make(map[string]bool)
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v == nil || v.Principal == nil || v.Resource == nil || v.Resource.LFTagPolicy == nil {
+				continue
+			}
+
+			granted[aws.StringValue(v.Principal.DataLakePrincipalIdentifier)] = true
+		}
+
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation LF-Tag Policy Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation LF-Tag Policy Permissions (%s): %w", d.Id(), err)
+	}
+
+	// Only principals managed by this resource are tracked; grants made elsewhere are ignored.
+	var principals []string
+	for _, v := range d.Get("principals").(*schema.Set).List() {
+		if principal := v.(string); granted[principal] {
+			principals = append(principals, principal)
+		}
+	}
+
+	if !d.IsNewResource() && len(principals) == 0 {
+		log.Printf("[WARN] Lake Formation LF-Tag Policy Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("principals", principals)
+
+	return nil
+}
+
+func resourceLFTagPolicyPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	if d.HasChange("principals") {
+		o, n := d.GetChange("principals")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		lfResource := expandLFTagPolicyPermissionsResource(d)
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			if err := batchRevokeLFTagPolicyPermissions(conn, d, lfResource, del); err != nil {
+				return fmt.Errorf("error updating Lake Formation LF-Tag Policy Permissions (%s): %w", d.Id(), err)
+			}
+		}
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			if err := batchGrantLFTagPolicyPermissions(conn, d, lfResource, add); err != nil {
+				return fmt.Errorf("error updating Lake Formation LF-Tag Policy Permissions (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceLFTagPolicyPermissionsRead(d, meta)
+}
+
+func resourceLFTagPolicyPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	principals := flex.ExpandStringValueSet(d.Get("principals").(*schema.Set))
+
+	if err := batchRevokeLFTagPolicyPermissions(conn, d, expandLFTagPolicyPermissionsResource(d), principals); err != nil {
+		return fmt.Errorf("error deleting Lake Formation LF-Tag Policy Permissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLFTagPolicyPermissionsResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.LFTagPolicyResource{
+		Expression:   ExpandLFTagExpression(d.Get("expression").([]interface{})),
+		ResourceType: aws.String(d.Get("resource_type").(string)),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		apiObject.CatalogId = aws.String(v.(string))
+	}
+
+	return &lakeformation.Resource{
+		LFTagPolicy: apiObject,
+	}
+}
+
+func expandLFTagPolicyPermissionsEntries(d *schema.ResourceData, lfResource *lakeformation.Resource, principals []string) []*lakeformation.BatchPermissionsRequestEntry {
+	var entries []*lakeformation.BatchPermissionsRequestEntry
+
+	for i, principal := range principals {
+		entry := &lakeformation.BatchPermissionsRequestEntry{
+			Id:          aws.String(fmt.Sprintf("%d", i)),
+			Permissions: flex.ExpandStringSet(d.Get("permissions").(*schema.Set)),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(principal),
+			},
+			Resource: lfResource,
+		}
+
+		if v, ok := d.GetOk("permissions_with_grant_option"); ok && v.(*schema.Set).Len() > 0 {
+			entry.PermissionsWithGrantOption = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func batchGrantLFTagPolicyPermissions(conn *lakeformation.LakeFormation, d *schema.ResourceData, lfResource *lakeformation.Resource, principals []string) error {
+	entries := expandLFTagPolicyPermissionsEntries(d, lfResource, principals)
+
+	for len(entries) > 0 {
+		n := len(entries)
+		if n > batchPermissionsMaxEntries {
+			n = batchPermissionsMaxEntries
+		}
+
+		input := &lakeformation.BatchGrantPermissionsInput{
+			Entries: entries[:n],
+		}
+
+		if v, ok := d.GetOk("catalog_id"); ok {
+			input.CatalogId = aws.String(v.(string))
+		}
+
+		err := resource.Retry(IAMPropagationTimeout, func() *resource.RetryError {
+			output, err := conn.BatchGrantPermissions(input)
+
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			// Retry only the failed entries, e.g. principals that have not yet propagated in IAM.
+			var retry []*lakeformation.BatchPermissionsRequestEntry
+			err = batchPermissionsFailuresError(output.Failures, func(v *lakeformation.BatchPermissionsFailureEntry) bool {
+				if v.Error != nil && (strings.Contains(aws.StringValue(v.Error.ErrorMessage), "Invalid principal") ||
+					strings.Contains(aws.StringValue(v.Error.ErrorMessage), "Grantee has no permissions")) {
+					retry = append(retry, v.RequestEntry)
+					return true
+				}
+				return false
+			})
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if len(retry) > 0 {
+				input.Entries = retry
+				return resource.RetryableError(fmt.Errorf("%d entries not yet granted", len(retry)))
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			var output *lakeformation.BatchGrantPermissionsOutput
+			output, err = conn.BatchGrantPermissions(input)
+
+			if err == nil {
+				err = batchPermissionsFailuresError(output.Failures, nil)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		entries = entries[n:]
+	}
+
+	return nil
+}
+
+func batchRevokeLFTagPolicyPermissions(conn *lakeformation.LakeFormation, d *schema.ResourceData, lfResource *lakeformation.Resource, principals []string) error {
+	entries := expandLFTagPolicyPermissionsEntries(d, lfResource, principals)
+
+	for len(entries) > 0 {
+		n := len(entries)
+		if n > batchPermissionsMaxEntries {
+			n = batchPermissionsMaxEntries
+		}
+
+		input := &lakeformation.BatchRevokePermissionsInput{
+			Entries: entries[:n],
+		}
+
+		if v, ok := d.GetOk("catalog_id"); ok {
+			input.CatalogId = aws.String(v.(string))
+		}
+
+		output, err := conn.BatchRevokePermissions(input)
+
+		if err != nil {
+			return err
+		}
+
+		// Permissions that no longer exist have already been revoked.
+		err = batchPermissionsFailuresError(output.Failures, func(v *lakeformation.BatchPermissionsFailureEntry) bool {
+			return v.Error != nil && (aws.StringValue(v.Error.ErrorCode) == lakeformation.ErrCodeEntityNotFoundException ||
+				strings.Contains(aws.StringValue(v.Error.ErrorMessage), "No permissions revoked"))
+		})
+
+		if err != nil {
+			return err
+		}
+
+		entries = entries[n:]
+	}
+
+	return nil
+}
+
+// batchPermissionsFailuresError returns an error for each batch failure not ignored by ignore.
+func batchPermissionsFailuresError(failures []*lakeformation.BatchPermissionsFailureEntry, ignore func(*lakeformation.BatchPermissionsFailureEntry) bool) error {
+	var errs *multierror.Error
+
+	for _, v := range failures {
+		if v == nil || (ignore != nil && ignore(v)) {
+			continue
+		}
+
+		var principal string
+		if v.RequestEntry != nil && v.RequestEntry.Principal != nil {
+			principal = aws.StringValue(v.RequestEntry.Principal.DataLakePrincipalIdentifier)
+		}
+
+		if v.Error != nil {
+			errs = multierror.Append(errs, fmt.Errorf("principal (%s): %s: %s", principal, aws.StringValue(v.Error.ErrorCode), aws.StringValue(v.Error.ErrorMessage)))
+		} else {
+			errs = multierror.Append(errs, fmt.Errorf("principal (%s): unknown error", principal))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
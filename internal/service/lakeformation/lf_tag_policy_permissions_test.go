@@ -0,0 +1,248 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+)
+
+func testAccLFTagPolicyPermissions_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_policy_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagPolicyPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagPolicyPermissionsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagPolicyPermissionsExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "expression.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "expression.0.key", "aws_lakeformation_lf_tag.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "expression.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "DESCRIBE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "SELECT"),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principals.*", "aws_iam_role.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principals.*", "aws_iam_role.test.1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "TABLE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLFTagPolicyPermissions_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_policy_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagPolicyPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagPolicyPermissionsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagPolicyPermissionsExists(resourceName, 2),
+					acctest.CheckResourceDisappears(acctest.Provider, tflakeformation.ResourceLFTagPolicyPermissions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLFTagPolicyPermissions_principals(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_policy_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagPolicyPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				// More principals than fit in a single batch request.
+				Config: testAccLFTagPolicyPermissionsConfig_basic(rName, 25),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagPolicyPermissionsExists(resourceName, 25),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "25"),
+				),
+			},
+			{
+				Config: testAccLFTagPolicyPermissionsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagPolicyPermissionsExists(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLFTagPolicyPermissionsExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation LF-Tag Policy Permissions ID is set")
+		}
+
+		principals, err := testAccLFTagPolicyPermissionsPrincipals(rs)
+
+		if err != nil {
+			return err
+		}
+
+		if len(principals) != count {
+			return fmt.Errorf("Lake Formation LF-Tag Policy Permissions (%s) granted to %d principals, want %d", rs.Primary.ID, len(principals), count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLFTagPolicyPermissionsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_lf_tag_policy_permissions" {
+			continue
+		}
+
+		principals, err := testAccLFTagPolicyPermissionsPrincipals(rs)
+
+		if err != nil {
+			return err
+		}
+
+		if len(principals) > 0 {
+			return fmt.Errorf("Lake Formation LF-Tag Policy Permissions (%s) still granted to %d principals", rs.Primary.ID, len(principals))
+		}
+	}
+
+	return nil
+}
+
+// testAccLFTagPolicyPermissionsPrincipals returns the principals in state that still hold permissions on the resource's LF-Tag expression.
+func testAccLFTagPolicyPermissionsPrincipals(rs *terraform.ResourceState) ([]string, error) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+	var values []*string
+	principals := make(map[string]bool)
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "expression.0.values.") && !strings.HasSuffix(k, "#") {
+			values = append(values, aws.String(v))
+		}
+
+		if strings.HasPrefix(k, "principals.") && !strings.HasSuffix(k, "#") {
+			principals[v] = true
+		}
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		Resource: &lakeformation.Resource{
+			LFTagPolicy: &lakeformation.LFTagPolicyResource{
+				Expression: []*lakeformation.LFTag{{
+					TagKey:    aws.String(rs.Primary.Attributes["expression.0.key"]),
+					TagValues: values,
+				}},
+				ResourceType: aws.String(rs.Primary.Attributes["resource_type"]),
+			},
+		},
+	}
+
+	var granted []string
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		for _, v := range page.PrincipalResourcePermissions {
+			if v != nil && v.Principal != nil && principals[aws.StringValue(v.Principal.DataLakePrincipalIdentifier)] {
+				granted = append(granted, aws.StringValue(v.Principal.DataLakePrincipalIdentifier))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return granted, nil
+}
+
+func testAccLFTagPolicyPermissionsConfig_basic(rName string, principalCount int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+  path = "/"
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_lf_tag_policy_permissions" "test" {
+  permissions   = ["DESCRIBE", "SELECT"]
+  principals    = aws_iam_role.test[*].arn
+  resource_type = "TABLE"
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = aws_lakeformation_lf_tag.test.values
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [
+    aws_lakeformation_data_lake_settings.test,
+    aws_lakeformation_lf_tag.test,
+  ]
+}
+`, rName, principalCount)
+}
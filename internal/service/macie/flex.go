@@ -2,8 +2,8 @@ package macie
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/aws-sdk-go/service/macie"
 )
 
 func expandClassificationType(d *schema.ResourceData) *macie.ClassificationType {
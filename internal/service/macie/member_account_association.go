@@ -5,9 +5,9 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/aws-sdk-go/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -18,6 +18,8 @@ func ResourceMemberAccountAssociation() *schema.Resource {
 		Read:   resourceMemberAccountAssociationRead,
 		Delete: resourceMemberAccountAssociationDelete,
 
+		DeprecationMessage: `With the retirement of Macie Classic the aws_macie_member_account_association resource has been deprecated and will be removed in a future version.`,
+
 		Schema: map[string]*schema.Schema{
 			"member_account_id": {
 				Type:         schema.TypeString,
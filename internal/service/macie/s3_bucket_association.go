@@ -6,9 +6,9 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/aws-sdk-go/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -20,6 +20,8 @@ func ResourceS3BucketAssociation() *schema.Resource {
 		Update: resourceS3BucketAssociationUpdate,
 		Delete: resourceS3BucketAssociationDelete,
 
+		DeprecationMessage: `With the retirement of Macie Classic the aws_macie_s3_bucket_association resource has been deprecated and will be removed in a future version.`,
+
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
 				Type:     schema.TypeString,
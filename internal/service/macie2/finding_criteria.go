@@ -0,0 +1,283 @@
+package macie2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+type findingFieldType int
+
+const (
+	findingFieldTypeString findingFieldType = iota
+	findingFieldTypeBoolean
+	findingFieldTypeNumber
+	findingFieldTypeDate
+)
+
+func (t findingFieldType) String() string {
+	switch t {
+	case findingFieldTypeBoolean:
+		return "boolean"
+	case findingFieldTypeNumber:
+		return "number"
+	case findingFieldTypeDate:
+		return "date"
+	default:
+		return "string"
+	}
+}
+
+// findingFields are the fields that can be used to filter findings, and their value types.
+// See https://docs.aws.amazon.com/macie/latest/user/findings-filter-fields.html.
+var findingFields = map[string]findingFieldType{
+	// Common fields.
+	"accountId":                        findingFieldTypeString,
+	"archived":                         findingFieldTypeBoolean,
+	"category":                         findingFieldTypeString,
+	"classificationDetails.jobArn":     findingFieldTypeString,
+	"classificationDetails.jobId":      findingFieldTypeString,
+	"classificationDetails.originType": findingFieldTypeString,
+	"count":                            findingFieldTypeNumber,
+	"createdAt":                        findingFieldTypeNumber,
+	"id":                               findingFieldTypeString,
+	"partition":                        findingFieldTypeString,
+	"region":                           findingFieldTypeString,
+	"resourcesAffected.s3Bucket.allowsUnencryptedObjectUploads":             findingFieldTypeString,
+	"resourcesAffected.s3Bucket.createdAt":                                  findingFieldTypeNumber,
+	"resourcesAffected.s3Bucket.defaultServerSideEncryption.encryptionType": findingFieldTypeString,
+	"resourcesAffected.s3Bucket.defaultServerSideEncryption.kmsMasterKeyId": findingFieldTypeString,
+	"resourcesAffected.s3Bucket.name":                                       findingFieldTypeString,
+	"resourcesAffected.s3Bucket.owner.displayName":                          findingFieldTypeString,
+	"resourcesAffected.s3Bucket.publicAccess.effectivePermission":           findingFieldTypeString,
+	"resourcesAffected.s3Bucket.tags.key":                                   findingFieldTypeString,
+	"resourcesAffected.s3Bucket.tags.value":                                 findingFieldTypeString,
+	"resourcesAffected.s3Object.extension":                                  findingFieldTypeString,
+	"resourcesAffected.s3Object.key":                                        findingFieldTypeString,
+	"resourcesAffected.s3Object.lastModified":                               findingFieldTypeNumber,
+	"resourcesAffected.s3Object.path":                                       findingFieldTypeString,
+	"resourcesAffected.s3Object.publicAccess":                               findingFieldTypeBoolean,
+	"resourcesAffected.s3Object.serverSideEncryption.encryptionType":        findingFieldTypeString,
+	"resourcesAffected.s3Object.serverSideEncryption.kmsMasterKeyId":        findingFieldTypeString,
+	"resourcesAffected.s3Object.size":                                       findingFieldTypeNumber,
+	"resourcesAffected.s3Object.storageClass":                               findingFieldTypeString,
+	"resourcesAffected.s3Object.tags.key":                                   findingFieldTypeString,
+	"resourcesAffected.s3Object.tags.value":                                 findingFieldTypeString,
+	"sample":                                                                findingFieldTypeBoolean,
+	"severity.description":                                                  findingFieldTypeString,
+	"severity.score":                                                        findingFieldTypeNumber,
+	"type":                                                                  findingFieldTypeString,
+	"updatedAt":                                                             findingFieldTypeDate,
+
+	// Sensitive data finding fields.
+	"classificationDetails.result.customDataIdentifiers.detections.arn":   findingFieldTypeString,
+	"classificationDetails.result.customDataIdentifiers.detections.count": findingFieldTypeNumber,
+	"classificationDetails.result.customDataIdentifiers.detections.name":  findingFieldTypeString,
+	"classificationDetails.result.customDataIdentifiers.totalCount":       findingFieldTypeNumber,
+	"classificationDetails.result.mimeType":                               findingFieldTypeString,
+	"classificationDetails.result.sensitiveData.category":                 findingFieldTypeString,
+	"classificationDetails.result.sensitiveData.detections.count":         findingFieldTypeNumber,
+	"classificationDetails.result.sensitiveData.detections.type":          findingFieldTypeString,
+	"classificationDetails.result.sensitiveData.totalCount":               findingFieldTypeNumber,
+	"classificationDetails.result.sizeClassified":                         findingFieldTypeNumber,
+	"classificationDetails.result.status.code":                            findingFieldTypeString,
+	"classificationDetails.result.status.reason":                          findingFieldTypeString,
+
+	// Policy finding fields.
+	"policyDetails.action.actionType":                                                           findingFieldTypeString,
+	"policyDetails.action.apiCallDetails.api":                                                   findingFieldTypeString,
+	"policyDetails.action.apiCallDetails.apiServiceName":                                        findingFieldTypeString,
+	"policyDetails.action.apiCallDetails.firstSeen":                                             findingFieldTypeNumber,
+	"policyDetails.action.apiCallDetails.lastSeen":                                              findingFieldTypeNumber,
+	"policyDetails.actor.domainDetails.domainName":                                              findingFieldTypeString,
+	"policyDetails.actor.ipAddressDetails.ipAddressV4":                                          findingFieldTypeString,
+	"policyDetails.actor.ipAddressDetails.ipCity.name":                                          findingFieldTypeString,
+	"policyDetails.actor.ipAddressDetails.ipCountry.name":                                       findingFieldTypeString,
+	"policyDetails.actor.ipAddressDetails.ipOwner.asn":                                          findingFieldTypeString,
+	"policyDetails.actor.ipAddressDetails.ipOwner.asnOrg":                                       findingFieldTypeString,
+	"policyDetails.actor.ipAddressDetails.ipOwner.isp":                                          findingFieldTypeString,
+	"policyDetails.actor.ipAddressDetails.ipOwner.org":                                          findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.accessKeyId":                                  findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.accountId":                                    findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.arn":                                          findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.principalId":                                  findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.sessionContext.attributes.mfaAuthenticated":   findingFieldTypeBoolean,
+	"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.accountId":       findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.arn":             findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.principalId":     findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.type":            findingFieldTypeString,
+	"policyDetails.actor.userIdentity.assumedRole.sessionContext.sessionIssuer.userName":        findingFieldTypeString,
+	"policyDetails.actor.userIdentity.awsAccount.accountId":                                     findingFieldTypeString,
+	"policyDetails.actor.userIdentity.awsAccount.principalId":                                   findingFieldTypeString,
+	"policyDetails.actor.userIdentity.awsService.invokedBy":                                     findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.accessKeyId":                                findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.accountId":                                  findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.arn":                                        findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.principalId":                                findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.sessionContext.attributes.mfaAuthenticated": findingFieldTypeBoolean,
+	"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.accountId":     findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.arn":           findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.principalId":   findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.type":          findingFieldTypeString,
+	"policyDetails.actor.userIdentity.federatedUser.sessionContext.sessionIssuer.userName":      findingFieldTypeString,
+	"policyDetails.actor.userIdentity.iamUser.accountId":                                        findingFieldTypeString,
+	"policyDetails.actor.userIdentity.iamUser.arn":                                              findingFieldTypeString,
+	"policyDetails.actor.userIdentity.iamUser.principalId":                                      findingFieldTypeString,
+	"policyDetails.actor.userIdentity.iamUser.userName":                                         findingFieldTypeString,
+	"policyDetails.actor.userIdentity.root.accountId":                                           findingFieldTypeString,
+	"policyDetails.actor.userIdentity.root.arn":                                                 findingFieldTypeString,
+	"policyDetails.actor.userIdentity.root.principalId":                                         findingFieldTypeString,
+	"policyDetails.actor.userIdentity.type":                                                     findingFieldTypeString,
+}
+
+func findingFieldNames() []string {
+	names := make([]string, 0, len(findingFields))
+
+	for name := range findingFields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func findingCriteriaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"criterion": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"field": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(findingFieldNames(), false),
+							},
+							"eq_exact_match": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"eq": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"neq": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"lt": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidStringDateOrPositiveInt,
+							},
+							"lte": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidStringDateOrPositiveInt,
+							},
+							"gt": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidStringDateOrPositiveInt,
+							},
+							"gte": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidStringDateOrPositiveInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateFindingCriteria checks that each criterion's conditions are compatible with the value type of its field.
+// Criteria whose fields are not yet known are skipped.
+func validateFindingCriteria(tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	v, ok := tfMap["criterion"].(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	for _, tfMapRaw := range v.List() {
+		criterion := tfMapRaw.(map[string]interface{})
+		field := criterion["field"].(string)
+
+		fieldType, ok := findingFields[field]
+		if !ok {
+			continue
+		}
+
+		for _, condition := range []string{"eq", "eq_exact_match", "neq"} {
+			for _, v := range criterion[condition].(*schema.Set).List() {
+				if err := validateFindingFieldValue(field, fieldType, condition, v.(string)); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, condition := range []string{"gt", "gte", "lt", "lte"} {
+			v := criterion[condition].(string)
+			if v == "" {
+				continue
+			}
+
+			if fieldType != findingFieldTypeNumber && fieldType != findingFieldTypeDate {
+				return fmt.Errorf("condition %q is not supported for %s field %q", condition, fieldType, field)
+			}
+
+			if err := validateFindingFieldValue(field, fieldType, condition, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateFindingFieldValue(field string, fieldType findingFieldType, condition, v string) error {
+	// Values that are not yet known are validated by the API.
+	if v == "" {
+		return nil
+	}
+
+	var err error
+
+	switch fieldType {
+	case findingFieldTypeBoolean:
+		_, err = strconv.ParseBool(v)
+	case findingFieldTypeNumber:
+		_, err = strconv.ParseInt(v, 10, 64)
+	case findingFieldTypeDate:
+		_, err = time.Parse(time.RFC3339, v)
+	}
+
+	if err != nil {
+		return fmt.Errorf("value %q of condition %q is not a valid %s for field %q", v, condition, fieldType, field)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package macie2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateFindingCriteria(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Criterion map[string]interface{}
+		ExpectErr bool
+	}{
+		{
+			Name:      "string eq",
+			Criterion: map[string]interface{}{"field": "region", "eq": []interface{}{"us-west-2"}},
+		},
+		{
+			Name:      "string gt",
+			Criterion: map[string]interface{}{"field": "region", "gt": "10"},
+			ExpectErr: true,
+		},
+		{
+			Name:      "boolean eq",
+			Criterion: map[string]interface{}{"field": "sample", "eq": []interface{}{"true"}},
+		},
+		{
+			Name:      "boolean eq invalid",
+			Criterion: map[string]interface{}{"field": "archived", "neq": []interface{}{"yes"}},
+			ExpectErr: true,
+		},
+		{
+			Name:      "number range",
+			Criterion: map[string]interface{}{"field": "count", "gte": "1", "lt": "10"},
+		},
+		{
+			Name:      "number invalid",
+			Criterion: map[string]interface{}{"field": "severity.score", "lt": "2020-01-01T00:00:00Z"},
+			ExpectErr: true,
+		},
+		{
+			Name:      "date range",
+			Criterion: map[string]interface{}{"field": "updatedAt", "gte": "2020-01-01T00:00:00Z"},
+		},
+		{
+			Name:      "date invalid",
+			Criterion: map[string]interface{}{"field": "updatedAt", "gte": "1577836800000"},
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"finding_criteria": findingCriteriaSchema()}, map[string]interface{}{
+				"finding_criteria": []interface{}{
+					map[string]interface{}{
+						"criterion": []interface{}{testCase.Criterion},
+					},
+				},
+			})
+
+			err := validateFindingCriteria(d.Get("finding_criteria").([]interface{}))
+
+			if testCase.ExpectErr && err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !testCase.ExpectErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
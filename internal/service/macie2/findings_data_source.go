@@ -0,0 +1,71 @@
+package macie2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"finding_criteria": findingCriteriaSchema(),
+			"finding_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"total_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	tfList := d.Get("finding_criteria").([]interface{})
+
+	if err := validateFindingCriteria(tfList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	findingCriteria, err := expandFindingCriteriaFilter(tfList)
+
+	if err != nil {
+		return diag.Errorf("reading Macie Findings: %s", err)
+	}
+
+	input := &macie2.ListFindingsInput{
+		FindingCriteria: findingCriteria,
+	}
+	var findingIDs []string
+
+	err = conn.ListFindingsPagesWithContext(ctx, input, func(page *macie2.ListFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		findingIDs = append(findingIDs, aws.StringValueSlice(page.FindingIds)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("reading Macie Findings: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("finding_ids", findingIDs)
+	d.Set("total_count", len(findingIDs))
+
+	return nil
+}
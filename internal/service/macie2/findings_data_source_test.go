@@ -0,0 +1,53 @@
+package macie2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_macie2_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					// A newly enabled account has no findings.
+					resource.TestCheckResourceAttr(dataSourceName, "finding_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "total_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig_basic() string {
+	return `
+data "aws_region" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+data "aws_macie2_findings" "test" {
+  finding_criteria {
+    criterion {
+      field = "region"
+      eq    = [data.aws_region.current.name]
+    }
+    criterion {
+      field = "archived"
+      eq    = ["false"]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`
+}
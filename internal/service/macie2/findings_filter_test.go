@@ -390,6 +390,33 @@ func testAccFindingsFilter_withTags(t *testing.T) {
 	})
 }
 
+func testAccFindingsFilter_invalidCriterion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFindingsFilterDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFindingsFilterConfig_criterion("severity", `eq = ["High"]`),
+				ExpectError: regexp.MustCompile(`expected finding_criteria.0.criterion.\d+.field to be one of`),
+			},
+			{
+				Config:      testAccFindingsFilterConfig_criterion("region", `gt = "10"`),
+				ExpectError: regexp.MustCompile(`condition "gt" is not supported for string field "region"`),
+			},
+			{
+				Config:      testAccFindingsFilterConfig_criterion("sample", `eq = ["yes"]`),
+				ExpectError: regexp.MustCompile(`value "yes" of condition "eq" is not a valid boolean for field "sample"`),
+			},
+			{
+				Config:      testAccFindingsFilterConfig_criterion("updatedAt", `gte = "10"`),
+				ExpectError: regexp.MustCompile(`value "10" of condition "gte" is not a valid date for field "updatedAt"`),
+			},
+		},
+	})
+}
+
 func testAccCheckFindingsFilterExists(resourceName string, macie2Session *macie2.GetFindingsFilterOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -581,3 +608,20 @@ resource "aws_macie2_findings_filter" "test" {
 }
 `, description, action, position)
 }
+
+func testAccFindingsFilterConfig_criterion(field, condition string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_findings_filter" "test" {
+  action = "ARCHIVE"
+  finding_criteria {
+    criterion {
+      field = %[1]q
+      %[2]s
+    }
+  }
+  depends_on = [aws_macie2_account.test]
+}
+`, field, condition)
+}
@@ -43,6 +43,10 @@ func TestAccMacie2_serial(t *testing.T) {
 			"date":           testAccFindingsFilter_WithDate,
 			"number":         testAccFindingsFilter_WithNumber,
 			"tags":           testAccFindingsFilter_withTags,
+			"invalid":        testAccFindingsFilter_invalidCriterion,
+		},
+		"FindingsDataSource": {
+			"basic": testAccFindingsDataSource_basic,
 		},
 		"OrganizationAdminAccount": {
 			"basic":      testAccOrganizationAdminAccount_basic,
@@ -3,6 +3,7 @@ package medialive
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -116,7 +117,7 @@ func ResourceInput() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARNOfService("iam", "role")),
 			},
 			"sources": {
 				Type:     schema.TypeSet,
@@ -189,11 +190,15 @@ func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if v, ok := d.GetOk("destinations"); ok && v.(*schema.Set).Len() > 0 {
-		in.Destinations = expandDestinations(v.(*schema.Set).List())
+		if err := flex.Expand(v, &in.Destinations); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameInput, d.Get("name").(string), err)
+		}
 	}
 
 	if v, ok := d.GetOk("input_devices"); ok && v.(*schema.Set).Len() > 0 {
-		in.InputDevices = inputDevices(v.(*schema.Set).List()).expandToDeviceSettings()
+		if err := flex.Expand(v, &in.InputDevices); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameInput, d.Get("name").(string), err)
+		}
 	}
 
 	if v, ok := d.GetOk("input_security_groups"); ok && len(v.([]interface{})) > 0 {
@@ -201,7 +206,9 @@ func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if v, ok := d.GetOk("media_connect_flows"); ok && v.(*schema.Set).Len() > 0 {
-		in.MediaConnectFlows = expandMediaConnectFlows(v.(*schema.Set).List())
+		if err := flex.Expand(v, &in.MediaConnectFlows); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameInput, d.Get("name").(string), err)
+		}
 	}
 
 	if v, ok := d.GetOk("role_arn"); ok {
@@ -209,11 +216,15 @@ func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if v, ok := d.GetOk("sources"); ok && v.(*schema.Set).Len() > 0 {
-		in.Sources = expandSources(v.(*schema.Set).List())
+		if err := flex.Expand(v, &in.Sources); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameInput, d.Get("name").(string), err)
+		}
 	}
 
 	if v, ok := d.GetOk("vpc"); ok && len(v.([]interface{})) > 0 {
-		in.Vpc = expandVPC(v.([]interface{}))
+		if err := flex.Expand(v, &in.Vpc); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameInput, d.Get("name").(string), err)
+		}
 	}
 
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -271,17 +282,32 @@ func resourceInputRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.Set("arn", out.Arn)
 	d.Set("attached_channels", out.AttachedChannels)
-	d.Set("media_connect_flows", flattenMediaConnectFlows(out.MediaConnectFlows))
 	d.Set("name", out.Name)
 	d.Set("input_class", out.InputClass)
-	d.Set("input_devices", flattenInputDevices(out.InputDevices))
 	d.Set("input_partner_ids", out.InputPartnerIds)
 	d.Set("input_security_groups", out.SecurityGroups)
 	d.Set("input_source_type", out.InputSourceType)
 	d.Set("role_arn", out.RoleArn)
-	d.Set("sources", flattenSources(out.Sources))
 	d.Set("type", out.Type)
 
+	tfSchema := ResourceInput().Schema
+
+	for k, v := range map[string]interface{}{
+		"input_devices":       out.InputDevices,
+		"media_connect_flows": out.MediaConnectFlows,
+		"sources":             out.Sources,
+	} {
+		tfList, err := flex.Flatten(v, tfSchema[k])
+
+		if err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameInput, d.Id(), err)
+		}
+
+		if err := d.Set(k, tfList); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameInput, d.Id(), err)
+		}
+	}
+
 	tags, err := ListTags(ctx, conn, aws.ToString(out.Arn))
 	if err != nil {
 		return create.DiagError(names.MediaLive, create.ErrActionReading, ResNameInput, d.Id(), err)
@@ -311,15 +337,21 @@ func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 
 		if d.HasChange("destinations") {
-			in.Destinations = expandDestinations(d.Get("destinations").(*schema.Set).List())
+			if err := flex.Expand(d.Get("destinations"), &in.Destinations); err != nil {
+				return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameInput, d.Id(), err)
+			}
 		}
 
 		if d.HasChange("input_devices") {
-			in.InputDevices = inputDevices(d.Get("input_devices").(*schema.Set).List()).expandToDeviceRequest()
+			if err := flex.Expand(d.Get("input_devices"), &in.InputDevices); err != nil {
+				return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameInput, d.Id(), err)
+			}
 		}
 
 		if d.HasChange("media_connect_flows") {
-			in.MediaConnectFlows = expandMediaConnectFlows(d.Get("media_connect_flows").(*schema.Set).List())
+			if err := flex.Expand(d.Get("media_connect_flows"), &in.MediaConnectFlows); err != nil {
+				return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameInput, d.Id(), err)
+			}
 		}
 
 		if d.HasChange("name") {
@@ -331,7 +363,9 @@ func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 
 		if d.HasChange("sources") {
-			in.Sources = expandSources(d.Get("sources").(*schema.Set).List())
+			if err := flex.Expand(d.Get("sources"), &in.Sources); err != nil {
+				return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameInput, d.Id(), err)
+			}
 		}
 
 		out, err := conn.UpdateInput(ctx, in)
@@ -391,7 +425,7 @@ func waitInputCreated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Input (%s) creation", id))
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -410,7 +444,7 @@ func waitInputUpdated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Input (%s) update", id))
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -426,7 +460,7 @@ func waitInputDeleted(ctx context.Context, conn *medialive.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Input (%s) deletion", id))
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -472,244 +506,3 @@ func FindInputByID(ctx context.Context, conn *medialive.Client, id string) (*med
 
 	return out, nil
 }
-
-func flattenMediaConnectFlow(apiObject types.MediaConnectFlow) map[string]interface{} {
-	if apiObject == (types.MediaConnectFlow{}) {
-		return nil
-	}
-
-	m := map[string]interface{}{}
-
-	if v := apiObject.FlowArn; v != nil {
-		m["flow_arn"] = aws.ToString(v)
-	}
-
-	return m
-}
-func flattenMediaConnectFlows(apiObjects []types.MediaConnectFlow) []interface{} {
-	if len(apiObjects) == 0 {
-		return nil
-	}
-
-	var l []interface{}
-
-	for _, apiObject := range apiObjects {
-		if apiObject == (types.MediaConnectFlow{}) {
-			continue
-		}
-
-		l = append(l, flattenMediaConnectFlow(apiObject))
-	}
-
-	return l
-}
-
-func flattenInputDevice(apiObject types.InputDeviceSettings) map[string]interface{} {
-	if apiObject == (types.InputDeviceSettings{}) {
-		return nil
-	}
-
-	m := map[string]interface{}{}
-
-	if v := apiObject.Id; v != nil {
-		m["id"] = aws.ToString(v)
-	}
-
-	return m
-}
-
-func flattenInputDevices(apiObjects []types.InputDeviceSettings) []interface{} {
-	if len(apiObjects) == 0 {
-		return nil
-	}
-
-	var l []interface{}
-
-	for _, apiObject := range apiObjects {
-		if apiObject == (types.InputDeviceSettings{}) {
-			continue
-		}
-
-		l = append(l, flattenInputDevice(apiObject))
-	}
-
-	return l
-}
-
-func flattenSource(apiObject types.InputSource) map[string]interface{} {
-	if apiObject == (types.InputSource{}) {
-		return nil
-	}
-
-	m := map[string]interface{}{}
-
-	if v := apiObject.Url; v != nil {
-		m["url"] = aws.ToString(v)
-	}
-	if v := apiObject.PasswordParam; v != nil {
-		m["password_param"] = aws.ToString(v)
-	}
-	if v := apiObject.Username; v != nil {
-		m["username"] = aws.ToString(v)
-	}
-	return m
-}
-
-func flattenSources(apiObjects []types.InputSource) []interface{} {
-	if len(apiObjects) == 0 {
-		return nil
-	}
-
-	var l []interface{}
-
-	for _, apiObject := range apiObjects {
-		if apiObject == (types.InputSource{}) {
-			continue
-		}
-
-		l = append(l, flattenSource(apiObject))
-	}
-
-	return l
-}
-
-func expandDestinations(tfList []interface{}) []types.InputDestinationRequest {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	var s []types.InputDestinationRequest
-
-	for _, v := range tfList {
-		m, ok := v.(map[string]interface{})
-
-		if !ok {
-			continue
-		}
-
-		var id types.InputDestinationRequest
-		if val, ok := m["stream_name"]; ok {
-			id.StreamName = aws.String(val.(string))
-			s = append(s, id)
-		}
-	}
-	return s
-}
-
-type inputDevices []interface{}
-
-func (i inputDevices) expandToDeviceSettings() []types.InputDeviceSettings {
-	if len(i) == 0 {
-		return nil
-	}
-
-	var s []types.InputDeviceSettings
-
-	for _, v := range i {
-		m, ok := v.(map[string]interface{})
-
-		if !ok {
-			continue
-		}
-
-		var id types.InputDeviceSettings
-		if val, ok := m["id"]; ok {
-			id.Id = aws.String(val.(string))
-			s = append(s, id)
-		}
-	}
-	return s
-}
-
-func (i inputDevices) expandToDeviceRequest() []types.InputDeviceRequest {
-	if len(i) == 0 {
-		return nil
-	}
-
-	var s []types.InputDeviceRequest
-
-	for _, v := range i {
-		m, ok := v.(map[string]interface{})
-
-		if !ok {
-			continue
-		}
-
-		var id types.InputDeviceRequest
-		if val, ok := m["id"]; ok {
-			id.Id = aws.String(val.(string))
-			s = append(s, id)
-		}
-	}
-	return s
-}
-
-func expandMediaConnectFlows(tfList []interface{}) []types.MediaConnectFlowRequest {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	var s []types.MediaConnectFlowRequest
-
-	for _, v := range tfList {
-		m, ok := v.(map[string]interface{})
-
-		if !ok {
-			continue
-		}
-
-		var id types.MediaConnectFlowRequest
-		if val, ok := m["flow_arn"]; ok {
-			id.FlowArn = aws.String(val.(string))
-			s = append(s, id)
-		}
-	}
-	return s
-}
-
-func expandSources(tfList []interface{}) []types.InputSourceRequest {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	var s []types.InputSourceRequest
-
-	for _, v := range tfList {
-		m, ok := v.(map[string]interface{})
-
-		if !ok {
-			continue
-		}
-
-		var id types.InputSourceRequest
-		if val, ok := m["password_param"]; ok {
-			id.PasswordParam = aws.String(val.(string))
-		}
-		if val, ok := m["url"]; ok {
-			id.Url = aws.String(val.(string))
-		}
-		if val, ok := m["username"]; ok {
-			id.Username = aws.String(val.(string))
-		}
-		s = append(s, id)
-	}
-	return s
-}
-
-func expandVPC(tfList []interface{}) *types.InputVpcRequest {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	var s types.InputVpcRequest
-	vpc := tfList[0].(map[string]interface{})
-
-	if val, ok := vpc["subnet_ids"]; ok {
-		s.SubnetIds = flex.ExpandStringValueList(val.([]interface{}))
-	}
-	if val, ok := vpc["security_group_ids"]; ok {
-		s.SecurityGroupIds = flex.ExpandStringValueList(val.([]interface{}))
-	}
-
-	return &s
-}
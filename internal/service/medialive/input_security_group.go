@@ -3,6 +3,7 @@ package medialive
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -215,7 +216,7 @@ func waitInputSecurityGroupCreated(ctx context.Context, conn *medialive.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Input Security Group (%s) creation", id))
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
@@ -233,7 +234,7 @@ func waitInputSecurityGroupUpdated(ctx context.Context, conn *medialive.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Input Security Group (%s) update", id))
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
@@ -249,7 +250,7 @@ func waitInputSecurityGroupDeleted(ctx context.Context, conn *medialive.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Input Security Group (%s) deletion", id))
 	if out, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
 		return out, err
 	}
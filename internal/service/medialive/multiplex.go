@@ -3,6 +3,7 @@ package medialive
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -113,7 +114,9 @@ func resourceMultiplexCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if v, ok := d.GetOk("multiplex_settings"); ok && len(v.([]interface{})) > 0 {
-		in.MultiplexSettings = expandMultiplexSettings(v.([]interface{}))
+		if err := flex.Expand(v, &in.MultiplexSettings); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameMultiplex, d.Get("name").(string), err)
+		}
 	}
 
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -166,7 +169,13 @@ func resourceMultiplexRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("availability_zones", out.AvailabilityZones)
 	d.Set("name", out.Name)
 
-	if err := d.Set("multiplex_settings", flattenMultiplexSettings(out.MultiplexSettings)); err != nil {
+	multiplexSettings, err := flex.Flatten(out.MultiplexSettings, ResourceMultiplex().Schema["multiplex_settings"])
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameMultiplex, d.Id(), err)
+	}
+
+	if err := d.Set("multiplex_settings", multiplexSettings); err != nil {
 		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameMultiplex, d.Id(), err)
 	}
 
@@ -202,7 +211,9 @@ func resourceMultiplexUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			in.Name = aws.String(d.Get("name").(string))
 		}
 		if d.HasChange("multiplex_settings") {
-			in.MultiplexSettings = expandMultiplexSettings(d.Get("multiplex_settings").([]interface{}))
+			if err := flex.Expand(d.Get("multiplex_settings"), &in.MultiplexSettings); err != nil {
+				return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameMultiplex, d.Id(), err)
+			}
 		}
 
 		log.Printf("[DEBUG] Updating MediaLive Multiplex (%s): %#v", d.Id(), in)
@@ -299,7 +310,7 @@ func waitMultiplexCreated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Multiplex (%s) creation", id))
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -318,7 +329,7 @@ func waitMultiplexUpdated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Multiplex (%s) update", id))
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -334,7 +345,7 @@ func waitMultiplexDeleted(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Multiplex (%s) deletion", id))
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -350,7 +361,7 @@ func waitMultiplexRunning(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Multiplex (%s) start", id))
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -366,7 +377,7 @@ func waitMultiplexStopped(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("MediaLive Multiplex (%s) stop", id))
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -413,46 +424,6 @@ func FindMultiplexByID(ctx context.Context, conn *medialive.Client, id string) (
 	return out, nil
 }
 
-func flattenMultiplexSettings(apiObject *types.MultiplexSettings) []interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	m := map[string]interface{}{
-		"transport_stream_bitrate":                apiObject.TransportStreamBitrate,
-		"transport_stream_id":                     apiObject.TransportStreamId,
-		"maximum_video_buffer_delay_milliseconds": apiObject.MaximumVideoBufferDelayMilliseconds,
-		"transport_stream_reserved_bitrate":       apiObject.TransportStreamReservedBitrate,
-	}
-
-	return []interface{}{m}
-}
-
-func expandMultiplexSettings(tfList []interface{}) *types.MultiplexSettings {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	m := tfList[0].(map[string]interface{})
-
-	s := types.MultiplexSettings{}
-
-	if v, ok := m["transport_stream_bitrate"]; ok {
-		s.TransportStreamBitrate = int32(v.(int))
-	}
-	if v, ok := m["transport_stream_id"]; ok {
-		s.TransportStreamId = int32(v.(int))
-	}
-	if val, ok := m["maximum_video_buffer_delay_milliseconds"]; ok {
-		s.MaximumVideoBufferDelayMilliseconds = int32(val.(int))
-	}
-	if val, ok := m["transport_stream_reserved_bitrate"]; ok {
-		s.TransportStreamReservedBitrate = int32(val.(int))
-	}
-
-	return &s
-}
-
 func startMultiplex(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Starting Medialive Multiplex: (%s)", id)
 	_, err := conn.StartMultiplex(ctx, &medialive.StartMultiplexInput{
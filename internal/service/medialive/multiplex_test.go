@@ -31,6 +31,7 @@ func testAccMultiplex_basic(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			acctest.PreCheckHourlyCost(t, acctest.HourlyCostMediaLiveMultiplex)
 			testAccMultiplexesPreCheck(t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
@@ -72,6 +73,7 @@ func testAccMultiplex_start(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			acctest.PreCheckHourlyCost(t, acctest.HourlyCostMediaLiveMultiplex)
 			testAccMultiplexesPreCheck(t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
@@ -111,6 +113,7 @@ func testAccMultiplex_update(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			acctest.PreCheckHourlyCost(t, acctest.HourlyCostMediaLiveMultiplex)
 			testAccMultiplexesPreCheck(t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
@@ -158,6 +161,7 @@ func testAccMultiplex_updateTags(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			acctest.PreCheckHourlyCost(t, acctest.HourlyCostMediaLiveMultiplex)
 			testAccMultiplexesPreCheck(t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
@@ -206,6 +210,7 @@ func testAccMultiplex_disappears(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			acctest.PreCheckHourlyCost(t, acctest.HourlyCostMediaLiveMultiplex)
 			testAccMultiplexesPreCheck(t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
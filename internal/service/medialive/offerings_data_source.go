@@ -0,0 +1,259 @@
+package medialive
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceOfferings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOfferingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"channel_class": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ChannelClass](),
+			},
+			"codec": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ReservationCodec](),
+			},
+			"duration": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"maximum_bitrate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ReservationMaximumBitrate](),
+			},
+			"maximum_framerate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ReservationMaximumFramerate](),
+			},
+			"offerings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"currency_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"duration_units": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fixed_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"offering_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offering_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offering_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_specification": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: offeringResourceSpecificationSchema(),
+							},
+						},
+						"usage_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resolution": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ReservationResolution](),
+			},
+			"resource_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ReservationResourceType](),
+			},
+			"special_feature": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ReservationSpecialFeature](),
+			},
+			"video_quality": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ReservationVideoQuality](),
+			},
+		},
+	}
+}
+
+const (
+	DSNameOfferings = "Offerings Data Source"
+)
+
+func dataSourceOfferingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	in := &medialive.ListOfferingsInput{}
+
+	if v, ok := d.GetOk("channel_class"); ok {
+		in.ChannelClass = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("codec"); ok {
+		in.Codec = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("duration"); ok {
+		in.Duration = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("maximum_bitrate"); ok {
+		in.MaximumBitrate = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("maximum_framerate"); ok {
+		in.MaximumFramerate = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("resolution"); ok {
+		in.Resolution = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("resource_type"); ok {
+		in.ResourceType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("special_feature"); ok {
+		in.SpecialFeature = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("video_quality"); ok {
+		in.VideoQuality = aws.String(v.(string))
+	}
+
+	var offerings []types.Offering
+	pages := medialive.NewListOfferingsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionReading, DSNameOfferings, "", err)
+		}
+
+		offerings = append(offerings, page.Offerings...)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("offerings", flattenOfferings(offerings)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, DSNameOfferings, d.Id(), err)
+	}
+
+	return nil
+}
+
+func offeringResourceSpecificationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"channel_class": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"codec": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"maximum_bitrate": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"maximum_framerate": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"resolution": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"resource_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"special_feature": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"video_quality": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func flattenOfferings(apiObjects []types.Offering) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var l []interface{}
+
+	for _, apiObject := range apiObjects {
+		m := map[string]interface{}{
+			"arn":                    aws.ToString(apiObject.Arn),
+			"currency_code":          aws.ToString(apiObject.CurrencyCode),
+			"duration":               apiObject.Duration,
+			"duration_units":         string(apiObject.DurationUnits),
+			"fixed_price":            apiObject.FixedPrice,
+			"offering_description":   aws.ToString(apiObject.OfferingDescription),
+			"offering_id":            aws.ToString(apiObject.OfferingId),
+			"offering_type":          string(apiObject.OfferingType),
+			"resource_specification": flattenReservationResourceSpecification(apiObject.ResourceSpecification),
+			"usage_price":            apiObject.UsagePrice,
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenReservationResourceSpecification(apiObject *types.ReservationResourceSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"channel_class":     string(apiObject.ChannelClass),
+		"codec":             string(apiObject.Codec),
+		"maximum_bitrate":   string(apiObject.MaximumBitrate),
+		"maximum_framerate": string(apiObject.MaximumFramerate),
+		"resolution":        string(apiObject.Resolution),
+		"resource_type":     string(apiObject.ResourceType),
+		"special_feature":   string(apiObject.SpecialFeature),
+		"video_quality":     string(apiObject.VideoQuality),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,47 @@
+package medialive_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveOfferingsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_medialive_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			testAccMultiplexesPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOfferingsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "offerings.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.offering_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.resource_specification.0.channel_class", "STANDARD"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.resource_specification.0.codec", "AVC"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.resource_specification.0.resolution", "HD"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.resource_specification.0.resource_type", "CHANNEL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOfferingsDataSourceConfig_basic() string {
+	return `
+data "aws_medialive_offerings" "test" {
+  channel_class = "STANDARD"
+  codec         = "AVC"
+  resolution    = "HD"
+  resource_type = "CHANNEL"
+}
+`
+}
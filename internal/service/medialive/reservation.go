@@ -0,0 +1,372 @@
+package medialive
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReservationCreate,
+		ReadWithoutTimeout:   resourceReservationRead,
+		UpdateWithoutTimeout: resourceReservationUpdate,
+		DeleteWithoutTimeout: resourceReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"duration_units": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"offering_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renewal_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatic_renewal": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.ReservationAutomaticRenewal](),
+						},
+						"renewal_count": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_count": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"resource_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: offeringResourceSpecificationSchema(),
+				},
+			},
+			"start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameReservation = "Reservation"
+)
+
+func resourceReservationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	offeringID := d.Get("offering_id").(string)
+	in := &medialive.PurchaseOfferingInput{
+		Count:      int32(d.Get("resource_count").(int)),
+		OfferingId: aws.String(offeringID),
+		RequestId:  aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("renewal_settings"); ok && len(v.([]interface{})) > 0 {
+		in.RenewalSettings = expandRenewalSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("start"); ok {
+		in.Start = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.PurchaseOffering(ctx, in)
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameReservation, offeringID, err)
+	}
+
+	if out == nil || out.Reservation == nil {
+		return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameReservation, offeringID, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Reservation.ReservationId))
+
+	return resourceReservationRead(ctx, d, meta)
+}
+
+func resourceReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	out, err := FindReservationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionReading, ResNameReservation, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("resource_count", out.Count)
+	d.Set("currency_code", out.CurrencyCode)
+	d.Set("duration", out.Duration)
+	d.Set("duration_units", string(out.DurationUnits))
+	d.Set("end", out.End)
+	d.Set("fixed_price", out.FixedPrice)
+	d.Set("name", out.Name)
+	d.Set("offering_description", out.OfferingDescription)
+	d.Set("offering_id", out.OfferingId)
+	d.Set("offering_type", string(out.OfferingType))
+	d.Set("reservation_id", out.ReservationId)
+	d.Set("start", out.Start)
+	d.Set("state", string(out.State))
+	d.Set("usage_price", out.UsagePrice)
+
+	if err := d.Set("renewal_settings", flattenRenewalSettings(out.RenewalSettings)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameReservation, d.Id(), err)
+	}
+
+	if err := d.Set("resource_specification", flattenReservationResourceSpecification(out.ResourceSpecification)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameReservation, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameReservation, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameReservation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceReservationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChanges("name", "renewal_settings") {
+		in := &medialive.UpdateReservationInput{
+			ReservationId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("name") {
+			in.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("renewal_settings") {
+			in.RenewalSettings = expandRenewalSettings(d.Get("renewal_settings").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Reservation (%s): %#v", d.Id(), in)
+		if _, err := conn.UpdateReservation(ctx, in); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameReservation, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameReservation, d.Id(), err)
+		}
+	}
+
+	return resourceReservationRead(ctx, d, meta)
+}
+
+func resourceReservationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	out, err := FindReservationByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionDeleting, ResNameReservation, d.Id(), err)
+	}
+
+	// Purchased reservations cannot be cancelled. Only expired or cancelled
+	// reservations can be deleted, so an active one is simply removed from state.
+	if out.State == types.ReservationStateActive {
+		log.Printf("[WARN] MediaLive Reservation (%s) is still active and cannot be deleted, removing from state", d.Id())
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting MediaLive Reservation %s", d.Id())
+
+	_, err = conn.DeleteReservation(ctx, &medialive.DeleteReservationInput{
+		ReservationId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.MediaLive, create.ErrActionDeleting, ResNameReservation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindReservationByID(ctx context.Context, conn *medialive.Client, id string) (*medialive.DescribeReservationOutput, error) {
+	in := &medialive.DescribeReservationInput{
+		ReservationId: aws.String(id),
+	}
+	out, err := conn.DescribeReservation(ctx, in)
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if out.State == types.ReservationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     string(out.State),
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+func expandRenewalSettings(tfList []interface{}) *types.RenewalSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	s := types.RenewalSettings{}
+
+	if v, ok := m["automatic_renewal"].(string); ok && v != "" {
+		s.AutomaticRenewal = types.ReservationAutomaticRenewal(v)
+	}
+	if v, ok := m["renewal_count"].(int); ok && v > 0 {
+		s.RenewalCount = int32(v)
+	}
+
+	return &s
+}
+
+func flattenRenewalSettings(apiObject *types.RenewalSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"automatic_renewal": string(apiObject.AutomaticRenewal),
+		"renewal_count":     apiObject.RenewalCount,
+	}
+
+	return []interface{}{m}
+}
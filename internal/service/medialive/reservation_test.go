@@ -0,0 +1,126 @@
+package medialive_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Purchasing a reservation incurs a non-refundable commitment, so the test
+// only runs when an offering to purchase is explicitly provided.
+func TestAccMediaLiveReservation_basic(t *testing.T) {
+	key := "MEDIALIVE_RESERVATION_OFFERING_ID"
+	offeringID := os.Getenv(key)
+	if offeringID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var reservation medialive.DescribeReservationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_reservation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			testAccMultiplexesPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservationConfig_basic(rName, offeringID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "offering_id", offeringID),
+					resource.TestCheckResourceAttr(resourceName, "resource_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "end"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReservationConfig_renewalSettings(rName, offeringID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReservationExists(resourceName, &reservation),
+					resource.TestCheckResourceAttr(resourceName, "renewal_settings.0.automatic_renewal", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "renewal_settings.0.renewal_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReservationExists(name string, reservation *medialive.DescribeReservationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameReservation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameReservation, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+		ctx := context.Background()
+		resp, err := tfmedialive.FindReservationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameReservation, rs.Primary.ID, err)
+		}
+
+		*reservation = *resp
+
+		return nil
+	}
+}
+
+func testAccReservationConfig_basic(rName, offeringID string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_reservation" "test" {
+  name        = %[1]q
+  offering_id = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, offeringID)
+}
+
+func testAccReservationConfig_renewalSettings(rName, offeringID string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_reservation" "test" {
+  name        = %[1]q
+  offering_id = %[2]q
+
+  renewal_settings {
+    automatic_renewal = "ENABLED"
+    renewal_count     = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, offeringID)
+}
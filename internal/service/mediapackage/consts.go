@@ -0,0 +1,37 @@
+package mediapackage
+
+// The SDK's enum helpers for list elements (__AdTriggersElement_Values etc.) are unexported.
+
+const (
+	adTriggersElementSpliceInsert                           = "SPLICE_INSERT"
+	adTriggersElementBreak                                  = "BREAK"
+	adTriggersElementProviderAdvertisement                  = "PROVIDER_ADVERTISEMENT"
+	adTriggersElementDistributorAdvertisement               = "DISTRIBUTOR_ADVERTISEMENT"
+	adTriggersElementProviderPlacementOpportunity           = "PROVIDER_PLACEMENT_OPPORTUNITY"
+	adTriggersElementDistributorPlacementOpportunity        = "DISTRIBUTOR_PLACEMENT_OPPORTUNITY"
+	adTriggersElementProviderOverlayPlacementOpportunity    = "PROVIDER_OVERLAY_PLACEMENT_OPPORTUNITY"
+	adTriggersElementDistributorOverlayPlacementOpportunity = "DISTRIBUTOR_OVERLAY_PLACEMENT_OPPORTUNITY"
+)
+
+func adTriggersElement_Values() []string {
+	return []string{
+		adTriggersElementSpliceInsert,
+		adTriggersElementBreak,
+		adTriggersElementProviderAdvertisement,
+		adTriggersElementDistributorAdvertisement,
+		adTriggersElementProviderPlacementOpportunity,
+		adTriggersElementDistributorPlacementOpportunity,
+		adTriggersElementProviderOverlayPlacementOpportunity,
+		adTriggersElementDistributorOverlayPlacementOpportunity,
+	}
+}
+
+const (
+	periodTriggersElementAds = "ADS"
+)
+
+func periodTriggersElement_Values() []string {
+	return []string{
+		periodTriggersElementAds,
+	}
+}
@@ -0,0 +1,61 @@
+package mediapackage
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindHarvestJobByID(ctx context.Context, conn *mediapackage.MediaPackage, id string) (*mediapackage.DescribeHarvestJobOutput, error) {
+	input := &mediapackage.DescribeHarvestJobInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeHarvestJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackage.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindOriginEndpointByID(ctx context.Context, conn *mediapackage.MediaPackage, id string) (*mediapackage.DescribeOriginEndpointOutput, error) {
+	input := &mediapackage.DescribeOriginEndpointInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeOriginEndpointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackage.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,221 @@
+package mediapackage
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceHarvestJob manages a one-off MediaPackage harvest job, which extracts a
+// live-to-VOD clip from an origin endpoint's startover window into S3.
+// Harvest jobs cannot be modified or deleted; destroying the resource only
+// removes it from state.
+func ResourceHarvestJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceHarvestJobCreate,
+		ReadWithoutTimeout:   resourceHarvestJobRead,
+		DeleteWithoutTimeout: resourceHarvestJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+			},
+			"harvest_job_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w-]+$`), "must only contain alphanumeric characters, dashes or underscores"),
+			},
+			"origin_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"manifest_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARNOfService("iam", "role"),
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Second),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHarvestJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageConn
+
+	id := d.Get("harvest_job_id").(string)
+	input := &mediapackage.CreateHarvestJobInput{
+		EndTime:          aws.String(d.Get("end_time").(string)),
+		Id:               aws.String(id),
+		OriginEndpointId: aws.String(d.Get("origin_endpoint_id").(string)),
+		StartTime:        aws.String(d.Get("start_time").(string)),
+	}
+
+	if v, ok := d.GetOk("s3_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3Destination = expandS3Destination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateHarvestJobWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MediaPackage Harvest Job (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitHarvestJobSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MediaPackage Harvest Job (%s) to succeed: %s", d.Id(), err)
+	}
+
+	return resourceHarvestJobRead(ctx, d, meta)
+}
+
+func resourceHarvestJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageConn
+
+	output, err := FindHarvestJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage Harvest Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaPackage Harvest Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("channel_id", output.ChannelId)
+	d.Set("created_at", output.CreatedAt)
+	d.Set("end_time", output.EndTime)
+	d.Set("harvest_job_id", output.Id)
+	d.Set("origin_endpoint_id", output.OriginEndpointId)
+	if output.S3Destination != nil {
+		if err := d.Set("s3_destination", []interface{}{flattenS3Destination(output.S3Destination)}); err != nil {
+			return diag.Errorf("setting s3_destination: %s", err)
+		}
+	} else {
+		d.Set("s3_destination", nil)
+	}
+	d.Set("start_time", output.StartTime)
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceHarvestJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] MediaPackage Harvest Job (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func expandS3Destination(tfMap map[string]interface{}) *mediapackage.S3Destination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.S3Destination{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_key"].(string); ok && v != "" {
+		apiObject.ManifestKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Destination(apiObject *mediapackage.S3Destination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BucketName; v != nil {
+		tfMap["bucket_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ManifestKey; v != nil {
+		tfMap["manifest_key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,136 @@
+package mediapackage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackage "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+)
+
+// Harvesting requires live content in the origin endpoint's startover window,
+// so the test runs against an existing endpoint that is receiving a stream.
+func TestAccMediaPackageHarvestJob_basic(t *testing.T) {
+	key := "MEDIAPACKAGE_HARVEST_ORIGIN_ENDPOINT_ID"
+	originEndpointID := os.Getenv(key)
+	if originEndpointID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v mediapackage.DescribeHarvestJobOutput
+	resourceName := "aws_media_package_harvest_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	endTime := time.Now().UTC().Add(-1 * time.Minute)
+	startTime := endTime.Add(-1 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackage.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHarvestJobConfig_basic(rName, originEndpointID, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHarvestJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "harvest_job_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "origin_endpoint_id", originEndpointID),
+					resource.TestCheckResourceAttr(resourceName, "status", mediapackage.StatusSucceeded),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHarvestJobExists(n string, v *mediapackage.DescribeHarvestJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaPackage Harvest Job ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageConn
+
+		output, err := tfmediapackage.FindHarvestJobByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccHarvestJobConfig_basic(rName, originEndpointID, startTime, endTime string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "mediapackage.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:PutObject", "s3:ListBucket", "s3:GetBucketLocation", "s3:GetBucketRequestPayment"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_media_package_harvest_job" "test" {
+  harvest_job_id     = %[1]q
+  origin_endpoint_id = %[2]q
+  start_time         = %[3]q
+  end_time           = %[4]q
+
+  s3_destination {
+    bucket_name  = aws_s3_bucket.test.bucket
+    manifest_key = "harvest/index.m3u8"
+    role_arn     = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, originEndpointID, startTime, endTime)
+}
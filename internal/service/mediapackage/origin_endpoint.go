@@ -0,0 +1,1500 @@
+package mediapackage
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var packageTypes = []string{"cmaf_package", "dash_package", "hls_package", "mss_package"}
+
+func ResourceOriginEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOriginEndpointCreate,
+		ReadWithoutTimeout:   resourceOriginEndpointRead,
+		UpdateWithoutTimeout: resourceOriginEndpointUpdate,
+		DeleteWithoutTimeout: resourceOriginEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cdn_identifier_secret": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"secrets_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARNOfService("iam", "role"),
+						},
+					},
+				},
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cmaf_package": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: packageTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"constant_initialization_vector": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"encryption_method": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediapackage.CmafEncryptionMethod_Values(), false),
+									},
+									"key_rotation_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"speke_key_provider": spekeKeyProviderSchema(),
+								},
+							},
+						},
+						"hls_manifests": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ad_markers": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediapackage.AdMarkers_Values(), false),
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"include_iframe_only_stream": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"manifest_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"playlist_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediapackage.PlaylistType_Values(), false),
+									},
+									"playlist_window_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"program_date_time_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_selection": streamSelectionSchema(),
+					},
+				},
+			},
+			"dash_package": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: packageTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_triggers": adTriggersSchema(),
+						"ads_on_delivery_restrictions": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.AdsOnDeliveryRestrictions_Values(), false),
+						},
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_rotation_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"speke_key_provider": spekeKeyProviderSchema(),
+								},
+							},
+						},
+						"include_iframe_only_stream": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"manifest_layout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.ManifestLayout_Values(), false),
+						},
+						"manifest_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_buffer_time_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_update_period_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"period_triggers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(periodTriggersElement_Values(), false),
+							},
+						},
+						"profile": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.Profile_Values(), false),
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_template_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.SegmentTemplateFormat_Values(), false),
+						},
+						"stream_selection": streamSelectionSchema(),
+						"suggested_presentation_delay_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"utc_timing": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.UtcTiming_Values(), false),
+						},
+						"utc_timing_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w-]+$`), "must only contain alphanumeric characters, dashes or underscores"),
+			},
+			"hls_package": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: packageTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_markers": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.AdMarkers_Values(), false),
+						},
+						"ad_triggers": adTriggersSchema(),
+						"ads_on_delivery_restrictions": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.AdsOnDeliveryRestrictions_Values(), false),
+						},
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"constant_initialization_vector": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"encryption_method": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediapackage.EncryptionMethod_Values(), false),
+									},
+									"key_rotation_interval_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"repeat_ext_x_key": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"speke_key_provider": spekeKeyProviderSchema(),
+								},
+							},
+						},
+						"include_dvb_subtitles": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"include_iframe_only_stream": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"playlist_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackage.PlaylistType_Values(), false),
+						},
+						"playlist_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"program_date_time_interval_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_selection": streamSelectionSchema(),
+						"use_audio_rendition_group": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"manifest_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"mss_package": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: packageTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"speke_key_provider": spekeKeyProviderSchema(),
+								},
+							},
+						},
+						"manifest_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_selection": streamSelectionSchema(),
+					},
+				},
+			},
+			"origination": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mediapackage.Origination_Values(), false),
+			},
+			"startover_window_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"time_delay_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whitelist": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func adTriggersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(adTriggersElement_Values(), false),
+		},
+	}
+}
+
+func spekeKeyProviderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"encryption_contract_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"preset_speke20_audio": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(mediapackage.PresetSpeke20Audio_Values(), false),
+							},
+							"preset_speke20_video": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(mediapackage.PresetSpeke20Video_Values(), false),
+							},
+						},
+					},
+				},
+				"resource_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARNOfService("iam", "role"),
+				},
+				"system_ids": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+			},
+		},
+	}
+}
+
+func streamSelectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_video_bits_per_second": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"min_video_bits_per_second": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"stream_order": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(mediapackage.StreamOrder_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceOriginEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	id := d.Get("endpoint_id").(string)
+	input := &mediapackage.CreateOriginEndpointInput{
+		ChannelId:   aws.String(d.Get("channel_id").(string)),
+		Description: aws.String(d.Get("description").(string)),
+		Id:          aws.String(id),
+	}
+
+	if v, ok := d.GetOk("authorization"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Authorization = expandAuthorization(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("cmaf_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CmafPackage = expandCmafPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("dash_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DashPackage = expandDashPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("hls_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.HlsPackage = expandHLSPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_name"); ok {
+		input.ManifestName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mss_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MssPackage = expandMssPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("origination"); ok {
+		input.Origination = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("startover_window_seconds"); ok {
+		input.StartoverWindowSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("time_delay_seconds"); ok {
+		input.TimeDelaySeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("whitelist"); ok && len(v.([]interface{})) > 0 {
+		input.Whitelist = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateOriginEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MediaPackage Origin Endpoint (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceOriginEndpointRead(ctx, d, meta)
+}
+
+func resourceOriginEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindOriginEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage Origin Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if output.Authorization != nil {
+		if err := d.Set("authorization", []interface{}{flattenAuthorization(output.Authorization)}); err != nil {
+			return diag.Errorf("setting authorization: %s", err)
+		}
+	} else {
+		d.Set("authorization", nil)
+	}
+	d.Set("channel_id", output.ChannelId)
+	if output.CmafPackage != nil {
+		if err := d.Set("cmaf_package", []interface{}{flattenCmafPackage(output.CmafPackage)}); err != nil {
+			return diag.Errorf("setting cmaf_package: %s", err)
+		}
+	} else {
+		d.Set("cmaf_package", nil)
+	}
+	if output.DashPackage != nil {
+		if err := d.Set("dash_package", []interface{}{flattenDashPackage(output.DashPackage)}); err != nil {
+			return diag.Errorf("setting dash_package: %s", err)
+		}
+	} else {
+		d.Set("dash_package", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("endpoint_id", output.Id)
+	if output.HlsPackage != nil {
+		if err := d.Set("hls_package", []interface{}{flattenHLSPackage(output.HlsPackage)}); err != nil {
+			return diag.Errorf("setting hls_package: %s", err)
+		}
+	} else {
+		d.Set("hls_package", nil)
+	}
+	d.Set("manifest_name", output.ManifestName)
+	if output.MssPackage != nil {
+		if err := d.Set("mss_package", []interface{}{flattenMssPackage(output.MssPackage)}); err != nil {
+			return diag.Errorf("setting mss_package: %s", err)
+		}
+	} else {
+		d.Set("mss_package", nil)
+	}
+	d.Set("origination", output.Origination)
+	d.Set("startover_window_seconds", output.StartoverWindowSeconds)
+	d.Set("time_delay_seconds", output.TimeDelaySeconds)
+	d.Set("url", output.Url)
+	d.Set("whitelist", aws.StringValueSlice(output.Whitelist))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOriginEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// The whole endpoint configuration is replaced on update.
+		input := &mediapackage.UpdateOriginEndpointInput{
+			Description: aws.String(d.Get("description").(string)),
+			Id:          aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("authorization"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Authorization = expandAuthorization(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("cmaf_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.CmafPackage = expandCmafPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("dash_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DashPackage = expandDashPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("hls_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.HlsPackage = expandHLSPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("manifest_name"); ok {
+			input.ManifestName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("mss_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MssPackage = expandMssPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("origination"); ok {
+			input.Origination = aws.String(v.(string))
+		}
+
+		input.StartoverWindowSeconds = aws.Int64(int64(d.Get("startover_window_seconds").(int)))
+		input.TimeDelaySeconds = aws.Int64(int64(d.Get("time_delay_seconds").(int)))
+		input.Whitelist = flex.ExpandStringList(d.Get("whitelist").([]interface{}))
+
+		_, err := conn.UpdateOriginEndpointWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating MediaPackage Origin Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceOriginEndpointRead(ctx, d, meta)
+}
+
+func resourceOriginEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageConn
+
+	log.Printf("[DEBUG] Deleting MediaPackage Origin Endpoint: %s", d.Id())
+	_, err := conn.DeleteOriginEndpointWithContext(ctx, &mediapackage.DeleteOriginEndpointInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediapackage.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAuthorization(tfMap map[string]interface{}) *mediapackage.Authorization {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.Authorization{}
+
+	if v, ok := tfMap["cdn_identifier_secret"].(string); ok && v != "" {
+		apiObject.CdnIdentifierSecret = aws.String(v)
+	}
+
+	if v, ok := tfMap["secrets_role_arn"].(string); ok && v != "" {
+		apiObject.SecretsRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCmafPackage(tfMap map[string]interface{}) *mediapackage.CmafPackageCreateOrUpdateParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.CmafPackageCreateOrUpdateParameters{}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandCmafEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["hls_manifests"].([]interface{}); ok && len(v) > 0 {
+		apiObject.HlsManifests = expandHLSManifests(v)
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_prefix"].(string); ok && v != "" {
+		apiObject.SegmentPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StreamSelection = expandStreamSelection(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCmafEncryption(tfMap map[string]interface{}) *mediapackage.CmafEncryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.CmafEncryption{}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_method"].(string); ok && v != "" {
+		apiObject.EncryptionMethod = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+		apiObject.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["speke_key_provider"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpekeKeyProvider = expandSpekeKeyProvider(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandHLSManifests(tfList []interface{}) []*mediapackage.HlsManifestCreateOrUpdateParameters {
+	var apiObjects []*mediapackage.HlsManifestCreateOrUpdateParameters
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediapackage.HlsManifestCreateOrUpdateParameters{}
+
+		if v, ok := tfMap["ad_markers"].(string); ok && v != "" {
+			apiObject.AdMarkers = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["include_iframe_only_stream"].(bool); ok {
+			apiObject.IncludeIframeOnlyStream = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["manifest_name"].(string); ok && v != "" {
+			apiObject.ManifestName = aws.String(v)
+		}
+
+		if v, ok := tfMap["playlist_type"].(string); ok && v != "" {
+			apiObject.PlaylistType = aws.String(v)
+		}
+
+		if v, ok := tfMap["playlist_window_seconds"].(int); ok && v != 0 {
+			apiObject.PlaylistWindowSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+			apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDashPackage(tfMap map[string]interface{}) *mediapackage.DashPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.DashPackage{}
+
+	if v, ok := tfMap["ad_triggers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdTriggers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["ads_on_delivery_restrictions"].(string); ok && v != "" {
+		apiObject.AdsOnDeliveryRestrictions = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandDashEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["include_iframe_only_stream"].(bool); ok {
+		apiObject.IncludeIframeOnlyStream = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["manifest_layout"].(string); ok && v != "" {
+		apiObject.ManifestLayout = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+		apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_buffer_time_seconds"].(int); ok && v != 0 {
+		apiObject.MinBufferTimeSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_update_period_seconds"].(int); ok && v != 0 {
+		apiObject.MinUpdatePeriodSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["period_triggers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PeriodTriggers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["profile"].(string); ok && v != "" {
+		apiObject.Profile = aws.String(v)
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_template_format"].(string); ok && v != "" {
+		apiObject.SegmentTemplateFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StreamSelection = expandStreamSelection(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["suggested_presentation_delay_seconds"].(int); ok && v != 0 {
+		apiObject.SuggestedPresentationDelaySeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["utc_timing"].(string); ok && v != "" {
+		apiObject.UtcTiming = aws.String(v)
+	}
+
+	if v, ok := tfMap["utc_timing_uri"].(string); ok && v != "" {
+		apiObject.UtcTimingUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDashEncryption(tfMap map[string]interface{}) *mediapackage.DashEncryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.DashEncryption{}
+
+	if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+		apiObject.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["speke_key_provider"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpekeKeyProvider = expandSpekeKeyProvider(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandHLSPackage(tfMap map[string]interface{}) *mediapackage.HlsPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.HlsPackage{}
+
+	if v, ok := tfMap["ad_markers"].(string); ok && v != "" {
+		apiObject.AdMarkers = aws.String(v)
+	}
+
+	if v, ok := tfMap["ad_triggers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdTriggers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["ads_on_delivery_restrictions"].(string); ok && v != "" {
+		apiObject.AdsOnDeliveryRestrictions = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandHLSEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["include_dvb_subtitles"].(bool); ok {
+		apiObject.IncludeDvbSubtitles = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_iframe_only_stream"].(bool); ok {
+		apiObject.IncludeIframeOnlyStream = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["playlist_type"].(string); ok && v != "" {
+		apiObject.PlaylistType = aws.String(v)
+	}
+
+	if v, ok := tfMap["playlist_window_seconds"].(int); ok && v != 0 {
+		apiObject.PlaylistWindowSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+		apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StreamSelection = expandStreamSelection(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["use_audio_rendition_group"].(bool); ok {
+		apiObject.UseAudioRenditionGroup = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandHLSEncryption(tfMap map[string]interface{}) *mediapackage.HlsEncryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.HlsEncryption{}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_method"].(string); ok && v != "" {
+		apiObject.EncryptionMethod = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+		apiObject.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["repeat_ext_x_key"].(bool); ok {
+		apiObject.RepeatExtXKey = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["speke_key_provider"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpekeKeyProvider = expandSpekeKeyProvider(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandMssPackage(tfMap map[string]interface{}) *mediapackage.MssPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.MssPackage{}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["speke_key_provider"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Encryption = &mediapackage.MssEncryption{
+				SpekeKeyProvider: expandSpekeKeyProvider(v[0].(map[string]interface{})),
+			}
+		}
+	}
+
+	if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+		apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StreamSelection = expandStreamSelection(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSpekeKeyProvider(tfMap map[string]interface{}) *mediapackage.SpekeKeyProvider {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.SpekeKeyProvider{}
+
+	if v, ok := tfMap["certificate_arn"].(string); ok && v != "" {
+		apiObject.CertificateArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_contract_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.EncryptionContractConfiguration = &mediapackage.EncryptionContractConfiguration{
+			PresetSpeke20Audio: aws.String(tfMap["preset_speke20_audio"].(string)),
+			PresetSpeke20Video: aws.String(tfMap["preset_speke20_video"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["system_ids"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SystemIds = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStreamSelection(tfMap map[string]interface{}) *mediapackage.StreamSelection {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackage.StreamSelection{}
+
+	if v, ok := tfMap["max_video_bits_per_second"].(int); ok && v != 0 {
+		apiObject.MaxVideoBitsPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_video_bits_per_second"].(int); ok && v != 0 {
+		apiObject.MinVideoBitsPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_order"].(string); ok && v != "" {
+		apiObject.StreamOrder = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAuthorization(apiObject *mediapackage.Authorization) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CdnIdentifierSecret; v != nil {
+		tfMap["cdn_identifier_secret"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SecretsRoleArn; v != nil {
+		tfMap["secrets_role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCmafPackage(apiObject *mediapackage.CmafPackage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Encryption; v != nil {
+		tfMap["encryption"] = []interface{}{flattenCmafEncryption(v)}
+	}
+
+	if v := apiObject.HlsManifests; v != nil {
+		tfMap["hls_manifests"] = flattenHLSManifests(v)
+	}
+
+	if v := apiObject.SegmentDurationSeconds; v != nil {
+		tfMap["segment_duration_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SegmentPrefix; v != nil {
+		tfMap["segment_prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StreamSelection; v != nil {
+		tfMap["stream_selection"] = []interface{}{flattenStreamSelection(v)}
+	}
+
+	return tfMap
+}
+
+func flattenCmafEncryption(apiObject *mediapackage.CmafEncryption) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConstantInitializationVector; v != nil {
+		tfMap["constant_initialization_vector"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EncryptionMethod; v != nil {
+		tfMap["encryption_method"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyRotationIntervalSeconds; v != nil {
+		tfMap["key_rotation_interval_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SpekeKeyProvider; v != nil {
+		tfMap["speke_key_provider"] = []interface{}{flattenSpekeKeyProvider(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHLSManifests(apiObjects []*mediapackage.HlsManifest) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AdMarkers; v != nil {
+			tfMap["ad_markers"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Id; v != nil {
+			tfMap["id"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.IncludeIframeOnlyStream; v != nil {
+			tfMap["include_iframe_only_stream"] = aws.BoolValue(v)
+		}
+
+		if v := apiObject.ManifestName; v != nil {
+			tfMap["manifest_name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.PlaylistType; v != nil {
+			tfMap["playlist_type"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.PlaylistWindowSeconds; v != nil {
+			tfMap["playlist_window_seconds"] = aws.Int64Value(v)
+		}
+
+		if v := apiObject.ProgramDateTimeIntervalSeconds; v != nil {
+			tfMap["program_date_time_interval_seconds"] = aws.Int64Value(v)
+		}
+
+		if v := apiObject.Url; v != nil {
+			tfMap["url"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDashPackage(apiObject *mediapackage.DashPackage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AdTriggers; v != nil {
+		tfMap["ad_triggers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AdsOnDeliveryRestrictions; v != nil {
+		tfMap["ads_on_delivery_restrictions"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		m := map[string]interface{}{
+			"key_rotation_interval_seconds": aws.Int64Value(v.KeyRotationIntervalSeconds),
+		}
+
+		if v := v.SpekeKeyProvider; v != nil {
+			m["speke_key_provider"] = []interface{}{flattenSpekeKeyProvider(v)}
+		}
+
+		tfMap["encryption"] = []interface{}{m}
+	}
+
+	if v := apiObject.IncludeIframeOnlyStream; v != nil {
+		tfMap["include_iframe_only_stream"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.ManifestLayout; v != nil {
+		tfMap["manifest_layout"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ManifestWindowSeconds; v != nil {
+		tfMap["manifest_window_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinBufferTimeSeconds; v != nil {
+		tfMap["min_buffer_time_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinUpdatePeriodSeconds; v != nil {
+		tfMap["min_update_period_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.PeriodTriggers; v != nil {
+		tfMap["period_triggers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Profile; v != nil {
+		tfMap["profile"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SegmentDurationSeconds; v != nil {
+		tfMap["segment_duration_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SegmentTemplateFormat; v != nil {
+		tfMap["segment_template_format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StreamSelection; v != nil {
+		tfMap["stream_selection"] = []interface{}{flattenStreamSelection(v)}
+	}
+
+	if v := apiObject.SuggestedPresentationDelaySeconds; v != nil {
+		tfMap["suggested_presentation_delay_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.UtcTiming; v != nil {
+		tfMap["utc_timing"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UtcTimingUri; v != nil {
+		tfMap["utc_timing_uri"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenHLSPackage(apiObject *mediapackage.HlsPackage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AdMarkers; v != nil {
+		tfMap["ad_markers"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AdTriggers; v != nil {
+		tfMap["ad_triggers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AdsOnDeliveryRestrictions; v != nil {
+		tfMap["ads_on_delivery_restrictions"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		m := map[string]interface{}{
+			"constant_initialization_vector": aws.StringValue(v.ConstantInitializationVector),
+			"encryption_method":              aws.StringValue(v.EncryptionMethod),
+			"key_rotation_interval_seconds":  aws.Int64Value(v.KeyRotationIntervalSeconds),
+			"repeat_ext_x_key":               aws.BoolValue(v.RepeatExtXKey),
+		}
+
+		if v := v.SpekeKeyProvider; v != nil {
+			m["speke_key_provider"] = []interface{}{flattenSpekeKeyProvider(v)}
+		}
+
+		tfMap["encryption"] = []interface{}{m}
+	}
+
+	if v := apiObject.IncludeDvbSubtitles; v != nil {
+		tfMap["include_dvb_subtitles"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.IncludeIframeOnlyStream; v != nil {
+		tfMap["include_iframe_only_stream"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.PlaylistType; v != nil {
+		tfMap["playlist_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PlaylistWindowSeconds; v != nil {
+		tfMap["playlist_window_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ProgramDateTimeIntervalSeconds; v != nil {
+		tfMap["program_date_time_interval_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SegmentDurationSeconds; v != nil {
+		tfMap["segment_duration_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.StreamSelection; v != nil {
+		tfMap["stream_selection"] = []interface{}{flattenStreamSelection(v)}
+	}
+
+	if v := apiObject.UseAudioRenditionGroup; v != nil {
+		tfMap["use_audio_rendition_group"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenMssPackage(apiObject *mediapackage.MssPackage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Encryption; v != nil && v.SpekeKeyProvider != nil {
+		tfMap["encryption"] = []interface{}{map[string]interface{}{
+			"speke_key_provider": []interface{}{flattenSpekeKeyProvider(v.SpekeKeyProvider)},
+		}}
+	}
+
+	if v := apiObject.ManifestWindowSeconds; v != nil {
+		tfMap["manifest_window_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SegmentDurationSeconds; v != nil {
+		tfMap["segment_duration_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.StreamSelection; v != nil {
+		tfMap["stream_selection"] = []interface{}{flattenStreamSelection(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSpekeKeyProvider(apiObject *mediapackage.SpekeKeyProvider) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CertificateArn; v != nil {
+		tfMap["certificate_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EncryptionContractConfiguration; v != nil {
+		tfMap["encryption_contract_configuration"] = []interface{}{map[string]interface{}{
+			"preset_speke20_audio": aws.StringValue(v.PresetSpeke20Audio),
+			"preset_speke20_video": aws.StringValue(v.PresetSpeke20Video),
+		}}
+	}
+
+	if v := apiObject.ResourceId; v != nil {
+		tfMap["resource_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SystemIds; v != nil {
+		tfMap["system_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Url; v != nil {
+		tfMap["url"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenStreamSelection(apiObject *mediapackage.StreamSelection) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaxVideoBitsPerSecond; v != nil {
+		tfMap["max_video_bits_per_second"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinVideoBitsPerSecond; v != nil {
+		tfMap["min_video_bits_per_second"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.StreamOrder; v != nil {
+		tfMap["stream_order"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
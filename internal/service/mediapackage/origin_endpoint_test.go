@@ -0,0 +1,371 @@
+package mediapackage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackage "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaPackageOriginEndpoint_basic(t *testing.T) {
+	var v mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackage.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_hls(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediapackage", regexp.MustCompile(`origin_endpoints/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "aws_media_package_channel.test", "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.playlist_type", "EVENT"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "origination", "ALLOW"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("^https://")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_hls(rName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.segment_duration_seconds", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageOriginEndpoint_disappears(t *testing.T) {
+	var v mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackage.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_hls(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediapackage.ResourceOriginEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageOriginEndpoint_dashPackage(t *testing.T) {
+	var v mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackage.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_dash(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.manifest_layout", "COMPACT"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.profile", "HBBTV_1_5"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.stream_selection.0.stream_order", "VIDEO_BITRATE_DESCENDING"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageOriginEndpoint_mssPackage(t *testing.T) {
+	var v mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackage.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_mss(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.manifest_window_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "mss_package.0.segment_duration_seconds", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageOriginEndpoint_cmafPackage(t *testing.T) {
+	var v mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackage.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_cmaf(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.segment_prefix", "seg"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifests.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifests.0.id", "hls"),
+					resource.TestCheckResourceAttr(resourceName, "cmaf_package.0.hls_manifests.0.ad_markers", "SCTE35_ENHANCED"),
+					resource.TestMatchResourceAttr(resourceName, "cmaf_package.0.hls_manifests.0.url", regexp.MustCompile("^https://")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageOriginEndpoint_tags(t *testing.T) {
+	var v mediapackage.DescribeOriginEndpointOutput
+	resourceName := "aws_media_package_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackage.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_package_origin_endpoint" {
+			continue
+		}
+
+		_, err := tfmediapackage.FindOriginEndpointByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaPackage Origin Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckOriginEndpointExists(n string, v *mediapackage.DescribeOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaPackage Origin Endpoint ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageConn
+
+		output, err := tfmediapackage.FindOriginEndpointByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_package_channel" "test" {
+  channel_id = %[1]q
+}
+`, rName)
+}
+
+func testAccOriginEndpointConfig_hls(rName string, segmentDuration int) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+
+  hls_package {
+    playlist_type            = "EVENT"
+    segment_duration_seconds = %[2]d
+  }
+}
+`, rName, segmentDuration))
+}
+
+func testAccOriginEndpointConfig_dash(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+
+  dash_package {
+    manifest_layout = "COMPACT"
+    profile         = "HBBTV_1_5"
+
+    stream_selection {
+      stream_order = "VIDEO_BITRATE_DESCENDING"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_mss(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+
+  mss_package {
+    manifest_window_seconds  = 60
+    segment_duration_seconds = 2
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_cmaf(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+
+  cmaf_package {
+    segment_prefix = "seg"
+
+    hls_manifests {
+      id         = "hls"
+      ad_markers = "SCTE35_ENHANCED"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+
+  hls_package {}
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccOriginEndpointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+
+  hls_package {}
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
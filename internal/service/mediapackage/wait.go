@@ -0,0 +1,27 @@
+package mediapackage
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitHarvestJobSucceeded(ctx context.Context, conn *mediapackage.MediaPackage, id string, timeout time.Duration) (*mediapackage.DescribeHarvestJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediapackage.StatusInProgress},
+		Target:  []string{mediapackage.StatusSucceeded},
+		Refresh: statusHarvestJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediapackage.DescribeHarvestJobOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
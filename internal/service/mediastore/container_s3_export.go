@@ -0,0 +1,146 @@
+package mediastore
+
+import (
+	"context"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ResourceContainerS3Export copies the objects in a MediaStore container to an S3 bucket.
+// It is intended as a one-off migration action: nothing is re-read after creation and
+// destroying the resource leaves both the container and the exported objects in place.
+func ResourceContainerS3Export() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerS3ExportCreate,
+		ReadContext:   schema.NoopContext,
+		DeleteContext: resourceContainerS3ExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exported_object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceContainerS3ExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaStoreConn
+
+	containerName := d.Get("container_name").(string)
+	output, err := conn.DescribeContainerWithContext(ctx, &mediastore.DescribeContainerInput{
+		ContainerName: aws.String(containerName),
+	})
+
+	if err != nil {
+		return diag.Errorf("reading MediaStore Container (%s): %s", containerName, err)
+	}
+
+	if status := aws.StringValue(output.Container.Status); status != mediastore.ContainerStatusActive {
+		return diag.Errorf("MediaStore Container (%s) is %s, it must be %s to export objects", containerName, status, mediastore.ContainerStatusActive)
+	}
+
+	dataConn := containerDataConn(meta, aws.StringValue(output.Container.Endpoint))
+	folder := strings.Trim(d.Get("path").(string), "/")
+
+	objects, err := listContainerObjects(ctx, dataConn, folder)
+
+	if err != nil {
+		return diag.Errorf("listing MediaStore Container (%s) objects: %s", containerName, err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	uploader := s3manager.NewUploaderWithClient(meta.(*conns.AWSClient).S3Conn)
+
+	for _, v := range objects {
+		key := path.Join(keyPrefix, v.path)
+
+		log.Printf("[DEBUG] Exporting MediaStore Container (%s) object (%s) to s3://%s/%s", containerName, v.path, bucket, key)
+		if err := exportContainerObject(ctx, dataConn, uploader, v, bucket, key); err != nil {
+			return diag.Errorf("exporting MediaStore Container (%s) object (%s) to S3 Bucket (%s): %s", containerName, v.path, bucket, err)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("exported_object_count", len(objects))
+
+	return nil
+}
+
+func resourceContainerS3ExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] MediaStore Container S3 Export (%s) only removed from Terraform state, exported objects are left in place", d.Id())
+
+	return nil
+}
+
+func exportContainerObject(ctx context.Context, conn *mediastoredata.MediaStoreData, uploader *s3manager.Uploader, object containerObject, bucket, key string) error {
+	output, err := conn.GetObjectWithContext(ctx, &mediastoredata.GetObjectInput{
+		Path: aws.String(object.path),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer output.Body.Close()
+
+	input := &s3manager.UploadInput{
+		Body:   output.Body,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if v := output.CacheControl; v != nil {
+		input.CacheControl = v
+	}
+
+	if v := output.ContentType; v != nil {
+		input.ContentType = v
+	}
+
+	_, err = uploader.UploadWithContext(ctx, input)
+
+	return err
+}
@@ -0,0 +1,60 @@
+package mediastore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMediaStoreContainerS3Export_basic(t *testing.T) {
+	resourceName := "aws_media_store_container_s3_export.test"
+	rName := sdkacctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediastore.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerS3ExportConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "container_name", "aws_media_store_container.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "exported_object_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "mediastore"),
+				),
+			},
+			{
+				Config: testAccContainerS3ExportConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exported_object_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerS3ExportConfig_basic(rName, run string) string {
+	return acctest.ConfigCompose(testAccContainerConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = "tf-mediastore-export-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_media_store_container_s3_export" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  container_name = aws_media_store_container.test.name
+  key_prefix     = "mediastore"
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, rName, run))
+}
@@ -0,0 +1,245 @@
+package mediastore
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceContainers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainersRead,
+
+		Schema: map[string]*schema.Schema{
+			"containers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_logging_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"objects": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content_length": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"content_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"etag": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_modified": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"include_objects": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceContainersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaStoreConn
+
+	var containers []*mediastore.Container
+
+	err := conn.ListContainersPagesWithContext(ctx, &mediastore.ListContainersInput{}, func(page *mediastore.ListContainersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Containers {
+			if v != nil {
+				containers = append(containers, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing MediaStore Containers: %s", err)
+	}
+
+	includeObjects := d.Get("include_objects").(bool)
+	var names []string
+	var tfList []interface{}
+
+	for _, container := range containers {
+		name := aws.StringValue(container.Name)
+		names = append(names, name)
+
+		tfMap := map[string]interface{}{
+			"access_logging_enabled": aws.BoolValue(container.AccessLoggingEnabled),
+			"arn":                    aws.StringValue(container.ARN),
+			"endpoint":               aws.StringValue(container.Endpoint),
+			"name":                   name,
+			"status":                 aws.StringValue(container.Status),
+		}
+
+		if v := container.CreationTime; v != nil {
+			tfMap["creation_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		// Objects can only be listed through the container's own data endpoint, which
+		// isn't available until the container is active.
+		if includeObjects && aws.StringValue(container.Status) == mediastore.ContainerStatusActive {
+			objects, err := listContainerObjects(ctx, containerDataConn(meta, aws.StringValue(container.Endpoint)), "")
+
+			if err != nil {
+				return diag.Errorf("listing MediaStore Container (%s) objects: %s", name, err)
+			}
+
+			tfMap["objects"] = flattenContainerObjects(objects)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("containers", tfList); err != nil {
+		return diag.Errorf("setting containers: %s", err)
+	}
+
+	d.Set("names", names)
+
+	return nil
+}
+
+// containerObject is an object stored in a MediaStore container, along with its full path.
+type containerObject struct {
+	path string
+	item *mediastoredata.Item
+}
+
+// containerDataConn returns a MediaStore Data client for the specified container endpoint.
+func containerDataConn(meta interface{}, endpoint string) *mediastoredata.MediaStoreData {
+	return mediastoredata.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithEndpoint(endpoint))
+}
+
+// listContainerObjects recursively lists all objects under the specified folder path.
+func listContainerObjects(ctx context.Context, conn *mediastoredata.MediaStoreData, folder string) ([]containerObject, error) {
+	input := &mediastoredata.ListItemsInput{}
+
+	if folder != "" {
+		input.Path = aws.String(folder)
+	}
+
+	var objects []containerObject
+	var folders []string
+
+	err := conn.ListItemsPagesWithContext(ctx, input, func(page *mediastoredata.ListItemsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v == nil {
+				continue
+			}
+
+			itemPath := path.Join(folder, aws.StringValue(v.Name))
+
+			switch aws.StringValue(v.Type) {
+			case mediastoredata.ItemTypeFolder:
+				folders = append(folders, itemPath)
+			case mediastoredata.ItemTypeObject:
+				objects = append(objects, containerObject{path: itemPath, item: v})
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range folders {
+		output, err := listContainerObjects(ctx, conn, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, output...)
+	}
+
+	return objects, nil
+}
+
+func flattenContainerObjects(objects []containerObject) []interface{} {
+	var tfList []interface{}
+
+	for _, v := range objects {
+		tfMap := map[string]interface{}{
+			"content_length": aws.Int64Value(v.item.ContentLength),
+			"content_type":   aws.StringValue(v.item.ContentType),
+			"etag":           aws.StringValue(v.item.ETag),
+			"path":           v.path,
+		}
+
+		if v := v.item.LastModified; v != nil {
+			tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,47 @@
+package mediastore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMediaStoreContainersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_media_store_containers.test"
+	resourceName := "aws_media_store_container.test"
+	rName := sdkacctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediastore.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, "name"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "containers.*", map[string]string{
+						"name":      fmt.Sprintf("tf_mediastore_%s", rName),
+						"status":    mediastore.ContainerStatusActive,
+						"objects.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccContainerConfig_basic(rName), `
+data "aws_media_store_containers" "test" {
+  include_objects = true
+
+  depends_on = [aws_media_store_container.test]
+}
+`)
+}
@@ -0,0 +1,196 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func init() {
+	registerFrameworkDataSourceFactory(newDataSourcePartitionServiceAvailability)
+}
+
+// newDataSourcePartitionServiceAvailability instantiates a new DataSource for the aws_partition_service_availability data source.
+func newDataSourcePartitionServiceAvailability(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourcePartitionServiceAvailability{}, nil
+}
+
+type dataSourcePartitionServiceAvailability struct {
+	meta *conns.AWSClient
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourcePartitionServiceAvailability) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_partition_service_availability"
+}
+
+// GetSchema returns the schema for this data source.
+func (d *dataSourcePartitionServiceAvailability) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"available": {
+				Type:     types.BoolType,
+				Computed: true,
+			},
+			"global": {
+				Type:     types.BoolType,
+				Computed: true,
+			},
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"partition": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+			"region": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+			"regions": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Computed: true,
+			},
+			"service_id": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	return schema, nil
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *dataSourcePartitionServiceAvailability) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		d.meta = v
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourcePartitionServiceAvailability) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourcePartitionServiceAvailabilityData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Region.IsNull() && data.Partition.IsNull() {
+		data.Region = types.String{Value: d.meta.Region}
+	}
+
+	var partition endpoints.Partition
+	var ok bool
+
+	if !data.Partition.IsNull() {
+		partition, ok = partitionByID(data.Partition.Value)
+
+		if !ok {
+			response.Diagnostics.AddError("unknown partition", data.Partition.Value)
+
+			return
+		}
+	} else {
+		partition, ok = endpoints.PartitionForRegion(endpoints.DefaultPartitions(), data.Region.Value)
+
+		if !ok {
+			response.Diagnostics.AddError("unknown region", fmt.Sprintf("no partition found for region %s", data.Region.Value))
+
+			return
+		}
+	}
+
+	data.Partition = types.String{Value: partition.ID()}
+
+	if !data.Region.IsNull() {
+		if _, ok := partition.Regions()[data.Region.Value]; !ok {
+			response.Diagnostics.AddError("unknown region", fmt.Sprintf("region %s is not in partition %s", data.Region.Value, partition.ID()))
+
+			return
+		}
+	}
+
+	serviceID := data.ServiceID.Value
+	available, global, regions := serviceAvailability(partition, serviceID, data.Region.Value)
+
+	data.Available = types.Bool{Value: available}
+	data.Global = types.Bool{Value: global}
+	data.Regions = flex.FlattenFrameworkStringValueSet(ctx, regions)
+
+	if data.Region.IsNull() {
+		data.ID = types.String{Value: fmt.Sprintf("%s/%s", partition.ID(), serviceID)}
+	} else {
+		data.ID = types.String{Value: fmt.Sprintf("%s/%s/%s", partition.ID(), data.Region.Value, serviceID)}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourcePartitionServiceAvailabilityData struct {
+	Available types.Bool   `tfsdk:"available"`
+	Global    types.Bool   `tfsdk:"global"`
+	ID        types.String `tfsdk:"id"`
+	Partition types.String `tfsdk:"partition"`
+	Region    types.String `tfsdk:"region"`
+	Regions   types.Set    `tfsdk:"regions"`
+	ServiceID types.String `tfsdk:"service_id"`
+}
+
+func partitionByID(id string) (endpoints.Partition, bool) {
+	for _, partition := range endpoints.DefaultPartitions() {
+		if partition.ID() == id {
+			return partition, true
+		}
+	}
+
+	return endpoints.Partition{}, false
+}
+
+// serviceAvailability reports, from the SDK's embedded endpoint metadata, whether
+// the service is available in the specified region (or anywhere in the partition
+// if region is empty), whether it is a global (non-regionalized) service and the
+// regions in which it has regional endpoints.
+func serviceAvailability(partition endpoints.Partition, serviceID, region string) (bool, bool, []string) {
+	service, ok := partition.Services()[serviceID]
+
+	if !ok {
+		return false, false, nil
+	}
+
+	var regions []string
+	for v := range service.Regions() {
+		regions = append(regions, v)
+	}
+
+	// Global services such as IAM only have a partition endpoint and are reachable from every region.
+	global := len(regions) == 0 && len(service.Endpoints()) > 0
+
+	if global {
+		return true, true, nil
+	}
+
+	if region == "" {
+		return len(regions) > 0, false, regions
+	}
+
+	_, ok = service.Regions()[region]
+
+	return ok, false, regions
+}
@@ -0,0 +1,110 @@
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaPartitionServiceAvailabilityDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_partition_service_availability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartitionServiceAvailabilityDataSourceConfig_basic("ec2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "available", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "global", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, "region", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "regions.*", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaPartitionServiceAvailabilityDataSource_global(t *testing.T) {
+	dataSourceName := "data.aws_partition_service_availability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartitionServiceAvailabilityDataSourceConfig_basic("iam"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "available", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "global", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaPartitionServiceAvailabilityDataSource_unavailable(t *testing.T) {
+	dataSourceName := "data.aws_partition_service_availability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartitionServiceAvailabilityDataSourceConfig_basic("not-a-service"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "available", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaPartitionServiceAvailabilityDataSource_partition(t *testing.T) {
+	dataSourceName := "data.aws_partition_service_availability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartitionServiceAvailabilityDataSourceConfig_partition(acctest.Partition(), "ec2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "available", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "id", acctest.Partition()+"/ec2"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckNoResourceAttr(dataSourceName, "region"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "regions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPartitionServiceAvailabilityDataSourceConfig_basic(serviceID string) string {
+	return fmt.Sprintf(`
+data "aws_partition_service_availability" "test" {
+  service_id = %[1]q
+}
+`, serviceID)
+}
+
+func testAccPartitionServiceAvailabilityDataSourceConfig_partition(partition, serviceID string) string {
+	return fmt.Sprintf(`
+data "aws_partition_service_availability" "test" {
+  partition  = %[1]q
+  service_id = %[2]q
+}
+`, partition, serviceID)
+}
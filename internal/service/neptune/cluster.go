@@ -1,6 +1,7 @@
 package neptune
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -28,8 +29,18 @@ const (
 	cloudWatchLogsExportsAudit = "audit"
 
 	DefaultPort = 8182
+
+	storageTypeIOOptimized = "iopt1"
+	storageTypeStandard    = "standard"
 )
 
+func storageType_Values() []string {
+	return []string{
+		storageTypeIOOptimized,
+		storageTypeStandard,
+	}
+}
+
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterCreate,
@@ -169,6 +180,12 @@ func ResourceCluster() *schema.Resource {
 				},
 			},
 
+			"global_cluster_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validGlobalClusterIdentifier,
+			},
+
 			"hosted_zone_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -247,6 +264,26 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 			},
 
+			"serverless_v2_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(serverlessMinNCUs, serverlessMaxNCUs),
+						},
+						"min_capacity": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(serverlessMinNCUs, serverlessMaxNCUs),
+						},
+					},
+				},
+			},
+
 			"storage_encrypted": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -254,6 +291,13 @@ func ResourceCluster() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(storageType_Values(), false),
+			},
+
 			"skip_final_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -348,6 +392,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		restoreDBClusterFromSnapshotInput.EngineVersion = aws.String(attr.(string))
 	}
 
+	if attr, ok := d.GetOk("global_cluster_identifier"); ok {
+		createDbClusterInput.GlobalClusterIdentifier = aws.String(attr.(string))
+	}
+
 	if attr, ok := d.GetOk("iam_database_authentication_enabled"); ok {
 		createDbClusterInput.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
 		restoreDBClusterFromSnapshotInput.EnableIAMDatabaseAuthentication = aws.Bool(attr.(bool))
@@ -381,6 +429,16 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		createDbClusterInput.ReplicationSourceIdentifier = aws.String(attr.(string))
 	}
 
+	if attr, ok := d.GetOk("serverless_v2_scaling_configuration"); ok && len(attr.([]interface{})) > 0 && attr.([]interface{})[0] != nil {
+		createDbClusterInput.ServerlessV2ScalingConfiguration = expandServerlessConfiguration(attr.([]interface{})[0].(map[string]interface{}))
+		restoreDBClusterFromSnapshotInput.ServerlessV2ScalingConfiguration = expandServerlessConfiguration(attr.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if attr, ok := d.GetOk("storage_type"); ok {
+		createDbClusterInput.StorageType = aws.String(attr.(string))
+		restoreDBClusterFromSnapshotInput.StorageType = aws.String(attr.(string))
+	}
+
 	if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
 		createDbClusterInput.VpcSecurityGroupIds = flex.ExpandStringSet(attr)
 		if restoreDBClusterFromSnapshot {
@@ -501,6 +559,7 @@ func flattenClusterResource(d *schema.ResourceData, meta interface{}, dbc *neptu
 	d.Set("endpoint", dbc.Endpoint)
 	d.Set("engine_version", dbc.EngineVersion)
 	d.Set("engine", dbc.Engine)
+	d.Set("global_cluster_identifier", dbc.GlobalClusterIdentifier)
 	d.Set("hosted_zone_id", dbc.HostedZoneId)
 	d.Set("iam_database_authentication_enabled", dbc.IAMDatabaseAuthenticationEnabled)
 	d.Set("kms_key_arn", dbc.KmsKeyId)
@@ -511,7 +570,11 @@ func flattenClusterResource(d *schema.ResourceData, meta interface{}, dbc *neptu
 	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
 	d.Set("reader_endpoint", dbc.ReaderEndpoint)
 	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
+	if err := d.Set("serverless_v2_scaling_configuration", flattenServerlessConfiguration(dbc.ServerlessV2ScalingConfiguration)); err != nil {
+		return fmt.Errorf("setting serverless_v2_scaling_configuration: %w", err)
+	}
 	d.Set("storage_encrypted", dbc.StorageEncrypted)
+	d.Set("storage_type", dbc.StorageType)
 	d.Set("deletion_protection", dbc.DeletionProtection)
 
 	var sg []string
@@ -642,6 +705,19 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("serverless_v2_scaling_configuration") {
+		// Scaling limits are changed in place; removing the block leaves the current limits unchanged.
+		if v, ok := d.GetOk("serverless_v2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.ServerlessV2ScalingConfiguration = expandServerlessConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			requestUpdate = true
+		}
+	}
+
+	if d.HasChange("storage_type") {
+		req.StorageType = aws.String(d.Get("storage_type").(string))
+		requestUpdate = true
+	}
+
 	if requestUpdate {
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			_, err := conn.ModifyDBCluster(req)
@@ -669,6 +745,24 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("global_cluster_identifier") {
+		oRaw, nRaw := d.GetChange("global_cluster_identifier")
+		o := oRaw.(string)
+		n := nRaw.(string)
+
+		if o == "" {
+			return errors.New("Existing Neptune Clusters cannot be added to an existing Neptune Global Cluster")
+		}
+
+		if n != "" {
+			return errors.New("Existing Neptune Clusters cannot be migrated between existing Neptune Global Clusters")
+		}
+
+		if err := removeClusterFromGlobalCluster(conn, d.Get("arn").(string), o, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("removing Neptune Cluster (%s) from Neptune Global Cluster (%s): %w", d.Id(), o, err)
+		}
+	}
+
 	if d.HasChange("iam_roles") {
 		oraw, nraw := d.GetChange("iam_roles")
 		if oraw == nil {
@@ -711,6 +805,15 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	// Automatically remove from global cluster to bypass this error on deletion:
+	// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
+	if globalClusterID := d.Get("global_cluster_identifier").(string); globalClusterID != "" {
+		if err := removeClusterFromGlobalCluster(conn, d.Get("arn").(string), globalClusterID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("removing Neptune Cluster (%s) from Neptune Global Cluster (%s): %w", d.Id(), globalClusterID, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Destroying Neptune Cluster (%s)", d.Id())
 
 	deleteOpts := neptune.DeleteDBClusterInput{
@@ -778,3 +881,23 @@ func removeIAMRoleFromCluster(clusterIdentifier string, roleArn string, conn *ne
 	_, err := conn.RemoveRoleFromDBCluster(params)
 	return err
 }
+
+func removeClusterFromGlobalCluster(conn *neptune.Neptune, clusterARN, globalClusterID string, timeout time.Duration) error {
+	input := &neptune.RemoveFromGlobalClusterInput{
+		DbClusterIdentifier:     aws.String(clusterARN),
+		GlobalClusterIdentifier: aws.String(globalClusterID),
+	}
+
+	log.Printf("[DEBUG] Removing Neptune Cluster from Neptune Global Cluster: %s", input)
+	_, err := conn.RemoveFromGlobalCluster(input)
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeGlobalClusterNotFoundFault) || tfawserr.ErrMessageContains(err, "InvalidParameterValue", "is not found in global cluster") {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return waitGlobalClusterMemberRemoved(conn, clusterARN, timeout)
+}
@@ -5,3 +5,17 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	serverlessMaxNCUs = 128.0
+	serverlessMinNCUs = 1.0
+)
+
+const (
+	globalClusterStatusAvailable   = "available"
+	globalClusterStatusCreating    = "creating"
+	globalClusterStatusDeleting    = "deleting"
+	globalClusterStatusFailingOver = "failing-over"
+	globalClusterStatusModifying   = "modifying"
+	globalClusterStatusUpgrading   = "upgrading"
+)
@@ -48,3 +48,79 @@ func FindEndpointByID(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpo
 
 	return endpoints[0], nil
 }
+
+func FindGlobalClusterByID(conn *neptune.Neptune, id string) (*neptune.GlobalCluster, error) {
+	input := &neptune.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(id),
+	}
+
+	output, err := findGlobalCluster(conn, input, func(v *neptune.GlobalCluster) bool {
+		return aws.StringValue(v.GlobalClusterIdentifier) == id
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.Status); status == "deleted" {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindGlobalClusterByClusterARN(conn *neptune.Neptune, arn string) (*neptune.GlobalCluster, error) {
+	input := &neptune.DescribeGlobalClustersInput{}
+
+	return findGlobalCluster(conn, input, func(v *neptune.GlobalCluster) bool {
+		for _, member := range v.GlobalClusterMembers {
+			if aws.StringValue(member.DBClusterArn) == arn {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+func findGlobalCluster(conn *neptune.Neptune, input *neptune.DescribeGlobalClustersInput, filter func(*neptune.GlobalCluster) bool) (*neptune.GlobalCluster, error) {
+	var output *neptune.GlobalCluster
+
+	err := conn.DescribeGlobalClustersPages(input, func(page *neptune.DescribeGlobalClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GlobalClusters {
+			if v != nil && filter(v) {
+				output = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeGlobalClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
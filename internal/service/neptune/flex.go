@@ -41,3 +41,39 @@ func flattenParameters(list []*neptune.Parameter) []map[string]interface{} {
 	}
 	return result
 }
+
+func expandServerlessConfiguration(tfMap map[string]interface{}) *neptune.ServerlessV2ScalingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &neptune.ServerlessV2ScalingConfiguration{}
+
+	if v, ok := tfMap["max_capacity"].(float64); ok && v != 0.0 {
+		apiObject.MaxCapacity = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["min_capacity"].(float64); ok && v != 0.0 {
+		apiObject.MinCapacity = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func flattenServerlessConfiguration(apiObject *neptune.ServerlessV2ScalingConfigurationInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaxCapacity; v != nil {
+		tfMap["max_capacity"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.MinCapacity; v != nil {
+		tfMap["min_capacity"] = aws.Float64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
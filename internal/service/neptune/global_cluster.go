@@ -0,0 +1,326 @@
+package neptune
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGlobalCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGlobalClusterCreate,
+		Read:   resourceGlobalClusterRead,
+		Update: resourceGlobalClusterUpdate,
+		Delete: resourceGlobalClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_db_cluster_identifier"},
+				ValidateFunc:  validEngine(),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"global_cluster_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validGlobalClusterIdentifier,
+			},
+			"global_cluster_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"global_cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"engine"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"writer_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	globalClusterID := d.Get("global_cluster_identifier").(string)
+	input := &neptune.CreateGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(globalClusterID),
+	}
+
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		input.DeletionProtection = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("engine"); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_db_cluster_identifier"); ok {
+		input.SourceDBClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_encrypted"); ok {
+		input.StorageEncrypted = aws.Bool(v.(bool))
+	}
+
+	// A standalone global cluster must specify an engine.
+	if input.Engine == nil && input.SourceDBClusterIdentifier == nil {
+		input.Engine = aws.String("neptune")
+	}
+
+	log.Printf("[DEBUG] Creating Neptune Global Cluster: %s", input)
+	output, err := conn.CreateGlobalCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("creating Neptune Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	d.SetId(aws.StringValue(output.GlobalCluster.GlobalClusterIdentifier))
+
+	if _, err := waitGlobalClusterCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceGlobalClusterRead(d, meta)
+}
+
+func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	globalCluster, err := FindGlobalClusterByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Neptune Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", globalCluster.GlobalClusterArn)
+	d.Set("deletion_protection", globalCluster.DeletionProtection)
+	d.Set("engine", globalCluster.Engine)
+	d.Set("engine_version", globalCluster.EngineVersion)
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	if err := d.Set("global_cluster_members", flattenGlobalClusterMembers(globalCluster.GlobalClusterMembers)); err != nil {
+		return fmt.Errorf("setting global_cluster_members: %w", err)
+	}
+	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("status", globalCluster.Status)
+	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+	// A new global cluster has no members until its primary DB cluster is created.
+	if v := globalClusterWriterARN(globalCluster); v != "" {
+		d.Set("writer_db_cluster_arn", v)
+	}
+
+	return nil
+}
+
+func resourceGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	if d.HasChange("writer_db_cluster_arn") {
+		if v := d.Get("writer_db_cluster_arn").(string); v != "" {
+			if err := globalClusterSwitchover(conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChanges("deletion_protection", "engine_version") {
+		input := &neptune.ModifyGlobalClusterInput{
+			DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
+			GlobalClusterIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("engine_version") {
+			input.AllowMajorVersionUpgrade = aws.Bool(true)
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Neptune Global Cluster: %s", input)
+		_, err := conn.ModifyGlobalCluster(input)
+
+		if err != nil {
+			return fmt.Errorf("updating Neptune Global Cluster (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitGlobalClusterUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for Neptune Global Cluster (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGlobalClusterRead(d, meta)
+}
+
+func resourceGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).NeptuneConn
+
+	log.Printf("[DEBUG] Deleting Neptune Global Cluster: %s", d.Id())
+	// Allow for eventual consistency as member clusters are removed:
+	// InvalidGlobalClusterStateFault: Global Cluster ... is not empty
+	_, err := tfresource.RetryWhen(d.Timeout(schema.TimeoutDelete),
+		func() (interface{}, error) {
+			return conn.DeleteGlobalCluster(&neptune.DeleteGlobalClusterInput{
+				GlobalClusterIdentifier: aws.String(d.Id()),
+			})
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, neptune.ErrCodeInvalidGlobalClusterStateFault, "is not empty") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if tfawserr.ErrCodeEquals(err, neptune.ErrCodeGlobalClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Neptune Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitGlobalClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// globalClusterSwitchover makes the specified secondary DB cluster the primary cluster of a global cluster.
+func globalClusterSwitchover(conn *neptune.Neptune, globalClusterID, clusterARN string, timeout time.Duration) error {
+	globalCluster, err := FindGlobalClusterByID(conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if globalClusterWriterARN(globalCluster) == clusterARN {
+		return nil
+	}
+
+	input := &neptune.FailoverGlobalClusterInput{
+		GlobalClusterIdentifier:   aws.String(globalClusterID),
+		TargetDbClusterIdentifier: aws.String(clusterARN),
+	}
+
+	log.Printf("[DEBUG] Switching over Neptune Global Cluster: %s", input)
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.FailoverGlobalCluster(input)
+	}, neptune.ErrCodeInvalidDBClusterStateFault)
+
+	if err != nil {
+		return fmt.Errorf("switching over Neptune Global Cluster (%s) to %s: %w", globalClusterID, clusterARN, err)
+	}
+
+	if _, err := waitGlobalClusterSwitchedOver(conn, globalClusterID, clusterARN, timeout); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) switchover to %s: %w", globalClusterID, clusterARN, err)
+	}
+
+	return nil
+}
+
+// globalClusterWriterARN returns the ARN of the primary (writer) DB cluster of a global cluster.
+func globalClusterWriterARN(globalCluster *neptune.GlobalCluster) string {
+	for _, member := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(member.IsWriter) {
+			return aws.StringValue(member.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
+func flattenGlobalClusterMembers(apiObjects []*neptune.GlobalClusterMember) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"db_cluster_arn": aws.StringValue(apiObject.DBClusterArn),
+			"is_writer":      aws.BoolValue(apiObject.IsWriter),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,242 @@
+package neptune_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/neptune"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfneptune "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccNeptuneGlobalCluster_basic(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckGlobalCluster(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "arn", "rds", fmt.Sprintf("global-cluster:%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "neptune"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_identifier", rName),
+					resource.TestMatchResourceAttr(resourceName, "global_cluster_resource_id", regexp.MustCompile(`cluster-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGlobalCluster_disappears(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckGlobalCluster(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					acctest.CheckResourceDisappears(acctest.Provider, tfneptune.ResourceGlobalCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGlobalCluster_deletionProtection(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckGlobalCluster(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_deletionProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalClusterConfig_deletionProtection(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGlobalCluster_primaryCluster(t *testing.T) {
+	var globalCluster neptune.GlobalCluster
+	var dbCluster neptune.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_global_cluster.test"
+	clusterResourceName := "aws_neptune_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckGlobalCluster(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_primaryCluster(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster),
+					testAccCheckClusterExists(clusterResourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(clusterResourceName, "global_cluster_identifier", resourceName, "global_cluster_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_cluster_members.*", map[string]string{
+						"is_writer": "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "global_cluster_members.*.db_cluster_arn", clusterResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "writer_db_cluster_arn", clusterResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalClusterExists(n string, v *neptune.GlobalCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Neptune Global Cluster ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+
+		output, err := tfneptune.FindGlobalClusterByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalClusterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_neptune_global_cluster" {
+			continue
+		}
+
+		_, err := tfneptune.FindGlobalClusterByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Neptune Global Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheckGlobalCluster(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+
+	_, err := conn.DescribeGlobalClusters(&neptune.DescribeGlobalClustersInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccGlobalClusterConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+}
+`, rName)
+}
+
+func testAccGlobalClusterConfig_deletionProtection(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+  deletion_protection       = %[2]t
+}
+`, rName, deletionProtection)
+}
+
+func testAccGlobalClusterConfig_primaryCluster(rName string) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+}
+
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier                   = %[1]q
+  availability_zones                   = local.availability_zone_names
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+}
+`, rName))
+}
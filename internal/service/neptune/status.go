@@ -84,3 +84,45 @@ func StatusDBClusterEndpoint(conn *neptune.Neptune, id string) resource.StateRef
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusGlobalCluster(conn *neptune.Neptune, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusGlobalClusterSwitchover reports the global cluster's status, or "failing-over"
+// until the specified DB cluster has become the global cluster's writer.
+func statusGlobalClusterSwitchover(conn *neptune.Neptune, id, clusterARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.StringValue(output.Status); status != globalClusterStatusAvailable {
+			return output, status, nil
+		}
+
+		if globalClusterWriterARN(output) != clusterARN {
+			return output, globalClusterStatusFailingOver, nil
+		}
+
+		return output, globalClusterStatusAvailable, nil
+	}
+}
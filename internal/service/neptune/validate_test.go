@@ -209,3 +209,31 @@ func TestValidSubnetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidGlobalClusterIdentifier(t *testing.T) {
+	validIds := []string{
+		"a",
+		"Global-Cluster-1",
+		"tf-acc-test",
+	}
+	for _, v := range validIds {
+		_, errors := validGlobalClusterIdentifier(v, "global_cluster_identifier")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Neptune Global Cluster identifier: %q", v, errors)
+		}
+	}
+
+	invalidIds := []string{
+		"1global",
+		"global--cluster",
+		"global-cluster-",
+		"global_cluster",
+		sdkacctest.RandStringFromCharSet(256, sdkacctest.CharSetAlpha),
+	}
+	for _, v := range invalidIds {
+		_, errors := validGlobalClusterIdentifier(v, "global_cluster_identifier")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Neptune Global Cluster identifier", v)
+		}
+	}
+}
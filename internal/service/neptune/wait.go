@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -124,3 +125,82 @@ func WaitDBClusterEndpointDeleted(conn *neptune.Neptune, id string) (*neptune.DB
 
 	return nil, err
 }
+
+func waitGlobalClusterCreated(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalClusterStatusCreating},
+		Target:  []string{globalClusterStatusAvailable},
+		Refresh: statusGlobalCluster(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalClusterUpdated(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalClusterStatusModifying, globalClusterStatusUpgrading},
+		Target:  []string{globalClusterStatusAvailable},
+		Refresh: statusGlobalCluster(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalClusterDeleted(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalClusterStatusAvailable, globalClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusGlobalCluster(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalClusterSwitchedOver(conn *neptune.Neptune, id, clusterARN string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalClusterStatusFailingOver, globalClusterStatusModifying},
+		Target:  []string{globalClusterStatusAvailable},
+		Refresh: statusGlobalClusterSwitchover(conn, id, clusterARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitGlobalClusterMemberRemoved waits until the specified DB cluster is no longer a member of any global cluster.
+func waitGlobalClusterMemberRemoved(conn *neptune.Neptune, clusterARN string, timeout time.Duration) error {
+	_, err := tfresource.RetryUntilNotFound(timeout, func() (interface{}, error) {
+		return FindGlobalClusterByClusterARN(conn, clusterARN)
+	})
+
+	return err
+}
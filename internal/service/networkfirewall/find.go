@@ -83,3 +83,20 @@ func FindRuleGroup(ctx context.Context, conn *networkfirewall.NetworkFirewall, a
 	}
 	return output, nil
 }
+
+// FindRuleGroupAnalysisResults returns the AnalysisResults from a call to DescribeRuleGroupWithContext
+// with rule group analysis enabled, given the context and rule group ARN.
+func FindRuleGroupAnalysisResults(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) ([]*networkfirewall.AnalysisResult, error) {
+	input := &networkfirewall.DescribeRuleGroupInput{
+		AnalyzeRuleGroup: aws.Bool(true),
+		RuleGroupArn:     aws.String(arn),
+	}
+	output, err := conn.DescribeRuleGroupWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if output == nil || output.RuleGroupResponse == nil {
+		return nil, nil
+	}
+	return output.RuleGroupResponse.AnalysisResults, nil
+}
@@ -0,0 +1,115 @@
+package networkfirewall
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceFirewallPolicyAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFirewallPolicyAnalysisRead,
+		Schema: map[string]*schema.Schema{
+			"analysis_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identified_rule_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"identified_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"firewall_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceFirewallPolicyAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+
+	arn := d.Get("firewall_policy_arn").(string)
+
+	output, err := FindFirewallPolicyByNameAndARN(ctx, conn, arn, "")
+
+	if err != nil {
+		return diag.Errorf("reading NetworkFirewall Firewall Policy (%s): %s", arn, err)
+	}
+
+	if output == nil || output.FirewallPolicy == nil {
+		return diag.Errorf("reading NetworkFirewall Firewall Policy (%s): empty output", arn)
+	}
+
+	// Rule group analysis only reports on stateless rules, so analyze the
+	// stateless rule groups in the order the firewall evaluates them.
+	references := output.FirewallPolicy.StatelessRuleGroupReferences
+	sort.SliceStable(references, func(i, j int) bool {
+		return aws.Int64Value(references[i].Priority) < aws.Int64Value(references[j].Priority)
+	})
+
+	var results []interface{}
+
+	for _, reference := range references {
+		ruleGroupARN := aws.StringValue(reference.ResourceArn)
+
+		analysisResults, err := FindRuleGroupAnalysisResults(ctx, conn, ruleGroupARN)
+
+		if err != nil {
+			return diag.Errorf("analyzing NetworkFirewall Rule Group (%s): %s", ruleGroupARN, err)
+		}
+
+		results = append(results, flattenAnalysisResults(ruleGroupARN, analysisResults)...)
+	}
+
+	d.SetId(arn)
+
+	if err := d.Set("analysis_results", results); err != nil {
+		return diag.Errorf("setting analysis_results: %s", err)
+	}
+
+	return nil
+}
+
+func flattenAnalysisResults(ruleGroupARN string, l []*networkfirewall.AnalysisResult) []interface{} {
+	results := make([]interface{}, 0, len(l))
+
+	for _, result := range l {
+		if result == nil {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"analysis_detail":     aws.StringValue(result.AnalysisDetail),
+			"identified_rule_ids": aws.StringValueSlice(result.IdentifiedRuleIds),
+			"identified_type":     aws.StringValue(result.IdentifiedType),
+			"rule_group_arn":      ruleGroupARN,
+		})
+	}
+
+	return results
+}
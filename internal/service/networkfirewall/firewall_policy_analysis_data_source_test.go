@@ -0,0 +1,83 @@
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkFirewallFirewallPolicyAnalysisDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+	ruleGroupResourceName := "aws_networkfirewall_rule_group.test"
+	datasourceName := "data.aws_networkfirewall_firewall_policy_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyAnalysisDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "firewall_policy_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "analysis_results.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "analysis_results.0.analysis_detail"),
+					resource.TestCheckResourceAttr(datasourceName, "analysis_results.0.identified_rule_ids.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "analysis_results.0.identified_type", networkfirewall.IdentifiedTypeStatelessRuleForwardingAsymmetrically),
+					resource.TestCheckResourceAttrPair(datasourceName, "analysis_results.0.rule_group_arn", ruleGroupResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallPolicyAnalysisDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+          rule_definition {
+            actions = ["aws:pass"]
+            match_attributes {
+              destination {
+                address_definition = "0.0.0.0/0"
+              }
+              source {
+                address_definition = "10.0.0.0/16"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    stateless_rule_group_reference {
+      priority     = 1
+      resource_arn = aws_networkfirewall_rule_group.test.arn
+    }
+  }
+}
+
+data "aws_networkfirewall_firewall_policy_analysis" "test" {
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+}
+`, rName)
+}
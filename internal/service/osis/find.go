@@ -0,0 +1,61 @@
+package osis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindPipelineByName(ctx context.Context, conn *osis.OSIS, name string) (*osis.Pipeline, error) {
+	input := &osis.GetPipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.GetPipelineWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, osis.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+func findPipelineChangeProgressByName(ctx context.Context, conn *osis.OSIS, name string) (*osis.ChangeProgressStatus, error) {
+	input := &osis.GetPipelineChangeProgressInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.GetPipelineChangeProgressWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, osis.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ChangeProgressStatuses) == 0 || output.ChangeProgressStatuses[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChangeProgressStatuses[0], nil
+}
@@ -0,0 +1,546 @@
+package osis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"buffer_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"persistent_buffer_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"encryption_at_rest_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"ingest_endpoint_urls": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"log_publishing_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_log_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 512),
+											validation.StringMatch(regexp.MustCompile(`^\/aws\/vendedlogs\/[\.\-_/#A-Za-z0-9]+$`), "must start with /aws/vendedlogs/"),
+										),
+									},
+								},
+							},
+						},
+						"is_logging_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"max_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"pipeline_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pipeline_configuration_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 24000),
+			},
+			"pipeline_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 28),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9\-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_endpoint_service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 12,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 12,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_endpoint_management": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(osis.VpcEndpointManagement_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("pipeline_name").(string)
+	body := d.Get("pipeline_configuration_body").(string)
+
+	if err := validatePipelineConfigurationBody(ctx, conn, body); err != nil {
+		return diag.Errorf("validating OpenSearch Ingestion Pipeline (%s) configuration: %s", name, err)
+	}
+
+	input := &osis.CreatePipelineInput{
+		MaxUnits:                  aws.Int64(int64(d.Get("max_units").(int))),
+		MinUnits:                  aws.Int64(int64(d.Get("min_units").(int))),
+		PipelineConfigurationBody: aws.String(body),
+		PipelineName:              aws.String(name),
+	}
+
+	if v, ok := d.GetOk("buffer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BufferOptions = expandBufferOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("encryption_at_rest_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionAtRestOptions = expandEncryptionAtRestOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_publishing_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogPublishingOptions = expandLogPublishingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcOptions = expandVPCOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Ingestion Pipeline: %s", input)
+	_, err := conn.CreatePipelineWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating OpenSearch Ingestion Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitPipelineCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for OpenSearch Ingestion Pipeline (%s) create: %s", d.Id(), err)
+	}
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	pipeline, err := FindPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Ingestion Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if pipeline.BufferOptions != nil {
+		if err := d.Set("buffer_options", []interface{}{flattenBufferOptions(pipeline.BufferOptions)}); err != nil {
+			return diag.Errorf("setting buffer_options: %s", err)
+		}
+	} else {
+		d.Set("buffer_options", nil)
+	}
+	if pipeline.EncryptionAtRestOptions != nil {
+		if err := d.Set("encryption_at_rest_options", []interface{}{flattenEncryptionAtRestOptions(pipeline.EncryptionAtRestOptions)}); err != nil {
+			return diag.Errorf("setting encryption_at_rest_options: %s", err)
+		}
+	} else {
+		d.Set("encryption_at_rest_options", nil)
+	}
+	d.Set("ingest_endpoint_urls", aws.StringValueSlice(pipeline.IngestEndpointUrls))
+	if pipeline.LogPublishingOptions != nil {
+		if err := d.Set("log_publishing_options", []interface{}{flattenLogPublishingOptions(pipeline.LogPublishingOptions)}); err != nil {
+			return diag.Errorf("setting log_publishing_options: %s", err)
+		}
+	} else {
+		d.Set("log_publishing_options", nil)
+	}
+	d.Set("max_units", pipeline.MaxUnits)
+	d.Set("min_units", pipeline.MinUnits)
+	arn := aws.StringValue(pipeline.PipelineArn)
+	d.Set("pipeline_arn", arn)
+	d.Set("pipeline_configuration_body", pipeline.PipelineConfigurationBody)
+	d.Set("pipeline_name", pipeline.PipelineName)
+	d.Set("vpc_endpoint_service", pipeline.VpcEndpointService)
+	if len(pipeline.VpcEndpoints) > 0 && pipeline.VpcEndpoints[0] != nil && pipeline.VpcEndpoints[0].VpcOptions != nil {
+		if err := d.Set("vpc_options", []interface{}{flattenVPCOptions(pipeline.VpcEndpoints[0].VpcOptions)}); err != nil {
+			return diag.Errorf("setting vpc_options: %s", err)
+		}
+	} else {
+		d.Set("vpc_options", nil)
+	}
+
+	tags := KeyValueTags(pipeline.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &osis.UpdatePipelineInput{
+			PipelineName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("buffer_options") {
+			if v, ok := d.GetOk("buffer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.BufferOptions = expandBufferOptions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.BufferOptions = &osis.BufferOptions{
+					PersistentBufferEnabled: aws.Bool(false),
+				}
+			}
+		}
+
+		if d.HasChange("encryption_at_rest_options") {
+			if v, ok := d.GetOk("encryption_at_rest_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.EncryptionAtRestOptions = expandEncryptionAtRestOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("log_publishing_options") {
+			if v, ok := d.GetOk("log_publishing_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogPublishingOptions = expandLogPublishingOptions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.LogPublishingOptions = &osis.LogPublishingOptions{
+					IsLoggingEnabled: aws.Bool(false),
+				}
+			}
+		}
+
+		if d.HasChange("max_units") {
+			input.MaxUnits = aws.Int64(int64(d.Get("max_units").(int)))
+		}
+
+		if d.HasChange("min_units") {
+			input.MinUnits = aws.Int64(int64(d.Get("min_units").(int)))
+		}
+
+		// Configuration changes are rolled out as a blue/green deployment, so reject
+		// an invalid configuration before the running pipeline is touched.
+		if d.HasChange("pipeline_configuration_body") {
+			body := d.Get("pipeline_configuration_body").(string)
+
+			if err := validatePipelineConfigurationBody(ctx, conn, body); err != nil {
+				return diag.Errorf("validating OpenSearch Ingestion Pipeline (%s) configuration: %s", d.Id(), err)
+			}
+
+			input.PipelineConfigurationBody = aws.String(body)
+		}
+
+		log.Printf("[DEBUG] Updating OpenSearch Ingestion Pipeline: %s", input)
+		_, err := conn.UpdatePipelineWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPipelineUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for OpenSearch Ingestion Pipeline (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("pipeline_arn").(string), o, n); err != nil {
+			return diag.Errorf("updating OpenSearch Ingestion Pipeline (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch Ingestion Pipeline: %s", d.Id())
+	_, err := conn.DeletePipelineWithContext(ctx, &osis.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, osis.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPipelineDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for OpenSearch Ingestion Pipeline (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func validatePipelineConfigurationBody(ctx context.Context, conn *osis.OSIS, body string) error {
+	output, err := conn.ValidatePipelineWithContext(ctx, &osis.ValidatePipelineInput{
+		PipelineConfigurationBody: aws.String(body),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if aws.BoolValue(output.IsValid) {
+		return nil
+	}
+
+	var messages []string
+
+	for _, v := range output.Errors {
+		if v == nil {
+			continue
+		}
+
+		messages = append(messages, aws.StringValue(v.Message))
+	}
+
+	return fmt.Errorf("invalid configuration: %s", strings.Join(messages, "; "))
+}
+
+func expandBufferOptions(tfMap map[string]interface{}) *osis.BufferOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &osis.BufferOptions{}
+
+	if v, ok := tfMap["persistent_buffer_enabled"].(bool); ok {
+		apiObject.PersistentBufferEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandEncryptionAtRestOptions(tfMap map[string]interface{}) *osis.EncryptionAtRestOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &osis.EncryptionAtRestOptions{}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLogPublishingOptions(tfMap map[string]interface{}) *osis.LogPublishingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &osis.LogPublishingOptions{}
+
+	if v, ok := tfMap["cloudwatch_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLogDestination = &osis.CloudWatchLogDestination{
+			LogGroup: aws.String(v[0].(map[string]interface{})["log_group"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["is_logging_enabled"].(bool); ok {
+		apiObject.IsLoggingEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandVPCOptions(tfMap map[string]interface{}) *osis.VpcOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &osis.VpcOptions{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["vpc_endpoint_management"].(string); ok && v != "" {
+		apiObject.VpcEndpointManagement = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBufferOptions(apiObject *osis.BufferOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"persistent_buffer_enabled": aws.BoolValue(apiObject.PersistentBufferEnabled),
+	}
+}
+
+func flattenEncryptionAtRestOptions(apiObject *osis.EncryptionAtRestOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"kms_key_arn": aws.StringValue(apiObject.KmsKeyArn),
+	}
+}
+
+func flattenLogPublishingOptions(apiObject *osis.LogPublishingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"is_logging_enabled": aws.BoolValue(apiObject.IsLoggingEnabled),
+	}
+
+	if v := apiObject.CloudWatchLogDestination; v != nil {
+		tfMap["cloudwatch_log_destination"] = []interface{}{map[string]interface{}{
+			"log_group": aws.StringValue(v.LogGroup),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenVPCOptions(apiObject *osis.VpcOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"security_group_ids":      aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_ids":              aws.StringValueSlice(apiObject.SubnetIds),
+		"vpc_endpoint_management": aws.StringValue(apiObject.VpcEndpointManagement),
+	}
+}
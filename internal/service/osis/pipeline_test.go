@@ -0,0 +1,407 @@
+package osis_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/osis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfosis "github.com/hashicorp/terraform-provider-aws/internal/service/osis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchIngestionPipeline_basic(t *testing.T) {
+	var pipeline osis.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "buffer_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "buffer_options.0.persistent_buffer_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ingest_endpoint_urls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_units", "1"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "pipeline_arn", "osis", regexp.MustCompile(`pipeline/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "pipeline_configuration_body"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_disappears(t *testing.T) {
+	var pipeline osis.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					acctest.CheckResourceDisappears(acctest.Provider, tfosis.ResourcePipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_tags(t *testing.T) {
+	var pipeline osis.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_update(t *testing.T) {
+	var pipeline osis.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "1"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "buffer_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "buffer_options.0.persistent_buffer_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.0.is_logging_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_publishing_options.0.cloudwatch_log_destination.0.log_group", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_units", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_invalidConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineConfig_invalid(rName),
+				ExpectError: regexp.MustCompile(`invalid configuration`),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_vpc(t *testing.T) {
+	var pipeline osis.Pipeline
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, osis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_vpc(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.0.subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.0.vpc_endpoint_management", "SERVICE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchIngestionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_osis_pipeline" {
+			continue
+		}
+
+		_, err := tfosis.FindPipelineByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Ingestion Pipeline %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPipelineExists(n string, v *osis.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Ingestion Pipeline ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchIngestionConn
+
+		output, err := tfosis.FindPipelineByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPipelineConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "osis-pipelines.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPipelineConfig_body(rName string) string {
+	return fmt.Sprintf(`
+  pipeline_configuration_body = <<-EOT
+            version: "2"
+            test-pipeline:
+              source:
+                http:
+                  path: "/%[1]s/logs"
+              sink:
+                - s3:
+                    aws:
+                      sts_role_arn: "${aws_iam_role.test.arn}"
+                      region: "${data.aws_region.current.name}"
+                    bucket: "test"
+                    threshold:
+                      event_collect_timeout: "60s"
+                    codec:
+                      ndjson:
+            EOT
+`, rName)
+}
+
+func testAccPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name = %[1]q
+  max_units     = 1
+  min_units     = 1
+%[2]s
+}
+`, rName, testAccPipelineConfig_body(rName)))
+}
+
+func testAccPipelineConfig_update(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = "/aws/vendedlogs/OpenSearchIngestion/%[1]s"
+}
+
+resource "aws_osis_pipeline" "test" {
+  pipeline_name = %[1]q
+  max_units     = 2
+  min_units     = 2
+%[2]s
+  buffer_options {
+    persistent_buffer_enabled = true
+  }
+
+  log_publishing_options {
+    is_logging_enabled = true
+
+    cloudwatch_log_destination {
+      log_group = aws_cloudwatch_log_group.test.name
+    }
+  }
+}
+`, rName, testAccPipelineConfig_body(rName)))
+}
+
+func testAccPipelineConfig_invalid(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name = %[1]q
+  max_units     = 1
+  min_units     = 1
+
+  pipeline_configuration_body = <<-EOT
+            version: "2"
+            test-pipeline:
+              source:
+                not-a-source: {}
+            EOT
+}
+`, rName))
+}
+
+func testAccPipelineConfig_vpc(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineConfig_base(rName),
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_osis_pipeline" "test" {
+  pipeline_name = %[1]q
+  max_units     = 1
+  min_units     = 1
+%[2]s
+  vpc_options {
+    security_group_ids      = [aws_security_group.test.id]
+    subnet_ids              = aws_subnet.test[*].id
+    vpc_endpoint_management = "SERVICE"
+  }
+}
+`, rName, testAccPipelineConfig_body(rName)))
+}
+
+func testAccPipelineConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name = %[1]q
+  max_units     = 1
+  min_units     = 1
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccPipelineConfig_body(rName)))
+}
+
+func testAccPipelineConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name = %[1]q
+  max_units     = 1
+  min_units     = 1
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccPipelineConfig_body(rName)))
+}
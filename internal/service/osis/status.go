@@ -0,0 +1,59 @@
+package osis
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusPipeline(ctx context.Context, conn *osis.OSIS, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPipelineByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusPipelineWithChangeProgress behaves like statusPipeline but also logs the
+// stages of any in-flight blue/green deployment of the pipeline's configuration.
+func statusPipelineWithChangeProgress(ctx context.Context, conn *osis.OSIS, name string) resource.StateRefreshFunc {
+	refresh := statusPipeline(ctx, conn, name)
+
+	return func() (interface{}, string, error) {
+		output, status, err := refresh()
+
+		if err != nil || output == nil {
+			return output, status, err
+		}
+
+		progress, err := findPipelineChangeProgressByName(ctx, conn, name)
+
+		if err != nil {
+			log.Printf("[DEBUG] Unable to read OpenSearch Ingestion Pipeline (%s) change progress: %s", name, err)
+
+			return output, status, nil
+		}
+
+		for _, stage := range progress.ChangeProgressStages {
+			if stage == nil {
+				continue
+			}
+
+			log.Printf("[DEBUG] OpenSearch Ingestion Pipeline (%s) change stage %s: %s (%s)", name, aws.StringValue(stage.Name), aws.StringValue(stage.Status), aws.StringValue(stage.Description))
+		}
+
+		return output, status, nil
+	}
+}
@@ -0,0 +1,69 @@
+//go:build sweep
+// +build sweep
+
+package osis
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_osis_pipeline", &resource.Sweeper{
+		Name: "aws_osis_pipeline",
+		F:    sweepPipelines,
+	})
+}
+
+func sweepPipelines(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchIngestionConn
+	sweepResources := make([]sweep.Sweepable, 0)
+	ctx := context.Background()
+	input := &osis.ListPipelinesInput{}
+
+	err = conn.ListPipelinesPagesWithContext(ctx, input, func(page *osis.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Pipelines {
+			r := ResourcePipeline()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PipelineName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping OpenSearch Ingestion Pipeline sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing OpenSearch Ingestion Pipelines (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping OpenSearch Ingestion Pipelines (%s): %w", region, err)
+	}
+
+	return nil
+}
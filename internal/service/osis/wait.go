@@ -0,0 +1,82 @@
+package osis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/osis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitPipelineCreated(ctx context.Context, conn *osis.OSIS, name string, timeout time.Duration) (*osis.Pipeline, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{osis.PipelineStatusCreating, osis.PipelineStatusStarting},
+		Target:     []string{osis.PipelineStatusActive},
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("OpenSearch Ingestion Pipeline (%s) creation", name))
+
+	if output, ok := outputRaw.(*osis.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineUpdated(ctx context.Context, conn *osis.OSIS, name string, timeout time.Duration) (*osis.Pipeline, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{osis.PipelineStatusUpdating},
+		Target:     []string{osis.PipelineStatusActive},
+		Refresh:    statusPipelineWithChangeProgress(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("OpenSearch Ingestion Pipeline (%s) update", name))
+
+	if output, ok := outputRaw.(*osis.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineDeleted(ctx context.Context, conn *osis.OSIS, name string, timeout time.Duration) (*osis.Pipeline, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{osis.PipelineStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("OpenSearch Ingestion Pipeline (%s) deletion", name))
+
+	if output, ok := outputRaw.(*osis.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
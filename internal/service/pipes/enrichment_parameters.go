@@ -0,0 +1,123 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func enrichmentParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"http_parameters": httpParametersSchema(),
+				"input_template": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 8192),
+				},
+			},
+		},
+	}
+}
+
+// httpParametersSchema returns the schema for the HTTP request parameters of
+// API destination and API Gateway enrichments and targets.
+func httpParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"header_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"path_parameter_values": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"query_string_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandEnrichmentParameters(tfMap map[string]interface{}) *pipes.PipeEnrichmentParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeEnrichmentParameters{}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpParameters = expandEnrichmentHTTPParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEnrichmentHTTPParameters(tfMap map[string]interface{}) *pipes.PipeEnrichmentHttpParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeEnrichmentHttpParameters{}
+
+	if v, ok := tfMap["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.HeaderParameters = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PathParameterValues = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.QueryStringParameters = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func flattenEnrichmentParameters(apiObject *pipes.PipeEnrichmentParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_template": aws.StringValue(apiObject.InputTemplate),
+	}
+
+	if v := apiObject.HttpParameters; v != nil {
+		tfMap["http_parameters"] = []interface{}{flattenHTTPParameters(v.HeaderParameters, v.PathParameterValues, v.QueryStringParameters)}
+	}
+
+	return tfMap
+}
+
+func flattenHTTPParameters(headerParameters map[string]*string, pathParameterValues []*string, queryStringParameters map[string]*string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"header_parameters":       aws.StringValueMap(headerParameters),
+		"path_parameter_values":   aws.StringValueSlice(pathParameterValues),
+		"query_string_parameters": aws.StringValueMap(queryStringParameters),
+	}
+
+	return tfMap
+}
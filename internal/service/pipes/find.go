@@ -0,0 +1,36 @@
+package pipes
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindPipeByName(ctx context.Context, conn *pipes.Pipes, name string) (*pipes.DescribePipeOutput, error) {
+	input := &pipes.DescribePipeInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribePipeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pipes.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Arn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
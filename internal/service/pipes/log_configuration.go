@@ -0,0 +1,177 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func logConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cloudwatch_logs_log_destination": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_group_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"firehose_log_destination": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delivery_stream_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"include_execution_data": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(pipes.IncludeExecutionDataOption_Values(), false),
+					},
+				},
+				"level": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(pipes.LogLevel_Values(), false),
+				},
+				"s3_log_destination": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(3, 63),
+							},
+							"bucket_owner": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidAccountID,
+							},
+							"output_format": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(pipes.S3OutputFormat_Values(), false),
+							},
+							"prefix": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandLogConfigurationParameters(tfMap map[string]interface{}) *pipes.PipeLogConfigurationParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeLogConfigurationParameters{}
+
+	if v, ok := tfMap["cloudwatch_logs_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CloudwatchLogsLogDestination = &pipes.CloudwatchLogsLogDestinationParameters{
+			LogGroupArn: aws.String(tfMap["log_group_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["firehose_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.FirehoseLogDestination = &pipes.FirehoseLogDestinationParameters{
+			DeliveryStreamArn: aws.String(tfMap["delivery_stream_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["include_execution_data"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.IncludeExecutionData = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["level"].(string); ok && v != "" {
+		apiObject.Level = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3LogDestination = &pipes.S3LogDestinationParameters{
+			BucketName:  aws.String(tfMap["bucket_name"].(string)),
+			BucketOwner: aws.String(tfMap["bucket_owner"].(string)),
+		}
+
+		if v, ok := tfMap["output_format"].(string); ok && v != "" {
+			apiObject.S3LogDestination.OutputFormat = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3LogDestination.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLogConfiguration(apiObject *pipes.PipeLogConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"include_execution_data": aws.StringValueSlice(apiObject.IncludeExecutionData),
+		"level":                  aws.StringValue(apiObject.Level),
+	}
+
+	if v := apiObject.CloudwatchLogsLogDestination; v != nil {
+		tfMap["cloudwatch_logs_log_destination"] = []interface{}{map[string]interface{}{
+			"log_group_arn": aws.StringValue(v.LogGroupArn),
+		}}
+	}
+
+	if v := apiObject.FirehoseLogDestination; v != nil {
+		tfMap["firehose_log_destination"] = []interface{}{map[string]interface{}{
+			"delivery_stream_arn": aws.StringValue(v.DeliveryStreamArn),
+		}}
+	}
+
+	if v := apiObject.S3LogDestination; v != nil {
+		tfMap["s3_log_destination"] = []interface{}{map[string]interface{}{
+			"bucket_name":   aws.StringValue(v.BucketName),
+			"bucket_owner":  aws.StringValue(v.BucketOwner),
+			"output_format": aws.StringValue(v.OutputFormat),
+			"prefix":        aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,314 @@
+package pipes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePipe() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipeCreate,
+		ReadWithoutTimeout:   resourcePipeRead,
+		UpdateWithoutTimeout: resourcePipeUpdate,
+		DeleteWithoutTimeout: resourcePipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      pipes.RequestedPipeStateRunning,
+				ValidateFunc: validation.StringInSlice(pipes.RequestedPipeState_Values(), false),
+			},
+			"enrichment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1600),
+			},
+			"enrichment_parameters": enrichmentParametersSchema(),
+			"log_configuration":     logConfigurationSchema(),
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(1, 64),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(1, 64-26),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1600),
+			},
+			"source_parameters": sourceParametersSchema(),
+			"tags":              tftags.TagsSchema(),
+			"tags_all":          tftags.TagsSchemaComputed(),
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_parameters": targetParametersSchema(),
+		},
+	}
+}
+
+func resourcePipeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &pipes.CreatePipeInput{
+		Description:  aws.String(d.Get("description").(string)),
+		DesiredState: aws.String(d.Get("desired_state").(string)),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		Source:       aws.String(d.Get("source").(string)),
+		Target:       aws.String(d.Get("target").(string)),
+	}
+
+	if v, ok := d.GetOk("enrichment"); ok {
+		input.Enrichment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EnrichmentParameters = expandEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogConfiguration = expandLogConfigurationParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceParameters = expandSourceParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TargetParameters = expandTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating EventBridge Pipes Pipe: %s", input)
+	_, err := conn.CreatePipeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EventBridge Pipes Pipe (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitPipeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for EventBridge Pipes Pipe (%s) create: %s", d.Id(), err)
+	}
+
+	return resourcePipeRead(ctx, d, meta)
+}
+
+func resourcePipeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPipeByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Pipes Pipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("desired_state", output.DesiredState)
+	d.Set("enrichment", output.Enrichment)
+	if output.EnrichmentParameters != nil {
+		if err := d.Set("enrichment_parameters", []interface{}{flattenEnrichmentParameters(output.EnrichmentParameters)}); err != nil {
+			return diag.Errorf("setting enrichment_parameters: %s", err)
+		}
+	} else {
+		d.Set("enrichment_parameters", nil)
+	}
+	// Logging that was switched off is reported back as an OFF level with no destinations.
+	if v := output.LogConfiguration; v != nil && !(aws.StringValue(v.Level) == pipes.LogLevelOff && v.CloudwatchLogsLogDestination == nil && v.FirehoseLogDestination == nil && v.S3LogDestination == nil) {
+		if err := d.Set("log_configuration", []interface{}{flattenLogConfiguration(v)}); err != nil {
+			return diag.Errorf("setting log_configuration: %s", err)
+		}
+	} else {
+		d.Set("log_configuration", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("role_arn", output.RoleArn)
+	d.Set("source", output.Source)
+	if output.SourceParameters != nil {
+		if err := d.Set("source_parameters", []interface{}{flattenSourceParameters(output.SourceParameters)}); err != nil {
+			return diag.Errorf("setting source_parameters: %s", err)
+		}
+	} else {
+		d.Set("source_parameters", nil)
+	}
+	d.Set("target", output.Target)
+	if output.TargetParameters != nil {
+		if err := d.Set("target_parameters", []interface{}{flattenTargetParameters(output.TargetParameters)}); err != nil {
+			return diag.Errorf("setting target_parameters: %s", err)
+		}
+	} else {
+		d.Set("target_parameters", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// UpdatePipe replaces the whole pipe definition, so every argument is sent
+		// and removed optional blocks are explicitly cleared.
+		input := &pipes.UpdatePipeInput{
+			Description:  aws.String(d.Get("description").(string)),
+			DesiredState: aws.String(d.Get("desired_state").(string)),
+			Enrichment:   aws.String(d.Get("enrichment").(string)),
+			Name:         aws.String(d.Id()),
+			RoleArn:      aws.String(d.Get("role_arn").(string)),
+			Target:       aws.String(d.Get("target").(string)),
+		}
+
+		if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.EnrichmentParameters = expandEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.EnrichmentParameters = &pipes.PipeEnrichmentParameters{}
+		}
+
+		if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.LogConfiguration = expandLogConfigurationParameters(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.LogConfiguration = &pipes.PipeLogConfigurationParameters{
+				Level: aws.String(pipes.LogLevelOff),
+			}
+		}
+
+		if v, ok := d.GetOk("source_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SourceParameters = expandUpdateSourceParameters(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.SourceParameters = expandUpdateSourceParameters(nil)
+		}
+
+		if v, ok := d.GetOk("target_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.TargetParameters = expandTargetParameters(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.TargetParameters = &pipes.PipeTargetParameters{}
+		}
+
+		log.Printf("[DEBUG] Updating EventBridge Pipes Pipe: %s", input)
+		_, err := conn.UpdatePipeWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPipeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for EventBridge Pipes Pipe (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating EventBridge Pipes Pipe (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePipeRead(ctx, d, meta)
+}
+
+func resourcePipeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PipesConn
+
+	log.Printf("[DEBUG] Deleting EventBridge Pipes Pipe: %s", d.Id())
+	_, err := conn.DeletePipeWithContext(ctx, &pipes.DeletePipeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pipes.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EventBridge Pipes Pipe (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPipeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for EventBridge Pipes Pipe (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,1235 @@
+package pipes
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var sourceParametersTypes = []string{
+	"source_parameters.0.activemq_broker_parameters",
+	"source_parameters.0.dynamodb_stream_parameters",
+	"source_parameters.0.kinesis_stream_parameters",
+	"source_parameters.0.managed_streaming_kafka_parameters",
+	"source_parameters.0.rabbitmq_broker_parameters",
+	"source_parameters.0.self_managed_kafka_parameters",
+	"source_parameters.0.sqs_queue_parameters",
+}
+
+func sourceParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"activemq_broker_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflicts("activemq_broker_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"basic_auth": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": maximumBatchingWindowInSecondsSchema(),
+							"queue_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+						},
+					},
+				},
+				"dynamodb_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflicts("dynamodb_stream_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config":                 deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": maximumBatchingWindowInSecondsSchema(),
+							"maximum_record_age_in_seconds":      maximumRecordAgeInSecondsSchema(),
+							"maximum_retry_attempts":             maximumRetryAttemptsSchema(),
+							"on_partial_batch_item_failure":      onPartialBatchItemFailureSchema(),
+							"parallelization_factor":             parallelizationFactorSchema(),
+							"starting_position": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(pipes.DynamoDBStreamStartPosition_Values(), false),
+							},
+						},
+					},
+				},
+				"filter_criteria": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"filter": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 5,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"pattern": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 4096),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"kinesis_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflicts("kinesis_stream_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config":                 deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": maximumBatchingWindowInSecondsSchema(),
+							"maximum_record_age_in_seconds":      maximumRecordAgeInSecondsSchema(),
+							"maximum_retry_attempts":             maximumRetryAttemptsSchema(),
+							"on_partial_batch_item_failure":      onPartialBatchItemFailureSchema(),
+							"parallelization_factor":             parallelizationFactorSchema(),
+							"starting_position": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(pipes.KinesisStreamStartPosition_Values(), false),
+							},
+							"starting_position_timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+							},
+						},
+					},
+				},
+				"managed_streaming_kafka_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflicts("managed_streaming_kafka_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"consumer_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"client_certificate_tls_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_512_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": maximumBatchingWindowInSecondsSchema(),
+							"starting_position": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(pipes.MSKStartPosition_Values(), false),
+							},
+							"topic_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 249),
+							},
+						},
+					},
+				},
+				"rabbitmq_broker_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflicts("rabbitmq_broker_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"basic_auth": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": maximumBatchingWindowInSecondsSchema(),
+							"queue_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+							"virtual_host": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+					},
+				},
+				"self_managed_kafka_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflicts("self_managed_kafka_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"additional_bootstrap_servers": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 2,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 300),
+								},
+							},
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"consumer_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"basic_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"client_certificate_tls_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_256_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_512_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": maximumBatchingWindowInSecondsSchema(),
+							"server_root_ca_certificate": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"starting_position": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(pipes.SelfManagedKafkaStartPosition_Values(), false),
+							},
+							"topic_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 249),
+							},
+							"vpc": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"security_groups": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											MaxItems: 5,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"subnets": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											MaxItems: 16,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					MaxItems:      1,
+					ConflictsWith: sourceParametersConflicts("sqs_queue_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"maximum_batching_window_in_seconds": maximumBatchingWindowInSecondsSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sourceParametersConflicts returns the source type blocks that conflict with the named block.
+func sourceParametersConflicts(name string) []string {
+	var conflicts []string
+
+	for _, v := range sourceParametersTypes {
+		if v != "source_parameters.0."+name {
+			conflicts = append(conflicts, v)
+		}
+	}
+
+	return conflicts
+}
+
+func deadLetterConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func maximumBatchingWindowInSecondsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(0, 300),
+	}
+}
+
+func maximumRecordAgeInSecondsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.Any(
+			validation.IntInSlice([]int{-1}),
+			validation.IntBetween(60, 604800),
+		),
+	}
+}
+
+func maximumRetryAttemptsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(-1, 10000),
+	}
+}
+
+func onPartialBatchItemFailureSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(pipes.OnPartialBatchItemFailureStreams_Values(), false),
+	}
+}
+
+func parallelizationFactorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(1, 10),
+	}
+}
+
+func expandSourceParameters(tfMap map[string]interface{}) *pipes.PipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceParameters{}
+
+	if v, ok := tfMap["activemq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ActiveMQBrokerParameters = expandSourceActiveMQBrokerParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDBStreamParameters = expandSourceDynamoDBStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamParameters = expandSourceKinesisStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedStreamingKafkaParameters = expandSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rabbitmq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RabbitMQBrokerParameters = expandSourceRabbitMQBrokerParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["self_managed_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SelfManagedKafkaParameters = expandSourceSelfManagedKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsQueueParameters = expandSourceSQSQueueParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+// expandUpdateSourceParameters expands the source parameters that can be changed without replacing the pipe.
+// Filter criteria are always sent so that removing them clears any filters on the pipe.
+func expandUpdateSourceParameters(tfMap map[string]interface{}) *pipes.UpdatePipeSourceParameters {
+	apiObject := &pipes.UpdatePipeSourceParameters{
+		FilterCriteria: &pipes.FilterCriteria{},
+	}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	if v, ok := tfMap["activemq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v := expandSourceActiveMQBrokerParameters(v[0].(map[string]interface{})); v != nil {
+			apiObject.ActiveMQBrokerParameters = &pipes.UpdatePipeSourceActiveMQBrokerParameters{
+				BatchSize:                      v.BatchSize,
+				Credentials:                    v.Credentials,
+				MaximumBatchingWindowInSeconds: v.MaximumBatchingWindowInSeconds,
+			}
+		}
+	}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v := expandSourceDynamoDBStreamParameters(v[0].(map[string]interface{})); v != nil {
+			apiObject.DynamoDBStreamParameters = &pipes.UpdatePipeSourceDynamoDBStreamParameters{
+				BatchSize:                      v.BatchSize,
+				DeadLetterConfig:               v.DeadLetterConfig,
+				MaximumBatchingWindowInSeconds: v.MaximumBatchingWindowInSeconds,
+				MaximumRecordAgeInSeconds:      v.MaximumRecordAgeInSeconds,
+				MaximumRetryAttempts:           v.MaximumRetryAttempts,
+				OnPartialBatchItemFailure:      v.OnPartialBatchItemFailure,
+				ParallelizationFactor:          v.ParallelizationFactor,
+			}
+		}
+	}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v := expandSourceKinesisStreamParameters(v[0].(map[string]interface{})); v != nil {
+			apiObject.KinesisStreamParameters = &pipes.UpdatePipeSourceKinesisStreamParameters{
+				BatchSize:                      v.BatchSize,
+				DeadLetterConfig:               v.DeadLetterConfig,
+				MaximumBatchingWindowInSeconds: v.MaximumBatchingWindowInSeconds,
+				MaximumRecordAgeInSeconds:      v.MaximumRecordAgeInSeconds,
+				MaximumRetryAttempts:           v.MaximumRetryAttempts,
+				OnPartialBatchItemFailure:      v.OnPartialBatchItemFailure,
+				ParallelizationFactor:          v.ParallelizationFactor,
+			}
+		}
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v := expandSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{})); v != nil {
+			apiObject.ManagedStreamingKafkaParameters = &pipes.UpdatePipeSourceManagedStreamingKafkaParameters{
+				BatchSize:                      v.BatchSize,
+				Credentials:                    v.Credentials,
+				MaximumBatchingWindowInSeconds: v.MaximumBatchingWindowInSeconds,
+			}
+		}
+	}
+
+	if v, ok := tfMap["rabbitmq_broker_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v := expandSourceRabbitMQBrokerParameters(v[0].(map[string]interface{})); v != nil {
+			apiObject.RabbitMQBrokerParameters = &pipes.UpdatePipeSourceRabbitMQBrokerParameters{
+				BatchSize:                      v.BatchSize,
+				Credentials:                    v.Credentials,
+				MaximumBatchingWindowInSeconds: v.MaximumBatchingWindowInSeconds,
+			}
+		}
+	}
+
+	if v, ok := tfMap["self_managed_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v := expandSourceSelfManagedKafkaParameters(v[0].(map[string]interface{})); v != nil {
+			apiObject.SelfManagedKafkaParameters = &pipes.UpdatePipeSourceSelfManagedKafkaParameters{
+				BatchSize:                      v.BatchSize,
+				Credentials:                    v.Credentials,
+				MaximumBatchingWindowInSeconds: v.MaximumBatchingWindowInSeconds,
+				ServerRootCaCertificate:        v.ServerRootCaCertificate,
+				Vpc:                            v.Vpc,
+			}
+		}
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v := expandSourceSQSQueueParameters(v[0].(map[string]interface{})); v != nil {
+			apiObject.SqsQueueParameters = &pipes.UpdatePipeSourceSqsQueueParameters{
+				BatchSize:                      v.BatchSize,
+				MaximumBatchingWindowInSeconds: v.MaximumBatchingWindowInSeconds,
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandSourceActiveMQBrokerParameters(tfMap map[string]interface{}) *pipes.PipeSourceActiveMQBrokerParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceActiveMQBrokerParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMQBrokerAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["queue_name"].(string); ok && v != "" {
+		apiObject.QueueName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceDynamoDBStreamParameters(tfMap map[string]interface{}) *pipes.PipeSourceDynamoDBStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceDynamoDBStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = aws.String(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *pipes.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.FilterCriteria{}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			filter := &pipes.Filter{}
+
+			if v, ok := tfMap["pattern"].(string); ok && v != "" {
+				filter.Pattern = aws.String(v)
+			}
+
+			apiObject.Filters = append(apiObject.Filters, filter)
+		}
+	}
+
+	return apiObject
+}
+
+func expandSourceKinesisStreamParameters(tfMap map[string]interface{}) *pipes.PipeSourceKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceKinesisStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = aws.String(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = aws.String(v)
+	}
+
+	if v, ok := tfMap["starting_position_timestamp"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.StartingPositionTimestamp = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceManagedStreamingKafkaParameters(tfMap map[string]interface{}) *pipes.PipeSourceManagedStreamingKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceManagedStreamingKafkaParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMSKAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = aws.String(v)
+	}
+
+	if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+		apiObject.TopicName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceRabbitMQBrokerParameters(tfMap map[string]interface{}) *pipes.PipeSourceRabbitMQBrokerParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceRabbitMQBrokerParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMQBrokerAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["queue_name"].(string); ok && v != "" {
+		apiObject.QueueName = aws.String(v)
+	}
+
+	if v, ok := tfMap["virtual_host"].(string); ok && v != "" {
+		apiObject.VirtualHost = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceSelfManagedKafkaParameters(tfMap map[string]interface{}) *pipes.PipeSourceSelfManagedKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceSelfManagedKafkaParameters{}
+
+	if v, ok := tfMap["additional_bootstrap_servers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdditionalBootstrapServers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandSelfManagedKafkaAccessConfigurationCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["server_root_ca_certificate"].(string); ok && v != "" {
+		apiObject.ServerRootCaCertificate = aws.String(v)
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = aws.String(v)
+	}
+
+	if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+		apiObject.TopicName = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Vpc = expandSelfManagedKafkaAccessConfigurationVPC(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSourceSQSQueueParameters(tfMap map[string]interface{}) *pipes.PipeSourceSqsQueueParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeSourceSqsQueueParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandDeadLetterConfig(tfMap map[string]interface{}) *pipes.DeadLetterConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.DeadLetterConfig{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMQBrokerAccessCredentials(tfMap map[string]interface{}) *pipes.MQBrokerAccessCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.MQBrokerAccessCredentials{}
+
+	if v, ok := tfMap["basic_auth"].(string); ok && v != "" {
+		apiObject.BasicAuth = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMSKAccessCredentials(tfMap map[string]interface{}) *pipes.MSKAccessCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.MSKAccessCredentials{}
+
+	if v, ok := tfMap["client_certificate_tls_auth"].(string); ok && v != "" {
+		apiObject.ClientCertificateTlsAuth = aws.String(v)
+	}
+
+	if v, ok := tfMap["sasl_scram_512_auth"].(string); ok && v != "" {
+		apiObject.SaslScram512Auth = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSelfManagedKafkaAccessConfigurationCredentials(tfMap map[string]interface{}) *pipes.SelfManagedKafkaAccessConfigurationCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.SelfManagedKafkaAccessConfigurationCredentials{}
+
+	if v, ok := tfMap["basic_auth"].(string); ok && v != "" {
+		apiObject.BasicAuth = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_certificate_tls_auth"].(string); ok && v != "" {
+		apiObject.ClientCertificateTlsAuth = aws.String(v)
+	}
+
+	if v, ok := tfMap["sasl_scram_256_auth"].(string); ok && v != "" {
+		apiObject.SaslScram256Auth = aws.String(v)
+	}
+
+	if v, ok := tfMap["sasl_scram_512_auth"].(string); ok && v != "" {
+		apiObject.SaslScram512Auth = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSelfManagedKafkaAccessConfigurationVPC(tfMap map[string]interface{}) *pipes.SelfManagedKafkaAccessConfigurationVpc {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.SelfManagedKafkaAccessConfigurationVpc{}
+
+	if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroup = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Subnets = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceParameters(apiObject *pipes.PipeSourceParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ActiveMQBrokerParameters; v != nil {
+		tfMap["activemq_broker_parameters"] = []interface{}{flattenSourceActiveMQBrokerParameters(v)}
+	}
+
+	if v := apiObject.DynamoDBStreamParameters; v != nil {
+		tfMap["dynamodb_stream_parameters"] = []interface{}{flattenSourceDynamoDBStreamParameters(v)}
+	}
+
+	if v := apiObject.FilterCriteria; v != nil && len(v.Filters) > 0 {
+		tfMap["filter_criteria"] = []interface{}{flattenFilterCriteria(v)}
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{flattenSourceKinesisStreamParameters(v)}
+	}
+
+	if v := apiObject.ManagedStreamingKafkaParameters; v != nil {
+		tfMap["managed_streaming_kafka_parameters"] = []interface{}{flattenSourceManagedStreamingKafkaParameters(v)}
+	}
+
+	if v := apiObject.RabbitMQBrokerParameters; v != nil {
+		tfMap["rabbitmq_broker_parameters"] = []interface{}{flattenSourceRabbitMQBrokerParameters(v)}
+	}
+
+	if v := apiObject.SelfManagedKafkaParameters; v != nil {
+		tfMap["self_managed_kafka_parameters"] = []interface{}{flattenSourceSelfManagedKafkaParameters(v)}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{flattenSourceSQSQueueParameters(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSourceActiveMQBrokerParameters(apiObject *pipes.PipeSourceActiveMQBrokerParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"queue_name":                         aws.StringValue(apiObject.QueueName),
+	}
+
+	if v := apiObject.Credentials; v != nil {
+		tfMap["credentials"] = []interface{}{flattenMQBrokerAccessCredentials(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSourceDynamoDBStreamParameters(apiObject *pipes.PipeSourceDynamoDBStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"maximum_record_age_in_seconds":      aws.Int64Value(apiObject.MaximumRecordAgeInSeconds),
+		"maximum_retry_attempts":             aws.Int64Value(apiObject.MaximumRetryAttempts),
+		"on_partial_batch_item_failure":      aws.StringValue(apiObject.OnPartialBatchItemFailure),
+		"parallelization_factor":             aws.Int64Value(apiObject.ParallelizationFactor),
+		"starting_position":                  aws.StringValue(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil && v.Arn != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenFilterCriteria(apiObject *pipes.FilterCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Filters {
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"pattern": aws.StringValue(v.Pattern),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"filter": tfList,
+	}
+
+	return tfMap
+}
+
+func flattenSourceKinesisStreamParameters(apiObject *pipes.PipeSourceKinesisStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"maximum_record_age_in_seconds":      aws.Int64Value(apiObject.MaximumRecordAgeInSeconds),
+		"maximum_retry_attempts":             aws.Int64Value(apiObject.MaximumRetryAttempts),
+		"on_partial_batch_item_failure":      aws.StringValue(apiObject.OnPartialBatchItemFailure),
+		"parallelization_factor":             aws.Int64Value(apiObject.ParallelizationFactor),
+		"starting_position":                  aws.StringValue(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil && v.Arn != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	if v := apiObject.StartingPositionTimestamp; v != nil {
+		tfMap["starting_position_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenSourceManagedStreamingKafkaParameters(apiObject *pipes.PipeSourceManagedStreamingKafkaParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"consumer_group_id":                  aws.StringValue(apiObject.ConsumerGroupID),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"starting_position":                  aws.StringValue(apiObject.StartingPosition),
+		"topic_name":                         aws.StringValue(apiObject.TopicName),
+	}
+
+	if v := apiObject.Credentials; v != nil {
+		tfMap["credentials"] = []interface{}{flattenMSKAccessCredentials(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSourceRabbitMQBrokerParameters(apiObject *pipes.PipeSourceRabbitMQBrokerParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"queue_name":                         aws.StringValue(apiObject.QueueName),
+		"virtual_host":                       aws.StringValue(apiObject.VirtualHost),
+	}
+
+	if v := apiObject.Credentials; v != nil {
+		tfMap["credentials"] = []interface{}{flattenMQBrokerAccessCredentials(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSourceSelfManagedKafkaParameters(apiObject *pipes.PipeSourceSelfManagedKafkaParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"additional_bootstrap_servers":       aws.StringValueSlice(apiObject.AdditionalBootstrapServers),
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"consumer_group_id":                  aws.StringValue(apiObject.ConsumerGroupID),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+		"server_root_ca_certificate":         aws.StringValue(apiObject.ServerRootCaCertificate),
+		"starting_position":                  aws.StringValue(apiObject.StartingPosition),
+		"topic_name":                         aws.StringValue(apiObject.TopicName),
+	}
+
+	if v := apiObject.Credentials; v != nil {
+		tfMap["credentials"] = []interface{}{flattenSelfManagedKafkaAccessConfigurationCredentials(v)}
+	}
+
+	if v := apiObject.Vpc; v != nil {
+		tfMap["vpc"] = []interface{}{flattenSelfManagedKafkaAccessConfigurationVPC(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSourceSQSQueueParameters(apiObject *pipes.PipeSourceSqsQueueParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"batch_size":                         aws.Int64Value(apiObject.BatchSize),
+		"maximum_batching_window_in_seconds": aws.Int64Value(apiObject.MaximumBatchingWindowInSeconds),
+	}
+
+	return tfMap
+}
+
+func flattenDeadLetterConfig(apiObject *pipes.DeadLetterConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn": aws.StringValue(apiObject.Arn),
+	}
+
+	return tfMap
+}
+
+func flattenMQBrokerAccessCredentials(apiObject *pipes.MQBrokerAccessCredentials) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"basic_auth": aws.StringValue(apiObject.BasicAuth),
+	}
+
+	return tfMap
+}
+
+func flattenMSKAccessCredentials(apiObject *pipes.MSKAccessCredentials) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"client_certificate_tls_auth": aws.StringValue(apiObject.ClientCertificateTlsAuth),
+		"sasl_scram_512_auth":         aws.StringValue(apiObject.SaslScram512Auth),
+	}
+
+	return tfMap
+}
+
+func flattenSelfManagedKafkaAccessConfigurationCredentials(apiObject *pipes.SelfManagedKafkaAccessConfigurationCredentials) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"basic_auth":                  aws.StringValue(apiObject.BasicAuth),
+		"client_certificate_tls_auth": aws.StringValue(apiObject.ClientCertificateTlsAuth),
+		"sasl_scram_256_auth":         aws.StringValue(apiObject.SaslScram256Auth),
+		"sasl_scram_512_auth":         aws.StringValue(apiObject.SaslScram512Auth),
+	}
+
+	return tfMap
+}
+
+func flattenSelfManagedKafkaAccessConfigurationVPC(apiObject *pipes.SelfManagedKafkaAccessConfigurationVpc) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_groups": aws.StringValueSlice(apiObject.SecurityGroup),
+		"subnets":         aws.StringValueSlice(apiObject.Subnets),
+	}
+
+	return tfMap
+}
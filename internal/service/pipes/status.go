@@ -0,0 +1,26 @@
+package pipes
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusPipe(ctx context.Context, conn *pipes.Pipes, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPipeByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.CurrentState), nil
+	}
+}
@@ -0,0 +1,69 @@
+//go:build sweep
+// +build sweep
+
+package pipes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
+		Name: "aws_pipes_pipe",
+		F:    sweepPipes,
+	})
+}
+
+func sweepPipes(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).PipesConn
+	sweepResources := make([]sweep.Sweepable, 0)
+	ctx := context.Background()
+	input := &pipes.ListPipesInput{}
+
+	err = conn.ListPipesPagesWithContext(ctx, input, func(page *pipes.ListPipesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Pipes {
+			r := ResourcePipe()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EventBridge Pipes Pipe sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EventBridge Pipes Pipes (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EventBridge Pipes Pipes (%s): %w", region, err)
+	}
+
+	return nil
+}
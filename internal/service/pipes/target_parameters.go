@@ -0,0 +1,1982 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var targetParametersTypes = []string{
+	"target_parameters.0.batch_job_parameters",
+	"target_parameters.0.cloudwatch_logs_parameters",
+	"target_parameters.0.ecs_task_parameters",
+	"target_parameters.0.eventbridge_event_bus_parameters",
+	"target_parameters.0.http_parameters",
+	"target_parameters.0.kinesis_stream_parameters",
+	"target_parameters.0.lambda_function_parameters",
+	"target_parameters.0.redshift_data_parameters",
+	"target_parameters.0.sagemaker_pipeline_parameters",
+	"target_parameters.0.sqs_queue_parameters",
+	"target_parameters.0.step_function_state_machine_parameters",
+	"target_parameters.0.timestream_parameters",
+}
+
+func targetParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_job_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("batch_job_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"array_properties": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"size": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(2, 10000),
+										},
+									},
+								},
+							},
+							"container_overrides": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"command": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"environment": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+										"instance_type": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"resource_requirement": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"type": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringInSlice(pipes.BatchResourceRequirementType_Values(), false),
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"depends_on": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 20,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"job_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(pipes.BatchJobDependencyType_Values(), false),
+										},
+									},
+								},
+							},
+							"job_definition": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"job_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"parameters": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"retry_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"attempts": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(1, 10),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"cloudwatch_logs_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("cloudwatch_logs_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_stream_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"ecs_task_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("ecs_task_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"capacity_provider_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 6,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"base": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 100000),
+										},
+										"capacity_provider": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+										"weight": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 1000),
+										},
+									},
+								},
+							},
+							"enable_ecs_managed_tags": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"enable_execute_command": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"group": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"launch_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(pipes.LaunchType_Values(), false),
+							},
+							"network_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"aws_vpc_configuration": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"assign_public_ip": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringInSlice(pipes.AssignPublicIp_Values(), false),
+													},
+													"security_groups": {
+														Type:     schema.TypeSet,
+														Optional: true,
+														MaxItems: 5,
+														Elem:     &schema.Schema{Type: schema.TypeString},
+													},
+													"subnets": {
+														Type:     schema.TypeSet,
+														Optional: true,
+														MaxItems: 16,
+														Elem:     &schema.Schema{Type: schema.TypeString},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"overrides": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"container_override": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"command": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem:     &schema.Schema{Type: schema.TypeString},
+													},
+													"cpu": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+													"environment": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"name": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+																"value": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+															},
+														},
+													},
+													"environment_file": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"type": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validation.StringInSlice(pipes.EcsEnvironmentFileType_Values(), false),
+																},
+																"value": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: verify.ValidARN,
+																},
+															},
+														},
+													},
+													"memory": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+													"memory_reservation": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+													"name": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"resource_requirement": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"type": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validation.StringInSlice(pipes.EcsResourceRequirementType_Values(), false),
+																},
+																"value": {
+																	Type:     schema.TypeString,
+																	Required: true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										"cpu": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"ephemeral_storage": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"size_in_gib": {
+														Type:         schema.TypeInt,
+														Required:     true,
+														ValidateFunc: validation.IntBetween(21, 200),
+													},
+												},
+											},
+										},
+										"execution_role_arn": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"inference_accelerator_override": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"device_name": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"device_type": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+										"memory": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"task_role_arn": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"placement_constraint": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"expression": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(0, 2000),
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(pipes.PlacementConstraintType_Values(), false),
+										},
+									},
+								},
+							},
+							"placement_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 5,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"field": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(0, 255),
+										},
+										"type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(pipes.PlacementStrategyType_Values(), false),
+										},
+									},
+								},
+							},
+							"platform_version": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"propagate_tags": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(pipes.PropagateTags_Values(), false),
+							},
+							"reference_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 1024),
+							},
+							"tags": tftags.TagsSchema(),
+							"task_count": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      1,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"task_definition_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"eventbridge_event_bus_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("eventbridge_event_bus_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"detail_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"endpoint_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 50),
+							},
+							"resources": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidARN,
+								},
+							},
+							"source": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"http_parameters": func() *schema.Schema {
+					s := httpParametersSchema()
+					s.ConflictsWith = targetParametersConflicts("http_parameters")
+
+					return s
+				}(),
+				"input_template": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 8192),
+				},
+				"kinesis_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("kinesis_stream_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"partition_key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+							},
+						},
+					},
+				},
+				"lambda_function_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("lambda_function_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(pipes.PipeTargetInvocationType_Values(), false),
+							},
+						},
+					},
+				},
+				"redshift_data_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("redshift_data_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"database": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+							"db_user": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"secret_manager_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"sqls": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 100000),
+								},
+							},
+							"statement_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 500),
+							},
+							"with_event": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"sagemaker_pipeline_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("sagemaker_pipeline_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"pipeline_parameter": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 200,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+										"value": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(0, 1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("sqs_queue_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"message_deduplication_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 100),
+							},
+							"message_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 100),
+							},
+						},
+					},
+				},
+				"step_function_state_machine_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("step_function_state_machine_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(pipes.PipeTargetInvocationType_Values(), false),
+							},
+						},
+					},
+				},
+				"timestream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: targetParametersConflicts("timestream_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"dimension_mapping": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 128,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dimension_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+										"dimension_value": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 2048),
+										},
+										"dimension_value_type": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(pipes.DimensionValueType_Values(), false),
+										},
+									},
+								},
+							},
+							"epoch_time_unit": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(pipes.EpochTimeUnit_Values(), false),
+							},
+							"multi_measure_mapping": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1024,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"multi_measure_attribute_mapping": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											MaxItems: 256,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"measure_value": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 2048),
+													},
+													"measure_value_type": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringInSlice(pipes.MeasureValueType_Values(), false),
+													},
+													"multi_measure_attribute_name": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 256),
+													},
+												},
+											},
+										},
+										"multi_measure_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+								},
+							},
+							"single_measure_mapping": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 8192,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"measure_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+										"measure_value": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 2048),
+										},
+										"measure_value_type": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(pipes.MeasureValueType_Values(), false),
+										},
+									},
+								},
+							},
+							"time_field_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(pipes.TimeFieldType_Values(), false),
+							},
+							"time_value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"timestamp_format": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"version_value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// targetParametersConflicts returns the target type blocks that conflict with the named block.
+func targetParametersConflicts(name string) []string {
+	var conflicts []string
+
+	for _, v := range targetParametersTypes {
+		if v != "target_parameters.0."+name {
+			conflicts = append(conflicts, v)
+		}
+	}
+
+	return conflicts
+}
+
+func expandTargetParameters(tfMap map[string]interface{}) *pipes.PipeTargetParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetParameters{}
+
+	if v, ok := tfMap["batch_job_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BatchJobParameters = expandTargetBatchJobParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["cloudwatch_logs_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLogsParameters = expandTargetCloudWatchLogsParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ecs_task_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EcsTaskParameters = expandTargetECSTaskParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["eventbridge_event_bus_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventBridgeEventBusParameters = expandTargetEventBridgeEventBusParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpParameters = expandTargetHTTPParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KinesisStreamParameters = &pipes.PipeTargetKinesisStreamParameters{
+			PartitionKey: aws.String(tfMap["partition_key"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["lambda_function_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LambdaFunctionParameters = &pipes.PipeTargetLambdaFunctionParameters{
+			InvocationType: aws.String(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["redshift_data_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RedshiftDataParameters = expandTargetRedshiftDataParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sagemaker_pipeline_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SageMakerPipelineParameters = expandTargetSageMakerPipelineParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SqsQueueParameters = &pipes.PipeTargetSqsQueueParameters{}
+
+		if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+			apiObject.SqsQueueParameters.MessageDeduplicationId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+			apiObject.SqsQueueParameters.MessageGroupId = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["step_function_state_machine_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.StepFunctionStateMachineParameters = &pipes.PipeTargetStateMachineParameters{
+			InvocationType: aws.String(tfMap["invocation_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["timestream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TimestreamParameters = expandTargetTimestreamParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTargetBatchJobParameters(tfMap map[string]interface{}) *pipes.PipeTargetBatchJobParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetBatchJobParameters{}
+
+	if v, ok := tfMap["array_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ArrayProperties = &pipes.BatchArrayProperties{}
+
+		if v, ok := tfMap["size"].(int); ok && v != 0 {
+			apiObject.ArrayProperties.Size = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["container_overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerOverrides = expandBatchContainerOverrides(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			dependency := &pipes.BatchJobDependency{}
+
+			if v, ok := tfMap["job_id"].(string); ok && v != "" {
+				dependency.JobId = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				dependency.Type = aws.String(v)
+			}
+
+			apiObject.DependsOn = append(apiObject.DependsOn, dependency)
+		}
+	}
+
+	if v, ok := tfMap["job_definition"].(string); ok && v != "" {
+		apiObject.JobDefinition = aws.String(v)
+	}
+
+	if v, ok := tfMap["job_name"].(string); ok && v != "" {
+		apiObject.JobName = aws.String(v)
+	}
+
+	if v, ok := tfMap["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Parameters = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["retry_strategy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.RetryStrategy = &pipes.BatchRetryStrategy{}
+
+		if v, ok := tfMap["attempts"].(int); ok && v != 0 {
+			apiObject.RetryStrategy.Attempts = aws.Int64(int64(v))
+		}
+	}
+
+	return apiObject
+}
+
+func expandBatchContainerOverrides(tfMap map[string]interface{}) *pipes.BatchContainerOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.BatchContainerOverrides{}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			variable := &pipes.BatchEnvironmentVariable{}
+
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				variable.Name = aws.String(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				variable.Value = aws.String(v)
+			}
+
+			apiObject.Environment = append(apiObject.Environment, variable)
+		}
+	}
+
+	if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+		apiObject.InstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_requirement"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.ResourceRequirements = append(apiObject.ResourceRequirements, &pipes.BatchResourceRequirement{
+				Type:  aws.String(tfMap["type"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandTargetCloudWatchLogsParameters(tfMap map[string]interface{}) *pipes.PipeTargetCloudWatchLogsParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetCloudWatchLogsParameters{}
+
+	if v, ok := tfMap["log_stream_name"].(string); ok && v != "" {
+		apiObject.LogStreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].(string); ok && v != "" {
+		apiObject.Timestamp = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetECSTaskParameters(tfMap map[string]interface{}) *pipes.PipeTargetEcsTaskParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetEcsTaskParameters{}
+
+	if v, ok := tfMap["capacity_provider_strategy"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			item := &pipes.CapacityProviderStrategyItem{
+				CapacityProvider: aws.String(tfMap["capacity_provider"].(string)),
+			}
+
+			if v, ok := tfMap["base"].(int); ok && v != 0 {
+				item.Base = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["weight"].(int); ok && v != 0 {
+				item.Weight = aws.Int64(int64(v))
+			}
+
+			apiObject.CapacityProviderStrategy = append(apiObject.CapacityProviderStrategy, item)
+		}
+	}
+
+	if v, ok := tfMap["enable_ecs_managed_tags"].(bool); ok && v {
+		apiObject.EnableECSManagedTags = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["enable_execute_command"].(bool); ok && v {
+		apiObject.EnableExecuteCommand = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["group"].(string); ok && v != "" {
+		apiObject.Group = aws.String(v)
+	}
+
+	if v, ok := tfMap["launch_type"].(string); ok && v != "" {
+		apiObject.LaunchType = aws.String(v)
+	}
+
+	if v, ok := tfMap["network_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkConfiguration = expandNetworkConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Overrides = expandECSTaskOverride(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["placement_constraint"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			constraint := &pipes.PlacementConstraint{}
+
+			if v, ok := tfMap["expression"].(string); ok && v != "" {
+				constraint.Expression = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				constraint.Type = aws.String(v)
+			}
+
+			apiObject.PlacementConstraints = append(apiObject.PlacementConstraints, constraint)
+		}
+	}
+
+	if v, ok := tfMap["placement_strategy"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			strategy := &pipes.PlacementStrategy{}
+
+			if v, ok := tfMap["field"].(string); ok && v != "" {
+				strategy.Field = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				strategy.Type = aws.String(v)
+			}
+
+			apiObject.PlacementStrategy = append(apiObject.PlacementStrategy, strategy)
+		}
+	}
+
+	if v, ok := tfMap["platform_version"].(string); ok && v != "" {
+		apiObject.PlatformVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+		apiObject.PropagateTags = aws.String(v)
+	}
+
+	if v, ok := tfMap["reference_id"].(string); ok && v != "" {
+		apiObject.ReferenceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		for k, v := range v {
+			apiObject.Tags = append(apiObject.Tags, &pipes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["task_count"].(int); ok && v != 0 {
+		apiObject.TaskCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["task_definition_arn"].(string); ok && v != "" {
+		apiObject.TaskDefinitionArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNetworkConfiguration(tfMap map[string]interface{}) *pipes.NetworkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.NetworkConfiguration{}
+
+	if v, ok := tfMap["aws_vpc_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AwsvpcConfiguration = &pipes.AwsVpcConfiguration{}
+
+		if v, ok := tfMap["assign_public_ip"].(string); ok && v != "" {
+			apiObject.AwsvpcConfiguration.AssignPublicIp = aws.String(v)
+		}
+
+		if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AwsvpcConfiguration.SecurityGroups = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AwsvpcConfiguration.Subnets = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandECSTaskOverride(tfMap map[string]interface{}) *pipes.EcsTaskOverride {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.EcsTaskOverride{}
+
+	if v, ok := tfMap["container_override"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.ContainerOverrides = append(apiObject.ContainerOverrides, expandECSContainerOverride(tfMap))
+		}
+	}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["ephemeral_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.EphemeralStorage = &pipes.EcsEphemeralStorage{
+			SizeInGiB: aws.Int64(int64(tfMap["size_in_gib"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["inference_accelerator_override"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			override := &pipes.EcsInferenceAcceleratorOverride{}
+
+			if v, ok := tfMap["device_name"].(string); ok && v != "" {
+				override.DeviceName = aws.String(v)
+			}
+
+			if v, ok := tfMap["device_type"].(string); ok && v != "" {
+				override.DeviceType = aws.String(v)
+			}
+
+			apiObject.InferenceAcceleratorOverrides = append(apiObject.InferenceAcceleratorOverrides, override)
+		}
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandECSContainerOverride(tfMap map[string]interface{}) *pipes.EcsContainerOverride {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.EcsContainerOverride{}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["environment"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			variable := &pipes.EcsEnvironmentVariable{}
+
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				variable.Name = aws.String(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				variable.Value = aws.String(v)
+			}
+
+			apiObject.Environment = append(apiObject.Environment, variable)
+		}
+	}
+
+	if v, ok := tfMap["environment_file"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.EnvironmentFiles = append(apiObject.EnvironmentFiles, &pipes.EcsEnvironmentFile{
+				Type:  aws.String(tfMap["type"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_requirement"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.ResourceRequirements = append(apiObject.ResourceRequirements, &pipes.EcsResourceRequirement{
+				Type:  aws.String(tfMap["type"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandTargetEventBridgeEventBusParameters(tfMap map[string]interface{}) *pipes.PipeTargetEventBridgeEventBusParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetEventBridgeEventBusParameters{}
+
+	if v, ok := tfMap["detail_type"].(string); ok && v != "" {
+		apiObject.DetailType = aws.String(v)
+	}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Resources = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	if v, ok := tfMap["time"].(string); ok && v != "" {
+		apiObject.Time = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetHTTPParameters(tfMap map[string]interface{}) *pipes.PipeTargetHttpParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetHttpParameters{}
+
+	if v, ok := tfMap["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.HeaderParameters = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PathParameterValues = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.QueryStringParameters = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetRedshiftDataParameters(tfMap map[string]interface{}) *pipes.PipeTargetRedshiftDataParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetRedshiftDataParameters{}
+
+	if v, ok := tfMap["database"].(string); ok && v != "" {
+		apiObject.Database = aws.String(v)
+	}
+
+	if v, ok := tfMap["db_user"].(string); ok && v != "" {
+		apiObject.DbUser = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_manager_arn"].(string); ok && v != "" {
+		apiObject.SecretManagerArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["sqls"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Sqls = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["statement_name"].(string); ok && v != "" {
+		apiObject.StatementName = aws.String(v)
+	}
+
+	if v, ok := tfMap["with_event"].(bool); ok && v {
+		apiObject.WithEvent = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetSageMakerPipelineParameters(tfMap map[string]interface{}) *pipes.PipeTargetSageMakerPipelineParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetSageMakerPipelineParameters{}
+
+	if v, ok := tfMap["pipeline_parameter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.PipelineParameterList = append(apiObject.PipelineParameterList, &pipes.SageMakerPipelineParameter{
+				Name:  aws.String(tfMap["name"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandTargetTimestreamParameters(tfMap map[string]interface{}) *pipes.PipeTargetTimestreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pipes.PipeTargetTimestreamParameters{}
+
+	if v, ok := tfMap["dimension_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.DimensionMappings = append(apiObject.DimensionMappings, &pipes.DimensionMapping{
+				DimensionName:      aws.String(tfMap["dimension_name"].(string)),
+				DimensionValue:     aws.String(tfMap["dimension_value"].(string)),
+				DimensionValueType: aws.String(tfMap["dimension_value_type"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["epoch_time_unit"].(string); ok && v != "" {
+		apiObject.EpochTimeUnit = aws.String(v)
+	}
+
+	if v, ok := tfMap["multi_measure_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			mapping := &pipes.MultiMeasureMapping{
+				MultiMeasureName: aws.String(tfMap["multi_measure_name"].(string)),
+			}
+
+			if v, ok := tfMap["multi_measure_attribute_mapping"].([]interface{}); ok && len(v) > 0 {
+				for _, tfMapRaw := range v {
+					tfMap, ok := tfMapRaw.(map[string]interface{})
+
+					if !ok {
+						continue
+					}
+
+					mapping.MultiMeasureAttributeMappings = append(mapping.MultiMeasureAttributeMappings, &pipes.MultiMeasureAttributeMapping{
+						MeasureValue:              aws.String(tfMap["measure_value"].(string)),
+						MeasureValueType:          aws.String(tfMap["measure_value_type"].(string)),
+						MultiMeasureAttributeName: aws.String(tfMap["multi_measure_attribute_name"].(string)),
+					})
+				}
+			}
+
+			apiObject.MultiMeasureMappings = append(apiObject.MultiMeasureMappings, mapping)
+		}
+	}
+
+	if v, ok := tfMap["single_measure_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.SingleMeasureMappings = append(apiObject.SingleMeasureMappings, &pipes.SingleMeasureMapping{
+				MeasureName:      aws.String(tfMap["measure_name"].(string)),
+				MeasureValue:     aws.String(tfMap["measure_value"].(string)),
+				MeasureValueType: aws.String(tfMap["measure_value_type"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["time_field_type"].(string); ok && v != "" {
+		apiObject.TimeFieldType = aws.String(v)
+	}
+
+	if v, ok := tfMap["time_value"].(string); ok && v != "" {
+		apiObject.TimeValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp_format"].(string); ok && v != "" {
+		apiObject.TimestampFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["version_value"].(string); ok && v != "" {
+		apiObject.VersionValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTargetParameters(apiObject *pipes.PipeTargetParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_template": aws.StringValue(apiObject.InputTemplate),
+	}
+
+	if v := apiObject.BatchJobParameters; v != nil {
+		tfMap["batch_job_parameters"] = []interface{}{flattenTargetBatchJobParameters(v)}
+	}
+
+	if v := apiObject.CloudWatchLogsParameters; v != nil {
+		tfMap["cloudwatch_logs_parameters"] = []interface{}{map[string]interface{}{
+			"log_stream_name": aws.StringValue(v.LogStreamName),
+			"timestamp":       aws.StringValue(v.Timestamp),
+		}}
+	}
+
+	if v := apiObject.EcsTaskParameters; v != nil {
+		tfMap["ecs_task_parameters"] = []interface{}{flattenTargetECSTaskParameters(v)}
+	}
+
+	if v := apiObject.EventBridgeEventBusParameters; v != nil {
+		tfMap["eventbridge_event_bus_parameters"] = []interface{}{map[string]interface{}{
+			"detail_type": aws.StringValue(v.DetailType),
+			"endpoint_id": aws.StringValue(v.EndpointId),
+			"resources":   aws.StringValueSlice(v.Resources),
+			"source":      aws.StringValue(v.Source),
+			"time":        aws.StringValue(v.Time),
+		}}
+	}
+
+	if v := apiObject.HttpParameters; v != nil {
+		tfMap["http_parameters"] = []interface{}{flattenHTTPParameters(v.HeaderParameters, v.PathParameterValues, v.QueryStringParameters)}
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{map[string]interface{}{
+			"partition_key": aws.StringValue(v.PartitionKey),
+		}}
+	}
+
+	if v := apiObject.LambdaFunctionParameters; v != nil {
+		tfMap["lambda_function_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": aws.StringValue(v.InvocationType),
+		}}
+	}
+
+	if v := apiObject.RedshiftDataParameters; v != nil {
+		tfMap["redshift_data_parameters"] = []interface{}{map[string]interface{}{
+			"database":           aws.StringValue(v.Database),
+			"db_user":            aws.StringValue(v.DbUser),
+			"secret_manager_arn": aws.StringValue(v.SecretManagerArn),
+			"sqls":               aws.StringValueSlice(v.Sqls),
+			"statement_name":     aws.StringValue(v.StatementName),
+			"with_event":         aws.BoolValue(v.WithEvent),
+		}}
+	}
+
+	if v := apiObject.SageMakerPipelineParameters; v != nil {
+		var tfList []interface{}
+
+		for _, v := range v.PipelineParameterList {
+			if v == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"name":  aws.StringValue(v.Name),
+				"value": aws.StringValue(v.Value),
+			})
+		}
+
+		tfMap["sagemaker_pipeline_parameters"] = []interface{}{map[string]interface{}{
+			"pipeline_parameter": tfList,
+		}}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{map[string]interface{}{
+			"message_deduplication_id": aws.StringValue(v.MessageDeduplicationId),
+			"message_group_id":         aws.StringValue(v.MessageGroupId),
+		}}
+	}
+
+	if v := apiObject.StepFunctionStateMachineParameters; v != nil {
+		tfMap["step_function_state_machine_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": aws.StringValue(v.InvocationType),
+		}}
+	}
+
+	if v := apiObject.TimestreamParameters; v != nil {
+		tfMap["timestream_parameters"] = []interface{}{flattenTargetTimestreamParameters(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTargetBatchJobParameters(apiObject *pipes.PipeTargetBatchJobParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_definition": aws.StringValue(apiObject.JobDefinition),
+		"job_name":       aws.StringValue(apiObject.JobName),
+		"parameters":     aws.StringValueMap(apiObject.Parameters),
+	}
+
+	if v := apiObject.ArrayProperties; v != nil {
+		tfMap["array_properties"] = []interface{}{map[string]interface{}{
+			"size": aws.Int64Value(v.Size),
+		}}
+	}
+
+	if v := apiObject.ContainerOverrides; v != nil {
+		var environment []interface{}
+
+		for _, v := range v.Environment {
+			if v == nil {
+				continue
+			}
+
+			environment = append(environment, map[string]interface{}{
+				"name":  aws.StringValue(v.Name),
+				"value": aws.StringValue(v.Value),
+			})
+		}
+
+		var resourceRequirements []interface{}
+
+		for _, v := range v.ResourceRequirements {
+			if v == nil {
+				continue
+			}
+
+			resourceRequirements = append(resourceRequirements, map[string]interface{}{
+				"type":  aws.StringValue(v.Type),
+				"value": aws.StringValue(v.Value),
+			})
+		}
+
+		tfMap["container_overrides"] = []interface{}{map[string]interface{}{
+			"command":              aws.StringValueSlice(v.Command),
+			"environment":          environment,
+			"instance_type":        aws.StringValue(v.InstanceType),
+			"resource_requirement": resourceRequirements,
+		}}
+	}
+
+	var dependsOn []interface{}
+
+	for _, v := range apiObject.DependsOn {
+		if v == nil {
+			continue
+		}
+
+		dependsOn = append(dependsOn, map[string]interface{}{
+			"job_id": aws.StringValue(v.JobId),
+			"type":   aws.StringValue(v.Type),
+		})
+	}
+
+	tfMap["depends_on"] = dependsOn
+
+	if v := apiObject.RetryStrategy; v != nil {
+		tfMap["retry_strategy"] = []interface{}{map[string]interface{}{
+			"attempts": aws.Int64Value(v.Attempts),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenTargetECSTaskParameters(apiObject *pipes.PipeTargetEcsTaskParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_ecs_managed_tags": aws.BoolValue(apiObject.EnableECSManagedTags),
+		"enable_execute_command":  aws.BoolValue(apiObject.EnableExecuteCommand),
+		"group":                   aws.StringValue(apiObject.Group),
+		"launch_type":             aws.StringValue(apiObject.LaunchType),
+		"platform_version":        aws.StringValue(apiObject.PlatformVersion),
+		"propagate_tags":          aws.StringValue(apiObject.PropagateTags),
+		"reference_id":            aws.StringValue(apiObject.ReferenceId),
+		"task_count":              aws.Int64Value(apiObject.TaskCount),
+		"task_definition_arn":     aws.StringValue(apiObject.TaskDefinitionArn),
+	}
+
+	var capacityProviderStrategy []interface{}
+
+	for _, v := range apiObject.CapacityProviderStrategy {
+		if v == nil {
+			continue
+		}
+
+		capacityProviderStrategy = append(capacityProviderStrategy, map[string]interface{}{
+			"base":              aws.Int64Value(v.Base),
+			"capacity_provider": aws.StringValue(v.CapacityProvider),
+			"weight":            aws.Int64Value(v.Weight),
+		})
+	}
+
+	tfMap["capacity_provider_strategy"] = capacityProviderStrategy
+
+	if v := apiObject.NetworkConfiguration; v != nil && v.AwsvpcConfiguration != nil {
+		tfMap["network_configuration"] = []interface{}{map[string]interface{}{
+			"aws_vpc_configuration": []interface{}{map[string]interface{}{
+				"assign_public_ip": aws.StringValue(v.AwsvpcConfiguration.AssignPublicIp),
+				"security_groups":  aws.StringValueSlice(v.AwsvpcConfiguration.SecurityGroups),
+				"subnets":          aws.StringValueSlice(v.AwsvpcConfiguration.Subnets),
+			}},
+		}}
+	}
+
+	if v := apiObject.Overrides; v != nil {
+		tfMap["overrides"] = []interface{}{flattenECSTaskOverride(v)}
+	}
+
+	var placementConstraints []interface{}
+
+	for _, v := range apiObject.PlacementConstraints {
+		if v == nil {
+			continue
+		}
+
+		placementConstraints = append(placementConstraints, map[string]interface{}{
+			"expression": aws.StringValue(v.Expression),
+			"type":       aws.StringValue(v.Type),
+		})
+	}
+
+	tfMap["placement_constraint"] = placementConstraints
+
+	var placementStrategy []interface{}
+
+	for _, v := range apiObject.PlacementStrategy {
+		if v == nil {
+			continue
+		}
+
+		placementStrategy = append(placementStrategy, map[string]interface{}{
+			"field": aws.StringValue(v.Field),
+			"type":  aws.StringValue(v.Type),
+		})
+	}
+
+	tfMap["placement_strategy"] = placementStrategy
+
+	tags := map[string]interface{}{}
+
+	for _, v := range apiObject.Tags {
+		if v == nil {
+			continue
+		}
+
+		tags[aws.StringValue(v.Key)] = aws.StringValue(v.Value)
+	}
+
+	tfMap["tags"] = tags
+
+	return tfMap
+}
+
+func flattenECSTaskOverride(apiObject *pipes.EcsTaskOverride) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cpu":                aws.StringValue(apiObject.Cpu),
+		"execution_role_arn": aws.StringValue(apiObject.ExecutionRoleArn),
+		"memory":             aws.StringValue(apiObject.Memory),
+		"task_role_arn":      aws.StringValue(apiObject.TaskRoleArn),
+	}
+
+	var containerOverrides []interface{}
+
+	for _, v := range apiObject.ContainerOverrides {
+		if v == nil {
+			continue
+		}
+
+		containerOverrides = append(containerOverrides, flattenECSContainerOverride(v))
+	}
+
+	tfMap["container_override"] = containerOverrides
+
+	if v := apiObject.EphemeralStorage; v != nil {
+		tfMap["ephemeral_storage"] = []interface{}{map[string]interface{}{
+			"size_in_gib": aws.Int64Value(v.SizeInGiB),
+		}}
+	}
+
+	var inferenceAcceleratorOverrides []interface{}
+
+	for _, v := range apiObject.InferenceAcceleratorOverrides {
+		if v == nil {
+			continue
+		}
+
+		inferenceAcceleratorOverrides = append(inferenceAcceleratorOverrides, map[string]interface{}{
+			"device_name": aws.StringValue(v.DeviceName),
+			"device_type": aws.StringValue(v.DeviceType),
+		})
+	}
+
+	tfMap["inference_accelerator_override"] = inferenceAcceleratorOverrides
+
+	return tfMap
+}
+
+func flattenECSContainerOverride(apiObject *pipes.EcsContainerOverride) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"command":            aws.StringValueSlice(apiObject.Command),
+		"cpu":                aws.Int64Value(apiObject.Cpu),
+		"memory":             aws.Int64Value(apiObject.Memory),
+		"memory_reservation": aws.Int64Value(apiObject.MemoryReservation),
+		"name":               aws.StringValue(apiObject.Name),
+	}
+
+	var environment []interface{}
+
+	for _, v := range apiObject.Environment {
+		if v == nil {
+			continue
+		}
+
+		environment = append(environment, map[string]interface{}{
+			"name":  aws.StringValue(v.Name),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+
+	tfMap["environment"] = environment
+
+	var environmentFiles []interface{}
+
+	for _, v := range apiObject.EnvironmentFiles {
+		if v == nil {
+			continue
+		}
+
+		environmentFiles = append(environmentFiles, map[string]interface{}{
+			"type":  aws.StringValue(v.Type),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+
+	tfMap["environment_file"] = environmentFiles
+
+	var resourceRequirements []interface{}
+
+	for _, v := range apiObject.ResourceRequirements {
+		if v == nil {
+			continue
+		}
+
+		resourceRequirements = append(resourceRequirements, map[string]interface{}{
+			"type":  aws.StringValue(v.Type),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+
+	tfMap["resource_requirement"] = resourceRequirements
+
+	return tfMap
+}
+
+func flattenTargetTimestreamParameters(apiObject *pipes.PipeTargetTimestreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"epoch_time_unit":  aws.StringValue(apiObject.EpochTimeUnit),
+		"time_field_type":  aws.StringValue(apiObject.TimeFieldType),
+		"time_value":       aws.StringValue(apiObject.TimeValue),
+		"timestamp_format": aws.StringValue(apiObject.TimestampFormat),
+		"version_value":    aws.StringValue(apiObject.VersionValue),
+	}
+
+	var dimensionMappings []interface{}
+
+	for _, v := range apiObject.DimensionMappings {
+		if v == nil {
+			continue
+		}
+
+		dimensionMappings = append(dimensionMappings, map[string]interface{}{
+			"dimension_name":       aws.StringValue(v.DimensionName),
+			"dimension_value":      aws.StringValue(v.DimensionValue),
+			"dimension_value_type": aws.StringValue(v.DimensionValueType),
+		})
+	}
+
+	tfMap["dimension_mapping"] = dimensionMappings
+
+	var multiMeasureMappings []interface{}
+
+	for _, v := range apiObject.MultiMeasureMappings {
+		if v == nil {
+			continue
+		}
+
+		var attributeMappings []interface{}
+
+		for _, v := range v.MultiMeasureAttributeMappings {
+			if v == nil {
+				continue
+			}
+
+			attributeMappings = append(attributeMappings, map[string]interface{}{
+				"measure_value":                aws.StringValue(v.MeasureValue),
+				"measure_value_type":           aws.StringValue(v.MeasureValueType),
+				"multi_measure_attribute_name": aws.StringValue(v.MultiMeasureAttributeName),
+			})
+		}
+
+		multiMeasureMappings = append(multiMeasureMappings, map[string]interface{}{
+			"multi_measure_attribute_mapping": attributeMappings,
+			"multi_measure_name":              aws.StringValue(v.MultiMeasureName),
+		})
+	}
+
+	tfMap["multi_measure_mapping"] = multiMeasureMappings
+
+	var singleMeasureMappings []interface{}
+
+	for _, v := range apiObject.SingleMeasureMappings {
+		if v == nil {
+			continue
+		}
+
+		singleMeasureMappings = append(singleMeasureMappings, map[string]interface{}{
+			"measure_name":       aws.StringValue(v.MeasureName),
+			"measure_value":      aws.StringValue(v.MeasureValue),
+			"measure_value_type": aws.StringValue(v.MeasureValueType),
+		})
+	}
+
+	tfMap["single_measure_mapping"] = singleMeasureMappings
+
+	return tfMap
+}
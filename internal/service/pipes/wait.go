@@ -0,0 +1,79 @@
+package pipes
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitPipeCreated(ctx context.Context, conn *pipes.Pipes, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{pipes.PipeStateCreating, pipes.PipeStateStarting, pipes.PipeStateStopping},
+		Target:                    []string{pipes.PipeStateRunning, pipes.PipeStateStopped},
+		Refresh:                   statusPipe(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		if reason := aws.StringValue(output.StateReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeUpdated(ctx context.Context, conn *pipes.Pipes, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{pipes.PipeStateUpdating, pipes.PipeStateStarting, pipes.PipeStateStopping},
+		Target:                    []string{pipes.PipeStateRunning, pipes.PipeStateStopped},
+		Refresh:                   statusPipe(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		if reason := aws.StringValue(output.StateReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeDeleted(ctx context.Context, conn *pipes.Pipes, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pipes.PipeStateDeleting},
+		Target:  []string{},
+		Refresh: statusPipe(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		if reason := aws.StringValue(output.StateReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
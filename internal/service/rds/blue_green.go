@@ -0,0 +1,371 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// instanceBlueGreenUpdateAttributes are the DB instance attributes that are changed by
+// creating a Blue/Green deployment rather than by modifying the instance in place.
+var instanceBlueGreenUpdateAttributes = []string{
+	"engine_version",
+	"instance_class",
+	"parameter_group_name",
+}
+
+// instanceBlueGreenUpdateRequired returns whether the pending changes to a DB instance
+// should be applied with a Blue/Green deployment.
+func instanceBlueGreenUpdateRequired(d *schema.ResourceData) bool {
+	return d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(instanceBlueGreenUpdateAttributes...)
+}
+
+// clusterBlueGreenUpdateAttributes are the DB cluster attributes that are changed by
+// creating a Blue/Green deployment rather than by modifying the cluster in place.
+var clusterBlueGreenUpdateAttributes = []string{
+	"db_cluster_parameter_group_name",
+	"engine_version",
+}
+
+// clusterBlueGreenUpdateRequired returns whether the pending changes to a DB cluster
+// should be applied with a Blue/Green deployment.
+func clusterBlueGreenUpdateRequired(d *schema.ResourceData) bool {
+	return d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(clusterBlueGreenUpdateAttributes...)
+}
+
+// checkBlueGreenSourceDeletable checks that the old (blue) source can be deleted after switchover.
+// This is done before the Blue/Green deployment is created so that a misconfiguration doesn't
+// leave the old source behind.
+func checkBlueGreenSourceDeletable(d *schema.ResourceData) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("deletion_protection must be disabled to use blue_green_update, as the old source is deleted after switchover")
+	}
+
+	if !d.Get("skip_final_snapshot").(bool) && d.Get("final_snapshot_identifier").(string) == "" {
+		return fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false")
+	}
+
+	return nil
+}
+
+// updateInstanceBlueGreen applies engine version, instance class and parameter group changes
+// to a DB instance using a Blue/Green deployment.
+// The green environment is created and allowed to synchronize, traffic is switched over and
+// the deployment and the old (blue) DB instance are then deleted.
+// After switchover the green DB instance has the identifier of the original DB instance.
+func updateInstanceBlueGreen(conn *rds.RDS, d *schema.ResourceData, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	if err := checkBlueGreenSourceDeletable(d); err != nil {
+		return err
+	}
+
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	if d.HasChange("instance_class") {
+		input.TargetDBInstanceClass = aws.String(d.Get("instance_class").(string))
+	}
+
+	if d.HasChange("parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+	}
+
+	sourceARN, err := switchoverBlueGreen(conn, input, deadline)
+
+	if err != nil {
+		return err
+	}
+
+	// After switchover the deployment's source is the renamed, old DB instance.
+	oldID, err := dbInstanceIdentifierFromARN(sourceARN)
+
+	if err != nil {
+		return err
+	}
+
+	if err := deleteBlueGreenSourceInstance(conn, d, oldID, deadline); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateClusterBlueGreen applies engine version and DB cluster parameter group changes
+// to a DB cluster using a Blue/Green deployment.
+// After switchover the old (blue) DB cluster and its DB instances are deleted, and the green
+// DB cluster and its DB instances have the identifiers of the originals.
+func updateClusterBlueGreen(conn *rds.RDS, d *schema.ResourceData, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	if err := checkBlueGreenSourceDeletable(d); err != nil {
+		return err
+	}
+
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	sourceARN, err := switchoverBlueGreen(conn, input, deadline)
+
+	if err != nil {
+		return err
+	}
+
+	// After switchover the deployment's source is the renamed, old DB cluster.
+	oldID, err := dbClusterIdentifierFromARN(sourceARN)
+
+	if err != nil {
+		return err
+	}
+
+	if err := deleteBlueGreenSourceCluster(conn, d, oldID, deadline); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// switchoverBlueGreen creates a Blue/Green deployment, waits for the green environment to become
+// available, switches over to it and then deletes the deployment.
+// The ARN of the old (blue) source, which is renamed by the switchover, is returned.
+func switchoverBlueGreen(conn *rds.RDS, input *rds.CreateBlueGreenDeploymentInput, deadline tfresource.Deadline) (string, error) {
+	log.Printf("[DEBUG] Creating RDS Blue/Green Deployment: %s", input)
+	output, err := conn.CreateBlueGreenDeployment(input)
+
+	if err != nil {
+		return "", fmt.Errorf("creating RDS Blue/Green Deployment (%s): %w", aws.StringValue(input.BlueGreenDeploymentName), err)
+	}
+
+	deploymentID := aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+
+	if _, err := waitBlueGreenDeploymentAvailable(conn, deploymentID, deadline.Remaining()); err != nil {
+		return "", fmt.Errorf("waiting for RDS Blue/Green Deployment (%s) create: %w", deploymentID, err)
+	}
+
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment: %s", deploymentID)
+	_, err = conn.SwitchoverBlueGreenDeployment(&rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("switching over RDS Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	deployment, err := waitBlueGreenDeploymentSwitchoverCompleted(conn, deploymentID, deadline.Remaining())
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for RDS Blue/Green Deployment (%s) switchover: %w", deploymentID, err)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", deploymentID)
+	_, err = conn.DeleteBlueGreenDeployment(&rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return "", fmt.Errorf("deleting RDS Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(conn, deploymentID, deadline.Remaining()); err != nil {
+		return "", fmt.Errorf("waiting for RDS Blue/Green Deployment (%s) delete: %w", deploymentID, err)
+	}
+
+	return aws.StringValue(deployment.Source), nil
+}
+
+// deleteBlueGreenSourceInstance deletes the old DB instance left behind by a Blue/Green switchover.
+// The resource's delete_automated_backups, skip_final_snapshot and final_snapshot_identifier settings are used.
+// Deletion protection is carried over from the original DB instance, so if the configuration
+// has it disabled it is also disabled on the old DB instance first.
+func deleteBlueGreenSourceInstance(conn *rds.RDS, d *schema.ResourceData, id string, deadline tfresource.Deadline) error {
+	instance, err := FindDBInstanceByID(conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Instance (%s): %w", id, err)
+	}
+
+	if aws.BoolValue(instance.DeletionProtection) {
+		_, err := conn.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(id),
+			DeletionProtection:   aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("disabling deletion protection on RDS DB Instance (%s): %w", id, err)
+		}
+
+		if _, err := waitDBInstanceUpdated(conn, id, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", id, err)
+		}
+	}
+
+	input := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier:   aws.String(id),
+		DeleteAutomatedBackups: aws.Bool(d.Get("delete_automated_backups").(bool)),
+		SkipFinalSnapshot:      aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	}
+
+	if !aws.BoolValue(input.SkipFinalSnapshot) {
+		input.FinalDBSnapshotIdentifier = aws.String(blueGreenSourceFinalSnapshotID(d.Get("final_snapshot_identifier").(string), id))
+	}
+
+	log.Printf("[DEBUG] Deleting RDS DB Instance: %s", input)
+	_, err = conn.DeleteDBInstance(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS DB Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(conn, id, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+// deleteBlueGreenSourceCluster deletes the old DB cluster, and its DB instances, left behind by a Blue/Green switchover.
+// The resource's skip_final_snapshot and final_snapshot_identifier settings are used for the DB cluster.
+// Deletion protection is carried over from the original DB cluster and is disabled on the old DB cluster first.
+func deleteBlueGreenSourceCluster(conn *rds.RDS, d *schema.ResourceData, id string, deadline tfresource.Deadline) error {
+	cluster, err := FindDBClusterByID(conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Cluster (%s): %w", id, err)
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		instanceID := aws.StringValue(member.DBInstanceIdentifier)
+
+		log.Printf("[DEBUG] Deleting RDS Cluster Instance: %s", instanceID)
+		_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+
+		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting RDS Cluster Instance (%s): %w", instanceID, err)
+		}
+	}
+
+	for _, member := range cluster.DBClusterMembers {
+		instanceID := aws.StringValue(member.DBInstanceIdentifier)
+
+		if _, err := waitDBClusterInstanceDeleted(conn, instanceID, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS Cluster Instance (%s) delete: %w", instanceID, err)
+		}
+	}
+
+	if aws.BoolValue(cluster.DeletionProtection) {
+		_, err := conn.ModifyDBCluster(&rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(id),
+			DeletionProtection:  aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("disabling deletion protection on RDS Cluster (%s): %w", id, err)
+		}
+
+		if _, err := waitDBClusterUpdated(conn, id, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS Cluster (%s) update: %w", id, err)
+		}
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+		SkipFinalSnapshot:   aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	}
+
+	if !aws.BoolValue(input.SkipFinalSnapshot) {
+		input.FinalDBSnapshotIdentifier = aws.String(blueGreenSourceFinalSnapshotID(d.Get("final_snapshot_identifier").(string), id))
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Cluster: %s", input)
+	_, err = conn.DeleteDBCluster(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): %w", id, err)
+	}
+
+	if _, err := waitDBClusterDeleted(conn, id, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+// blueGreenSourceFinalSnapshotID returns the identifier of the final snapshot of the old DB instance or DB cluster.
+// The old source's identifier is appended so that the snapshot does not collide with the
+// final snapshot taken when the resource is destroyed, or with those from earlier deployments.
+func blueGreenSourceFinalSnapshotID(finalSnapshotID, id string) string {
+	return fmt.Sprintf("%s-%s", finalSnapshotID, id)
+}
+
+func dbInstanceIdentifierFromARN(s string) (string, error) {
+	return identifierFromARN(s, "db:", "RDS DB Instance")
+}
+
+func dbClusterIdentifierFromARN(s string) (string, error) {
+	return identifierFromARN(s, "cluster:", "RDS Cluster")
+}
+
+func identifierFromARN(s, prefix, resourceType string) (string, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing %s ARN (%s): %w", resourceType, s, err)
+	}
+
+	id := strings.TrimPrefix(parsedARN.Resource, prefix)
+
+	if id == parsedARN.Resource {
+		return "", fmt.Errorf("unexpected format for %s ARN (%s)", resourceType, s)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,113 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameCertificate = "Default Certificate"
+)
+
+// ResourceCertificate manages the account-level override of the default certificate authority (CA)
+// for new DB instances in the current Region.
+func ResourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCertificatePut,
+		ReadWithoutTimeout:   resourceCertificateRead,
+		UpdateWithoutTimeout: resourceCertificatePut,
+		DeleteWithoutTimeout: resourceCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"customer_override_valid_till": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCertificatePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	id := d.Get("certificate_identifier").(string)
+	input := &rds.ModifyCertificatesInput{
+		CertificateIdentifier: aws.String(id),
+	}
+
+	_, err := conn.ModifyCertificatesWithContext(ctx, input)
+
+	if err != nil {
+		action := create.ErrActionUpdating
+		if d.IsNewResource() {
+			action = create.ErrActionCreating
+		}
+
+		return create.DiagError(names.RDS, action, ResNameCertificate, id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return resourceCertificateRead(ctx, d, meta)
+}
+
+func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	certificate, err := FindDefaultCertificate(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.RDS, create.ErrActionReading, ResNameCertificate, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.RDS, create.ErrActionReading, ResNameCertificate, d.Id(), err)
+	}
+
+	d.Set("certificate_identifier", certificate.CertificateIdentifier)
+
+	if certificate.CustomerOverrideValidTill != nil {
+		d.Set("customer_override_valid_till", aws.TimeValue(certificate.CustomerOverrideValidTill).Format(time.RFC3339))
+	} else {
+		d.Set("customer_override_valid_till", nil)
+	}
+
+	return nil
+}
+
+func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	log.Printf("[DEBUG] Removing RDS Default Certificate override: %s", d.Id())
+	_, err := conn.ModifyCertificatesWithContext(ctx, &rds.ModifyCertificatesInput{
+		RemoveCustomerOverride: aws.Bool(true),
+	})
+
+	if err != nil {
+		return create.DiagError(names.RDS, create.ErrActionDeleting, ResNameCertificate, d.Id(), err)
+	}
+
+	return nil
+}
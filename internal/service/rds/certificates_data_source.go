@@ -0,0 +1,114 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameCertificates = "Certificates"
+)
+
+func DataSourceCertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"customer_override": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"customer_override_valid_till": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_till": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"default_certificate_for_new_launches": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	certificates, defaultCertificate, err := findCertificates(ctx, conn, &rds.DescribeCertificatesInput{})
+
+	if err != nil {
+		return create.DiagError(names.RDS, create.ErrActionReading, ResNameCertificates, "", err)
+	}
+
+	var tfList []interface{}
+
+	for _, certificate := range certificates {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                          aws.StringValue(certificate.CertificateArn),
+			"certificate_identifier":       aws.StringValue(certificate.CertificateIdentifier),
+			"certificate_type":             aws.StringValue(certificate.CertificateType),
+			"customer_override":            aws.BoolValue(certificate.CustomerOverride),
+			"customer_override_valid_till": formatCertificateTime(certificate.CustomerOverrideValidTill),
+			"thumbprint":                   aws.StringValue(certificate.Thumbprint),
+			"valid_from":                   formatCertificateTime(certificate.ValidFrom),
+			"valid_till":                   formatCertificateTime(certificate.ValidTill),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("default_certificate_for_new_launches", defaultCertificate)
+
+	if err := d.Set("certificates", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("setting certificates: %w", err))
+	}
+
+	return nil
+}
+
+func formatCertificateTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
@@ -0,0 +1,39 @@
+package rds_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSCertificatesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_rds_certificates.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccCertificatePreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificatesDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "certificates.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "certificates.0.certificate_type", "CA"),
+					resource.TestMatchResourceAttr(dataSourceName, "certificates.0.certificate_identifier", regexp.MustCompile(`^rds-ca-`)),
+					resource.TestMatchResourceAttr(dataSourceName, "certificates.0.valid_till", regexp.MustCompile(acctest.RFC3339RegexPattern)),
+					resource.TestMatchResourceAttr(dataSourceName, "default_certificate_for_new_launches", regexp.MustCompile(`^rds-ca-`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificatesDataSourceConfig_basic() string {
+	return `
+data "aws_rds_certificates" "test" {}
+`
+}
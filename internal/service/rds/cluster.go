@@ -83,6 +83,19 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -413,9 +426,10 @@ func ResourceCluster() *schema.Resource {
 							ValidateFunc: validation.FloatBetween(0.5, 128),
 						},
 						"min_capacity": {
-							Type:         schema.TypeFloat,
-							Required:     true,
-							ValidateFunc: validation.FloatBetween(0.5, 128),
+							Type:     schema.TypeFloat,
+							Required: true,
+							// A minimum capacity of 0 enables automatic pause and resume.
+							ValidateFunc: validation.FloatBetween(0, 128),
 						},
 					},
 				},
@@ -1094,14 +1108,26 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RDSConn
 
-	if d.HasChangesExcept(
+	exclusions := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"final_snapshot_identifier",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		"tags", "tags_all") {
+		"tags", "tags_all",
+	}
+
+	if clusterBlueGreenUpdateRequired(d) {
+		if err := updateClusterBlueGreen(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("updating RDS Cluster (%s): %w", d.Id(), err)
+		}
+
+		exclusions = append(exclusions, clusterBlueGreenUpdateAttributes...)
+	}
+
+	if d.HasChangesExcept(exclusions...) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -90,6 +90,16 @@ const (
 	InstanceAutomatedBackupStatusRetained    = "retained"
 )
 
+const (
+	BlueGreenDeploymentStatusAvailable            = "AVAILABLE"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	BlueGreenDeploymentStatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenDeploymentStatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
 const (
 	EventSubscriptionStatusActive    = "active"
 	EventSubscriptionStatusCreating  = "creating"
@@ -223,3 +233,18 @@ const (
 	ReservedInstanceStateRetired        = "retired"
 	ReservedInstanceStatePaymentPending = "payment-pending"
 )
+
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
+
+const (
+	ParameterApplyStatusApplying      = "applying"
+	ParameterApplyStatusInSync        = "in-sync"
+	ParameterApplyStatusPendingReboot = "pending-reboot"
+)
@@ -0,0 +1,241 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameExportTask = "Export Task"
+)
+
+func ResourceExportTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceExportTaskCreate,
+		ReadWithoutTimeout:   resourceExportTaskRead,
+		DeleteWithoutTimeout: resourceExportTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"export_only": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"export_task_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 60),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`), "must begin with a letter and contain only alphanumeric and hyphen (-) characters"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`-$`), "cannot end with a hyphen"),
+				),
+			},
+			"failure_cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"percent_progress": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"snapshot_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExportTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	id := d.Get("export_task_identifier").(string)
+	input := &rds.StartExportTaskInput{
+		ExportTaskIdentifier: aws.String(id),
+		IamRoleArn:           aws.String(d.Get("iam_role_arn").(string)),
+		KmsKeyId:             aws.String(d.Get("kms_key_id").(string)),
+		S3BucketName:         aws.String(d.Get("s3_bucket_name").(string)),
+		SourceArn:            aws.String(d.Get("source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("export_only"); ok && len(v.([]interface{})) > 0 {
+		input.ExportOnly = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	_, err := conn.StartExportTaskWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.RDS, create.ErrActionCreating, ResNameExportTask, id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitExportTaskCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.RDS, create.ErrActionWaitingForCreation, ResNameExportTask, d.Id(), err)
+	}
+
+	return resourceExportTaskRead(ctx, d, meta)
+}
+
+func resourceExportTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	task, err := FindExportTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.RDS, create.ErrActionReading, ResNameExportTask, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.RDS, create.ErrActionReading, ResNameExportTask, d.Id(), err)
+	}
+
+	flattenExportTask(d, task)
+
+	return nil
+}
+
+func resourceExportTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	// Completed, failed and canceled export tasks cannot be deleted. Only running tasks are canceled.
+	switch d.Get("status").(string) {
+	case ExportTaskStatusStarting, ExportTaskStatusInProgress:
+	default:
+		log.Printf("[DEBUG] %s %s cannot be deleted. Removing from state: %s", names.RDS, ResNameExportTask, d.Id())
+		return nil
+	}
+
+	log.Printf("[DEBUG] Canceling RDS Export Task: %s", d.Id())
+	_, err := conn.CancelExportTaskWithContext(ctx, &rds.CancelExportTaskInput{
+		ExportTaskIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault) || tfawserr.ErrCodeEquals(err, rds.ErrCodeInvalidExportTaskStateFault) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.RDS, create.ErrActionDeleting, ResNameExportTask, d.Id(), err)
+	}
+
+	if _, err := waitExportTaskCanceled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.RDS, create.ErrActionWaitingForDeletion, ResNameExportTask, d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenExportTask(d *schema.ResourceData, task *rds.ExportTask) {
+	d.Set("export_only", aws.StringValueSlice(task.ExportOnly))
+	d.Set("export_task_identifier", task.ExportTaskIdentifier)
+	d.Set("failure_cause", task.FailureCause)
+	d.Set("iam_role_arn", task.IamRoleArn)
+	d.Set("kms_key_id", task.KmsKeyId)
+	d.Set("percent_progress", task.PercentProgress)
+	d.Set("s3_bucket_name", task.S3Bucket)
+	d.Set("s3_prefix", task.S3Prefix)
+	d.Set("source_arn", task.SourceArn)
+	d.Set("source_type", task.SourceType)
+	d.Set("status", task.Status)
+	d.Set("warning_message", task.WarningMessage)
+
+	if task.SnapshotTime != nil {
+		d.Set("snapshot_time", aws.TimeValue(task.SnapshotTime).Format(time.RFC3339))
+	} else {
+		d.Set("snapshot_time", nil)
+	}
+
+	if task.TaskEndTime != nil {
+		d.Set("task_end_time", aws.TimeValue(task.TaskEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("task_end_time", nil)
+	}
+
+	if task.TaskStartTime != nil {
+		d.Set("task_start_time", aws.TimeValue(task.TaskStartTime).Format(time.RFC3339))
+	} else {
+		d.Set("task_start_time", nil)
+	}
+}
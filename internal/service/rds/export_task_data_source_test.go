@@ -0,0 +1,50 @@
+package rds_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSExportTaskDataSource_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rds_export_task.test"
+	resourceName := "aws_rds_export_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "export_task_identifier", resourceName, "export_task_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_role_arn", resourceName, "iam_role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_bucket_name", resourceName, "s3_bucket_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_arn", resourceName, "source_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_type", resourceName, "source_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_extracted_data_in_gb"),
+				),
+			},
+		},
+	})
+}
+
+func testAccExportTaskDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExportTaskConfig_basic(rName), `
+data "aws_rds_export_task" "test" {
+  export_task_identifier = aws_rds_export_task.test.id
+}
+`)
+}
@@ -0,0 +1,249 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSExportTask_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var exportTask rds.ExportTask
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_export_task.test"
+	snapshotResourceName := "aws_db_snapshot.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	roleResourceName := "aws_iam_role.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(resourceName, &exportTask),
+					resource.TestCheckResourceAttr(resourceName, "export_only.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "export_task_identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", keyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "percent_progress", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_name", bucketResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", snapshotResourceName, "db_snapshot_arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_type", rds.ExportSourceTypeSnapshot),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.ExportTaskStatusComplete),
+					resource.TestCheckResourceAttrSet(resourceName, "task_end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_start_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSExportTask_exportOnly(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var exportTask rds.ExportTask
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_export_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskConfig_exportOnly(rName, "mydb"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(resourceName, &exportTask),
+					resource.TestCheckResourceAttr(resourceName, "export_only.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "export_only.0", "mydb"),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.ExportTaskStatusComplete),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExportTaskDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_export_task" {
+			continue
+		}
+
+		output, err := tfrds.FindExportTaskByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Export tasks cannot be deleted. Running tasks are canceled.
+		switch status := aws.StringValue(output.Status); status {
+		case tfrds.ExportTaskStatusStarting, tfrds.ExportTaskStatusInProgress:
+			return fmt.Errorf("RDS Export Task %s still running (%s)", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckExportTaskExists(n string, v *rds.ExportTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Export Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindExportTaskByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccExportTaskConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotBaseConfig(rName), fmt.Sprintf(`
+resource "aws_db_snapshot" "test" {
+  db_instance_identifier = aws_db_instance.test.id
+  db_snapshot_identifier = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "export.rds.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = [
+          "s3:PutObject*",
+          "s3:ListBucket",
+          "s3:GetObject*",
+          "s3:DeleteObject*",
+          "s3:GetBucketLocation",
+        ]
+        Effect = "Allow"
+        Resource = [
+          aws_s3_bucket.test.arn,
+          "${aws_s3_bucket.test.arn}/*",
+        ]
+      },
+      {
+        Action = [
+          "kms:Decrypt",
+          "kms:Encrypt",
+          "kms:GenerateDataKey",
+          "kms:ReEncryptTo",
+          "kms:GenerateDataKeyWithoutPlaintext",
+          "kms:DescribeKey",
+          "kms:RetireGrant",
+          "kms:CreateGrant",
+          "kms:ReEncryptFrom",
+        ]
+        Effect   = "Allow"
+        Resource = aws_kms_key.test.arn
+      },
+    ]
+  })
+}
+`, rName))
+}
+
+func testAccExportTaskConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExportTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_snapshot.test.db_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccExportTaskConfig_exportOnly(rName, database string) string {
+	return acctest.ConfigCompose(testAccExportTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_snapshot.test.db_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+  export_only            = [%[2]q]
+  s3_prefix              = "exports"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, database))
+}
@@ -37,6 +37,31 @@ func FindDBProxyTarget(conn *rds.RDS, dbProxyName, targetGroupName, targetType,
 	return dbProxyTarget, err
 }
 
+// FindDBProxyTargetsByTrackedClusterID returns the DB instance targets registered for a tracked DB cluster.
+func FindDBProxyTargetsByTrackedClusterID(conn *rds.RDS, dbProxyName, targetGroupName, clusterID string) ([]*rds.DBProxyTarget, error) {
+	input := &rds.DescribeDBProxyTargetsInput{
+		DBProxyName:     aws.String(dbProxyName),
+		TargetGroupName: aws.String(targetGroupName),
+	}
+	var dbProxyTargets []*rds.DBProxyTarget
+
+	err := conn.DescribeDBProxyTargetsPages(input, func(page *rds.DescribeDBProxyTargetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, target := range page.Targets {
+			if aws.StringValue(target.Type) == rds.TargetTypeRdsInstance && aws.StringValue(target.TrackedClusterId) == clusterID {
+				dbProxyTargets = append(dbProxyTargets, target)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return dbProxyTargets, err
+}
+
 // FindDBProxyEndpoint returns matching FindDBProxyEndpoint.
 func FindDBProxyEndpoint(conn *rds.RDS, id string) (*rds.DBProxyEndpoint, error) {
 	dbProxyName, dbProxyEndpointName, err := ProxyEndpointParseID(id)
@@ -517,3 +542,150 @@ func FindReservedDBInstanceByID(ctx context.Context, conn *rds.RDS, id string) (
 
 	return output.ReservedDBInstances[0], nil
 }
+
+func FindBlueGreenDeploymentByID(conn *rds.RDS, id string) (*rds.BlueGreenDeployment, error) {
+	input := &rds.DescribeBlueGreenDeploymentsInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeBlueGreenDeployments(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.BlueGreenDeployments) == 0 || output.BlueGreenDeployments[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BlueGreenDeployments[0], nil
+}
+
+func FindExportTaskByID(ctx context.Context, conn *rds.RDS, id string) (*rds.ExportTask, error) {
+	input := &rds.DescribeExportTasksInput{
+		ExportTaskIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeExportTasksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ExportTasks) == 0 || output.ExportTasks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ExportTasks); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ExportTasks[0], nil
+}
+
+// findDBInstancesByParameterGroupName returns the DB instances using the specified DB parameter group.
+// DescribeDBInstances cannot filter on DB parameter group so all DB instances are listed.
+func findDBInstancesByParameterGroupName(conn *rds.RDS, name string) ([]*rds.DBInstance, error) {
+	input := &rds.DescribeDBInstancesInput{}
+	var output []*rds.DBInstance
+
+	err := conn.DescribeDBInstancesPages(input, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBInstances {
+			if v == nil {
+				continue
+			}
+
+			for _, pg := range v.DBParameterGroups {
+				if aws.StringValue(pg.DBParameterGroupName) == name {
+					output = append(output, v)
+					break
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindDefaultCertificate returns the certificate authority (CA) that overrides the system default CA for new DB instances.
+func FindDefaultCertificate(ctx context.Context, conn *rds.RDS) (*rds.Certificate, error) {
+	input := &rds.DescribeCertificatesInput{}
+
+	certificates, _, err := findCertificates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range certificates {
+		if aws.BoolValue(v.CustomerOverride) {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "no certificate authority override is set",
+		LastRequest: input,
+	}
+}
+
+// findCertificates returns the available certificate authorities (CAs) and the default CA for new DB instances.
+func findCertificates(ctx context.Context, conn *rds.RDS, input *rds.DescribeCertificatesInput) ([]*rds.Certificate, string, error) {
+	var defaultCertificate string
+	var output []*rds.Certificate
+
+	err := conn.DescribeCertificatesPagesWithContext(ctx, input, func(page *rds.DescribeCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		if v := aws.StringValue(page.DefaultCertificateForNewLaunches); v != "" {
+			defaultCertificate = v
+		}
+
+		for _, v := range page.Certificates {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCertificateNotFoundFault) {
+		return nil, "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return output, defaultCertificate, nil
+}
@@ -84,7 +84,7 @@ func expandServerlessV2ScalingConfiguration(tfMap map[string]interface{}) *rds.S
 		apiObject.MaxCapacity = aws.Float64(v)
 	}
 
-	if v, ok := tfMap["min_capacity"].(float64); ok && v != 0.0 {
+	if v, ok := tfMap["min_capacity"].(float64); ok {
 		apiObject.MinCapacity = aws.Float64(v)
 	}
 
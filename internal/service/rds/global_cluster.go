@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 const (
@@ -41,6 +42,11 @@ func ResourceGlobalCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -119,6 +125,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"writer_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 		},
 	}
 }
@@ -212,6 +224,10 @@ func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
 	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+	// A new global cluster has no members until its primary DB cluster is created.
+	if v := globalClusterWriterARN(globalCluster); v != "" {
+		d.Set("writer_db_cluster_arn", v)
+	}
 
 	oldEngineVersion := d.Get("engine_version").(string)
 	newEngineVersion := aws.StringValue(globalCluster.EngineVersion)
@@ -240,6 +256,14 @@ func resourceGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		GlobalClusterIdentifier: aws.String(d.Id()),
 	}
 
+	if d.HasChange("writer_db_cluster_arn") {
+		if v := d.Get("writer_db_cluster_arn").(string); v != "" {
+			if err := globalClusterSwitchover(conn, d.Id(), v, d.Get("allow_data_loss").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
 	if d.HasChange("engine_version") {
 		if err := globalClusterUpgradeEngineVersion(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
@@ -362,6 +386,60 @@ func flattenGlobalClusterMembers(apiObjects []*rds.GlobalClusterMember) []interf
 	return tfList
 }
 
+// globalClusterWriterARN returns the ARN of the primary (writer) DB cluster of a global cluster.
+func globalClusterWriterARN(globalCluster *rds.GlobalCluster) string {
+	for _, member := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(member.IsWriter) {
+			return aws.StringValue(member.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
+// globalClusterSwitchover makes the specified secondary DB cluster the primary cluster of a global cluster.
+// A planned switchover is used unless allowDataLoss is set, in which case an unplanned failover is performed.
+func globalClusterSwitchover(conn *rds.RDS, globalClusterID, dbClusterARN string, allowDataLoss bool, timeout time.Duration) error {
+	globalCluster, err := DescribeGlobalCluster(conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if globalCluster != nil && globalClusterWriterARN(globalCluster) == dbClusterARN {
+		return nil
+	}
+
+	if allowDataLoss {
+		input := &rds.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(dbClusterARN),
+		}
+
+		log.Printf("[DEBUG] Failing over RDS Global Cluster: %s", input)
+		if _, err := conn.FailoverGlobalCluster(input); err != nil {
+			return fmt.Errorf("failing over RDS Global Cluster (%s) to %s: %w", globalClusterID, dbClusterARN, err)
+		}
+	} else {
+		input := &rds.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(dbClusterARN),
+		}
+
+		log.Printf("[DEBUG] Switching over RDS Global Cluster: %s", input)
+		if _, err := conn.SwitchoverGlobalCluster(input); err != nil {
+			return fmt.Errorf("switching over RDS Global Cluster (%s) to %s: %w", globalClusterID, dbClusterARN, err)
+		}
+	}
+
+	if err := waitForGlobalClusterSwitchover(conn, globalClusterID, dbClusterARN, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Global Cluster (%s) switchover to %s: %w", globalClusterID, dbClusterARN, err)
+	}
+
+	return nil
+}
+
 func DescribeGlobalCluster(conn *rds.RDS, globalClusterID string) (*rds.GlobalCluster, error) {
 	var globalCluster *rds.GlobalCluster
 
@@ -478,6 +556,56 @@ func waitForGlobalClusterUpdate(conn *rds.RDS, globalClusterID string, timeout t
 	return err
 }
 
+// globalClusterSwitchoverRefreshFunc reports "available" once the specified DB cluster is the global cluster's writer
+// and no switchover or failover is in progress.
+func globalClusterSwitchoverRefreshFunc(conn *rds.RDS, globalClusterID, dbClusterARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalCluster, err := DescribeGlobalCluster(conn, globalClusterID)
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading RDS Global Cluster (%s): %s", globalClusterID, err)
+		}
+
+		if globalCluster == nil {
+			return nil, "", nil
+		}
+
+		if v := globalCluster.FailoverState; v != nil && aws.StringValue(v.Status) != "" {
+			return globalCluster, aws.StringValue(v.Status), nil
+		}
+
+		if status := aws.StringValue(globalCluster.Status); status != "available" {
+			return globalCluster, status, nil
+		}
+
+		if globalClusterWriterARN(globalCluster) != dbClusterARN {
+			return globalCluster, rds.FailoverStatusPending, nil
+		}
+
+		return globalCluster, "available", nil
+	}
+}
+
+func waitForGlobalClusterSwitchover(conn *rds.RDS, globalClusterID, dbClusterARN string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			rds.FailoverStatusPending,
+			rds.FailoverStatusFailingOver,
+			"modifying",
+			"switching-over",
+		},
+		Target:  []string{"available"},
+		Refresh: globalClusterSwitchoverRefreshFunc(conn, globalClusterID, dbClusterARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) switchover", globalClusterID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func WaitForGlobalClusterDeletion(conn *rds.RDS, globalClusterID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -417,6 +417,42 @@ func TestAccRDSGlobalCluster_EngineVersion_updateMajorMultiRegion(t *testing.T)
 	})
 }
 
+func TestAccRDSGlobalCluster_switchover(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalCluster1, globalCluster2 rds.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckGlobalCluster(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_switchover(rNameGlobal, rNamePrimary, rNameSecondary, "data.aws_region.current.name", rNamePrimary),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster1),
+					resource.TestCheckResourceAttrPair(resourceName, "writer_db_cluster_arn", "aws_rds_cluster.primary", "arn"),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_switchover(rNameGlobal, rNamePrimary, rNameSecondary, "data.aws_region.alternate.name", rNameSecondary),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(resourceName, &globalCluster2),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttrPair(resourceName, "writer_db_cluster_arn", "aws_rds_cluster.secondary", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSGlobalCluster_EngineVersion_auroraMySQL(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -881,6 +917,120 @@ resource "aws_rds_cluster_instance" "secondary" {
 `, rNameGlobal, engine, engineVersion, rNamePrimary, rNameSecondary))
 }
 
+func testAccGlobalClusterConfig_switchover(rNameGlobal, rNamePrimary, rNameSecondary, writerRegion, writerName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_rds_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "aurora-postgresql"
+  engine_version            = "14.6"
+
+  # The secondary cluster depends on the global cluster, so its ARN is built rather than referenced.
+  writer_db_cluster_arn = "arn:${data.aws_partition.current.partition}:rds:${%[4]s}:${data.aws_caller_identity.current.account_id}:cluster:%[5]s"
+}
+
+resource "aws_rds_cluster" "primary" {
+  apply_immediately         = true
+  cluster_identifier        = %[2]q
+  database_name             = "totoro"
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  master_password           = "satsukimae"
+  master_username           = "maesatsuki"
+  skip_final_snapshot       = true
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+}
+
+resource "aws_rds_cluster_instance" "primary" {
+  apply_immediately  = true
+  cluster_identifier = aws_rds_cluster.primary.id
+  engine             = aws_rds_cluster.primary.engine
+  engine_version     = aws_rds_cluster.primary.engine_version
+  identifier         = %[2]q
+  instance_class     = "db.r5.large"
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_db_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[3]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+resource "aws_rds_cluster" "secondary" {
+  provider                  = "awsalternate"
+  apply_immediately         = true
+  cluster_identifier        = %[3]q
+  db_subnet_group_name      = aws_db_subnet_group.alternate.name
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  skip_final_snapshot       = true
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+
+  depends_on = [aws_rds_cluster_instance.primary]
+}
+
+resource "aws_rds_cluster_instance" "secondary" {
+  provider           = "awsalternate"
+  apply_immediately  = true
+  cluster_identifier = aws_rds_cluster.secondary.id
+  engine             = aws_rds_cluster.secondary.engine
+  engine_version     = aws_rds_cluster.secondary.engine_version
+  identifier         = %[3]q
+  instance_class     = "db.r5.large"
+}
+`, rNameGlobal, rNamePrimary, rNameSecondary, writerRegion, writerName))
+}
+
 func testAccGlobalClusterConfig_sourceClusterID(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
@@ -119,6 +119,19 @@ func ResourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"ca_cert_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1590,13 +1603,27 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	// Having allowing_major_version_upgrade by itself should not trigger ModifyDBInstance
 	// as it results in "InvalidParameterCombination: No modifications were requested".
-	if d.HasChangesExcept(
+	exclusions := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"delete_automated_backups",
 		"final_snapshot_identifier",
 		"replicate_source_db",
 		"skip_final_snapshot",
-		"tags", "tags_all") {
+		"tags", "tags_all",
+	}
+
+	blueGreenUpdate := instanceBlueGreenUpdateRequired(d)
+
+	if blueGreenUpdate {
+		if err := updateInstanceBlueGreen(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("updating RDS DB Instance (%s): %w", d.Id(), err)
+		}
+
+		exclusions = append(exclusions, instanceBlueGreenUpdateAttributes...)
+	}
+
+	if d.HasChangesExcept(exclusions...) {
 		input := &rds.ModifyDBInstanceInput{
 			ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
 			DBInstanceIdentifier: aws.String(d.Id()),
@@ -1666,7 +1693,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if d.HasChange("engine_version") {
+		if d.HasChange("engine_version") && !blueGreenUpdate {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 			input.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 		}
@@ -1675,7 +1702,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
 		}
 
-		if d.HasChange("instance_class") {
+		if d.HasChange("instance_class") && !blueGreenUpdate {
 			input.DBInstanceClass = aws.String(d.Get("instance_class").(string))
 		}
 
@@ -1720,7 +1747,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.OptionGroupName = aws.String(d.Get("option_group_name").(string))
 		}
 
-		if d.HasChange("parameter_group_name") {
+		if d.HasChange("parameter_group_name") && !blueGreenUpdate {
 			input.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		}
 
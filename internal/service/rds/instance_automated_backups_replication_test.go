@@ -47,6 +47,35 @@ func TestAccRDSInstanceAutomatedBackupsReplication_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstanceAutomatedBackupsReplication_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExist(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrds.ResourceInstanceAutomatedBackupsReplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccRDSInstanceAutomatedBackupsReplication_retentionPeriod(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
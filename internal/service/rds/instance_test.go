@@ -319,6 +319,94 @@ func TestAccRDSInstance_onlyMajorVersion(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenDeployment_updateInstanceClass(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_blueGreenDeploymentInstanceClass(rName, "db.t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.micro"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_blueGreenDeploymentInstanceClass(rName, "db.t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.small"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"blue_green_update",
+					"delete_automated_backups",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_BlueGreenDeployment_deletionProtection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_blueGreenDeploymentDeletionProtection(rName, "db.t3.micro", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_blueGreenDeploymentDeletionProtection(rName, "db.t3.small", true),
+				ExpectError: regexp.MustCompile(`deletion_protection must be disabled to use blue_green_update`),
+			},
+			{
+				Config: testAccInstanceConfig_blueGreenDeploymentDeletionProtection(rName, "db.t3.micro", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.micro"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_kmsKey(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -4522,6 +4610,15 @@ func testAccCheckInstanceNotRecreated(instance1, instance2 *rds.DBInstance) reso
 	}
 }
 
+func testAccCheckInstanceRecreated(instance1, instance2 *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(instance1.DbiResourceId) == aws.StringValue(instance2.DbiResourceId) {
+			return fmt.Errorf("database instance was not recreated: %s", aws.StringValue(instance1.DbiResourceId))
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -8955,3 +9052,56 @@ resource "aws_db_instance" "test" {
 }
 `, rName))
 }
+
+func testAccInstanceConfig_blueGreenDeploymentInstanceClass(rName, instanceClass string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine = "mysql"
+}
+
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  apply_immediately       = true
+  backup_retention_period = 1
+  engine                  = data.aws_rds_engine_version.test.engine
+  engine_version          = data.aws_rds_engine_version.test.version
+  instance_class          = %[2]q
+  db_name                 = "test"
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, instanceClass)
+}
+
+func testAccInstanceConfig_blueGreenDeploymentDeletionProtection(rName, instanceClass string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine = "mysql"
+}
+
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  apply_immediately       = true
+  backup_retention_period = 1
+  deletion_protection     = %[3]t
+  engine                  = data.aws_rds_engine_version.test.engine
+  engine_version          = data.aws_rds_engine_version.test.version
+  instance_class          = %[2]q
+  db_name                 = "test"
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, instanceClass, deletionProtection)
+}
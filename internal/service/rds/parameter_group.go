@@ -2,6 +2,7 @@ package rds
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -26,7 +28,11 @@ func ResourceParameterGroup() *schema.Resource {
 		Update: resourceParameterGroupUpdate,
 		Delete: resourceParameterGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceParameterGroupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,12 +89,35 @@ func ResourceParameterGroup() *schema.Resource {
 				},
 				Set: resourceParameterHash,
 			},
+			"list_pending_reboot_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"pending_reboot_db_instance_identifiers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"reboot_pending_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"skipped_reboot_db_instance_identifiers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceParameterGroupCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -230,6 +259,27 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 	arn := aws.StringValue(describeResp.DBParameterGroups[0].DBParameterGroupArn)
 	d.Set("arn", arn)
 
+	// Listing the DB instances that use the parameter group requires the rds:DescribeDBInstances permission,
+	// so it is only done when opted in to, or when pending DB instances are to be rebooted.
+	if d.Get("list_pending_reboot_instances").(bool) || d.Get("reboot_pending_instances").(bool) {
+		instances, err := findDBInstancesByParameterGroupName(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("reading RDS DB Instances using DB Parameter Group (%s): %w", d.Id(), err)
+		}
+
+		d.Set("pending_reboot_db_instance_identifiers", parameterGroupPendingRebootInstanceIDs(instances, d.Id()))
+
+		if d.Get("reboot_pending_instances").(bool) {
+			d.Set("skipped_reboot_db_instance_identifiers", parameterGroupPendingRebootUnavailableInstanceIDs(instances, d.Id()))
+		} else {
+			d.Set("skipped_reboot_db_instance_identifiers", nil)
+		}
+	} else {
+		d.Set("pending_reboot_db_instance_identifiers", nil)
+		d.Set("skipped_reboot_db_instance_identifiers", nil)
+	}
+
 	tags, err := ListTags(conn, d.Get("arn").(string))
 
 	if err != nil {
@@ -255,6 +305,9 @@ const maxParamModifyChunk = 20
 func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RDSConn
 
+	// Whether any of the parameter changes only take effect once the DB instances are rebooted.
+	var pendingReboot bool
+
 	if d.HasChange("parameter") {
 		o, n := d.GetChange("parameter")
 		if o == nil {
@@ -269,6 +322,7 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 
 		// Expand the "parameter" set to aws-sdk-go compat []rds.Parameter
 		parameters := ExpandParameters(ns.Difference(os).List())
+		pendingReboot = parametersPendingReboot(parameters)
 
 		if len(parameters) > 0 {
 			// We can only modify 20 parameters at a time, so walk them until
@@ -311,6 +365,8 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 			resetParameters = append(resetParameters, v)
 		}
 		if len(resetParameters) > 0 {
+			pendingReboot = pendingReboot || parametersPendingReboot(resetParameters)
+
 			for resetParameters != nil {
 				var paramsToReset []*rds.Parameter
 				if len(resetParameters) <= maxParamModifyChunk {
@@ -335,6 +391,14 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// DB instances pending a reboot are rebooted whenever there are any, not only after parameter changes.
+	// A plan is made for pending_reboot_db_instance_identifiers while any of them can be rebooted.
+	if d.Get("reboot_pending_instances").(bool) && !d.IsNewResource() && d.HasChanges("parameter", "pending_reboot_db_instance_identifiers", "reboot_pending_instances") {
+		if err := rebootParameterGroupPendingInstances(conn, d.Id(), pendingReboot, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -370,6 +434,130 @@ func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceParameterGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("list_pending_reboot_instances", false)
+	d.Set("reboot_pending_instances", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceParameterGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("reboot_pending_instances").(bool) {
+		return nil
+	}
+
+	// Plan a change while DB instances that can be rebooted are pending a reboot so that Update reboots them.
+	// DB instances that are not available, e.g. stopped, cannot be rebooted and would otherwise cause a change on every plan.
+	pending := diff.Get("pending_reboot_db_instance_identifiers").(*schema.Set)
+	skipped := diff.Get("skipped_reboot_db_instance_identifiers").(*schema.Set)
+
+	if pending.Difference(skipped).Len() > 0 {
+		if err := diff.SetNewComputed("pending_reboot_db_instance_identifiers"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("skipped_reboot_db_instance_identifiers")
+	}
+
+	return nil
+}
+
+// rebootParameterGroupPendingInstances waits for parameter changes to be applied to the available DB instances
+// using the DB parameter group and then reboots those instances on which static parameter changes are
+// pending a reboot.
+// DB instances that are not available, e.g. stopped, cannot be rebooted and are skipped.
+// If pendingReboot is set, changes that only take effect on reboot have just been made, and the wait
+// continues until every DB instance has picked them up rather than stopping at a stale "in-sync".
+func rebootParameterGroupPendingInstances(conn *rds.RDS, name string, pendingReboot bool, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	instances, err := waitDBParameterGroupApplied(conn, name, pendingReboot, deadline.Remaining())
+
+	if err != nil {
+		return fmt.Errorf("waiting for RDS DB Parameter Group (%s) changes to apply: %w", name, err)
+	}
+
+	for _, id := range parameterGroupPendingRebootAvailableInstanceIDs(instances, name) {
+		log.Printf("[DEBUG] Rebooting RDS DB Instance (%s) to apply DB Parameter Group (%s) changes", id, name)
+		_, err := conn.RebootDBInstance(&rds.RebootDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+		})
+
+		if err != nil {
+			return fmt.Errorf("rebooting RDS DB Instance (%s): %w", id, err)
+		}
+
+		if _, err := waitDBInstanceUpdated(conn, id, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// parameterGroupPendingRebootInstanceIDs returns the identifiers of the DB instances on which changes to the
+// specified DB parameter group are pending a reboot.
+func parameterGroupPendingRebootInstanceIDs(instances []*rds.DBInstance, name string) []string {
+	var ids []string
+
+	for _, instance := range instances {
+		if parameterGroupApplyStatus(instance, name) == ParameterApplyStatusPendingReboot {
+			ids = append(ids, aws.StringValue(instance.DBInstanceIdentifier))
+		}
+	}
+
+	return ids
+}
+
+// parameterGroupPendingRebootAvailableInstanceIDs returns the identifiers of the available DB instances on which
+// changes to the specified DB parameter group are pending a reboot.
+func parameterGroupPendingRebootAvailableInstanceIDs(instances []*rds.DBInstance, name string) []string {
+	var ids []string
+
+	for _, instance := range instances {
+		if aws.StringValue(instance.DBInstanceStatus) == InstanceStatusAvailable && parameterGroupApplyStatus(instance, name) == ParameterApplyStatusPendingReboot {
+			ids = append(ids, aws.StringValue(instance.DBInstanceIdentifier))
+		}
+	}
+
+	return ids
+}
+
+// parameterGroupPendingRebootUnavailableInstanceIDs returns the identifiers of the DB instances that are not available,
+// and so cannot be rebooted, on which changes to the specified DB parameter group are pending a reboot.
+func parameterGroupPendingRebootUnavailableInstanceIDs(instances []*rds.DBInstance, name string) []string {
+	var ids []string
+
+	for _, instance := range instances {
+		if aws.StringValue(instance.DBInstanceStatus) != InstanceStatusAvailable && parameterGroupApplyStatus(instance, name) == ParameterApplyStatusPendingReboot {
+			ids = append(ids, aws.StringValue(instance.DBInstanceIdentifier))
+		}
+	}
+
+	return ids
+}
+
+// parametersPendingReboot returns whether any of the specified parameters only take effect on reboot.
+func parametersPendingReboot(parameters []*rds.Parameter) bool {
+	for _, v := range parameters {
+		if aws.StringValue(v.ApplyMethod) == rds.ApplyMethodPendingReboot {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parameterGroupApplyStatus(instance *rds.DBInstance, name string) string {
+	for _, v := range instance.DBParameterGroups {
+		if aws.StringValue(v.DBParameterGroupName) == name {
+			return aws.StringValue(v.ParameterApplyStatus)
+		}
+	}
+
+	return ""
+}
+
 func resourceParameterHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -752,6 +752,73 @@ func TestAccRDSParameterGroup_caseParameters(t *testing.T) {
 	})
 }
 
+func TestAccRDSParameterGroup_rebootPendingInstances(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBParameterGroup
+	resourceName := "aws_db_parameter_group.test"
+	instanceResourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig_rebootPendingInstances(rName, "0", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "list_pending_reboot_instances", "false"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_pending_instances", "false"),
+				),
+			},
+			{
+				// Changing a static parameter without rebooting leaves the DB instance pending a reboot.
+				Config: testAccParameterGroupConfig_rebootPendingInstances(rName, "1", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_db_instance_identifiers.#", "0"),
+				),
+			},
+			{
+				// Pending DB instances are listed, but not rebooted, with list_pending_reboot_instances.
+				Config: testAccParameterGroupConfig_rebootPendingInstances(rName, "1", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_db_instance_identifiers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pending_reboot_db_instance_identifiers.*", instanceResourceName, "identifier"),
+				),
+			},
+			{
+				// Listing alone does not plan a change.
+				Config:             testAccParameterGroupConfig_rebootPendingInstances(rName, "1", true, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				// Enabling reboot_pending_instances reboots the pending DB instance without a parameter change.
+				Config: testAccParameterGroupConfig_rebootPendingInstances(rName, "1", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_db_instance_identifiers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_pending_instances", "true"),
+					resource.TestCheckResourceAttr(resourceName, "skipped_reboot_db_instance_identifiers.#", "0"),
+				),
+			},
+			{
+				// Static parameter changes are picked up by the DB instance and rebooted in the same apply.
+				Config: testAccParameterGroupConfig_rebootPendingInstances(rName, "0", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_db_instance_identifiers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestDBParameterModifyChunk(t *testing.T) {
 	cases := []struct {
 		Name              string
@@ -1769,6 +1836,46 @@ resource "aws_db_parameter_group" "test" {
 `, rName, paramName)
 }
 
+func testAccParameterGroupConfig_rebootPendingInstances(rName, performanceSchema string, listPendingRebootInstances, rebootPendingInstances bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  preferred_instance_classes = ["db.t3.micro", "db.t2.micro", "db.t3.small"]
+}
+
+resource "aws_db_parameter_group" "test" {
+  name                          = %[1]q
+  family                        = data.aws_rds_engine_version.default.parameter_group_family
+  list_pending_reboot_instances = %[3]t
+  reboot_pending_instances      = %[4]t
+
+  parameter {
+    name         = "performance_schema"
+    value        = %[2]q
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage       = 10
+  backup_retention_period = 0
+  engine                  = data.aws_rds_engine_version.default.engine
+  engine_version          = data.aws_rds_engine_version.default.version
+  identifier              = %[1]q
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  parameter_group_name    = aws_db_parameter_group.test.name
+  password                = "avoid-plaintext-passwords"
+  skip_final_snapshot     = true
+  username                = "tfacctest"
+}
+`, rName, performanceSchema, listPendingRebootInstances, rebootPendingInstances)
+}
+
 const testAccDBParameterGroupConfig_namePrefix = `
 resource "aws_db_parameter_group" "test" {
   name_prefix = "tf-test-"
@@ -77,6 +77,11 @@ func ResourceProxyTarget() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tracked_db_instance_identifiers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -113,7 +118,24 @@ func resourceProxyTargetCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error registering RDS DB Proxy (%s/%s) Target: %w", dbProxyName, targetGroupName, err)
 	}
 
-	dbProxyTarget := outputRaw.(*rds.RegisterDBProxyTargetsOutput).DBProxyTargets[0]
+	// Registering a DB cluster also registers each of its DB instances, so pick out the requested target.
+	targetType := rds.TargetTypeRdsInstance
+	if _, ok := d.GetOk("db_cluster_identifier"); ok {
+		targetType = rds.TargetTypeTrackedCluster
+	}
+
+	var dbProxyTarget *rds.DBProxyTarget
+
+	for _, v := range outputRaw.(*rds.RegisterDBProxyTargetsOutput).DBProxyTargets {
+		if aws.StringValue(v.Type) == targetType {
+			dbProxyTarget = v
+			break
+		}
+	}
+
+	if dbProxyTarget == nil {
+		return fmt.Errorf("error registering RDS DB Proxy (%s/%s) Target: %s target not found in response", dbProxyName, targetGroupName, targetType)
+	}
 
 	d.SetId(strings.Join([]string{dbProxyName, targetGroupName, aws.StringValue(dbProxyTarget.Type), aws.StringValue(dbProxyTarget.RdsResourceId)}, "/"))
 
@@ -171,8 +193,23 @@ func resourceProxyTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if aws.StringValue(dbProxyTarget.Type) == rds.TargetTypeRdsInstance {
 		d.Set("db_instance_identifier", dbProxyTarget.RdsResourceId)
+		d.Set("tracked_db_instance_identifiers", nil)
 	} else {
 		d.Set("db_cluster_identifier", dbProxyTarget.RdsResourceId)
+
+		// RDS Proxy keeps the DB instances registered for a tracked cluster in step with the cluster's membership.
+		instanceTargets, err := FindDBProxyTargetsByTrackedClusterID(conn, dbProxyName, targetGroupName, aws.StringValue(dbProxyTarget.RdsResourceId))
+
+		if err != nil {
+			return fmt.Errorf("error reading RDS DB Proxy Target (%s) tracked DB instances: %w", d.Id(), err)
+		}
+
+		var instanceIDs []string
+		for _, v := range instanceTargets {
+			instanceIDs = append(instanceIDs, aws.StringValue(v.RdsResourceId))
+		}
+
+		d.Set("tracked_db_instance_identifiers", instanceIDs)
 	}
 
 	return nil
@@ -38,6 +38,7 @@ func TestAccRDSProxyTarget_instance(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rds_resource_id", rName),
 					resource.TestCheckResourceAttr(resourceName, "target_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, "tracked_cluster_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tracked_db_instance_identifiers.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "type", "RDS_INSTANCE"),
 				),
 			},
@@ -74,6 +75,7 @@ func TestAccRDSProxyTarget_cluster(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rds_resource_id", rName),
 					resource.TestCheckResourceAttr(resourceName, "target_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, "tracked_cluster_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tracked_db_instance_identifiers.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "type", "TRACKED_CLUSTER"),
 				),
 			},
@@ -86,6 +88,39 @@ func TestAccRDSProxyTarget_cluster(t *testing.T) {
 	})
 }
 
+func TestAccRDSProxyTarget_clusterTrackedInstances(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxyTarget rds.DBProxyTarget
+	resourceName := "aws_db_proxy_target.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyTargetConfig_clusterInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyTargetExists(resourceName, &dbProxyTarget),
+					resource.TestCheckResourceAttr(resourceName, "type", "TRACKED_CLUSTER"),
+					resource.TestCheckResourceAttr(resourceName, "tracked_db_instance_identifiers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "tracked_db_instance_identifiers.*", "aws_rds_cluster_instance.test", "identifier"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccRDSProxyTarget_disappears(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -362,3 +397,44 @@ resource "aws_db_proxy_target" "test" {
 }
 `, rName))
 }
+
+func testAccProxyTargetConfig_clusterInstance(rName string) string {
+	return acctest.ConfigCompose(testAccProxyTargetBaseConfig(rName), fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine = "aurora-mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large"]
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier     = %[1]q
+  db_subnet_group_name   = aws_db_subnet_group.test.id
+  engine                 = data.aws_rds_engine_version.test.engine
+  engine_version         = data.aws_rds_engine_version.test.version
+  master_username        = "test"
+  master_password        = "testtest"
+  skip_final_snapshot    = true
+  vpc_security_group_ids = [aws_security_group.test.id]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_db_proxy_target" "test" {
+  db_cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  db_proxy_name         = aws_db_proxy.test.name
+  target_group_name     = "default"
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName))
+}
@@ -373,6 +373,46 @@ func TestAccRDSProxy_authIAMAuth(t *testing.T) {
 	})
 }
 
+func TestAccRDSProxy_authClientPasswordAuthType(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxy rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig_authClientPasswordAuthType(rName, "DISABLED", "MYSQL_NATIVE_PASSWORD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.client_password_auth_type", "MYSQL_NATIVE_PASSWORD"),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.iam_auth", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProxyConfig_authClientPasswordAuthType(rName, "REQUIRED", "MYSQL_NATIVE_PASSWORD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.client_password_auth_type", "MYSQL_NATIVE_PASSWORD"),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.iam_auth", "REQUIRED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSProxy_authSecretARN(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -898,6 +938,32 @@ resource "aws_db_proxy" "test" {
 `, rName, iamAuth)
 }
 
+func testAccProxyConfig_authClientPasswordAuthType(rName, iamAuth, clientPasswordAuthType string) string {
+	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_iam_role_policy.test
+  ]
+
+  name                   = "%[1]s"
+  engine_family          = "MYSQL"
+  role_arn               = aws_iam_role.test.arn
+  require_tls            = true
+  vpc_security_group_ids = [aws_security_group.test.id]
+  vpc_subnet_ids         = aws_subnet.test.*.id
+
+  auth {
+    auth_scheme               = "SECRETS"
+    client_password_auth_type = "%[3]s"
+    description               = "test"
+    iam_auth                  = "%[2]s"
+    secret_arn                = aws_secretsmanager_secret.test.arn
+  }
+}
+`, rName, iamAuth, clientPasswordAuthType)
+}
+
 func testAccProxyConfig_authSecretARN(rName, nName string) string {
 	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
@@ -45,3 +45,36 @@ data "aws_rds_reserved_instance_offering" "test" {
 }
 `
 }
+
+func TestAccRDSInstanceOfferingsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_rds_reserved_instance_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceOfferingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "offerings.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.db_instance_class", "db.t3.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.duration", "31536000"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.product_description", "postgresql"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.offering_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceOfferingsConfig_basic() string {
+	return `
+data "aws_rds_reserved_instance_offerings" "test" {
+  db_instance_class   = "db.t3.micro"
+  duration            = 31536000
+  product_description = "postgresql"
+}
+`
+}
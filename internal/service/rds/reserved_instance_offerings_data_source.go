@@ -0,0 +1,164 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameReservedInstanceOfferings = "Reserved Instance Offerings"
+)
+
+func DataSourceReservedOfferings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReservedOfferingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"db_instance_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Partial Upfront",
+					"All Upfront",
+					"No Upfront",
+				}, false),
+			},
+			"offerings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"currency_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fixed_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"multi_az": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"offering_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offering_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedOfferingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	input := &rds.DescribeReservedDBInstancesOfferingsInput{}
+
+	if v, ok := d.GetOk("db_instance_class"); ok {
+		input.DBInstanceClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("duration"); ok {
+		input.Duration = aws.String(strconv.Itoa(v.(int)))
+	}
+
+	if v, ok := d.GetOkExists("multi_az"); ok {
+		input.MultiAZ = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("offering_type"); ok {
+		input.OfferingType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("product_description"); ok {
+		input.ProductDescription = aws.String(v.(string))
+	}
+
+	var tfList []interface{}
+
+	err := conn.DescribeReservedDBInstancesOfferingsPagesWithContext(ctx, input, func(page *rds.DescribeReservedDBInstancesOfferingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, offering := range page.ReservedDBInstancesOfferings {
+			if offering == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"currency_code":       aws.StringValue(offering.CurrencyCode),
+				"db_instance_class":   aws.StringValue(offering.DBInstanceClass),
+				"duration":            aws.Int64Value(offering.Duration),
+				"fixed_price":         aws.Float64Value(offering.FixedPrice),
+				"multi_az":            aws.BoolValue(offering.MultiAZ),
+				"offering_id":         aws.StringValue(offering.ReservedDBInstancesOfferingId),
+				"offering_type":       aws.StringValue(offering.OfferingType),
+				"product_description": aws.StringValue(offering.ProductDescription),
+				"usage_price":         aws.Float64Value(offering.UsagePrice),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.DiagError(names.RDS, create.ErrActionReading, ResNameReservedInstanceOfferings, "", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("offerings", tfList); err != nil {
+		return diag.FromErr(fmt.Errorf("setting offerings: %w", err))
+	}
+
+	return nil
+}
@@ -190,3 +190,67 @@ func statusReservedInstance(ctx context.Context, conn *rds.RDS, id string) resou
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func statusBlueGreenDeployment(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBlueGreenDeploymentByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusExportTask(ctx context.Context, conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindExportTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusDBParameterGroupApply returns "applying" while changes to the DB parameter group are being applied
+// to any of the available DB instances using it.
+// If pendingReboot is set, a DB instance that is still "in-sync" has not yet picked up changes that require
+// a reboot and is also treated as "applying".
+// DB instances that are not available, e.g. stopped, may not pick up changes until they are started and are ignored.
+func statusDBParameterGroupApply(conn *rds.RDS, name string, pendingReboot bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBInstancesByParameterGroupName(conn, name)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output {
+			if aws.StringValue(v.DBInstanceStatus) != InstanceStatusAvailable {
+				continue
+			}
+
+			switch parameterGroupApplyStatus(v, name) {
+			case ParameterApplyStatusApplying:
+				return output, ParameterApplyStatusApplying, nil
+			case ParameterApplyStatusInSync:
+				if pendingReboot {
+					return output, ParameterApplyStatusApplying, nil
+				}
+			}
+		}
+
+		return output, ParameterApplyStatusInSync, nil
+	}
+}
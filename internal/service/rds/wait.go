@@ -2,13 +2,16 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,7 +32,7 @@ func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Event Subscription (%s) creation", id))
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -48,7 +51,7 @@ func waitEventSubscriptionDeleted(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Event Subscription (%s) deletion", id))
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -68,7 +71,7 @@ func waitEventSubscriptionUpdated(conn *rds.RDS, id string, timeout time.Duratio
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Event Subscription (%s) update", id))
 
 	if output, ok := outputRaw.(*rds.EventSubscription); ok {
 		return output, err
@@ -89,7 +92,7 @@ func waitDBProxyEndpointAvailable(conn *rds.RDS, id string, timeout time.Duratio
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Proxy Endpoint (%s) to become available", id))
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -107,7 +110,7 @@ func waitDBProxyEndpointDeleted(conn *rds.RDS, id string, timeout time.Duration)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Proxy Endpoint (%s) deletion", id))
 
 	if output, ok := outputRaw.(*rds.DBProxyEndpoint); ok {
 		return output, err
@@ -134,7 +137,7 @@ func waitDBClusterCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Cluster (%s) creation", id))
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -158,7 +161,7 @@ func waitDBClusterDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Cluster (%s) deletion", id))
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -184,7 +187,7 @@ func waitDBClusterUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rds
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Cluster (%s) update", id))
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -201,7 +204,7 @@ func waitDBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN str
 		Timeout: dbClusterRoleAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Cluster (%s) IAM Role (%s) association", dbClusterID, roleARN))
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -218,7 +221,7 @@ func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN str
 		Timeout: dbClusterRoleAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Cluster (%s) IAM Role (%s) disassociation", dbClusterID, roleARN))
 
 	if output, ok := outputRaw.(*rds.DBClusterRole); ok {
 		return output, err
@@ -252,7 +255,7 @@ func waitDBInstanceCreated(conn *rds.RDS, id string, timeout time.Duration) (*rd
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Instance (%s) creation", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -286,7 +289,7 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Instance (%s) deletion", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -322,7 +325,7 @@ func waitDBInstanceUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rd
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Instance (%s) update", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -355,7 +358,7 @@ func waitDBClusterInstanceCreated(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Cluster Instance (%s) creation", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -378,7 +381,7 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Cluster Instance (%s) deletion", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -411,7 +414,7 @@ func waitDBClusterInstanceUpdated(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Cluster Instance (%s) update", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -433,7 +436,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.RDS, dbClusterArn
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("RDS Cluster Activity Stream (%s) to start", dbClusterArn))
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS Cluster Activity Stream (%s) to be started: %v", dbClusterArn, err)
 	}
@@ -453,7 +456,7 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.RDS, dbClusterArn
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("RDS Cluster Activity Stream (%s) to stop", dbClusterArn))
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS Cluster Activity Stream (%s) to be stopped: %v", dbClusterArn, err)
 	}
@@ -468,7 +471,7 @@ func waitDBInstanceAutomatedBackupCreated(conn *rds.RDS, arn string, timeout tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Instance Automated Backup (%s) creation", arn))
 
 	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
 		return output, err
@@ -487,7 +490,7 @@ func waitDBInstanceAutomatedBackupDeleted(conn *rds.RDS, dbInstanceID, dbInstanc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Instance Automated Backup (%s) deletion", dbInstanceAutomatedBackupsARN))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -504,7 +507,7 @@ func waitDBProxyCreated(conn *rds.RDS, name string, timeout time.Duration) (*rds
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Proxy (%s) creation", name))
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -521,7 +524,7 @@ func waitDBProxyDeleted(conn *rds.RDS, name string, timeout time.Duration) (*rds
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Proxy (%s) deletion", name))
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -538,7 +541,7 @@ func waitDBProxyUpdated(conn *rds.RDS, name string, timeout time.Duration) (*rds
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Proxy (%s) update", name))
 
 	if output, ok := outputRaw.(*rds.DBProxy); ok {
 		return output, err
@@ -560,7 +563,132 @@ func waitReservedInstanceCreated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:          30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("RDS Reserved Instance (%s) to become active", id))
 
 	return err
 }
+
+func waitBlueGreenDeploymentAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{BlueGreenDeploymentStatusProvisioning},
+		Target:     []string{BlueGreenDeploymentStatusAvailable},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Blue/Green Deployment (%s) to become available", id))
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentSwitchoverCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{BlueGreenDeploymentStatusAvailable, BlueGreenDeploymentStatusSwitchoverInProgress},
+		Target:     []string{BlueGreenDeploymentStatusSwitchoverCompleted},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Blue/Green Deployment (%s) switchover", id))
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BlueGreenDeploymentStatusAvailable,
+			BlueGreenDeploymentStatusDeleting,
+			BlueGreenDeploymentStatusSwitchoverCompleted,
+		},
+		Target:     []string{},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS Blue/Green Deployment (%s) deletion", id))
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCompleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress},
+		Target:     []string{ExportTaskStatusComplete},
+		Refresh:    statusExportTask(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("RDS Export Task (%s) completion", id))
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		if aws.StringValue(output.Status) == ExportTaskStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureCause)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCanceled(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress, ExportTaskStatusCanceling},
+		Target:     []string{ExportTaskStatusCanceled},
+		Refresh:    statusExportTask(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("RDS Export Task (%s) cancellation", id))
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBParameterGroupApplied(conn *rds.RDS, name string, pendingReboot bool, timeout time.Duration) ([]*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{ParameterApplyStatusApplying},
+		Target:                    []string{ParameterApplyStatusInSync},
+		Refresh:                   statusDBParameterGroupApply(conn, name, pendingReboot),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		Delay:                     10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, fmt.Sprintf("RDS DB Parameter Group (%s) parameters to be applied", name))
+
+	if output, ok := outputRaw.([]*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -106,6 +106,10 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"cluster_namespace_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"cluster_parameter_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -275,6 +279,10 @@ func ResourceCluster() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`(?i)^[a-z_]`), "first character must be a letter"),
 				),
 			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -432,6 +440,11 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		input.AvailabilityZoneRelocation = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("multi_az"); ok {
+		backupInput.MultiAZ = aws.Bool(v.(bool))
+		input.MultiAZ = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("cluster_parameter_group_name"); ok {
 		backupInput.ClusterParameterGroupName = aws.String(v.(string))
 		input.ClusterParameterGroupName = aws.String(v.(string))
@@ -618,6 +631,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting cluster_nodes: %w", err)
 	}
 	d.Set("cluster_parameter_group_name", rsc.ClusterParameterGroups[0].ParameterGroupName)
+	d.Set("cluster_namespace_arn", rsc.ClusterNamespaceArn)
 	d.Set("cluster_public_key", rsc.ClusterPublicKey)
 	d.Set("cluster_revision_number", rsc.ClusterRevisionNumber)
 	d.Set("cluster_subnet_group_name", rsc.ClusterSubnetGroupName)
@@ -638,6 +652,11 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("maintenance_track_name", rsc.MaintenanceTrackName)
 	d.Set("manual_snapshot_retention_period", rsc.ManualSnapshotRetentionPeriod)
 	d.Set("master_username", rsc.MasterUsername)
+	multiAZ, err := clusterMultiAZStatus(rsc)
+	if err != nil {
+		return err
+	}
+	d.Set("multi_az", multiAZ)
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
@@ -699,7 +718,14 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RedshiftConn
 
-	if d.HasChangesExcept("aqua_configuration_status", "availability_zone", "iam_roles", "logging", "snapshot_copy", "tags", "tags_all") {
+	// Multi-AZ cannot be changed at the same time as other settings, and changing it
+	// also changes the Availability Zone relocation setting, so both are handled below.
+	except := []string{"aqua_configuration_status", "availability_zone", "iam_roles", "logging", "multi_az", "snapshot_copy", "tags", "tags_all"}
+	if d.HasChange("multi_az") {
+		except = append(except, "availability_zone_relocation_enabled")
+	}
+
+	if d.HasChangesExcept(except...) {
 		input := &redshift.ModifyClusterInput{
 			ClusterIdentifier: aws.String(d.Id()),
 		}
@@ -712,7 +738,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.AutomatedSnapshotRetentionPeriod = aws.Int64(int64(d.Get("automated_snapshot_retention_period").(int)))
 		}
 
-		if d.HasChange("availability_zone_relocation_enabled") {
+		if d.HasChange("availability_zone_relocation_enabled") && !d.HasChange("multi_az") {
 			input.AvailabilityZoneRelocation = aws.Bool(d.Get("availability_zone_relocation_enabled").(bool))
 		}
 
@@ -865,6 +891,12 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("multi_az") {
+		if err := modifyClusterMultiAZ(conn, d.Id(), d.Get("multi_az").(bool), d.Get("availability_zone_relocation_enabled").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	// Availability Zone cannot be changed at the same time as other settings
 	if d.HasChange("availability_zone") {
 		input := &redshift.ModifyClusterInput{
@@ -1090,6 +1122,78 @@ func flattenClusterNodes(apiObjects []*redshift.ClusterNode) []interface{} {
 	return tfList
 }
 
+// modifyClusterMultiAZ enables or disables a cluster's Multi-AZ deployment.
+// Redshift manages Availability Zone relocation along with Multi-AZ (disabling
+// Multi-AZ enables relocation), so the desired relocation setting is applied in
+// a second call once the Multi-AZ change has completed.
+func modifyClusterMultiAZ(conn *redshift.Redshift, id string, multiAZ, availabilityZoneRelocation bool, timeout time.Duration) error {
+	input := &redshift.ModifyClusterInput{
+		ClusterIdentifier: aws.String(id),
+		MultiAZ:           aws.Bool(multiAZ),
+	}
+
+	log.Printf("[DEBUG] Modifying Redshift Cluster Multi-AZ: %s", input)
+	_, err := conn.ModifyCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("modifying Redshift Cluster (%s) Multi-AZ: %w", id, err)
+	}
+
+	cluster, err := waitClusterUpdated(conn, id, timeout)
+
+	if err != nil {
+		return fmt.Errorf("waiting for Redshift Cluster (%s) update: %w", id, err)
+	}
+
+	if multiAZ {
+		return nil
+	}
+
+	azr, err := clusterAvailabilityZoneRelocationStatus(cluster)
+
+	if err != nil {
+		return err
+	}
+
+	if azr == availabilityZoneRelocation {
+		return nil
+	}
+
+	input = &redshift.ModifyClusterInput{
+		AvailabilityZoneRelocation: aws.Bool(availabilityZoneRelocation),
+		ClusterIdentifier:          aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Modifying Redshift Cluster Availability Zone Relocation: %s", input)
+	_, err = conn.ModifyCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("modifying Redshift Cluster (%s) Availability Zone Relocation: %w", id, err)
+	}
+
+	if _, err := waitClusterUpdated(conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for Redshift Cluster (%s) update: %w", id, err)
+	}
+
+	if _, err := waitClusterRelocationStatusResolved(conn, id); err != nil {
+		return fmt.Errorf("waiting for Redshift Cluster (%s) Availability Zone Relocation Status resolution: %w", id, err)
+	}
+
+	return nil
+}
+
+func clusterMultiAZStatus(cluster *redshift.Cluster) (bool, error) {
+	// MultiAZ is returned as a string by the API, but is exposed as a bool like the other settings.
+	switch multiAZStatus := aws.StringValue(cluster.MultiAZ); strings.ToLower(multiAZStatus) {
+	case "enabled":
+		return true, nil
+	case "disabled", "":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected MultiAZ value %q returned by API", multiAZStatus)
+	}
+}
+
 func clusterAvailabilityZoneRelocationStatus(cluster *redshift.Cluster) (bool, error) {
 	// AvailabilityZoneRelocation is not returned by the API, and AvailabilityZoneRelocationStatus is not implemented as Const at this time.
 	switch availabilityZoneRelocationStatus := aws.StringValue(cluster.AvailabilityZoneRelocationStatus); availabilityZoneRelocationStatus {
@@ -147,6 +147,10 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -274,6 +278,11 @@ func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("kms_key_id", rsc.KmsKeyId)
 	d.Set("master_username", rsc.MasterUsername)
+	multiAZ, err := clusterMultiAZStatus(rsc)
+	if err != nil {
+		return err
+	}
+	d.Set("multi_az", multiAZ)
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set("port", rsc.Endpoint.Port)
@@ -0,0 +1,167 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataShareAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataShareAuthorizationCreate,
+		Read:   resourceDataShareAuthorizationRead,
+		Delete: resourceDataShareAuthorizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_writes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"consumer_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	consumerIdentifier := d.Get("consumer_identifier").(string)
+	id := DataShareAuthorizationCreateResourceID(dataShareARN, consumerIdentifier)
+	input := &redshift.AuthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerIdentifier),
+		DataShareArn:       aws.String(dataShareARN),
+	}
+
+	if v, ok := d.GetOk("allow_writes"); ok {
+		input.AllowWrites = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Data Share Authorization: %s", input)
+	_, err := conn.AuthorizeDataShare(input)
+
+	if err != nil {
+		return fmt.Errorf("creating Redshift Data Share Authorization (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDataShareAuthorizationRead(d, meta)
+}
+
+func resourceDataShareAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN, consumerIdentifier, err := DataShareAuthorizationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindDataShareAuthorizationByID(conn, dataShareARN, consumerIdentifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Redshift Data Share Authorization (%s): %w", d.Id(), err)
+	}
+
+	dataShare, err := findDataShareByARN(conn, dataShareARN)
+
+	if err != nil {
+		return fmt.Errorf("reading Redshift Data Share (%s): %w", dataShareARN, err)
+	}
+
+	d.Set("allow_writes", association.ProducerAllowedWrites)
+	d.Set("consumer_identifier", association.ConsumerIdentifier)
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("managed_by", dataShare.ManagedBy)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return nil
+}
+
+func resourceDataShareAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN, consumerIdentifier, err := DataShareAuthorizationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Authorization: %s", d.Id())
+	_, err = conn.DeauthorizeDataShare(&redshift.DeauthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerIdentifier),
+		DataShareArn:       aws.String(dataShareARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Redshift Data Share Authorization (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const dataShareAuthorizationResourceIDSeparator = ","
+
+func DataShareAuthorizationCreateResourceID(dataShareARN, consumerIdentifier string) string {
+	parts := []string{dataShareARN, consumerIdentifier}
+	id := strings.Join(parts, dataShareAuthorizationResourceIDSeparator)
+
+	return id
+}
+
+func DataShareAuthorizationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, dataShareAuthorizationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DATA-SHARE-ARN%[2]sCONSUMER-IDENTIFIER", id, dataShareAuthorizationResourceIDSeparator)
+}
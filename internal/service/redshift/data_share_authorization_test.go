@@ -0,0 +1,185 @@
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftDataShareAuthorization_basic(t *testing.T) {
+	resourceName := "aws_redshift_data_share_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckDataShareAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_writes", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "consumer_identifier", "data.aws_caller_identity.consumer", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "producer_arn", "aws_redshift_cluster.test", "cluster_namespace_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", redshift.DataShareStatusAuthorized),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareAuthorization_disappears(t *testing.T) {
+	resourceName := "aws_redshift_data_share_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckDataShareAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceDataShareAuthorization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareAuthorizationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_data_share_authorization" {
+			continue
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfredshift.FindDataShareAuthorizationByID(conn, dataShareARN, consumerIdentifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Data Share Authorization %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataShareAuthorizationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Authorization ID is set")
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		_, err = tfredshift.FindDataShareAuthorizationByID(conn, dataShareARN, consumerIdentifier)
+
+		return err
+	}
+}
+
+// testAccDataShareConfig_base creates a data share named rName on a cluster
+// managed by the given provider alias ("" for the default provider).
+func testAccDataShareConfig_base(rName, provider string) string {
+	providerArg := ""
+	if provider != "" {
+		providerArg = fmt.Sprintf("provider = %q", provider)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  %[2]s
+
+  cluster_identifier                  = %[1]q
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "ra3.xlplus"
+  number_of_nodes                     = 1
+  cluster_type                        = "single-node"
+  encrypted                           = true
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+
+resource "aws_redshiftdata_statement" "test" {
+  %[2]s
+
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  database           = aws_redshift_cluster.test.database_name
+  db_user            = aws_redshift_cluster.test.master_username
+  sql                = "CREATE DATASHARE ${replace(%[1]q, "-", "_")}"
+}
+
+locals {
+  data_share_arn = "${replace(aws_redshift_cluster.test.cluster_namespace_arn, ":namespace:", ":datashare:")}/${replace(%[1]q, "-", "_")}"
+}
+`, rName, providerArg)
+}
+
+func testAccDataShareAuthorizationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccDataShareConfig_base(rName, ""),
+		`
+data "aws_caller_identity" "consumer" {
+  provider = "awsalternate"
+}
+
+resource "aws_redshift_data_share_authorization" "test" {
+  consumer_identifier = data.aws_caller_identity.consumer.account_id
+  data_share_arn      = local.data_share_arn
+
+  depends_on = [aws_redshiftdata_statement.test]
+}
+`)
+}
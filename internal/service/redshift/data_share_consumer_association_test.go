@@ -0,0 +1,162 @@
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftDataShareConsumerAssociation_basic(t *testing.T) {
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckDataShareConsumerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "associate_entire_account", "true"),
+					resource.TestCheckResourceAttr(resourceName, "consumer_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "consumer_region", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "data_share_arn", "aws_redshift_data_share_authorization.test", "data_share_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "producer_arn", "aws_redshift_cluster.test", "cluster_namespace_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareConsumerAssociation_disappears(t *testing.T) {
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckDataShareConsumerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceDataShareConsumerAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareConsumerAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+	accountID := acctest.Provider.Meta().(*conns.AWSClient).AccountID
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_data_share_consumer_association" {
+			continue
+		}
+
+		dataShareARN, _, consumerARN, consumerRegion, err := tfredshift.DataShareConsumerAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		consumerIdentifier := consumerARN
+		if consumerIdentifier == "" {
+			consumerIdentifier = accountID
+		}
+
+		_, err = tfredshift.FindDataShareConsumerAssociationByID(conn, dataShareARN, consumerIdentifier, consumerRegion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Data Share Consumer Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataShareConsumerAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Consumer Association ID is set")
+		}
+
+		dataShareARN, _, consumerARN, consumerRegion, err := tfredshift.DataShareConsumerAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		consumerIdentifier := consumerARN
+		if consumerIdentifier == "" {
+			consumerIdentifier = acctest.Provider.Meta().(*conns.AWSClient).AccountID
+		}
+
+		_, err = tfredshift.FindDataShareConsumerAssociationByID(conn, dataShareARN, consumerIdentifier, consumerRegion)
+
+		return err
+	}
+}
+
+func testAccDataShareConsumerAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		testAccDataShareConfig_base(rName, "awsalternate"),
+		`
+data "aws_caller_identity" "consumer" {}
+
+resource "aws_redshift_data_share_authorization" "test" {
+  provider = "awsalternate"
+
+  consumer_identifier = data.aws_caller_identity.consumer.account_id
+  data_share_arn      = local.data_share_arn
+
+  depends_on = [aws_redshiftdata_statement.test]
+}
+
+resource "aws_redshift_data_share_consumer_association" "test" {
+  associate_entire_account = true
+  data_share_arn           = aws_redshift_data_share_authorization.test.data_share_arn
+}
+`)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -356,3 +357,135 @@ func FindEndpointAccessByName(conn *redshift.Redshift, name string) (*redshift.E
 
 	return output.EndpointAccessList[0], nil
 }
+
+func findDataShareByARN(conn *redshift.Redshift, arn string) (*redshift.DataShare, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(arn),
+	}
+	var output []*redshift.DataShare
+
+	err := conn.DescribeDataSharesPages(input, func(page *redshift.DescribeDataSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataShares {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindDataShareAuthorizationByID(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShareAssociation, error) {
+	dataShare, err := findDataShareByARN(conn, dataShareARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if aws.StringValue(v.ConsumerIdentifier) != consumerIdentifier {
+			continue
+		}
+
+		if status := aws.StringValue(v.Status); status == redshift.DataShareStatusDeauthorized || status == redshift.DataShareStatusRejected {
+			continue
+		}
+
+		return v, nil
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindDataShareConsumerAssociationByID(conn *redshift.Redshift, dataShareARN, consumerIdentifier, consumerRegion string) (*redshift.DataShareAssociation, error) {
+	dataShare, err := findDataShareByARN(conn, dataShareARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if aws.StringValue(v.ConsumerIdentifier) != consumerIdentifier {
+			continue
+		}
+
+		if consumerRegion != "" && aws.StringValue(v.ConsumerRegion) != consumerRegion {
+			continue
+		}
+
+		if aws.StringValue(v.Status) != redshift.DataShareStatusActive {
+			continue
+		}
+
+		return v, nil
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindIntegrationByARN(conn *rds.RDS, arn string) (*rds.Integration, error) {
+	input := &rds.DescribeIntegrationsInput{
+		IntegrationIdentifier: aws.String(arn),
+	}
+	var output []*rds.Integration
+
+	err := conn.DescribeIntegrationsPages(input, func(page *rds.DescribeIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Integrations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeIntegrationNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
@@ -0,0 +1,250 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Zero-ETL integrations into Redshift are managed through the RDS integration API.
+func ResourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIntegrationCreate,
+		Read:   resourceIntegrationRead,
+		Update: resourceIntegrationUpdate,
+		Delete: resourceIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"kms_key_id"},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 25600),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"integration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`), "must begin with a letter and contain only alphanumeric characters and hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`--|-$`), "cannot contain two consecutive hyphens or end with a hyphen"),
+				),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("integration_name").(string)
+	input := &rds.CreateIntegrationInput{
+		IntegrationName: aws.String(name),
+		SourceArn:       aws.String(d.Get("source_arn").(string)),
+		TargetArn:       aws.String(d.Get("target_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("data_filter"); ok {
+		input.DataFilter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tfrds.Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Integration: %s", input)
+	output, err := conn.CreateIntegration(input)
+
+	if err != nil {
+		return fmt.Errorf("creating Redshift Integration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IntegrationArn))
+
+	if _, err := waitIntegrationCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for Redshift Integration (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceIntegrationRead(d, meta)
+}
+
+func resourceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	integration, err := FindIntegrationByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Redshift Integration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(integration.AdditionalEncryptionContext))
+	d.Set("arn", integration.IntegrationArn)
+	d.Set("data_filter", integration.DataFilter)
+	d.Set("description", integration.Description)
+	d.Set("integration_name", integration.IntegrationName)
+	d.Set("kms_key_id", integration.KMSKeyId)
+	d.Set("source_arn", integration.SourceArn)
+	d.Set("target_arn", integration.TargetArn)
+
+	tags := tfrds.KeyValueTags(integration.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &rds.ModifyIntegrationInput{
+			IntegrationIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_filter") {
+			input.DataFilter = aws.String(d.Get("data_filter").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("integration_name") {
+			input.IntegrationName = aws.String(d.Get("integration_name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Redshift Integration: %s", input)
+		_, err := conn.ModifyIntegration(input)
+
+		if err != nil {
+			return fmt.Errorf("updating Redshift Integration (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitIntegrationUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for Redshift Integration (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := tfrds.UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating Redshift Integration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceIntegrationRead(d, meta)
+}
+
+func resourceIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	log.Printf("[DEBUG] Deleting Redshift Integration: %s", d.Id())
+	_, err := conn.DeleteIntegration(&rds.DeleteIntegrationInput{
+		IntegrationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeIntegrationNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Redshift Integration (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitIntegrationDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for Redshift Integration (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
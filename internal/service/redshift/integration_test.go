@@ -0,0 +1,366 @@
+package redshift_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftIntegration_basic(t *testing.T) {
+	var integration rds.Integration
+	resourceName := "aws_redshift_integration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_base(rName),
+				Check:  testAccCheckIntegrationAuthorizeSource("aws_redshift_cluster.test", "aws_rds_cluster.test"),
+			},
+			{
+				Config: testAccIntegrationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(resourceName, &integration),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`integration:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "integration_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_rds_cluster.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_arn", "aws_redshift_cluster.test", "cluster_namespace_arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_disappears(t *testing.T) {
+	var integration rds.Integration
+	resourceName := "aws_redshift_integration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_base(rName),
+				Check:  testAccCheckIntegrationAuthorizeSource("aws_redshift_cluster.test", "aws_rds_cluster.test"),
+			},
+			{
+				Config: testAccIntegrationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(resourceName, &integration),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceIntegration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_update(t *testing.T) {
+	var integration rds.Integration
+	resourceName := "aws_redshift_integration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_base(rName),
+				Check:  testAccCheckIntegrationAuthorizeSource("aws_redshift_cluster.test", "aws_rds_cluster.test"),
+			},
+			{
+				Config: testAccIntegrationConfig_kmsKey(rName, "description 1", "include: test.*", "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(resourceName, &integration),
+					resource.TestCheckResourceAttr(resourceName, "additional_encryption_context.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_encryption_context.department", "test"),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: test.*"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntegrationConfig_kmsKey(rName, "description 2", "include: test.*, exclude: test.secret", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(resourceName, &integration),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: test.*, exclude: test.secret"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIntegrationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_integration" {
+			continue
+		}
+
+		_, err := tfredshift.FindIntegrationByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Integration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIntegrationExists(n string, v *rds.Integration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Integration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfredshift.FindIntegrationByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckIntegrationAuthorizeSource authorizes the source database to
+// create integrations into the target cluster's namespace.
+func testAccCheckIntegrationAuthorizeSource(targetName, sourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		target, ok := s.RootModule().Resources[targetName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", targetName)
+		}
+
+		source, ok := s.RootModule().Resources[sourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", sourceName)
+		}
+
+		namespaceARN := target.Primary.Attributes["cluster_namespace_arn"]
+		policy := fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "redshift.amazonaws.com"},
+    "Action": "redshift:AuthorizeInboundIntegration",
+    "Resource": %[1]q,
+    "Condition": {"StringEquals": {"aws:SourceArn": %[2]q}}
+  }, {
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:%[3]s:iam::%[4]s:root"},
+    "Action": "redshift:CreateInboundIntegration",
+    "Resource": %[1]q
+  }]
+}`, namespaceARN, source.Primary.Attributes["arn"], acctest.Partition(), acctest.AccountID())
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		_, err := conn.PutResourcePolicy(&redshift.PutResourcePolicyInput{
+			Policy:      aws.String(policy),
+			ResourceArn: aws.String(namespaceARN),
+		})
+
+		return err
+	}
+}
+
+// testAccIntegrationConfig_base creates an Aurora MySQL source cluster with
+// the binary log settings zero-ETL requires and a case-sensitive target cluster.
+func testAccIntegrationConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 3), fmt.Sprintf(`
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = "aurora-mysql8.0"
+
+  dynamic "parameter" {
+    for_each = {
+      aurora_enhanced_binlog      = "1"
+      binlog_backup               = "0"
+      binlog_format               = "ROW"
+      binlog_replication_globaldb = "0"
+      binlog_row_image            = "full"
+      binlog_row_metadata         = "full"
+    }
+
+    content {
+      name         = parameter.key
+      value        = parameter.value
+      apply_method = "pending-reboot"
+    }
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  engine                          = "aurora-mysql"
+  engine_version                  = "8.0.mysql_aurora.3.05.2"
+  database_name                   = "test"
+  master_username                 = "tfacctest"
+  master_password                 = "avoid-plaintext-passwords"
+  db_subnet_group_name            = aws_db_subnet_group.test.name
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  skip_final_snapshot             = true
+  apply_immediately               = true
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = "db.r6g.large"
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+}
+
+resource "aws_redshift_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_redshift_parameter_group" "test" {
+  name   = %[1]q
+  family = "redshift-1.0"
+
+  parameter {
+    name  = "enable_case_sensitive_identifier"
+    value = "true"
+  }
+}
+
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "ra3.xlplus"
+  number_of_nodes                     = 1
+  cluster_type                        = "single-node"
+  encrypted                           = true
+  cluster_subnet_group_name           = aws_redshift_subnet_group.test.name
+  cluster_parameter_group_name        = aws_redshift_parameter_group.test.name
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_rds_cluster.test.arn
+  target_arn       = aws_redshift_cluster.test.cluster_namespace_arn
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_kmsKey(rName, description, dataFilter, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+      Action    = "kms:*"
+      Resource  = "*"
+    }, {
+      Effect    = "Allow"
+      Principal = { Service = "redshift.amazonaws.com" }
+      Action    = ["kms:Decrypt", "kms:CreateGrant"]
+      Resource  = "*"
+      Condition = {
+        StringEquals = { "aws:SourceAccount" = data.aws_caller_identity.current.account_id }
+      }
+    }]
+  })
+}
+
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_rds_cluster.test.arn
+  target_arn       = aws_redshift_cluster.test.cluster_namespace_arn
+  description      = %[2]q
+  data_filter      = %[3]q
+  kms_key_id       = aws_kms_key.test.arn
+
+  additional_encryption_context = {
+    department = "test"
+  }
+
+  tags = {
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName, description, dataFilter, tagKey1, tagValue1))
+}
@@ -2,9 +2,12 @@ package redshift
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -215,3 +218,79 @@ func waitEndpointAccessDeleted(conn *redshift.Redshift, id string) (*redshift.En
 
 	return nil, err
 }
+
+func waitIntegrationCreated(conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.IntegrationStatusCreating, rds.IntegrationStatusModifying},
+		Target:     []string{rds.IntegrationStatusActive},
+		Refresh:    statusIntegration(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationUpdated(conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.IntegrationStatusModifying},
+		Target:     []string{rds.IntegrationStatusActive},
+		Refresh:    statusIntegration(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(conn *rds.RDS, arn string, timeout time.Duration) (*rds.Integration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.IntegrationStatusActive, rds.IntegrationStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusIntegration(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// integrationError returns the errors reported for a failed integration, or its status.
+func integrationError(integration *rds.Integration) error {
+	if len(integration.Errors) == 0 {
+		return errors.New(aws.StringValue(integration.Status))
+	}
+
+	var errs []string
+
+	for _, v := range integration.Errors {
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
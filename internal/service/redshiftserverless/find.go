@@ -107,3 +107,61 @@ func FindUsageLimitByName(conn *redshiftserverless.RedshiftServerless, id string
 
 	return output.UsageLimit, nil
 }
+
+func FindScheduledActionByName(conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.ScheduledActionResponse, error) {
+	input := &redshiftserverless.GetScheduledActionInput{
+		ScheduledActionName: aws.String(name),
+	}
+
+	output, err := conn.GetScheduledAction(input)
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ScheduledAction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ScheduledAction, nil
+}
+
+func FindSnapshotCopyConfigurationByID(conn *redshiftserverless.RedshiftServerless, id string) (*redshiftserverless.SnapshotCopyConfiguration, error) {
+	input := &redshiftserverless.ListSnapshotCopyConfigurationsInput{}
+	var output *redshiftserverless.SnapshotCopyConfiguration
+
+	err := conn.ListSnapshotCopyConfigurationsPages(input, func(page *redshiftserverless.ListSnapshotCopyConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SnapshotCopyConfigurations {
+			if aws.StringValue(v.SnapshotCopyConfigurationId) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
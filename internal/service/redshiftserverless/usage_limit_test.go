@@ -52,6 +52,43 @@ func TestAccRedshiftServerlessUsageLimit_basic(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftServerlessUsageLimit_crossRegionDatasharing(t *testing.T) {
+	resourceName := "aws_redshiftserverless_usage_limit.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageLimitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageLimitConfig_crossRegionDatasharing(rName, "log"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_redshiftserverless_workgroup.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "amount", "1"),
+					resource.TestCheckResourceAttr(resourceName, "usage_type", "cross-region-datasharing"),
+					resource.TestCheckResourceAttr(resourceName, "breach_action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "period", "daily"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsageLimitConfig_crossRegionDatasharing(rName, "deactivate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageLimitExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "breach_action", "deactivate"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRedshiftServerlessUsageLimit_disappears(t *testing.T) {
 	resourceName := "aws_redshiftserverless_usage_limit.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -134,3 +171,24 @@ resource "aws_redshiftserverless_usage_limit" "test" {
 }
 `, rName, amount)
 }
+
+func testAccUsageLimitConfig_crossRegionDatasharing(rName, breachAction string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftserverless_usage_limit" "test" {
+  resource_arn  = aws_redshiftserverless_workgroup.test.arn
+  usage_type    = "cross-region-datasharing"
+  amount        = 1
+  period        = "daily"
+  breach_action = %[2]q
+}
+`, rName, breachAction)
+}
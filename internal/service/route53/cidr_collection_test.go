@@ -0,0 +1,126 @@
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRoute53CIDRCollection_basic(t *testing.T) {
+	var v route53.CollectionSummary
+	resourceName := "aws_route53_cidr_collection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCIDRCollectionDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCIDRCollectionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCIDRCollectionExists(resourceName, &v),
+					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "route53", regexp.MustCompile(`cidrcollection/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53CIDRCollection_disappears(t *testing.T) {
+	var v route53.CollectionSummary
+	resourceName := "aws_route53_cidr_collection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCIDRCollectionDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCIDRCollectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCIDRCollectionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53.ResourceCIDRCollection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCIDRCollectionExists(n string, v *route53.CollectionSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 CIDR Collection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+		output, err := tfroute53.FindCIDRCollectionByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCIDRCollectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_cidr_collection" {
+			continue
+		}
+
+		_, err := tfroute53.FindCIDRCollectionByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route53 CIDR Collection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCIDRCollectionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+`, rName)
+}
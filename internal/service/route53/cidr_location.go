@@ -0,0 +1,189 @@
+package route53
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	cidrCollectionChangeTimeout = 2 * time.Minute
+)
+
+func ResourceCIDRLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCIDRLocationCreate,
+		ReadWithoutTimeout:   resourceCIDRLocationRead,
+		UpdateWithoutTimeout: resourceCIDRLocationUpdate,
+		DeleteWithoutTimeout: resourceCIDRLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"cidr_collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 16),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_\-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+		},
+	}
+}
+
+func resourceCIDRLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID := d.Get("cidr_collection_id").(string)
+	name := d.Get("name").(string)
+	id := CIDRLocationCreateResourceID(collectionID, name)
+
+	if err := changeCIDRCollection(ctx, conn, collectionID, &route53.CidrCollectionChange{
+		Action:       aws.String(route53.CidrCollectionChangeActionPut),
+		CidrList:     flex.ExpandStringSet(d.Get("cidr_blocks").(*schema.Set)),
+		LocationName: aws.String(name),
+	}); err != nil {
+		return diag.Errorf("error creating Route53 CIDR Location (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCIDRLocationRead(ctx, d, meta)
+}
+
+func resourceCIDRLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cidrBlocks, err := FindCIDRLocationBlocks(ctx, conn, collectionID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 CIDR Location %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cidr_blocks", aws.StringValueSlice(cidrBlocks))
+	d.Set("cidr_collection_id", collectionID)
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceCIDRLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, n := d.GetChange("cidr_blocks")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	// Add new blocks before removing old ones so that the location is never left empty.
+	if add := ns.Difference(os); add.Len() > 0 {
+		if err := changeCIDRCollection(ctx, conn, collectionID, &route53.CidrCollectionChange{
+			Action:       aws.String(route53.CidrCollectionChangeActionPut),
+			CidrList:     flex.ExpandStringSet(add),
+			LocationName: aws.String(name),
+		}); err != nil {
+			return diag.Errorf("error adding Route53 CIDR Location (%s) CIDR blocks: %s", d.Id(), err)
+		}
+	}
+
+	if del := os.Difference(ns); del.Len() > 0 {
+		if err := changeCIDRCollection(ctx, conn, collectionID, &route53.CidrCollectionChange{
+			Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
+			CidrList:     flex.ExpandStringSet(del),
+			LocationName: aws.String(name),
+		}); err != nil {
+			return diag.Errorf("error removing Route53 CIDR Location (%s) CIDR blocks: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCIDRLocationRead(ctx, d, meta)
+}
+
+func resourceCIDRLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Route53 CIDR Location: %s", d.Id())
+	err = changeCIDRCollection(ctx, conn, collectionID, &route53.CidrCollectionChange{
+		Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
+		CidrList:     flex.ExpandStringSet(d.Get("cidr_blocks").(*schema.Set)),
+		LocationName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException, route53.ErrCodeNoSuchCidrLocationException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// changeCIDRCollection applies a single change to a CIDR collection, retrying
+// while other changes to the same collection are in progress.
+func changeCIDRCollection(ctx context.Context, conn *route53.Route53, collectionID string, change *route53.CidrCollectionChange) error {
+	input := &route53.ChangeCidrCollectionInput{
+		Changes: []*route53.CidrCollectionChange{change},
+		Id:      aws.String(collectionID),
+	}
+
+	log.Printf("[DEBUG] Changing Route53 CIDR Collection: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, cidrCollectionChangeTimeout, func() (interface{}, error) {
+		return conn.ChangeCidrCollectionWithContext(ctx, input)
+	}, route53.ErrCodeConcurrentModification)
+
+	return err
+}
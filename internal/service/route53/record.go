@@ -117,10 +117,36 @@ func ResourceRecord() *schema.Resource {
 				Set: resourceAliasRecordHash,
 			},
 
+			"cidr_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"failover_routing_policy",
+					"geolocation_routing_policy",
+					"latency_routing_policy",
+					"multivalue_answer_routing_policy",
+					"weighted_routing_policy",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collection_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"location_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"failover_routing_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -147,6 +173,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"weighted_routing_policy",
@@ -166,6 +193,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -193,6 +221,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -212,6 +241,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -280,12 +310,26 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	// If the old record has any of the following, we need to pass that in
 	// here because otherwise the API will give us an error:
+	// - cidr_routing_policy
 	// - failover_routing_policy
 	// - geolocation_routing_policy
 	// - latency_routing_policy
 	// - multivalue_answer_routing_policy
 	// - weighted_routing_policy
 
+	if v, _ := d.GetChange("cidr_routing_policy"); v != nil {
+		if o, ok := v.([]interface{}); ok {
+			if len(o) == 1 {
+				if v, ok := o[0].(map[string]interface{}); ok {
+					oldRec.CidrRoutingConfig = &route53.CidrRoutingConfig{
+						CollectionId: aws.String(v["collection_id"].(string)),
+						LocationName: aws.String(v["location_name"].(string)),
+					}
+				}
+			}
+		}
+	}
+
 	if v, _ := d.GetChange("failover_routing_policy"); v != nil {
 		if o, ok := v.([]interface{}); ok {
 			if len(o) == 1 {
@@ -595,6 +639,16 @@ func resourceRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("ttl", record.TTL)
 
+	if record.CidrRoutingConfig != nil {
+		v := []map[string]interface{}{{
+			"collection_id": aws.StringValue(record.CidrRoutingConfig.CollectionId),
+			"location_name": aws.StringValue(record.CidrRoutingConfig.LocationName),
+		}}
+		if err := d.Set("cidr_routing_policy", v); err != nil {
+			return fmt.Errorf("Error setting CIDR records for: %s, error: %w", d.Id(), err)
+		}
+	}
+
 	if record.Failover != nil {
 		v := []map[string]interface{}{{
 			"type": aws.StringValue(record.Failover),
@@ -693,7 +747,7 @@ func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceReco
 	recordType := d.Get("type").(string)
 	recordSetIdentifier := d.Get("set_identifier").(string)
 
-	// If this isn't a Weighted, Latency, Geo, or Failover resource with
+	// If this isn't a Weighted, Latency, Geo, CIDR, or Failover resource with
 	// a SetIdentifier we only need to look at the first record in the response since there can be
 	// only one
 	maxItems := "1"
@@ -862,6 +916,18 @@ func resourceRecordBuildSet(d *schema.ResourceData, zoneName string) (*route53.R
 		}
 	}
 
+	if v, ok := d.GetOk("cidr_routing_policy"); ok {
+		if _, ok := d.GetOk("set_identifier"); !ok {
+			return nil, fmt.Errorf(`provider.aws: aws_route53_record: %s: "set_identifier": required field is not set when "cidr_routing_policy" is set`, d.Get("name").(string))
+		}
+		cidr := v.([]interface{})[0].(map[string]interface{})
+
+		rec.CidrRoutingConfig = &route53.CidrRoutingConfig{
+			CollectionId: aws.String(cidr["collection_id"].(string)),
+			LocationName: aws.String(cidr["location_name"].(string)),
+		}
+	}
+
 	if v, ok := d.GetOk("failover_routing_policy"); ok {
 		if _, ok := d.GetOk("set_identifier"); !ok {
 			return nil, fmt.Errorf(`provider.aws: aws_route53_record: %s: "set_identifier": required field is not set when "failover_routing_policy" is set`, d.Get("name").(string))
@@ -815,6 +815,39 @@ func TestAccRoute53Record_Latency_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_CIDR_basic(t *testing.T) {
+	var record1, record2 route53.ResourceRecordSet
+	resourceName := "aws_route53_record.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := sdkacctest.RandString(16)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_cidr(rName, locationName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(resourceName, &record1),
+					testAccCheckRecordExists("aws_route53_record.default", &record2),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_routing_policy.0.collection_id", "aws_route53_cidr_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.0.location_name", locationName),
+					resource.TestCheckResourceAttr("aws_route53_record.default", "cidr_routing_policy.0.location_name", "*"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite", "weight"},
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_typeChange(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.sample"
@@ -2921,3 +2954,49 @@ resource "aws_route53_record" "www-server1" {
   records = ["127.0.0.1"]
 }
 `
+
+func testAccRecordConfig_cidr(rName, locationName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "domain.test"
+}
+
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = %[2]q
+  cidr_blocks        = ["200.5.3.0/24", "200.6.3.0/24"]
+}
+
+resource "aws_route53_record" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "CNAME"
+  ttl            = "5"
+  set_identifier = "cidr-location"
+  records        = ["cidr.domain.test"]
+
+  cidr_routing_policy {
+    collection_id = aws_route53_cidr_collection.test.id
+    location_name = aws_route53_cidr_location.test.name
+  }
+}
+
+resource "aws_route53_record" "default" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "CNAME"
+  ttl            = "5"
+  set_identifier = "cidr-default"
+  records        = ["default.domain.test"]
+
+  cidr_routing_policy {
+    collection_id = aws_route53_cidr_collection.test.id
+    location_name = "*"
+  }
+}
+`, rName, locationName)
+}
@@ -0,0 +1,222 @@
+package route53domains
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDomain() *schema.Resource {
+	contactSchema := contactDetailSchema()
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceRegisteredDomainRead,
+		UpdateWithoutTimeout: resourceRegisteredDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abuse_contact_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"abuse_contact_phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_contact": contactSchema,
+			"admin_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"duration_in_years": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+				// Only used when the domain is registered.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_server":        nameserversSchema(),
+			"registrant_contact": contactSchema,
+			"registrant_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"registrar_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registrar_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reseller": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":         tftags.TagsSchema(),
+			"tags_all":     tftags.TagsSchemaComputed(),
+			"tech_contact": contactSchema,
+			"tech_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transfer_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whois_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53DomainsConn
+
+	domainName := d.Get("domain_name").(string)
+	domainDetail, err := findDomainDetailByName(ctx, conn, domainName)
+
+	if tfresource.NotFound(err) {
+		if err := registerDomain(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		domainDetail, err = findDomainDetailByName(ctx, conn, domainName)
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Domains Domain (%s): %s", domainName, err)
+	}
+
+	d.SetId(aws.ToString(domainDetail.DomainName))
+
+	if err := adoptDomain(ctx, conn, d, meta, domainDetail, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRegisteredDomainRead(ctx, d, meta)
+}
+
+func resourceDomainDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Route 53 Domains Domain (%s) not deleted, removing from state", d.Id()),
+			Detail:   "The domain remains registered to the AWS account. Disable auto_renew before removing the resource to let the registration expire.",
+		},
+	}
+}
+
+// registerDomain registers a domain that is not yet associated with the current AWS account.
+// Settings that RegisterDomain does not accept are applied afterwards by adoptDomain.
+func registerDomain(ctx context.Context, conn *route53domains.Client, d *schema.ResourceData, timeout time.Duration) error {
+	domainName := d.Get("domain_name").(string)
+
+	availability, err := conn.CheckDomainAvailability(ctx, &route53domains.CheckDomainAvailabilityInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error checking Route 53 Domains Domain (%s) availability: %w", domainName, err)
+	}
+
+	if v := availability.Availability; v != types.DomainAvailabilityAvailable {
+		return fmt.Errorf("Route 53 Domains Domain (%s) is not available for registration: %s", domainName, v)
+	}
+
+	input := &route53domains.RegisterDomainInput{
+		AutoRenew:                       aws.Bool(d.Get("auto_renew").(bool)),
+		DomainName:                      aws.String(domainName),
+		DurationInYears:                 aws.Int32(int32(d.Get("duration_in_years").(int))),
+		PrivacyProtectAdminContact:      aws.Bool(d.Get("admin_privacy").(bool)),
+		PrivacyProtectRegistrantContact: aws.Bool(d.Get("registrant_privacy").(bool)),
+		PrivacyProtectTechContact:       aws.Bool(d.Get("tech_privacy").(bool)),
+	}
+
+	for key, contact := range map[string]**types.ContactDetail{
+		"admin_contact":      &input.AdminContact,
+		"registrant_contact": &input.RegistrantContact,
+		"tech_contact":       &input.TechContact,
+	} {
+		v, ok := d.GetOk(key)
+
+		if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+			return fmt.Errorf("error registering Route 53 Domains Domain (%s): %q is required", domainName, key)
+		}
+
+		*contact = expandContactDetail(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Registering Route 53 Domains Domain: %s", domainName)
+	output, err := conn.RegisterDomain(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error registering Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) registration: %w", domainName, err)
+	}
+
+	return nil
+}
@@ -26,7 +26,104 @@ import (
 )
 
 func ResourceRegisteredDomain() *schema.Resource {
-	contactSchema := &schema.Schema{
+	contactSchema := contactDetailSchema()
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRegisteredDomainCreate,
+		ReadWithoutTimeout:   resourceRegisteredDomainRead,
+		UpdateWithoutTimeout: resourceRegisteredDomainUpdate,
+		DeleteWithoutTimeout: resourceRegisteredDomainDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abuse_contact_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"abuse_contact_phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_contact": contactSchema,
+			"admin_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_server":        nameserversSchema(),
+			"registrant_contact": contactSchema,
+			"registrant_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"registrar_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registrar_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reseller": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":         tftags.TagsSchema(),
+			"tags_all":     tftags.TagsSchemaComputed(),
+			"tech_contact": contactSchema,
+			"tech_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transfer_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whois_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func contactDetailSchema() *schema.Schema {
+	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
 		Computed: true,
@@ -120,124 +217,35 @@ func ResourceRegisteredDomain() *schema.Resource {
 			},
 		},
 	}
+}
 
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceRegisteredDomainCreate,
-		ReadWithoutTimeout:   resourceRegisteredDomainRead,
-		UpdateWithoutTimeout: resourceRegisteredDomainUpdate,
-		DeleteWithoutTimeout: resourceRegisteredDomainDelete,
-
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(30 * time.Minute),
-			Update: schema.DefaultTimeout(30 * time.Minute),
-		},
-
-		Schema: map[string]*schema.Schema{
-			"abuse_contact_email": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"abuse_contact_phone": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"admin_contact": contactSchema,
-			"admin_privacy": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"auto_renew": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"creation_date": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"domain_name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"expiration_date": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"name_server": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 6,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"glue_ips": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							MaxItems: 2,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.IsIPAddress,
-							},
-						},
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: validation.All(
-								validation.StringLenBetween(1, 255),
-								validation.StringMatch(regexp.MustCompile(`[a-zA-Z0-9_\-.]*`), "can contain only alphabetical characters (A-Z or a-z), numeric characters (0-9), underscore (_), the minus sign (-), and the period (.)"),
-							),
-						},
+func nameserversSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 6,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"glue_ips": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MaxItems: 2,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsIPAddress,
 					},
 				},
-			},
-			"registrant_contact": contactSchema,
-			"registrant_privacy": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"registrar_name": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"registrar_url": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"reseller": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"status_list": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-			"tags":         tftags.TagsSchema(),
-			"tags_all":     tftags.TagsSchemaComputed(),
-			"tech_contact": contactSchema,
-			"tech_privacy": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"transfer_lock": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
-			"updated_date": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"whois_server": {
-				Type:     schema.TypeString,
-				Computed: true,
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexp.MustCompile(`[a-zA-Z0-9_\-.]*`), "can contain only alphabetical characters (A-Z or a-z), numeric characters (0-9), underscore (_), the minus sign (-), and the period (.)"),
+					),
+				},
 			},
 		},
-
-		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -253,75 +261,8 @@ func resourceRegisteredDomainCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(aws.ToString(domainDetail.DomainName))
 
-	var adminContact, registrantContact, techContact *types.ContactDetail
-
-	if v, ok := d.GetOk("admin_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		if v := expandContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.AdminContact) {
-			adminContact = v
-		}
-	}
-
-	if v, ok := d.GetOk("registrant_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		if v := expandContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.RegistrantContact) {
-			registrantContact = v
-		}
-	}
-
-	if v, ok := d.GetOk("tech_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		if v := expandContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.TechContact) {
-			techContact = v
-		}
-	}
-
-	if adminContact != nil || registrantContact != nil || techContact != nil {
-		if err := modifyDomainContact(ctx, conn, d.Id(), adminContact, registrantContact, techContact, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	if adminPrivacy, registrantPrivacy, techPrivacy := d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool); adminPrivacy != aws.ToBool(domainDetail.AdminPrivacy) || registrantPrivacy != aws.ToBool(domainDetail.RegistrantPrivacy) || techPrivacy != aws.ToBool(domainDetail.TechPrivacy) {
-		if err := modifyDomainContactPrivacy(ctx, conn, d.Id(), adminPrivacy, registrantPrivacy, techPrivacy, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	if v := d.Get("auto_renew").(bool); v != aws.ToBool(domainDetail.AutoRenew) {
-		if err := modifyDomainAutoRenew(ctx, conn, d.Id(), v); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
-		nameservers := expandNameservers(v.([]interface{}))
-
-		if !reflect.DeepEqual(nameservers, domainDetail.Nameservers) {
-			if err := modifyDomainNameservers(ctx, conn, d.Id(), nameservers, d.Timeout(schema.TimeoutCreate)); err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-
-	if v := d.Get("transfer_lock").(bool); v != hasDomainTransferLock(domainDetail.StatusList) {
-		if err := modifyDomainTransferLock(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	tags, err := ListTags(ctx, conn, d.Id())
-
-	if err != nil {
-		return diag.Errorf("error listing tags for Route 53 Domains Domain (%s): %s", d.Id(), err)
-	}
-
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
-	newTags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{}))).IgnoreConfig(ignoreTagsConfig)
-	oldTags := tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
-
-	if !oldTags.Equal(newTags) {
-		if err := UpdateTags(ctx, conn, d.Id(), oldTags, newTags); err != nil {
-			return diag.Errorf("error updating Route 53 Domains Domain (%s) tags: %s", d.Id(), err)
-		}
+	if err := adoptDomain(ctx, conn, d, meta, domainDetail, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceRegisteredDomainRead(ctx, d, meta)
@@ -493,6 +434,83 @@ func resourceRegisteredDomainDelete(_ context.Context, d *schema.ResourceData, m
 	}
 }
 
+// adoptDomain brings the settings of an existing domain registration in line
+// with the resource configuration, only calling the APIs for settings that differ.
+func adoptDomain(ctx context.Context, conn *route53domains.Client, d *schema.ResourceData, meta interface{}, domainDetail *route53domains.GetDomainDetailOutput, timeout time.Duration) error {
+	var adminContact, registrantContact, techContact *types.ContactDetail
+
+	if v, ok := d.GetOk("admin_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := expandContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.AdminContact) {
+			adminContact = v
+		}
+	}
+
+	if v, ok := d.GetOk("registrant_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := expandContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.RegistrantContact) {
+			registrantContact = v
+		}
+	}
+
+	if v, ok := d.GetOk("tech_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := expandContactDetail(v.([]interface{})[0].(map[string]interface{})); !reflect.DeepEqual(v, domainDetail.TechContact) {
+			techContact = v
+		}
+	}
+
+	if adminContact != nil || registrantContact != nil || techContact != nil {
+		if err := modifyDomainContact(ctx, conn, d.Id(), adminContact, registrantContact, techContact, timeout); err != nil {
+			return err
+		}
+	}
+
+	if adminPrivacy, registrantPrivacy, techPrivacy := d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool); adminPrivacy != aws.ToBool(domainDetail.AdminPrivacy) || registrantPrivacy != aws.ToBool(domainDetail.RegistrantPrivacy) || techPrivacy != aws.ToBool(domainDetail.TechPrivacy) {
+		if err := modifyDomainContactPrivacy(ctx, conn, d.Id(), adminPrivacy, registrantPrivacy, techPrivacy, timeout); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("auto_renew").(bool); v != aws.ToBool(domainDetail.AutoRenew) {
+		if err := modifyDomainAutoRenew(ctx, conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+		nameservers := expandNameservers(v.([]interface{}))
+
+		if !reflect.DeepEqual(nameservers, domainDetail.Nameservers) {
+			if err := modifyDomainNameservers(ctx, conn, d.Id(), nameservers, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := d.Get("transfer_lock").(bool); v != hasDomainTransferLock(domainDetail.StatusList) {
+		if err := modifyDomainTransferLock(ctx, conn, d.Id(), v, timeout); err != nil {
+			return err
+		}
+	}
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	newTags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{}))).IgnoreConfig(ignoreTagsConfig)
+	oldTags := tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if !oldTags.Equal(newTags) {
+		if err := UpdateTags(ctx, conn, d.Id(), oldTags, newTags); err != nil {
+			return fmt.Errorf("error updating Route 53 Domains Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
 const (
 	eppStatusClientTransferProhibited = "clientTransferProhibited"
 )
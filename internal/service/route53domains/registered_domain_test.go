@@ -16,6 +16,10 @@ import (
 
 func TestAccRoute53Domains_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"Domain": {
+			"basic":     testAccDomain_basic,
+			"autoRenew": testAccDomain_autoRenew,
+		},
 		"RegisteredDomain": {
 			"tags":           testAccRegisteredDomain_tags,
 			"autoRenew":      testAccRegisteredDomain_autoRenew,
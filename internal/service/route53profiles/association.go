@@ -0,0 +1,139 @@
+package route53profiles
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssociationCreate,
+		ReadWithoutTimeout:   resourceAssociationRead,
+		DeleteWithoutTimeout: resourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	name := d.Get("name").(string)
+	input := &route53profiles.AssociateProfileInput{
+		Name:       aws.String(name),
+		ProfileId:  aws.String(d.Get("profile_id").(string)),
+		ResourceId: aws.String(d.Get("resource_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Profile Association: %s", input)
+	output, err := conn.AssociateProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Route 53 Profile Association (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProfileAssociation.Id))
+
+	if _, err := waitProfileAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAssociationRead(ctx, d, meta)
+}
+
+func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	association, err := FindProfileAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Profile Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Profile Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("name", association.Name)
+	d.Set("owner_id", association.OwnerId)
+	d.Set("profile_id", association.ProfileId)
+	d.Set("resource_id", association.ResourceId)
+	d.Set("status", association.Status)
+	d.Set("status_message", association.StatusMessage)
+
+	return nil
+}
+
+func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	log.Printf("[DEBUG] Deleting Route 53 Profile Association: %s", d.Id())
+	_, err := conn.DisassociateProfileWithContext(ctx, &route53profiles.DisassociateProfileInput{
+		ProfileId:  aws.String(d.Get("profile_id").(string)),
+		ResourceId: aws.String(d.Get("resource_id").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53profiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Route 53 Profile Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProfileAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package route53profiles
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceAssociationCreate,
+		ReadWithoutTimeout:   resourceResourceAssociationRead,
+		UpdateWithoutTimeout: resourceResourceAssociationUpdate,
+		DeleteWithoutTimeout: resourceResourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_properties": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	name := d.Get("name").(string)
+	input := &route53profiles.AssociateResourceToProfileInput{
+		Name:        aws.String(name),
+		ProfileId:   aws.String(d.Get("profile_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_properties"); ok {
+		input.ResourceProperties = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Profile Resource Association: %s", input)
+	output, err := conn.AssociateResourceToProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Route 53 Profile Resource Association (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProfileResourceAssociation.Id))
+
+	if _, err := waitProfileResourceAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Resource Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceResourceAssociationRead(ctx, d, meta)
+}
+
+func resourceResourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	association, err := FindProfileResourceAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Profile Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Profile Resource Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("name", association.Name)
+	d.Set("owner_id", association.OwnerId)
+	d.Set("profile_id", association.ProfileId)
+	d.Set("resource_arn", association.ResourceArn)
+	d.Set("resource_properties", association.ResourceProperties)
+	d.Set("resource_type", association.ResourceType)
+	d.Set("status", association.Status)
+	d.Set("status_message", association.StatusMessage)
+
+	return nil
+}
+
+func resourceResourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	input := &route53profiles.UpdateProfileResourceAssociationInput{
+		ProfileResourceAssociationId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("resource_properties") {
+		input.ResourceProperties = aws.String(d.Get("resource_properties").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Profile Resource Association: %s", input)
+	_, err := conn.UpdateProfileResourceAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Route 53 Profile Resource Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProfileResourceAssociationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Resource Association (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceResourceAssociationRead(ctx, d, meta)
+}
+
+func resourceResourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53ProfilesConn
+
+	log.Printf("[DEBUG] Deleting Route 53 Profile Resource Association: %s", d.Id())
+	_, err := conn.DisassociateResourceFromProfileWithContext(ctx, &route53profiles.DisassociateResourceFromProfileInput{
+		ProfileId:   aws.String(d.Get("profile_id").(string)),
+		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53profiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Route 53 Profile Resource Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProfileResourceAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Route 53 Profile Resource Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
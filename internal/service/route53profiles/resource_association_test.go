@@ -0,0 +1,213 @@
+package route53profiles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53profiles "github.com/hashicorp/terraform-provider-aws/internal/service/route53profiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRoute53ProfilesResourceAssociation_basic(t *testing.T) {
+	var v route53profiles.ProfileResourceAssociation
+	resourceName := "aws_route53profiles_resource_association.test"
+	profileResourceName := "aws_route53profiles_profile.test"
+	zoneResourceName := "aws_route53_zone.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(route53profiles.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53profiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_hostedZone(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_id", profileResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", zoneResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "HOSTED_ZONE"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ProfilesResourceAssociation_disappears(t *testing.T) {
+	var v route53profiles.ProfileResourceAssociation
+	resourceName := "aws_route53profiles_resource_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(route53profiles.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53profiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_hostedZone(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAssociationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53profiles.ResourceResourceAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ProfilesResourceAssociation_firewallRuleGroup(t *testing.T) {
+	var v route53profiles.ProfileResourceAssociation
+	resourceName := "aws_route53profiles_resource_association.test"
+	ruleGroupResourceName := "aws_route53_resolver_firewall_rule_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(route53profiles.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53profiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_firewallRuleGroup(rName, 102),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", ruleGroupResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_properties", `{"priority":102}`),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "FIREWALL_RULE_GROUP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceAssociationConfig_firewallRuleGroup(rName, 103),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "resource_properties", `{"priority":103}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceAssociationExists(n string, v *route53profiles.ProfileResourceAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Profile Resource Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ProfilesConn
+
+		output, err := tfroute53profiles.FindProfileResourceAssociationByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckResourceAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ProfilesConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53profiles_resource_association" {
+			continue
+		}
+
+		_, err := tfroute53profiles.FindProfileResourceAssociationByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Profile Resource Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccResourceAssociationConfig_hostedZone(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+
+  vpc {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_route53profiles_profile" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53profiles_resource_association" "test" {
+  name         = %[1]q
+  profile_id   = aws_route53profiles_profile.test.id
+  resource_arn = aws_route53_zone.test.arn
+}
+`, rName, domainName)
+}
+
+func testAccResourceAssociationConfig_firewallRuleGroup(rName string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53profiles_profile" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53profiles_resource_association" "test" {
+  name         = %[1]q
+  profile_id   = aws_route53profiles_profile.test.id
+  resource_arn = aws_route53_resolver_firewall_rule_group.test.arn
+
+  resource_properties = jsonencode({
+    priority = %[2]d
+  })
+}
+`, rName, priority)
+}
@@ -0,0 +1,58 @@
+package route53profiles
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusProfile(ctx context.Context, conn *route53profiles.Route53Profiles, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProfileByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProfileAssociation(ctx context.Context, conn *route53profiles.Route53Profiles, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProfileAssociationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProfileResourceAssociation(ctx context.Context, conn *route53profiles.Route53Profiles, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProfileResourceAssociationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,159 @@
+package route53profiles
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitProfileCreated(ctx context.Context, conn *route53profiles.Route53Profiles, id string, timeout time.Duration) (*route53profiles.Profile, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusCreating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfile(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53profiles.Profile); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileDeleted(ctx context.Context, conn *route53profiles.Route53Profiles, id string, timeout time.Duration) (*route53profiles.Profile, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusComplete, route53profiles.ProfileStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProfile(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53profiles.Profile); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileAssociationCreated(ctx context.Context, conn *route53profiles.Route53Profiles, id string, timeout time.Duration) (*route53profiles.ProfileAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusCreating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfileAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53profiles.ProfileAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileAssociationDeleted(ctx context.Context, conn *route53profiles.Route53Profiles, id string, timeout time.Duration) (*route53profiles.ProfileAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusComplete, route53profiles.ProfileStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProfileAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53profiles.ProfileAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileResourceAssociationCreated(ctx context.Context, conn *route53profiles.Route53Profiles, id string, timeout time.Duration) (*route53profiles.ProfileResourceAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusCreating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfileResourceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53profiles.ProfileResourceAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileResourceAssociationUpdated(ctx context.Context, conn *route53profiles.Route53Profiles, id string, timeout time.Duration) (*route53profiles.ProfileResourceAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusUpdating},
+		Target:  []string{route53profiles.ProfileStatusComplete},
+		Refresh: statusProfileResourceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53profiles.ProfileResourceAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProfileResourceAssociationDeleted(ctx context.Context, conn *route53profiles.Route53Profiles, id string, timeout time.Duration) (*route53profiles.ProfileResourceAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53profiles.ProfileStatusComplete, route53profiles.ProfileStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProfileResourceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53profiles.ProfileResourceAssociation); ok {
+		if status := aws.StringValue(output.Status); status == route53profiles.ProfileStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
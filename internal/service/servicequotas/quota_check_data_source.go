@@ -0,0 +1,201 @@
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// quotaCheckUsageMetricPeriod is the lookback window for current usage. Usage metrics are published every minute.
+	quotaCheckUsageMetricPeriod = 15 * time.Minute
+)
+
+func DataSourceQuotaCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQuotaCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"available": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"exceeded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"quota_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quota_value": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"requested": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"usage": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"warn_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func dataSourceQuotaCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+
+	quota, err := findServiceQuotaDefaultByID(conn, serviceCode, quotaCode)
+
+	if err != nil {
+		return diag.Errorf("getting Default Service Quota for (%s/%s): %s", serviceCode, quotaCode, err)
+	}
+
+	// The applied quota value is only available once it has been set.
+	if appliedQuota, err := findServiceQuotaByID(conn, serviceCode, quotaCode); err == nil {
+		quota = appliedQuota
+	} else if !tfresource.NotFound(err) {
+		return diag.Errorf("getting Service Quota for (%s/%s): %s", serviceCode, quotaCode, err)
+	}
+
+	var usage float64
+	// Zero is a valid usage value, so check whether usage is configured rather than using GetOk.
+	if !d.GetRawConfig().GetAttr("usage").IsNull() {
+		usage = d.Get("usage").(float64)
+	} else {
+		if quota.UsageMetric == nil {
+			return diag.Errorf("Service Quota (%s/%s) does not publish a usage metric; set usage to the current usage", serviceCode, quotaCode)
+		}
+
+		usage, err = findQuotaUsage(ctx, meta.(*conns.AWSClient).CloudWatchConn, quota.UsageMetric)
+
+		if err != nil {
+			return diag.Errorf("getting usage for Service Quota (%s/%s): %s", serviceCode, quotaCode, err)
+		}
+	}
+
+	quotaValue := aws.Float64Value(quota.Value)
+	requested := d.Get("requested").(float64)
+	available := quotaValue - usage
+	exceeded := usage+requested > quotaValue
+
+	d.SetId(aws.StringValue(quota.QuotaArn))
+	d.Set("available", available)
+	d.Set("exceeded", exceeded)
+	d.Set("quota_name", quota.QuotaName)
+	d.Set("quota_value", quotaValue)
+	d.Set("usage", usage)
+
+	if !exceeded {
+		return nil
+	}
+
+	summary := fmt.Sprintf("Service Quota %q (%s/%s) would be exceeded", aws.StringValue(quota.QuotaName), serviceCode, quotaCode)
+	detail := fmt.Sprintf("Requested %g with current usage %g exceeds the quota value of %g (%g available).", requested, usage, quotaValue, available)
+
+	if d.Get("warn_only").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   detail,
+		}}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   detail,
+	}}
+}
+
+// findQuotaUsage returns the most recent value of a Service Quota's CloudWatch usage metric.
+// A metric with no recent datapoints indicates no usage.
+func findQuotaUsage(ctx context.Context, conn *cloudwatch.CloudWatch, metric *servicequotas.MetricInfo) (float64, error) {
+	statistic := aws.StringValue(metric.MetricStatisticRecommendation)
+	if statistic == "" {
+		statistic = cloudwatch.StatisticMaximum
+	}
+
+	endTime := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		EndTime:    aws.Time(endTime),
+		MetricName: metric.MetricName,
+		Namespace:  metric.MetricNamespace,
+		Period:     aws.Int64(int64(time.Minute.Seconds())),
+		StartTime:  aws.Time(endTime.Add(-quotaCheckUsageMetricPeriod)),
+		Statistics: aws.StringSlice([]string{statistic}),
+	}
+
+	for k, v := range metric.MetricDimensions {
+		input.Dimensions = append(input.Dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: v,
+		})
+	}
+
+	output, err := conn.GetMetricStatisticsWithContext(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var latest *cloudwatch.Datapoint
+	for _, v := range output.Datapoints {
+		if v == nil {
+			continue
+		}
+
+		if latest == nil || aws.TimeValue(v.Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = v
+		}
+	}
+
+	if latest == nil {
+		log.Printf("[DEBUG] No datapoints for Service Quota usage metric (%s/%s)", aws.StringValue(metric.MetricNamespace), aws.StringValue(metric.MetricName))
+		return 0, nil
+	}
+
+	switch statistic {
+	case cloudwatch.StatisticAverage:
+		return aws.Float64Value(latest.Average), nil
+	case cloudwatch.StatisticMinimum:
+		return aws.Float64Value(latest.Minimum), nil
+	case cloudwatch.StatisticSampleCount:
+		return aws.Float64Value(latest.SampleCount), nil
+	case cloudwatch.StatisticSum:
+		return aws.Float64Value(latest.Sum), nil
+	default:
+		return aws.Float64Value(latest.Maximum), nil
+	}
+}
@@ -0,0 +1,90 @@
+package servicequotas_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccServiceQuotasQuotaCheckDataSource_basic(t *testing.T) {
+	const dataSourceName = "data.aws_quota_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicequotas.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuotaCheckDataSourceConfig_basic(setQuotaServiceCode, setQuotaQuotaCode, 0, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "available", regexp.MustCompile(`^-?\d+(\.\d+)?$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "quota_code", setQuotaQuotaCode),
+					resource.TestCheckResourceAttr(dataSourceName, "quota_name", setQuotaQuotaName),
+					resource.TestMatchResourceAttr(dataSourceName, "quota_value", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "requested", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_code", setQuotaServiceCode),
+					resource.TestMatchResourceAttr(dataSourceName, "usage", regexp.MustCompile(`^\d+(\.\d+)?$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceQuotasQuotaCheckDataSource_exceeded(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicequotas.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQuotaCheckDataSourceConfig_basic(setQuotaServiceCode, setQuotaQuotaCode, 1000000, false),
+				ExpectError: regexp.MustCompile(`would be exceeded`),
+			},
+		},
+	})
+}
+
+func TestAccServiceQuotasQuotaCheckDataSource_warnOnly(t *testing.T) {
+	const dataSourceName = "data.aws_quota_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicequotas.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuotaCheckDataSourceConfig_basic(setQuotaServiceCode, setQuotaQuotaCode, 1000000, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exceeded", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQuotaCheckDataSourceConfig_basic(serviceCode, quotaCode string, requested int, warnOnly bool) string {
+	return fmt.Sprintf(`
+data "aws_quota_check" "test" {
+  service_code = %[1]q
+  quota_code   = %[2]q
+  requested    = %[3]d
+  warn_only    = %[4]t
+}
+`, serviceCode, quotaCode, requested, warnOnly)
+}
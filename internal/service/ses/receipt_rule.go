@@ -2,6 +2,7 @@ package ses
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -30,6 +31,8 @@ func ResourceReceiptRule() *schema.Resource {
 			State: resourceReceiptRuleImport,
 		},
 
+		CustomizeDiff: resourceReceiptRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -397,6 +400,41 @@ func ResourceReceiptRule() *schema.Resource {
 	}
 }
 
+var receiptRuleActionTypes = []string{
+	"add_header_action",
+	"bounce_action",
+	"lambda_action",
+	"s3_action",
+	"sns_action",
+	"stop_action",
+	"workmail_action",
+}
+
+func resourceReceiptRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Each action position may only be used once across all action types,
+	// otherwise one action silently replaces another during expansion.
+	positions := make(map[int]string)
+
+	for _, actionType := range receiptRuleActionTypes {
+		for _, element := range diff.Get(actionType).(*schema.Set).List() {
+			position := element.(map[string]interface{})["position"].(int)
+
+			// Unknown positions are not validated until apply.
+			if position == 0 {
+				continue
+			}
+
+			if other, ok := positions[position]; ok {
+				return fmt.Errorf("%s and %s cannot both use position %d", other, actionType, position)
+			}
+
+			positions[position] = actionType
+		}
+	}
+
+	return nil
+}
+
 func resourceReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), ":")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
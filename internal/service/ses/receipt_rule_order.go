@@ -0,0 +1,96 @@
+package ses
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func ResourceReceiptRuleOrder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReceiptRuleOrderPut,
+		Read:   resourceReceiptRuleOrderRead,
+		Update: resourceReceiptRuleOrderPut,
+		Delete: resourceReceiptRuleOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceReceiptRuleOrderPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESConn
+
+	ruleSetName := d.Get("rule_set_name").(string)
+	input := &ses.ReorderReceiptRuleSetInput{
+		RuleNames:   flex.ExpandStringList(d.Get("rule_names").([]interface{})),
+		RuleSetName: aws.String(ruleSetName),
+	}
+
+	// The whole rule set is reordered in a single call so that no intermediate ordering is ever active.
+	log.Printf("[DEBUG] Reordering SES Receipt Rule Set: %s", input)
+	_, err := conn.ReorderReceiptRuleSet(input)
+
+	if err != nil {
+		return fmt.Errorf("reordering SES Receipt Rule Set (%s): %w", ruleSetName, err)
+	}
+
+	d.SetId(ruleSetName)
+
+	return resourceReceiptRuleOrderRead(d, meta)
+}
+
+func resourceReceiptRuleOrderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESConn
+
+	resp, err := conn.DescribeReceiptRuleSet(&ses.DescribeReceiptRuleSetInput{
+		RuleSetName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ses.ErrCodeRuleSetDoesNotExistException) {
+		log.Printf("[WARN] SES Receipt Rule Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("describing SES Receipt Rule Set (%s): %w", d.Id(), err)
+	}
+
+	var ruleNames []string
+	for _, rule := range resp.Rules {
+		ruleNames = append(ruleNames, aws.StringValue(rule.Name))
+	}
+
+	d.Set("rule_names", ruleNames)
+	d.Set("rule_set_name", d.Id())
+
+	return nil
+}
+
+func resourceReceiptRuleOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	// Rule order is a property of the rule set. There is nothing to delete.
+	return nil
+}
@@ -0,0 +1,113 @@
+package ses_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccSESReceiptRuleOrder_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_receipt_rule_order.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			testAccPreCheckReceiptRule(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptRuleOrderConfig_basic(rName, "first", "second", "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleOrder("aws_ses_receipt_rule_set.test", "first", "second", "third"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_set_name", "aws_ses_receipt_rule_set.test", "rule_set_name"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.0", "first"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.1", "second"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.2", "third"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReceiptRuleOrderConfig_basic(rName, "third", "first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptRuleOrder("aws_ses_receipt_rule_set.test", "third", "first", "second"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.0", "third"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.1", "first"),
+					resource.TestCheckResourceAttr(resourceName, "rule_names.2", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReceiptRuleOrder(n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+
+		resp, err := conn.DescribeReceiptRuleSet(&ses.DescribeReceiptRuleSetInput{
+			RuleSetName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(resp.Rules), len(want); got != want {
+			return fmt.Errorf("SES Receipt Rule Set (%s) has %d rules, expected %d", rs.Primary.ID, got, want)
+		}
+
+		for i, rule := range resp.Rules {
+			if got := aws.StringValue(rule.Name); got != want[i] {
+				return fmt.Errorf("SES Receipt Rule Set (%s) rule %d is %q, expected %q", rs.Primary.ID, i, got, want[i])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccReceiptRuleOrderConfig_basic(rName, rule1, rule2, rule3 string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  for_each = toset(["first", "second", "third"])
+
+  name          = each.key
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+}
+
+resource "aws_ses_receipt_rule_order" "test" {
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+  rule_names = [
+    aws_ses_receipt_rule.test[%[2]q].name,
+    aws_ses_receipt_rule.test[%[3]q].name,
+    aws_ses_receipt_rule.test[%[4]q].name,
+  ]
+}
+`, rName, rule1, rule2, rule3)
+}
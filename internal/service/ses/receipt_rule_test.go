@@ -2,6 +2,7 @@ package ses_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -320,6 +321,27 @@ func TestAccSESReceiptRule_actions(t *testing.T) {
 	})
 }
 
+func TestAccSESReceiptRule_duplicateActionPosition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			testAccPreCheckReceiptRule(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReceiptRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReceiptRuleConfig_duplicateActionPosition(rName),
+				ExpectError: regexp.MustCompile(`cannot both use position 1`),
+			},
+		},
+	})
+}
+
 func TestAccSESReceiptRule_disappears(t *testing.T) {
 	var rule ses.ReceiptRule
 
@@ -683,3 +705,27 @@ resource "aws_ses_receipt_rule" "test" {
 }
 `, rName)
 }
+
+func testAccReceiptRuleConfig_duplicateActionPosition(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule" "test" {
+  name          = %[1]q
+  rule_set_name = aws_ses_receipt_rule_set.test.rule_set_name
+
+  add_header_action {
+    header_name  = "Added-By"
+    header_value = "Terraform"
+    position     = 1
+  }
+
+  stop_action {
+    scope    = "RuleSet"
+    position = 1
+  }
+}
+`, rName)
+}
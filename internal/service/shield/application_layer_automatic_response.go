@@ -0,0 +1,186 @@
+package shield
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	applicationLayerAutomaticResponseActionBlock = "BLOCK"
+	applicationLayerAutomaticResponseActionCount = "COUNT"
+)
+
+func applicationLayerAutomaticResponseAction_Values() []string {
+	return []string{
+		applicationLayerAutomaticResponseActionBlock,
+		applicationLayerAutomaticResponseActionCount,
+	}
+}
+
+func ResourceApplicationLayerAutomaticResponse() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationLayerAutomaticResponseCreate,
+		ReadWithoutTimeout:   resourceApplicationLayerAutomaticResponseRead,
+		UpdateWithoutTimeout: resourceApplicationLayerAutomaticResponseUpdate,
+		DeleteWithoutTimeout: resourceApplicationLayerAutomaticResponseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(applicationLayerAutomaticResponseAction_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceApplicationLayerAutomaticResponseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	arn := d.Get("resource_arn").(string)
+	input := &shield.EnableApplicationLayerAutomaticResponseInput{
+		Action:      expandResponseAction(d.Get("action").(string)),
+		ResourceArn: aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Enabling Shield Application Layer Automatic Response: %s", input)
+	_, err := conn.EnableApplicationLayerAutomaticResponseWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error enabling Shield Application Layer Automatic Response (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	if _, err := waitApplicationLayerAutomaticResponseEnabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Shield Application Layer Automatic Response (%s) enable: %s", d.Id(), err)
+	}
+
+	return resourceApplicationLayerAutomaticResponseRead(ctx, d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	output, err := FindApplicationLayerAutomaticResponseByResourceARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Application Layer Automatic Response (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Shield Application Layer Automatic Response (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", flattenResponseAction(output.Action))
+	d.Set("resource_arn", d.Id())
+
+	return nil
+}
+
+func resourceApplicationLayerAutomaticResponseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if d.HasChange("action") {
+		input := &shield.UpdateApplicationLayerAutomaticResponseInput{
+			Action:      expandResponseAction(d.Get("action").(string)),
+			ResourceArn: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Shield Application Layer Automatic Response: %s", input)
+		_, err := conn.UpdateApplicationLayerAutomaticResponseWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Shield Application Layer Automatic Response (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitApplicationLayerAutomaticResponseEnabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Shield Application Layer Automatic Response (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceApplicationLayerAutomaticResponseRead(ctx, d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	log.Printf("[DEBUG] Disabling Shield Application Layer Automatic Response: %s", d.Id())
+	_, err := conn.DisableApplicationLayerAutomaticResponseWithContext(ctx, &shield.DisableApplicationLayerAutomaticResponseInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error disabling Shield Application Layer Automatic Response (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitApplicationLayerAutomaticResponseDisabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Shield Application Layer Automatic Response (%s) disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandResponseAction(v string) *shield.ResponseAction {
+	switch v {
+	case applicationLayerAutomaticResponseActionBlock:
+		return &shield.ResponseAction{
+			Block: &shield.BlockAction{},
+		}
+	case applicationLayerAutomaticResponseActionCount:
+		return &shield.ResponseAction{
+			Count: &shield.CountAction{},
+		}
+	}
+
+	return nil
+}
+
+func flattenResponseAction(apiObject *shield.ResponseAction) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	if apiObject.Block != nil {
+		return applicationLayerAutomaticResponseActionBlock
+	}
+
+	if apiObject.Count != nil {
+		return applicationLayerAutomaticResponseActionCount
+	}
+
+	return ""
+}
@@ -0,0 +1,105 @@
+package shield
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindProtectionByResourceARN(ctx context.Context, conn *shield.Shield, arn string) (*shield.Protection, error) {
+	input := &shield.DescribeProtectionInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeProtectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Protection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Protection, nil
+}
+
+func FindApplicationLayerAutomaticResponseByResourceARN(ctx context.Context, conn *shield.Shield, arn string) (*shield.ApplicationLayerAutomaticResponseConfiguration, error) {
+	protection, err := FindProtectionByResourceARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := protection.ApplicationLayerAutomaticResponseConfiguration
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(arn)
+	}
+
+	if status := aws.StringValue(output.Status); status == shield.ApplicationLayerAutomaticResponseStatusDisabled {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: arn,
+		}
+	}
+
+	return output, nil
+}
+
+func FindSubscription(ctx context.Context, conn *shield.Shield) (*shield.Subscription, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscriptionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscription, nil
+}
+
+func FindEmergencyContacts(ctx context.Context, conn *shield.Shield) ([]*shield.EmergencyContact, error) {
+	input := &shield.DescribeEmergencyContactSettingsInput{}
+
+	output, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EmergencyContactList, nil
+}
@@ -0,0 +1,217 @@
+package shield
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProactiveEngagementPut,
+		ReadWithoutTimeout:   resourceProactiveEngagementRead,
+		UpdateWithoutTimeout: resourceProactiveEngagementPut,
+		DeleteWithoutTimeout: resourceProactiveEngagementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\+[1-9]\d{1,14}$`), "must be in E.164 format, e.g. +15555555555"),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceProactiveEngagementPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	subscription, err := FindSubscription(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("error reading Shield Subscription: %s", err)
+	}
+
+	contacts := expandEmergencyContacts(d.Get("emergency_contact").([]interface{}))
+	enabled := d.Get("enabled").(bool)
+
+	// Proactive engagement is initialized, and enabled, by associating the first set of contacts.
+	// Afterwards it is enabled and disabled separately from the contact list.
+	if enabled && subscription.ProactiveEngagementStatus == nil {
+		input := &shield.AssociateProactiveEngagementDetailsInput{
+			EmergencyContactList: contacts,
+		}
+
+		log.Printf("[DEBUG] Associating Shield Proactive Engagement Details: %s", input)
+		if _, err := conn.AssociateProactiveEngagementDetailsWithContext(ctx, input); err != nil {
+			return diag.Errorf("error associating Shield Proactive Engagement Details: %s", err)
+		}
+	} else {
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: contacts,
+		}
+
+		log.Printf("[DEBUG] Updating Shield Emergency Contact Settings: %s", input)
+		if _, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating Shield Emergency Contact Settings: %s", err)
+		}
+
+		if status := aws.StringValue(subscription.ProactiveEngagementStatus); enabled && status != shield.ProactiveEngagementStatusEnabled {
+			if _, err := conn.EnableProactiveEngagementWithContext(ctx, &shield.EnableProactiveEngagementInput{}); err != nil {
+				return diag.Errorf("error enabling Shield Proactive Engagement: %s", err)
+			}
+		} else if !enabled && status == shield.ProactiveEngagementStatusEnabled {
+			if _, err := conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{}); err != nil {
+				return diag.Errorf("error disabling Shield Proactive Engagement: %s", err)
+			}
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceProactiveEngagementRead(ctx, d, meta)
+}
+
+func resourceProactiveEngagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	subscription, err := FindSubscription(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Subscription (%s) not found, removing Proactive Engagement from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Shield Subscription: %s", err)
+	}
+
+	contacts, err := FindEmergencyContacts(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("error reading Shield Emergency Contact Settings: %s", err)
+	}
+
+	if err := d.Set("emergency_contact", flattenEmergencyContacts(contacts)); err != nil {
+		return diag.Errorf("error setting emergency_contact: %s", err)
+	}
+	d.Set("enabled", aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled)
+
+	return nil
+}
+
+func resourceProactiveEngagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	subscription, err := FindSubscription(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Shield Subscription: %s", err)
+	}
+
+	if aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+		log.Printf("[DEBUG] Disabling Shield Proactive Engagement: %s", d.Id())
+		if _, err := conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{}); err != nil {
+			return diag.Errorf("error disabling Shield Proactive Engagement: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Removing Shield Emergency Contacts: %s", d.Id())
+	_, err = conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if err != nil {
+		return diag.Errorf("error removing Shield Emergency Contacts: %s", err)
+	}
+
+	return nil
+}
+
+func expandEmergencyContacts(tfList []interface{}) []*shield.EmergencyContact {
+	apiObjects := make([]*shield.EmergencyContact, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &shield.EmergencyContact{
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			apiObject.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			apiObject.PhoneNumber = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"contact_notes": aws.StringValue(apiObject.ContactNotes),
+			"email_address": aws.StringValue(apiObject.EmailAddress),
+			"phone_number":  aws.StringValue(apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,32 @@
+package shield
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusApplicationLayerAutomaticResponse(ctx context.Context, conn *shield.Shield, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		protection, err := FindProtectionByResourceARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		output := protection.ApplicationLayerAutomaticResponseConfiguration
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,43 @@
+package shield
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitApplicationLayerAutomaticResponseEnabled(ctx context.Context, conn *shield.Shield, arn string, timeout time.Duration) (*shield.ApplicationLayerAutomaticResponseConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{shield.ApplicationLayerAutomaticResponseStatusDisabled},
+		Target:  []string{shield.ApplicationLayerAutomaticResponseStatusEnabled},
+		Refresh: statusApplicationLayerAutomaticResponse(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*shield.ApplicationLayerAutomaticResponseConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationLayerAutomaticResponseDisabled(ctx context.Context, conn *shield.Shield, arn string, timeout time.Duration) (*shield.ApplicationLayerAutomaticResponseConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{shield.ApplicationLayerAutomaticResponseStatusEnabled},
+		Target:  []string{shield.ApplicationLayerAutomaticResponseStatusDisabled},
+		Refresh: statusApplicationLayerAutomaticResponse(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*shield.ApplicationLayerAutomaticResponseConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
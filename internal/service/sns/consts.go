@@ -63,6 +63,7 @@ const (
 	SubscriptionAttributeNameProtocol                     = "Protocol"
 	SubscriptionAttributeNameRawMessageDelivery           = "RawMessageDelivery"
 	SubscriptionAttributeNameRedrivePolicy                = "RedrivePolicy"
+	SubscriptionAttributeNameReplayPolicy                 = "ReplayPolicy"
 	SubscriptionAttributeNameSubscriptionARN              = "SubscriptionArn"
 	SubscriptionAttributeNameSubscriptionRoleARN          = "SubscriptionRoleArn"
 	SubscriptionAttributeNameTopicARN                     = "TopicArn"
@@ -72,6 +73,8 @@ const (
 	TopicAttributeNameApplicationFailureFeedbackRoleARN    = "ApplicationFailureFeedbackRoleArn"
 	TopicAttributeNameApplicationSuccessFeedbackRoleARN    = "ApplicationSuccessFeedbackRoleArn"
 	TopicAttributeNameApplicationSuccessFeedbackSampleRate = "ApplicationSuccessFeedbackSampleRate"
+	TopicAttributeNameArchivePolicy                        = "ArchivePolicy"
+	TopicAttributeNameBeginningArchiveTime                 = "BeginningArchiveTime"
 	TopicAttributeNameContentBasedDeduplication            = "ContentBasedDeduplication"
 	TopicAttributeNameDeliveryPolicy                       = "DeliveryPolicy"
 	TopicAttributeNameDisplayName                          = "DisplayName"
@@ -84,3 +84,28 @@ func FindTopicAttributesByARN(ctx context.Context, conn *sns.SNS, arn string) (m
 
 	return aws.StringValueMap(output.Attributes), nil
 }
+
+func FindDataProtectionPolicyByARN(ctx context.Context, conn *sns.SNS, arn string) (string, error) {
+	input := &sns.GetDataProtectionPolicyInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDataProtectionPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || aws.StringValue(output.DataProtectionPolicy) == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.DataProtectionPolicy), nil
+}
@@ -40,10 +40,24 @@ var (
 			Optional:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
 		},
+		"archive_policy": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: suppressEquivalentTopicArchivePolicyDiffs,
+			StateFunc: func(v interface{}) string {
+				json, _ := structure.NormalizeJsonString(v)
+				return json
+			},
+		},
 		"arn": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"beginning_archive_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"content_based_deduplication": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -170,7 +184,9 @@ var (
 		"application_failure_feedback_role_arn":    TopicAttributeNameApplicationFailureFeedbackRoleARN,
 		"application_success_feedback_role_arn":    TopicAttributeNameApplicationSuccessFeedbackRoleARN,
 		"application_success_feedback_sample_rate": TopicAttributeNameApplicationSuccessFeedbackSampleRate,
+		"archive_policy":                        TopicAttributeNameArchivePolicy,
 		"arn":                                   TopicAttributeNameTopicARN,
+		"beginning_archive_time":                TopicAttributeNameBeginningArchiveTime,
 		"content_based_deduplication":           TopicAttributeNameContentBasedDeduplication,
 		"delivery_policy":                       TopicAttributeNameDeliveryPolicy,
 		"display_name":                          TopicAttributeNameDisplayName,
@@ -438,9 +454,23 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO topics")
 	}
 
+	if v, ok := diff.GetOk("archive_policy"); ok && v.(string) != "" && !fifoTopic {
+		return fmt.Errorf("message archiving can only be configured for FIFO topics")
+	}
+
 	return nil
 }
 
+// suppressEquivalentTopicArchivePolicyDiffs treats an empty JSON object, which is how
+// a disabled archive policy may be reported, as equivalent to no archive policy.
+func suppressEquivalentTopicArchivePolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if (old == "{}" && new == "") || (old == "" && new == "{}") {
+		return true
+	}
+
+	return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
+}
+
 func putTopicAttributes(ctx context.Context, conn *sns.SNS, arn string, attributes map[string]string) error {
 	for name, value := range attributes {
 		// Ignore an empty policy.
@@ -448,6 +478,11 @@ func putTopicAttributes(ctx context.Context, conn *sns.SNS, arn string, attribut
 			continue
 		}
 
+		// Archiving is disabled by setting an empty JSON object.
+		if name == TopicAttributeNameArchivePolicy && value == "" {
+			value = "{}"
+		}
+
 		err := putTopicAttribute(ctx, conn, arn, name, value)
 
 		if err != nil {
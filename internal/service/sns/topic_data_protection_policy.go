@@ -0,0 +1,193 @@
+package sns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTopicDataProtectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTopicDataProtectionPolicyUpsert,
+		ReadWithoutTimeout:   resourceTopicDataProtectionPolicyRead,
+		UpdateWithoutTimeout: resourceTopicDataProtectionPolicyUpsert,
+		DeleteWithoutTimeout: resourceTopicDataProtectionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDataProtectionPolicy,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceTopicDataProtectionPolicyUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	arn := d.Get("arn").(string)
+	input := &sns.PutDataProtectionPolicyInput{
+		DataProtectionPolicy: aws.String(policy),
+		ResourceArn:          aws.String(arn),
+	}
+
+	_, err = conn.PutDataProtectionPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("setting SNS Topic Data Protection Policy (%s): %s", arn, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(arn)
+	}
+
+	return resourceTopicDataProtectionPolicyRead(ctx, d, meta)
+}
+
+func resourceTopicDataProtectionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	policy, err := FindDataProtectionPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SNS Topic Data Protection Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SNS Topic Data Protection Policy (%s): %s", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceTopicDataProtectionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	log.Printf("[DEBUG] Deleting SNS Topic Data Protection Policy: %s", d.Id())
+	_, err := conn.PutDataProtectionPolicyWithContext(ctx, &sns.PutDataProtectionPolicyInput{
+		DataProtectionPolicy: aws.String(""),
+		ResourceArn:          aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SNS Topic Data Protection Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const (
+	dataProtectionPolicyVersion = "2021-06-01"
+)
+
+// validateDataProtectionPolicy performs plan-time validation of the structure of a
+// data protection policy document. Data identifier ARNs and operation contents are
+// validated by the service.
+func validateDataProtectionPolicy(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var policy struct {
+		Name      *string                  `json:"Name"`
+		Version   *string                  `json:"Version"`
+		Statement []map[string]interface{} `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid data protection policy: %s", k, err))
+		return
+	}
+
+	if aws.StringValue(policy.Name) == "" {
+		errors = append(errors, fmt.Errorf("%q must contain a non-empty Name", k))
+	}
+
+	if version := aws.StringValue(policy.Version); version != dataProtectionPolicyVersion {
+		errors = append(errors, fmt.Errorf("%q Version must be %q, got: %q", k, dataProtectionPolicyVersion, version))
+	}
+
+	if len(policy.Statement) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one Statement", k))
+	}
+
+	for i, statement := range policy.Statement {
+		switch direction := statement["DataDirection"]; direction {
+		case "Inbound", "Outbound":
+		default:
+			errors = append(errors, fmt.Errorf("%q Statement %d DataDirection must be one of \"Inbound\" or \"Outbound\", got: %v", k, i, direction))
+		}
+
+		for _, key := range []string{"DataIdentifier", "Principal"} {
+			if l, ok := statement[key].([]interface{}); !ok || len(l) == 0 {
+				errors = append(errors, fmt.Errorf("%q Statement %d must contain a non-empty %s list", k, i, key))
+			}
+		}
+
+		operation, ok := statement["Operation"].(map[string]interface{})
+
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q Statement %d must contain an Operation", k, i))
+			continue
+		}
+
+		n := 0
+		for _, key := range []string{"Audit", "Deidentify", "Deny"} {
+			if _, ok := operation[key]; ok {
+				n++
+			}
+		}
+
+		if n != 1 {
+			errors = append(errors, fmt.Errorf("%q Statement %d Operation must contain exactly one of Audit, Deidentify or Deny", k, i))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,170 @@
+package sns_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSNSTopicDataProtectionPolicy_basic(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic_data_protection_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicDataProtectionPolicyConfig_basic(rName, "Audit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists("aws_sns_topic.test", &attributes),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_sns_topic.test", "arn"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"Audit"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicDataProtectionPolicyConfig_basic(rName, "Deny"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists("aws_sns_topic.test", &attributes),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"Deny"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicDataProtectionPolicy_disappears(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic_data_protection_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicDataProtectionPolicyConfig_basic(rName, "Audit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists("aws_sns_topic.test", &attributes),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsns.ResourceTopicDataProtectionPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSNSTopicDataProtectionPolicy_invalidPolicy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicDataProtectionPolicyConfig_invalid(rName),
+				ExpectError: regexp.MustCompile(`Operation must contain exactly one of Audit, Deidentify or Deny`),
+			},
+		},
+	})
+}
+
+func testAccCheckTopicDataProtectionPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sns_topic_data_protection_policy" {
+			continue
+		}
+
+		_, err := tfsns.FindDataProtectionPolicyByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SNS Topic Data Protection Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTopicDataProtectionPolicyConfig_basic(rName, operation string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_data_protection_policy" "test" {
+  arn = aws_sns_topic.test.arn
+  policy = jsonencode({
+    Name    = %[1]q
+    Version = "2021-06-01"
+    Statement = [{
+      Sid            = %[1]q
+      DataDirection  = "Inbound"
+      Principal      = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+      DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/EmailAddress"]
+      Operation = {
+        %[2]s = {}
+      }
+    }]
+  })
+}
+`, rName, operation)
+}
+
+func testAccTopicDataProtectionPolicyConfig_invalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_data_protection_policy" "test" {
+  arn = aws_sns_topic.test.arn
+  policy = jsonencode({
+    Name    = %[1]q
+    Version = "2021-06-01"
+    Statement = [{
+      DataDirection  = "Inbound"
+      Principal      = ["*"]
+      DataIdentifier = ["arn:aws:dataprotection::aws:data-identifier/EmailAddress"]
+      Operation = {
+        Audit = {}
+        Deny  = {}
+      }
+    }]
+  })
+}
+`, rName)
+}
@@ -89,6 +89,12 @@ var (
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 		},
+		"replay_policy": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		},
 		"subscription_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
@@ -113,6 +119,7 @@ var (
 		"protocol":                       SubscriptionAttributeNameProtocol,
 		"raw_message_delivery":           SubscriptionAttributeNameRawMessageDelivery,
 		"redrive_policy":                 SubscriptionAttributeNameRedrivePolicy,
+		"replay_policy":                  SubscriptionAttributeNameReplayPolicy,
 		"subscription_role_arn":          SubscriptionAttributeNameSubscriptionRoleARN,
 		"topic_arn":                      SubscriptionAttributeNameTopicARN,
 	}, subscriptionSchema).WithMissingSetToNil("*")
@@ -483,6 +483,65 @@ func TestAccSNSTopic_fifoExpectContentBasedDeduplicationError(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_fifoWithArchivePolicy(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_fifoArchivePolicy(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":"30"}`),
+					resource.TestCheckResourceAttrSet(resourceName, "beginning_archive_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicConfig_fifoArchivePolicy(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":"60"}`),
+				),
+			},
+			{
+				Config: testAccTopicConfig_fifoContentBasedDeduplication(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopic_expectArchivePolicyError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicConfig_expectArchivePolicyError(rName),
+				ExpectError: regexp.MustCompile(`message archiving can only be configured for FIFO topics`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_encryption(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
@@ -926,6 +985,31 @@ resource "aws_sns_topic" "test" {
 `, rName)
 }
 
+func testAccTopicConfig_fifoArchivePolicy(rName string, retentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name       = "%[1]s.fifo"
+  fifo_topic = true
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = "%[2]d"
+  })
+}
+`, rName, retentionPeriod)
+}
+
+func testAccTopicConfig_expectArchivePolicyError(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = "30"
+  })
+}
+`, rName)
+}
+
 func testAccTopicConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
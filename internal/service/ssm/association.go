@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -52,6 +53,11 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"instance_id": {
 				Type:       schema.TypeString,
 				ForceNew:   true,
@@ -114,6 +120,42 @@ func ResourceAssociation() *schema.Resource {
 					},
 				},
 			},
+			"target_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 			"targets": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -170,6 +212,10 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("instance_id"); ok {
 		associationInput.InstanceId = aws.String(v.(string))
 	}
@@ -186,6 +232,10 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.Parameters = expandDocumentParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("targets"); ok {
 		associationInput.Targets = expandTargets(v.([]interface{}))
 	}
@@ -260,6 +310,7 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_id", association.InstanceId)
 	d.Set("name", association.Name)
 	d.Set("association_id", association.AssociationId)
+	d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames))
 	d.Set("schedule_expression", association.ScheduleExpression)
 	d.Set("document_version", association.DocumentVersion)
 	d.Set("compliance_severity", association.ComplianceSeverity)
@@ -271,6 +322,10 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("target_locations", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return fmt.Errorf("setting target_locations: %w", err)
+	}
+
 	if err := d.Set("targets", flattenTargets(association.Targets)); err != nil {
 		return fmt.Errorf("Error setting targets error: %w", err)
 	}
@@ -308,10 +363,18 @@ func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.ScheduleExpression = aws.String(v.(string))
 	}
 
+	if d.HasChange("calendar_names") {
+		associationInput.CalendarNames = flex.ExpandStringSet(d.Get("calendar_names").(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		associationInput.Parameters = expandDocumentParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("targets"); ok {
 		associationInput.Targets = expandTargets(d.Get("targets").([]interface{}))
 	}
@@ -423,3 +486,71 @@ func flattenAssociationOutputLocation(location *ssm.InstanceAssociationOutputLoc
 
 	return result
 }
+
+func expandTargetLocations(tfList []interface{}) []*ssm.TargetLocation {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssm.TargetLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.TargetLocation{}
+
+		if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Accounts = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["execution_role_name"].(string); ok && v != "" {
+			apiObject.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Regions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["target_location_max_concurrency"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_location_max_errors"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetLocations(apiObjects []*ssm.TargetLocation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"accounts":                        aws.StringValueSlice(apiObject.Accounts),
+			"execution_role_name":             aws.StringValue(apiObject.ExecutionRoleName),
+			"regions":                         aws.StringValueSlice(apiObject.Regions),
+			"target_location_max_concurrency": aws.StringValue(apiObject.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.StringValue(apiObject.TargetLocationMaxErrors),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
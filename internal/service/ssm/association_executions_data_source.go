@@ -0,0 +1,190 @@
+package ssm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceAssociationExecutions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataAssociationExecutionsRead,
+		Schema: map[string]*schema.Schema{
+			"association_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"executions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detailed_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"execution_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_execution_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_count_by_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ssm.AssociationExecutionFilterKey_Values(), false),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.AssociationFilterOperatorTypeEqual,
+							ValidateFunc: validation.StringInSlice(ssm.AssociationFilterOperatorType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataAssociationExecutionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	associationID := d.Get("association_id").(string)
+	input := &ssm.DescribeAssociationExecutionsInput{
+		AssociationId: aws.String(associationID),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = expandAssociationExecutionFilters(v.(*schema.Set).List())
+	}
+
+	var results []*ssm.AssociationExecution
+
+	err := conn.DescribeAssociationExecutionsPages(input, func(page *ssm.DescribeAssociationExecutionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, execution := range page.AssociationExecutions {
+			if execution == nil {
+				continue
+			}
+
+			results = append(results, execution)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading SSM Association (%s) executions: %w", associationID, err)
+	}
+
+	d.SetId(associationID)
+
+	if err := d.Set("executions", flattenAssociationExecutions(results)); err != nil {
+		return fmt.Errorf("setting executions: %w", err)
+	}
+
+	return nil
+}
+
+func expandAssociationExecutionFilters(tfList []interface{}) []*ssm.AssociationExecutionFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssm.AssociationExecutionFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.AssociationExecutionFilter{}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAssociationExecutions(apiObjects []*ssm.AssociationExecution) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"association_version":      aws.StringValue(apiObject.AssociationVersion),
+			"detailed_status":          aws.StringValue(apiObject.DetailedStatus),
+			"execution_id":             aws.StringValue(apiObject.ExecutionId),
+			"resource_count_by_status": aws.StringValue(apiObject.ResourceCountByStatus),
+			"status":                   aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.CreatedTime; v != nil {
+			tfMap["created_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastExecutionDate; v != nil {
+			tfMap["last_execution_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
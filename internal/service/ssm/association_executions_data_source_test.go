@@ -0,0 +1,53 @@
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMAssociationExecutionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ssm_association_executions.test"
+	resourceName := "aws_ssm_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationExecutionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "association_id", resourceName, "association_id"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "executions.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "executions.0.execution_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "executions.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssociationExecutionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name             = "AWS-UpdateSSMAgent"
+  association_name = %[1]q
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+}
+
+data "aws_ssm_association_executions" "test" {
+  association_id = aws_ssm_association.test.association_id
+}
+`, rName)
+}
@@ -129,6 +129,80 @@ func TestAccSSMAssociation_applyOnlyAtCronInterval(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_calendarNames(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_calendarNames(rName, "aws_ssm_document.calendar1.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_calendarNames(rName, "aws_ssm_document.calendar1.arn, aws_ssm_document.calendar2.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar1", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_targetLocations(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_locations.0.accounts.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.regions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_locations.0.regions.*", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMAssociation_withTargets(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_association.test"
@@ -677,6 +751,85 @@ resource "aws_ssm_association" "test" {
 `, rName, applyOnlyAtCronInterval)
 }
 
+func testAccAssociationConfig_calendarNames(rName, calendarNames string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "calendar1" {
+  name            = "%[1]s-1"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:
+BEGIN:VTODO
+DTSTAMP:20221101T000000Z
+UID:%[1]s-1
+SUMMARY:Add events to this calendar.
+END:VTODO
+END:VCALENDAR
+DOC
+}
+
+resource "aws_ssm_document" "calendar2" {
+  name            = "%[1]s-2"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:
+BEGIN:VTODO
+DTSTAMP:20221101T000000Z
+UID:%[1]s-2
+SUMMARY:Add events to this calendar.
+END:VTODO
+END:VCALENDAR
+DOC
+}
+
+resource "aws_ssm_association" "test" {
+  name                = "AWS-UpdateSSMAgent"
+  schedule_expression = "cron(0 16 ? * TUE *)"
+  calendar_names      = [%[2]s]
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+}
+`, rName, calendarNames)
+}
+
+func testAccAssociationConfig_targetLocations(rName, maxConcurrency string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_ssm_association" "test" {
+  name             = "AWS-UpdateSSMAgent"
+  association_name = %[1]q
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  target_locations {
+    accounts                        = [data.aws_caller_identity.current.account_id]
+    regions                         = [data.aws_region.current.name]
+    target_location_max_concurrency = %[2]q
+  }
+}
+`, rName, maxConcurrency)
+}
+
 func testAccAssociationConfig_basicAutomationTargetParamName(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
 resource "aws_iam_instance_profile" "ssm_profile" {
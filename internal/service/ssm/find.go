@@ -111,3 +111,70 @@ func FindServiceSettingByID(conn *ssm.SSM, id string) (*ssm.ServiceSetting, erro
 
 	return output.ServiceSetting, nil
 }
+
+func FindOpsItemByID(conn *ssm.SSM, id string) (*ssm.OpsItem, error) {
+	input := &ssm.GetOpsItemInput{
+		OpsItemId: aws.String(id),
+	}
+
+	output, err := conn.GetOpsItem(input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OpsItem == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OpsItem, nil
+}
+
+// FindOpsMetadataByARN returns the resource ID and all of the metadata of the specified OpsMetadata object.
+func FindOpsMetadataByARN(conn *ssm.SSM, arn string) (string, map[string]*ssm.MetadataValue, error) {
+	input := &ssm.GetOpsMetadataInput{
+		OpsMetadataArn: aws.String(arn),
+	}
+	var resourceID string
+	metadata := make(map[string]*ssm.MetadataValue)
+
+	for {
+		output, err := conn.GetOpsMetadata(input)
+
+		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+			return "", nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		if output == nil {
+			return "", nil, tfresource.NewEmptyResultError(input)
+		}
+
+		resourceID = aws.StringValue(output.ResourceId)
+
+		for k, v := range output.Metadata {
+			metadata[k] = v
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return resourceID, metadata, nil
+}
@@ -0,0 +1,502 @@
+package ssm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOpsItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOpsItemCreate,
+		Read:   resourceOpsItemRead,
+		Update: resourceOpsItemUpdate,
+		Delete: resourceOpsItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actual_end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"actual_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"notification_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"operational_data": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.OpsItemDataTypeSearchableString,
+							ValidateFunc: validation.StringInSlice(ssm.OpsItemDataType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ops_item_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"planned_end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"planned_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"related_ops_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "2", "3", "4"}, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.OpsItemStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsItemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	title := d.Get("title").(string)
+	input := &ssm.CreateOpsItemInput{
+		Description: aws.String(d.Get("description").(string)),
+		Source:      aws.String(d.Get("source").(string)),
+		Title:       aws.String(title),
+	}
+
+	if v, ok := d.GetOk("actual_end_time"); ok {
+		input.ActualEndTime = expandOpsItemTime(v.(string))
+	}
+
+	if v, ok := d.GetOk("actual_start_time"); ok {
+		input.ActualStartTime = expandOpsItemTime(v.(string))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && len(v.([]interface{})) > 0 {
+		input.Notifications = expandOpsItemNotifications(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("operational_data"); ok && v.(*schema.Set).Len() > 0 {
+		input.OperationalData = expandOpsItemOperationalData(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("ops_item_type"); ok {
+		input.OpsItemType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("planned_end_time"); ok {
+		input.PlannedEndTime = expandOpsItemTime(v.(string))
+	}
+
+	if v, ok := d.GetOk("planned_start_time"); ok {
+		input.PlannedStartTime = expandOpsItemTime(v.(string))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("related_ops_item_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.RelatedOpsItems = expandRelatedOpsItems(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM OpsItem: %s", input)
+	output, err := conn.CreateOpsItem(input)
+
+	if err != nil {
+		return fmt.Errorf("creating SSM OpsItem (%s): %w", title, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsItemId))
+
+	// New OpsItems are always Open.
+	if v, ok := d.GetOk("status"); ok && v.(string) != ssm.OpsItemStatusOpen {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+			Status:    aws.String(v.(string)),
+		}
+
+		if _, err := conn.UpdateOpsItem(input); err != nil {
+			return fmt.Errorf("setting SSM OpsItem (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceOpsItemRead(d, meta)
+}
+
+func resourceOpsItemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	opsItem, err := FindOpsItemByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsItem (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SSM OpsItem (%s): %w", d.Id(), err)
+	}
+
+	d.Set("actual_end_time", flattenOpsItemTime(opsItem.ActualEndTime))
+	d.Set("actual_start_time", flattenOpsItemTime(opsItem.ActualStartTime))
+	d.Set("arn", opsItem.OpsItemArn)
+	d.Set("category", opsItem.Category)
+	d.Set("description", opsItem.Description)
+	d.Set("notification_arns", flattenOpsItemNotifications(opsItem.Notifications))
+	if err := d.Set("operational_data", flattenOpsItemOperationalData(opsItem.OperationalData)); err != nil {
+		return fmt.Errorf("setting operational_data: %w", err)
+	}
+	d.Set("ops_item_type", opsItem.OpsItemType)
+	d.Set("planned_end_time", flattenOpsItemTime(opsItem.PlannedEndTime))
+	d.Set("planned_start_time", flattenOpsItemTime(opsItem.PlannedStartTime))
+	d.Set("priority", opsItem.Priority)
+	d.Set("related_ops_item_ids", flattenRelatedOpsItems(opsItem.RelatedOpsItems))
+	d.Set("severity", opsItem.Severity)
+	d.Set("source", opsItem.Source)
+	d.Set("status", opsItem.Status)
+	d.Set("title", opsItem.Title)
+
+	tags, err := ListTags(conn, d.Id(), ssm.ResourceTypeForTaggingOpsItem)
+
+	if err != nil {
+		return fmt.Errorf("listing tags for SSM OpsItem (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceOpsItemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("actual_end_time") {
+			input.ActualEndTime = expandOpsItemTime(d.Get("actual_end_time").(string))
+		}
+
+		if d.HasChange("actual_start_time") {
+			input.ActualStartTime = expandOpsItemTime(d.Get("actual_start_time").(string))
+		}
+
+		if d.HasChange("category") {
+			input.Category = aws.String(d.Get("category").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("notification_arns") {
+			input.Notifications = expandOpsItemNotifications(d.Get("notification_arns").([]interface{}))
+		}
+
+		if d.HasChange("operational_data") {
+			o, n := d.GetChange("operational_data")
+			input.OperationalData = expandOpsItemOperationalData(n.(*schema.Set).List())
+
+			for k := range expandOpsItemOperationalData(o.(*schema.Set).List()) {
+				if _, ok := input.OperationalData[k]; !ok {
+					input.OperationalDataToDelete = append(input.OperationalDataToDelete, aws.String(k))
+				}
+			}
+		}
+
+		if d.HasChange("planned_end_time") {
+			input.PlannedEndTime = expandOpsItemTime(d.Get("planned_end_time").(string))
+		}
+
+		if d.HasChange("planned_start_time") {
+			input.PlannedStartTime = expandOpsItemTime(d.Get("planned_start_time").(string))
+		}
+
+		if d.HasChange("priority") {
+			input.Priority = aws.Int64(int64(d.Get("priority").(int)))
+		}
+
+		if d.HasChange("related_ops_item_ids") {
+			input.RelatedOpsItems = expandRelatedOpsItems(d.Get("related_ops_item_ids").(*schema.Set).List())
+		}
+
+		if d.HasChange("severity") {
+			input.Severity = aws.String(d.Get("severity").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if d.HasChange("title") {
+			input.Title = aws.String(d.Get("title").(string))
+		}
+
+		log.Printf("[DEBUG] Updating SSM OpsItem: %s", input)
+		_, err := conn.UpdateOpsItem(input)
+
+		if err != nil {
+			return fmt.Errorf("updating SSM OpsItem (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), ssm.ResourceTypeForTaggingOpsItem, o, n); err != nil {
+			return fmt.Errorf("updating SSM OpsItem (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceOpsItemRead(d, meta)
+}
+
+func resourceOpsItemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	log.Printf("[DEBUG] Deleting SSM OpsItem: %s", d.Id())
+	_, err := conn.DeleteOpsItem(&ssm.DeleteOpsItemInput{
+		OpsItemId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting SSM OpsItem (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOpsItemTime(v string) *time.Time {
+	if v == "" {
+		return nil
+	}
+
+	t, _ := time.Parse(time.RFC3339, v)
+
+	return aws.Time(t)
+}
+
+func flattenOpsItemTime(apiObject *time.Time) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	return aws.TimeValue(apiObject).Format(time.RFC3339)
+}
+
+func expandOpsItemNotifications(tfList []interface{}) []*ssm.OpsItemNotification {
+	apiObjects := make([]*ssm.OpsItemNotification, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringList(tfList) {
+		apiObjects = append(apiObjects, &ssm.OpsItemNotification{Arn: v})
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemNotifications(apiObjects []*ssm.OpsItemNotification) []string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.Arn))
+	}
+
+	return tfList
+}
+
+func expandOpsItemOperationalData(tfList []interface{}) map[string]*ssm.OpsItemDataValue {
+	apiObjects := make(map[string]*ssm.OpsItemDataValue, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["key"].(string)] = &ssm.OpsItemDataValue{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemOperationalData(apiObjects map[string]*ssm.OpsItemDataValue) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"type":  aws.StringValue(apiObject.Type),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandRelatedOpsItems(tfList []interface{}) []*ssm.RelatedOpsItem {
+	apiObjects := make([]*ssm.RelatedOpsItem, 0, len(tfList))
+
+	for _, v := range flex.ExpandStringList(tfList) {
+		apiObjects = append(apiObjects, &ssm.RelatedOpsItem{OpsItemId: v})
+	}
+
+	return apiObjects
+}
+
+func flattenRelatedOpsItems(apiObjects []*ssm.RelatedOpsItem) []string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.OpsItemId))
+	}
+
+	return tfList
+}
@@ -0,0 +1,210 @@
+package ssm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMOpsItem_basic(t *testing.T) {
+	resourceName := "aws_ssm_ops_item.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName, "Open", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm", regexp.MustCompile(`opsitem/oi-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "ops_item_type", "/aws/issue"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Open"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "runbook",
+						"type":  "SearchableString",
+						"value": "https://example.com/runbook",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_basic(rName, "Resolved", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Resolved"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_tags(t *testing.T) {
+	resourceName := "aws_ssm_ops_item.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_disappears(t *testing.T) {
+	resourceName := "aws_ssm_ops_item.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName, "Open", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssm.ResourceOpsItem(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOpsItemDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_ops_item" {
+			continue
+		}
+
+		_, err := tfssm.FindOpsItemByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM OpsItem %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckOpsItemExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM OpsItem ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		_, err := tfssm.FindOpsItemByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccOpsItemConfig_basic(rName, status, severity string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title         = %[1]q
+  description   = "test"
+  source        = "terraform"
+  ops_item_type = "/aws/issue"
+  severity      = %[3]q
+  status        = %[2]q
+
+  operational_data {
+    key   = "runbook"
+    value = "https://example.com/runbook"
+  }
+}
+`, rName, status, severity)
+}
+
+func testAccOpsItemConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOpsItemConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
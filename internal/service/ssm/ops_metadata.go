@@ -0,0 +1,249 @@
+package ssm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOpsMetadata() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOpsMetadataCreate,
+		Read:   resourceOpsMetadataRead,
+		Update: resourceOpsMetadataUpdate,
+		Delete: resourceOpsMetadataDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 4096),
+				},
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsMetadataCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	resourceID := d.Get("resource_id").(string)
+	input := &ssm.CreateOpsMetadataInput{
+		ResourceId: aws.String(resourceID),
+	}
+
+	if v, ok := d.GetOk("metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Metadata = expandMetadataValues(v.(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM OpsMetadata: %s", input)
+	output, err := conn.CreateOpsMetadata(input)
+
+	if err != nil {
+		return fmt.Errorf("creating SSM OpsMetadata (%s): %w", resourceID, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsMetadataArn))
+
+	return resourceOpsMetadataRead(d, meta)
+}
+
+func resourceOpsMetadataRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resourceID, metadata, err := FindOpsMetadataByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsMetadata (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SSM OpsMetadata (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("metadata", flattenMetadataValues(metadata))
+	d.Set("resource_id", resourceID)
+
+	tagID, err := opsMetadataTaggingID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tags, err := ListTags(conn, tagID, ssm.ResourceTypeForTaggingOpsMetadata)
+
+	if err != nil {
+		return fmt.Errorf("listing tags for SSM OpsMetadata (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceOpsMetadataUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		om, nm := o.(map[string]interface{}), n.(map[string]interface{})
+		input := &ssm.UpdateOpsMetadataInput{
+			OpsMetadataArn: aws.String(d.Id()),
+		}
+
+		for k := range om {
+			if _, ok := nm[k]; !ok {
+				input.KeysToDelete = append(input.KeysToDelete, aws.String(k))
+			}
+		}
+
+		update := make(map[string]interface{})
+
+		for k, v := range nm {
+			if ov, ok := om[k]; !ok || ov != v {
+				update[k] = v
+			}
+		}
+
+		if len(update) > 0 {
+			input.MetadataToUpdate = expandMetadataValues(update)
+		}
+
+		log.Printf("[DEBUG] Updating SSM OpsMetadata: %s", input)
+		_, err := conn.UpdateOpsMetadata(input)
+
+		if err != nil {
+			return fmt.Errorf("updating SSM OpsMetadata (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		tagID, err := opsMetadataTaggingID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if err := UpdateTags(conn, tagID, ssm.ResourceTypeForTaggingOpsMetadata, o, n); err != nil {
+			return fmt.Errorf("updating SSM OpsMetadata (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceOpsMetadataRead(d, meta)
+}
+
+func resourceOpsMetadataDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMConn
+
+	log.Printf("[DEBUG] Deleting SSM OpsMetadata: %s", d.Id())
+	_, err := conn.DeleteOpsMetadata(&ssm.DeleteOpsMetadataInput{
+		OpsMetadataArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsMetadataNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting SSM OpsMetadata (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// opsMetadataTaggingID returns the identifier used to tag an OpsMetadata object,
+// which is the part of its ARN that follows "opsmetadata/".
+func opsMetadataTaggingID(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing SSM OpsMetadata ARN (%s): %w", v, err)
+	}
+
+	id := strings.TrimPrefix(parsedARN.Resource, "opsmetadata/")
+
+	if id == parsedARN.Resource {
+		return "", fmt.Errorf("unexpected SSM OpsMetadata ARN (%s)", v)
+	}
+
+	return id, nil
+}
+
+func expandMetadataValues(tfMap map[string]interface{}) map[string]*ssm.MetadataValue {
+	apiObjects := make(map[string]*ssm.MetadataValue, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects[k] = &ssm.MetadataValue{
+			Value: aws.String(v.(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenMetadataValues(apiObjects map[string]*ssm.MetadataValue) map[string]string {
+	tfMap := make(map[string]string, len(apiObjects))
+
+	for k, v := range apiObjects {
+		if v == nil {
+			continue
+		}
+
+		tfMap[k] = aws.StringValue(v.Value)
+	}
+
+	return tfMap
+}
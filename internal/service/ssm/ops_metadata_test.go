@@ -0,0 +1,200 @@
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMOpsMetadata_basic(t *testing.T) {
+	resourceName := "aws_ssm_ops_metadata.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsMetadataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsMetadataConfig_basic(rName, `
+    key1 = "value1"
+    key2 = "value2"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsMetadataExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_id", fmt.Sprintf("/aws/ssm/%s/appmanager", rName)),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key2", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsMetadataConfig_basic(rName, `
+    key1 = "value1updated"
+    key3 = "value3"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsMetadataExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key3", "value3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsMetadata_tags(t *testing.T) {
+	resourceName := "aws_ssm_ops_metadata.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsMetadataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsMetadataConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsMetadataExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsMetadataConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsMetadataExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsMetadata_disappears(t *testing.T) {
+	resourceName := "aws_ssm_ops_metadata.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsMetadataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsMetadataConfig_basic(rName, `
+    key1 = "value1"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsMetadataExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssm.ResourceOpsMetadata(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOpsMetadataDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_ops_metadata" {
+			continue
+		}
+
+		_, _, err := tfssm.FindOpsMetadataByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM OpsMetadata %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckOpsMetadataExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM OpsMetadata ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+
+		_, _, err := tfssm.FindOpsMetadataByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccOpsMetadataConfig_basic(rName, metadata string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_metadata" "test" {
+  resource_id = "/aws/ssm/%[1]s/appmanager"
+
+  metadata = {
+%[2]s
+  }
+}
+`, rName, metadata)
+}
+
+func testAccOpsMetadataConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_metadata" "test" {
+  resource_id = "/aws/ssm/%[1]s/appmanager"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOpsMetadataConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_metadata" "test" {
+  resource_id = "/aws/ssm/%[1]s/appmanager"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,391 @@
+package ssmcontacts
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContactCreate,
+		Read:   resourceContactRead,
+		Update: resourceContactUpdate,
+		Delete: resourceContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9_\-]*$`), "must contain only lowercase alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"rotation_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"stage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 30),
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_channel_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"retry_interval_in_minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 60),
+												},
+											},
+										},
+									},
+									"contact_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"is_essential": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssmcontacts.ContactType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceContactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	alias := d.Get("alias").(string)
+	input := &ssmcontacts.CreateContactInput{
+		Alias: aws.String(alias),
+		Plan:  expandPlan(d),
+		Type:  aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Contact: %s", input)
+	output, err := conn.CreateContact(input)
+
+	if err != nil {
+		return fmt.Errorf("creating SSM Contacts Contact (%s): %w", alias, err)
+	}
+
+	d.SetId(aws.StringValue(output.ContactArn))
+
+	return resourceContactRead(d, meta)
+}
+
+func resourceContactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindContactByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SSM Contacts Contact (%s): %w", d.Id(), err)
+	}
+
+	d.Set("alias", output.Alias)
+	d.Set("arn", output.ContactArn)
+	d.Set("display_name", output.DisplayName)
+	d.Set("type", output.Type)
+
+	if output.Plan != nil {
+		d.Set("rotation_ids", aws.StringValueSlice(output.Plan.RotationIds))
+
+		if err := d.Set("stage", flattenStages(output.Plan.Stages)); err != nil {
+			return fmt.Errorf("setting stage: %w", err)
+		}
+	} else {
+		d.Set("rotation_ids", nil)
+		d.Set("stage", nil)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("listing tags for SSM Contacts Contact (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceContactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	if d.HasChanges("display_name", "rotation_ids", "stage") {
+		input := &ssmcontacts.UpdateContactInput{
+			ContactId:   aws.String(d.Id()),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			Plan:        expandPlan(d),
+		}
+
+		log.Printf("[DEBUG] Updating SSM Contacts Contact: %s", input)
+		_, err := conn.UpdateContact(input)
+
+		if err != nil {
+			return fmt.Errorf("updating SSM Contacts Contact (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating SSM Contacts Contact (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceContactRead(d, meta)
+}
+
+func resourceContactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Contact: %s", d.Id())
+	_, err := conn.DeleteContact(&ssmcontacts.DeleteContactInput{
+		ContactId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting SSM Contacts Contact (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandPlan returns the contact's engagement plan. The API requires a plan on
+// every contact, so an empty plan is returned when none is configured.
+func expandPlan(d *schema.ResourceData) *ssmcontacts.Plan {
+	apiObject := &ssmcontacts.Plan{
+		Stages: []*ssmcontacts.Stage{},
+	}
+
+	if v, ok := d.GetOk("rotation_ids"); ok && len(v.([]interface{})) > 0 {
+		apiObject.RotationIds = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("stage"); ok && len(v.([]interface{})) > 0 {
+		apiObject.Stages = expandStages(v.([]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStages(tfList []interface{}) []*ssmcontacts.Stage {
+	var apiObjects []*ssmcontacts.Stage
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.Stage{
+			DurationInMinutes: aws.Int64(int64(tfMap["duration_in_minutes"].(int))),
+			Targets:           expandTargets(tfMap["target"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTargets(tfList []interface{}) []*ssmcontacts.Target {
+	apiObjects := []*ssmcontacts.Target{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.Target{}
+
+		if v, ok := tfMap["channel_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ChannelTargetInfo = &ssmcontacts.ChannelTargetInfo{
+				ContactChannelId: aws.String(tfMap["contact_channel_id"].(string)),
+			}
+
+			if v, ok := tfMap["retry_interval_in_minutes"].(int); ok && v != 0 {
+				apiObject.ChannelTargetInfo.RetryIntervalInMinutes = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["contact_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ContactTargetInfo = &ssmcontacts.ContactTargetInfo{
+				IsEssential: aws.Bool(tfMap["is_essential"].(bool)),
+			}
+
+			if v, ok := tfMap["contact_id"].(string); ok && v != "" {
+				apiObject.ContactTargetInfo.ContactId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenStages(apiObjects []*ssmcontacts.Stage) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"duration_in_minutes": aws.Int64Value(apiObject.DurationInMinutes),
+			"target":              flattenTargets(apiObject.Targets),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTargets(apiObjects []*ssmcontacts.Target) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ChannelTargetInfo; v != nil {
+			tfMap["channel_target_info"] = []interface{}{map[string]interface{}{
+				"contact_channel_id":        aws.StringValue(v.ContactChannelId),
+				"retry_interval_in_minutes": aws.Int64Value(v.RetryIntervalInMinutes),
+			}}
+		}
+
+		if v := apiObject.ContactTargetInfo; v != nil {
+			tfMap["contact_target_info"] = []interface{}{map[string]interface{}{
+				"contact_id":   aws.StringValue(v.ContactId),
+				"is_essential": aws.BoolValue(v.IsEssential),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,234 @@
+package ssmcontacts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContactChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContactChannelCreate,
+		Read:   resourceContactChannelRead,
+		Update: resourceContactChannelUpdate,
+		Delete: resourceContactChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(6, 10),
+			},
+			"activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"defer_activation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"delivery_address": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 320),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssmcontacts.ChannelType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceContactChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	name := d.Get("name").(string)
+	input := &ssmcontacts.CreateContactChannelInput{
+		ContactId:       aws.String(d.Get("contact_id").(string)),
+		DeferActivation: aws.Bool(d.Get("defer_activation").(bool)),
+		DeliveryAddress: expandContactChannelAddress(d.Get("delivery_address").([]interface{})),
+		Name:            aws.String(name),
+		Type:            aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Contact Channel: %s", input)
+	output, err := conn.CreateContactChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("creating SSM Contacts Contact Channel (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ContactChannelArn))
+
+	if v, ok := d.GetOk("activation_code"); ok {
+		if err := activateContactChannel(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceContactChannelRead(d, meta)
+}
+
+func resourceContactChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	output, err := FindContactChannelByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SSM Contacts Contact Channel (%s): %w", d.Id(), err)
+	}
+
+	d.Set("activation_status", output.ActivationStatus)
+	d.Set("arn", output.ContactChannelArn)
+	d.Set("contact_id", output.ContactArn)
+	if err := d.Set("delivery_address", flattenContactChannelAddress(output.DeliveryAddress)); err != nil {
+		return fmt.Errorf("setting delivery_address: %w", err)
+	}
+	d.Set("name", output.Name)
+	d.Set("type", output.Type)
+
+	return nil
+}
+
+func resourceContactChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	if d.HasChanges("delivery_address", "name") {
+		input := &ssmcontacts.UpdateContactChannelInput{
+			ContactChannelId: aws.String(d.Id()),
+			DeliveryAddress:  expandContactChannelAddress(d.Get("delivery_address").([]interface{})),
+			Name:             aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating SSM Contacts Contact Channel: %s", input)
+		_, err := conn.UpdateContactChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("updating SSM Contacts Contact Channel (%s): %w", d.Id(), err)
+		}
+	}
+
+	// A changed delivery address must be activated again, so the activation code is
+	// submitted whenever it changes or the channel has not been activated yet.
+	if v, ok := d.GetOk("activation_code"); ok && (d.HasChange("activation_code") || d.Get("activation_status").(string) != ssmcontacts.ActivationStatusActivated) {
+		if err := activateContactChannel(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceContactChannelRead(d, meta)
+}
+
+func resourceContactChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Contact Channel: %s", d.Id())
+	_, err := conn.DeleteContactChannel(&ssmcontacts.DeleteContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting SSM Contacts Contact Channel (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// activateContactChannel submits the activation code sent to the channel's
+// delivery address and verifies that the channel was activated.
+func activateContactChannel(conn *ssmcontacts.SSMContacts, id, activationCode string) error {
+	log.Printf("[DEBUG] Activating SSM Contacts Contact Channel: %s", id)
+	_, err := conn.ActivateContactChannel(&ssmcontacts.ActivateContactChannelInput{
+		ActivationCode:   aws.String(activationCode),
+		ContactChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("activating SSM Contacts Contact Channel (%s): %w", id, err)
+	}
+
+	output, err := FindContactChannelByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading SSM Contacts Contact Channel (%s): %w", id, err)
+	}
+
+	if status := aws.StringValue(output.ActivationStatus); status != ssmcontacts.ActivationStatusActivated {
+		return fmt.Errorf("activating SSM Contacts Contact Channel (%s): unexpected activation status: %s", id, status)
+	}
+
+	return nil
+}
+
+func expandContactChannelAddress(tfList []interface{}) *ssmcontacts.ContactChannelAddress {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &ssmcontacts.ContactChannelAddress{
+		SimpleAddress: aws.String(tfMap["simple_address"].(string)),
+	}
+}
+
+func flattenContactChannelAddress(apiObject *ssmcontacts.ContactChannelAddress) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"simple_address": aws.StringValue(apiObject.SimpleAddress),
+	}}
+}
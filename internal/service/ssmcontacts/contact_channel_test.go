@@ -0,0 +1,145 @@
+package ssmcontacts_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMContactsContactChannel_basic(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	domain := acctest.RandomDomainName()
+	address1 := acctest.RandomEmailAddress(domain)
+	address2 := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, address1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activation_status", ssmcontacts.ActivationStatusNotActivated),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(`contact-channel/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", "aws_ssmcontacts_contact.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", address1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "EMAIL"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"defer_activation"},
+			},
+			{
+				Config: testAccContactChannelConfig_basic(rName, address2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", address2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsContactChannel_disappears(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	address := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceContactChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContactChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_contact_channel" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindContactChannelByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Contact Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckContactChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Contact Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindContactChannelByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccContactChannelConfig_basic(rName, address string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+}
+
+resource "aws_ssmcontacts_contact_channel" "test" {
+  contact_id       = aws_ssmcontacts_contact.test.arn
+  defer_activation = true
+  name             = %[1]q
+  type             = "EMAIL"
+
+  delivery_address {
+    simple_address = %[2]q
+  }
+}
+`, rName, address)
+}
@@ -0,0 +1,274 @@
+package ssmcontacts_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMContactsContact_basic(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", rName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(`contact/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "PERSONAL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsContact_escalationPlan(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_escalationPlan(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "ESCALATION"),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage.0.target.0.contact_target_info.0.contact_id", "aws_ssmcontacts_contact.responder", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.0.is_essential", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactConfig_escalationPlan(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsContact_tags(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccContactConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsContact_disappears(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceContact(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccPreCheck skips the test if Incident Manager has not been set up in
+// the account, as contacts can only be created once a replication set exists.
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	_, err := conn.ListContacts(&ssmcontacts.ListContactsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckContactDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_contact" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindContactByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Contact %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckContactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Contact ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindContactByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccContactConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+}
+`, rName)
+}
+
+func testAccContactConfig_escalationPlan(rName string, duration int) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "responder" {
+  alias = "%[1]s-responder"
+  type  = "PERSONAL"
+}
+
+resource "aws_ssmcontacts_contact" "test" {
+  alias        = %[1]q
+  display_name = %[1]q
+  type         = "ESCALATION"
+
+  stage {
+    duration_in_minutes = %[2]d
+
+    target {
+      contact_target_info {
+        contact_id   = aws_ssmcontacts_contact.responder.arn
+        is_essential = false
+      }
+    }
+  }
+}
+`, rName, duration)
+}
+
+func testAccContactConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccContactConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,110 @@
+package ssmcontacts
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindContactByID(conn *ssmcontacts.SSMContacts, id string) (*ssmcontacts.GetContactOutput, error) {
+	input := &ssmcontacts.GetContactInput{
+		ContactId: aws.String(id),
+	}
+
+	output, err := conn.GetContact(input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindContactChannelByID(conn *ssmcontacts.SSMContacts, id string) (*ssmcontacts.GetContactChannelOutput, error) {
+	input := &ssmcontacts.GetContactChannelInput{
+		ContactChannelId: aws.String(id),
+	}
+
+	output, err := conn.GetContactChannel(input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRotationByID(conn *ssmcontacts.SSMContacts, id string) (*ssmcontacts.GetRotationOutput, error) {
+	input := &ssmcontacts.GetRotationInput{
+		RotationId: aws.String(id),
+	}
+
+	output, err := conn.GetRotation(input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRotationOverrideByTwoPartKey(conn *ssmcontacts.SSMContacts, rotationID, overrideID string) (*ssmcontacts.GetRotationOverrideOutput, error) {
+	input := &ssmcontacts.GetRotationOverrideInput{
+		RotationId:         aws.String(rotationID),
+		RotationOverrideId: aws.String(overrideID),
+	}
+
+	output, err := conn.GetRotationOverride(input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RotationOverrideId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,472 @@
+package ssmcontacts
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRotation() *schema.Resource {
+	handOffTimeSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hour_of_day": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 23),
+				},
+				"minute_of_hour": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 59),
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceRotationCreate,
+		Read:   resourceRotationRead,
+		Update: resourceRotationUpdate,
+		Delete: resourceRotationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 30,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"recurrence": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: handOffTimeSchema.Elem.(*schema.Resource).Schema,
+							},
+						},
+						"monthly_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_month": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 31),
+									},
+									"hand_off_time": handOffTimeSchema,
+								},
+							},
+						},
+						"number_of_on_calls": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"recurrence_multiplier": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"shift_coverages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"coverage_times": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end":   handOffTimeSchema,
+												"start": handOffTimeSchema,
+											},
+										},
+									},
+									"day_of_week": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssmcontacts.DayOfWeek_Values(), false),
+									},
+								},
+							},
+						},
+						"weekly_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_week": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssmcontacts.DayOfWeek_Values(), false),
+									},
+									"hand_off_time": handOffTimeSchema,
+								},
+							},
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"time_zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssmcontacts.CreateRotationInput{
+		ContactIds: flex.ExpandStringList(d.Get("contact_ids").([]interface{})),
+		Name:       aws.String(name),
+		Recurrence: expandRecurrenceSettings(d.Get("recurrence").([]interface{})),
+		TimeZoneId: aws.String(d.Get("time_zone_id").(string)),
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartTime = aws.Time(v)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Rotation: %s", input)
+	output, err := conn.CreateRotation(input)
+
+	if err != nil {
+		return fmt.Errorf("creating SSM Contacts Rotation (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RotationArn))
+
+	return resourceRotationRead(d, meta)
+}
+
+func resourceRotationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindRotationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Rotation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SSM Contacts Rotation (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.RotationArn)
+	d.Set("contact_ids", aws.StringValueSlice(output.ContactIds))
+	d.Set("name", output.Name)
+	if err := d.Set("recurrence", flattenRecurrenceSettings(output.Recurrence)); err != nil {
+		return fmt.Errorf("setting recurrence: %w", err)
+	}
+	if output.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("time_zone_id", output.TimeZoneId)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("listing tags for SSM Contacts Rotation (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssmcontacts.UpdateRotationInput{
+			ContactIds: flex.ExpandStringList(d.Get("contact_ids").([]interface{})),
+			Recurrence: expandRecurrenceSettings(d.Get("recurrence").([]interface{})),
+			RotationId: aws.String(d.Id()),
+			TimeZoneId: aws.String(d.Get("time_zone_id").(string)),
+		}
+
+		if d.HasChange("start_time") {
+			v, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+			input.StartTime = aws.Time(v)
+		}
+
+		log.Printf("[DEBUG] Updating SSM Contacts Rotation: %s", input)
+		_, err := conn.UpdateRotation(input)
+
+		if err != nil {
+			return fmt.Errorf("updating SSM Contacts Rotation (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating SSM Contacts Rotation (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceRotationRead(d, meta)
+}
+
+func resourceRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Rotation: %s", d.Id())
+	_, err := conn.DeleteRotation(&ssmcontacts.DeleteRotationInput{
+		RotationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting SSM Contacts Rotation (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRecurrenceSettings(tfList []interface{}) *ssmcontacts.RecurrenceSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ssmcontacts.RecurrenceSettings{
+		NumberOfOnCalls:      aws.Int64(int64(tfMap["number_of_on_calls"].(int))),
+		RecurrenceMultiplier: aws.Int64(int64(tfMap["recurrence_multiplier"].(int))),
+	}
+
+	if v, ok := tfMap["daily_settings"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.DailySettings = append(apiObject.DailySettings, expandHandOffTime(tfMap))
+			}
+		}
+	}
+
+	if v, ok := tfMap["monthly_settings"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.MonthlySettings = append(apiObject.MonthlySettings, &ssmcontacts.MonthlySetting{
+					DayOfMonth:  aws.Int64(int64(tfMap["day_of_month"].(int))),
+					HandOffTime: expandHandOffTimeList(tfMap["hand_off_time"].([]interface{})),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["shift_coverages"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ShiftCoverages = make(map[string][]*ssmcontacts.CoverageTime)
+
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			var coverageTimes []*ssmcontacts.CoverageTime
+
+			for _, tfMapRaw := range tfMap["coverage_times"].([]interface{}) {
+				if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+					coverageTimes = append(coverageTimes, &ssmcontacts.CoverageTime{
+						End:   expandHandOffTimeList(tfMap["end"].([]interface{})),
+						Start: expandHandOffTimeList(tfMap["start"].([]interface{})),
+					})
+				}
+			}
+
+			apiObject.ShiftCoverages[tfMap["day_of_week"].(string)] = coverageTimes
+		}
+	}
+
+	if v, ok := tfMap["weekly_settings"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.WeeklySettings = append(apiObject.WeeklySettings, &ssmcontacts.WeeklySetting{
+					DayOfWeek:   aws.String(tfMap["day_of_week"].(string)),
+					HandOffTime: expandHandOffTimeList(tfMap["hand_off_time"].([]interface{})),
+				})
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandHandOffTimeList(tfList []interface{}) *ssmcontacts.HandOffTime {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return expandHandOffTime(tfList[0].(map[string]interface{}))
+}
+
+func expandHandOffTime(tfMap map[string]interface{}) *ssmcontacts.HandOffTime {
+	return &ssmcontacts.HandOffTime{
+		HourOfDay:    aws.Int64(int64(tfMap["hour_of_day"].(int))),
+		MinuteOfHour: aws.Int64(int64(tfMap["minute_of_hour"].(int))),
+	}
+}
+
+func flattenRecurrenceSettings(apiObject *ssmcontacts.RecurrenceSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"number_of_on_calls":    aws.Int64Value(apiObject.NumberOfOnCalls),
+		"recurrence_multiplier": aws.Int64Value(apiObject.RecurrenceMultiplier),
+	}
+
+	var dailySettings []interface{}
+	for _, v := range apiObject.DailySettings {
+		if v != nil {
+			dailySettings = append(dailySettings, flattenHandOffTime(v))
+		}
+	}
+	tfMap["daily_settings"] = dailySettings
+
+	var monthlySettings []interface{}
+	for _, v := range apiObject.MonthlySettings {
+		if v != nil {
+			monthlySettings = append(monthlySettings, map[string]interface{}{
+				"day_of_month":  aws.Int64Value(v.DayOfMonth),
+				"hand_off_time": flattenHandOffTimeList(v.HandOffTime),
+			})
+		}
+	}
+	tfMap["monthly_settings"] = monthlySettings
+
+	var shiftCoverages []interface{}
+	// Keep the days in a stable order.
+	for _, day := range ssmcontacts.DayOfWeek_Values() {
+		v, ok := apiObject.ShiftCoverages[day]
+
+		if !ok {
+			continue
+		}
+
+		var coverageTimes []interface{}
+		for _, v := range v {
+			if v != nil {
+				coverageTimes = append(coverageTimes, map[string]interface{}{
+					"end":   flattenHandOffTimeList(v.End),
+					"start": flattenHandOffTimeList(v.Start),
+				})
+			}
+		}
+
+		shiftCoverages = append(shiftCoverages, map[string]interface{}{
+			"coverage_times": coverageTimes,
+			"day_of_week":    day,
+		})
+	}
+	tfMap["shift_coverages"] = shiftCoverages
+
+	var weeklySettings []interface{}
+	for _, v := range apiObject.WeeklySettings {
+		if v != nil {
+			weeklySettings = append(weeklySettings, map[string]interface{}{
+				"day_of_week":   aws.StringValue(v.DayOfWeek),
+				"hand_off_time": flattenHandOffTimeList(v.HandOffTime),
+			})
+		}
+	}
+	tfMap["weekly_settings"] = weeklySettings
+
+	return []interface{}{tfMap}
+}
+
+func flattenHandOffTimeList(apiObject *ssmcontacts.HandOffTime) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{flattenHandOffTime(apiObject)}
+}
+
+func flattenHandOffTime(apiObject *ssmcontacts.HandOffTime) map[string]interface{} {
+	return map[string]interface{}{
+		"hour_of_day":    aws.Int64Value(apiObject.HourOfDay),
+		"minute_of_hour": aws.Int64Value(apiObject.MinuteOfHour),
+	}
+}
@@ -0,0 +1,173 @@
+package ssmcontacts
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRotationOverride() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRotationOverrideCreate,
+		Read:   resourceRotationOverrideRead,
+		Delete: resourceRotationOverrideDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"new_contact_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"rotation_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rotation_override_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+	}
+}
+
+func resourceRotationOverrideCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	rotationID := d.Get("rotation_id").(string)
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	input := &ssmcontacts.CreateRotationOverrideInput{
+		EndTime:       aws.Time(endTime),
+		NewContactIds: flex.ExpandStringList(d.Get("new_contact_ids").([]interface{})),
+		RotationId:    aws.String(rotationID),
+		StartTime:     aws.Time(startTime),
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Rotation Override: %s", input)
+	output, err := conn.CreateRotationOverride(input)
+
+	if err != nil {
+		return fmt.Errorf("creating SSM Contacts Rotation (%s) Override: %w", rotationID, err)
+	}
+
+	d.SetId(RotationOverrideCreateResourceID(rotationID, aws.StringValue(output.RotationOverrideId)))
+
+	return resourceRotationOverrideRead(d, meta)
+}
+
+func resourceRotationOverrideRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	rotationID, overrideID, err := RotationOverrideParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindRotationOverrideByTwoPartKey(conn, rotationID, overrideID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Rotation Override (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading SSM Contacts Rotation Override (%s): %w", d.Id(), err)
+	}
+
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	d.Set("new_contact_ids", aws.StringValueSlice(output.NewContactIds))
+	d.Set("rotation_id", rotationID)
+	d.Set("rotation_override_id", output.RotationOverrideId)
+	d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceRotationOverrideDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	rotationID, overrideID, err := RotationOverrideParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Rotation Override: %s", d.Id())
+	_, err = conn.DeleteRotationOverride(&ssmcontacts.DeleteRotationOverrideInput{
+		RotationId:         aws.String(rotationID),
+		RotationOverrideId: aws.String(overrideID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting SSM Contacts Rotation Override (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const rotationOverrideResourceIDSeparator = ","
+
+func RotationOverrideCreateResourceID(rotationID, overrideID string) string {
+	parts := []string{rotationID, overrideID}
+	id := strings.Join(parts, rotationOverrideResourceIDSeparator)
+
+	return id
+}
+
+func RotationOverrideParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, rotationOverrideResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ROTATION-ARN%[2]sROTATION-OVERRIDE-ID", id, rotationOverrideResourceIDSeparator)
+}
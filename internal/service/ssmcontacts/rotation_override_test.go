@@ -0,0 +1,145 @@
+package ssmcontacts_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMContactsRotationOverride_basic(t *testing.T) {
+	resourceName := "aws_ssmcontacts_rotation_override.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	startTime := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+	endTime := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationOverrideDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationOverrideConfig_basic(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationOverrideExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", endTime),
+					resource.TestCheckResourceAttr(resourceName, "new_contact_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "new_contact_ids.0", "aws_ssmcontacts_contact.override", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "rotation_id", "aws_ssmcontacts_rotation.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotation_override_id"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", startTime),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsRotationOverride_disappears(t *testing.T) {
+	resourceName := "aws_ssmcontacts_rotation_override.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	startTime := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+	endTime := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationOverrideDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationOverrideConfig_basic(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationOverrideExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceRotationOverride(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRotationOverrideDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_rotation_override" {
+			continue
+		}
+
+		rotationID, overrideID, err := tfssmcontacts.RotationOverrideParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfssmcontacts.FindRotationOverrideByTwoPartKey(conn, rotationID, overrideID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Rotation Override %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRotationOverrideExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Rotation Override ID is set")
+		}
+
+		rotationID, overrideID, err := tfssmcontacts.RotationOverrideParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err = tfssmcontacts.FindRotationOverrideByTwoPartKey(conn, rotationID, overrideID)
+
+		return err
+	}
+}
+
+func testAccRotationOverrideConfig_basic(rName, startTime, endTime string) string {
+	return acctest.ConfigCompose(testAccRotationConfig_daily(rName, 9), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "override" {
+  alias = "%[1]s-override"
+  type  = "PERSONAL"
+}
+
+resource "aws_ssmcontacts_rotation_override" "test" {
+  end_time        = %[3]q
+  new_contact_ids = [aws_ssmcontacts_contact.override.arn]
+  rotation_id     = aws_ssmcontacts_rotation.test.arn
+  start_time      = %[2]q
+}
+`, rName, startTime, endTime))
+}
@@ -0,0 +1,312 @@
+package ssmcontacts_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMContactsRotation_basic(t *testing.T) {
+	resourceName := "aws_ssmcontacts_rotation.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_daily(rName, 9),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(`rotation/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.0", "aws_ssmcontacts_contact.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.minute_of_hour", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.number_of_on_calls", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.recurrence_multiplier", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_id", "Australia/Sydney"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRotationConfig_daily(rName, 17),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.hour_of_day", "17"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsRotation_weeklySettings(t *testing.T) {
+	resourceName := "aws_ssmcontacts_rotation.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_weekly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.day_of_week", "MON"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.hand_off_time.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.hand_off_time.0.minute_of_hour", "30"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.day_of_week", "MON"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.coverage_times.0.start.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.coverage_times.0.end.0.hour_of_day", "17"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsRotation_tags(t *testing.T) {
+	resourceName := "aws_ssmcontacts_rotation.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRotationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsRotation_disappears(t *testing.T) {
+	resourceName := "aws_ssmcontacts_rotation.test"
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_daily(rName, 9),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceRotation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRotationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_rotation" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindRotationByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Rotation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRotationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Rotation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindRotationByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRotationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+}
+`, rName)
+}
+
+func testAccRotationConfig_daily(rName string, hourOfDay int) string {
+	return acctest.ConfigCompose(testAccRotationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids  = [aws_ssmcontacts_contact.test.arn]
+  name         = %[1]q
+  time_zone_id = "Australia/Sydney"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = %[2]d
+      minute_of_hour = 0
+    }
+  }
+}
+`, rName, hourOfDay))
+}
+
+func testAccRotationConfig_weekly(rName string) string {
+	return acctest.ConfigCompose(testAccRotationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids  = [aws_ssmcontacts_contact.test.arn]
+  name         = %[1]q
+  time_zone_id = "Australia/Sydney"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    weekly_settings {
+      day_of_week = "MON"
+
+      hand_off_time {
+        hour_of_day    = 9
+        minute_of_hour = 30
+      }
+    }
+
+    shift_coverages {
+      day_of_week = "MON"
+
+      coverage_times {
+        start {
+          hour_of_day    = 9
+          minute_of_hour = 0
+        }
+
+        end {
+          hour_of_day    = 17
+          minute_of_hour = 0
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRotationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccRotationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids  = [aws_ssmcontacts_contact.test.arn]
+  name         = %[1]q
+  time_zone_id = "Australia/Sydney"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccRotationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccRotationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids  = [aws_ssmcontacts_contact.test.arn]
+  name         = %[1]q
+  time_zone_id = "Australia/Sydney"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,222 @@
+package sts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	temporaryCredentialsDefaultDurationSeconds = 3600
+	temporaryCredentialsSessionNamePrefix      = "terraform-"
+)
+
+func init() {
+	registerFrameworkDataSourceFactory(newDataSourceTemporaryCredentials)
+}
+
+// newDataSourceTemporaryCredentials instantiates a new DataSource for the aws_temporary_credentials data source.
+func newDataSourceTemporaryCredentials(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTemporaryCredentials{}, nil
+}
+
+type dataSourceTemporaryCredentials struct {
+	meta *conns.AWSClient
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceTemporaryCredentials) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_temporary_credentials"
+}
+
+// GetSchema returns the schema for this data source.
+func (d *dataSourceTemporaryCredentials) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"access_key_id": {
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"assumed_role_arn": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"assumed_role_id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"duration_seconds": {
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"expiration": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"external_id": {
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthBetween(2, 1224),
+				},
+			},
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"policy": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"policy_arns": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"role_arn": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"role_session_name": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"secret_access_key": {
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": {
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	return schema, nil
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *dataSourceTemporaryCredentials) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		d.meta = v
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceTemporaryCredentials) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceTemporaryCredentialsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.meta.STSConn
+
+	sessionName := data.RoleSessionName.Value
+	if data.RoleSessionName.IsNull() || sessionName == "" {
+		sessionName = resource.PrefixedUniqueId(temporaryCredentialsSessionNamePrefix)
+	}
+
+	durationSeconds := int64(temporaryCredentialsDefaultDurationSeconds)
+	if !data.DurationSeconds.IsNull() {
+		durationSeconds = data.DurationSeconds.Value
+	}
+
+	input := &sts.AssumeRoleInput{
+		DurationSeconds: aws.Int64(durationSeconds),
+		RoleArn:         aws.String(data.RoleARN.Value),
+		RoleSessionName: aws.String(sessionName),
+	}
+
+	if !data.ExternalID.IsNull() {
+		input.ExternalId = aws.String(data.ExternalID.Value)
+	}
+
+	if !data.Policy.IsNull() {
+		input.Policy = aws.String(data.Policy.Value)
+	}
+
+	for _, v := range flex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	output, err := conn.AssumeRoleWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", data.RoleARN.Value), err.Error())
+
+		return
+	}
+
+	if output == nil || output.Credentials == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", data.RoleARN.Value), "empty result")
+
+		return
+	}
+
+	credentials := output.Credentials
+	data.AccessKeyID = types.String{Value: aws.StringValue(credentials.AccessKeyId)}
+	data.DurationSeconds = types.Int64{Value: durationSeconds}
+	data.Expiration = types.String{Value: aws.TimeValue(credentials.Expiration).Format(time.RFC3339)}
+	data.RoleSessionName = types.String{Value: sessionName}
+	data.SecretAccessKey = types.String{Value: aws.StringValue(credentials.SecretAccessKey)}
+	data.SessionToken = types.String{Value: aws.StringValue(credentials.SessionToken)}
+
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = types.String{Value: aws.StringValue(v.Arn)}
+		data.AssumedRoleID = types.String{Value: aws.StringValue(v.AssumedRoleId)}
+		data.ID = types.String{Value: aws.StringValue(v.AssumedRoleId)}
+	} else {
+		data.AssumedRoleARN = types.String{Null: true}
+		data.AssumedRoleID = types.String{Null: true}
+		data.ID = types.String{Value: sessionName}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceTemporaryCredentialsData struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	AssumedRoleARN  types.String `tfsdk:"assumed_role_arn"`
+	AssumedRoleID   types.String `tfsdk:"assumed_role_id"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	Expiration      types.String `tfsdk:"expiration"`
+	ExternalID      types.String `tfsdk:"external_id"`
+	ID              types.String `tfsdk:"id"`
+	Policy          types.String `tfsdk:"policy"`
+	PolicyARNs      types.Set    `tfsdk:"policy_arns"`
+	RoleARN         types.String `tfsdk:"role_arn"`
+	RoleSessionName types.String `tfsdk:"role_session_name"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+}
@@ -0,0 +1,294 @@
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDBParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBParameterGroupCreate,
+		ReadWithoutTimeout:   resourceDBParameterGroupRead,
+		UpdateWithoutTimeout: resourceDBParameterGroupUpdate,
+		DeleteWithoutTimeout: resourceDBParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"influxdbv2": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"flux_log_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"log_level": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(timestreaminfluxdb.LogLevel_Values(), false),
+									},
+									"metrics_disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"no_tasks": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"query_concurrency": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"query_queue_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"tracing_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(timestreaminfluxdb.TracingType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDBParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &timestreaminfluxdb.CreateDbParameterGroupInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Parameters = expandParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Timestream for InfluxDB DB Parameter Group: %s", input)
+	output, err := conn.CreateDbParameterGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Timestream for InfluxDB DB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceDBParameterGroupRead(ctx, d, meta)
+}
+
+func resourceDBParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	parameterGroup, err := FindDBParameterGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Timestream for InfluxDB DB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", parameterGroup.Arn)
+	d.Set("description", parameterGroup.Description)
+	d.Set("name", parameterGroup.Name)
+	if parameterGroup.Parameters != nil {
+		if err := d.Set("parameters", []interface{}{flattenParameters(parameterGroup.Parameters)}); err != nil {
+			return diag.Errorf("setting parameters: %s", err)
+		}
+	} else {
+		d.Set("parameters", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Timestream for InfluxDB DB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDBParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Timestream for InfluxDB DB Parameter Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDBParameterGroupRead(ctx, d, meta)
+}
+
+func resourceDBParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Timestream for InfluxDB does not support deleting DB parameter groups.
+	log.Printf("[WARN] Timestream for InfluxDB DB Parameter Group (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func expandParameters(tfMap map[string]interface{}) *timestreaminfluxdb.Parameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreaminfluxdb.Parameters{}
+
+	if v, ok := tfMap["influxdbv2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InfluxDBv2 = expandInfluxDBv2Parameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInfluxDBv2Parameters(tfMap map[string]interface{}) *timestreaminfluxdb.InfluxDBv2Parameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreaminfluxdb.InfluxDBv2Parameters{}
+
+	if v, ok := tfMap["flux_log_enabled"].(bool); ok {
+		apiObject.FluxLogEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["log_level"].(string); ok && v != "" {
+		apiObject.LogLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["metrics_disabled"].(bool); ok {
+		apiObject.MetricsDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["no_tasks"].(bool); ok {
+		apiObject.NoTasks = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["query_concurrency"].(int); ok && v != 0 {
+		apiObject.QueryConcurrency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["query_queue_size"].(int); ok && v != 0 {
+		apiObject.QueryQueueSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["tracing_type"].(string); ok && v != "" {
+		apiObject.TracingType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenParameters(apiObject *timestreaminfluxdb.Parameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InfluxDBv2; v != nil {
+		tfMap["influxdbv2"] = []interface{}{map[string]interface{}{
+			"flux_log_enabled":  aws.BoolValue(v.FluxLogEnabled),
+			"log_level":         aws.StringValue(v.LogLevel),
+			"metrics_disabled":  aws.BoolValue(v.MetricsDisabled),
+			"no_tasks":          aws.BoolValue(v.NoTasks),
+			"query_concurrency": aws.Int64Value(v.QueryConcurrency),
+			"query_queue_size":  aws.Int64Value(v.QueryQueueSize),
+			"tracing_type":      aws.StringValue(v.TracingType),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package timestreaminfluxdb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_timestreaminfluxdb_db_instance", &resource.Sweeper{
+		Name: "aws_timestreaminfluxdb_db_instance",
+		F:    sweepDBInstances,
+	})
+}
+
+func sweepDBInstances(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).TimestreamInfluxDBConn
+	input := &timestreaminfluxdb.ListDbInstancesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDbInstancesPages(input, func(page *timestreaminfluxdb.ListDbInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceDBInstance()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Timestream for InfluxDB DB Instance sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Timestream for InfluxDB DB Instances (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Timestream for InfluxDB DB Instances (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package timestreaminfluxdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitDBInstanceAvailable(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			timestreaminfluxdb.StatusCreating,
+			timestreaminfluxdb.StatusModifying,
+			timestreaminfluxdb.StatusUpdating,
+		},
+		Target:     []string{timestreaminfluxdb.StatusAvailable},
+		Refresh:    statusDBInstance(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("Timestream for InfluxDB DB Instance (%s) to become available", id))
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceDeleted(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{timestreaminfluxdb.StatusAvailable, timestreaminfluxdb.StatusDeleting},
+		Target:     []string{},
+		Refresh:    statusDBInstance(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, fmt.Sprintf("Timestream for InfluxDB DB Instance (%s) deletion", id))
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
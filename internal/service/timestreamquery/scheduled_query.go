@@ -0,0 +1,782 @@
+package timestreamquery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceScheduledQuery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScheduledQueryCreate,
+		ReadWithoutTimeout:   resourceScheduledQueryRead,
+		UpdateWithoutTimeout: resourceScheduledQueryUpdate,
+		DeleteWithoutTimeout: resourceScheduledQueryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_report_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"encryption_option": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(timestreamquery.S3EncryptionOption_Values(), false),
+									},
+									"object_key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 896),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"next_invocation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sns_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"previous_invocation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"schedule_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      timestreamquery.ScheduledQueryStateEnabled,
+				ValidateFunc: validation.StringInSlice(timestreamquery.ScheduledQueryState_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestream_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"dimension_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimension_value_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(timestreamquery.DimensionValueType_Values(), false),
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"measure_name_column": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mixed_measure_mapping": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										ForceNew:      true,
+										ConflictsWith: []string{"target_configuration.0.timestream_configuration.0.multi_measure_mappings"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"measure_value_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(timestreamquery.MeasureValueType_Values(), false),
+												},
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingSchema(false),
+												"source_column": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"target_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"multi_measure_mappings": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										ForceNew:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"target_configuration.0.timestream_configuration.0.mixed_measure_mapping"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingSchema(true),
+												"target_multi_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"table_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"time_column": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func multiMeasureAttributeMappingSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"measure_value_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(timestreamquery.ScalarMeasureValueType_Values(), false),
+				},
+				"source_column": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"target_multi_measure_attribute_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduledQueryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &timestreamquery.CreateScheduledQueryInput{
+		ClientToken:                    aws.String(resource.UniqueId()),
+		ErrorReportConfiguration:       expandErrorReportConfiguration(d.Get("error_report_configuration").([]interface{})),
+		Name:                           aws.String(name),
+		NotificationConfiguration:      expandNotificationConfiguration(d.Get("notification_configuration").([]interface{})),
+		QueryString:                    aws.String(d.Get("query_string").(string)),
+		ScheduleConfiguration:          expandScheduleConfiguration(d.Get("schedule_configuration").([]interface{})),
+		ScheduledQueryExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("target_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TargetConfiguration = expandTargetConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Timestream Scheduled Query: %s", input)
+	output, err := conn.CreateScheduledQueryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Timestream Scheduled Query (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if v := d.Get("state").(string); v != timestreamquery.ScheduledQueryStateEnabled {
+		if err := updateScheduledQueryState(ctx, conn, d.Id(), v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScheduledQueryRead(ctx, d, meta)
+}
+
+func resourceScheduledQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	scheduledQuery, err := FindScheduledQueryByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream Scheduled Query (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Timestream Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", scheduledQuery.Arn)
+	if err := d.Set("error_report_configuration", flattenErrorReportConfiguration(scheduledQuery.ErrorReportConfiguration)); err != nil {
+		return diag.Errorf("setting error_report_configuration: %s", err)
+	}
+	d.Set("execution_role_arn", scheduledQuery.ScheduledQueryExecutionRoleArn)
+	d.Set("kms_key_id", scheduledQuery.KmsKeyId)
+	d.Set("name", scheduledQuery.Name)
+	if scheduledQuery.NextInvocationTime != nil {
+		d.Set("next_invocation_time", aws.TimeValue(scheduledQuery.NextInvocationTime).Format(time.RFC3339))
+	} else {
+		d.Set("next_invocation_time", nil)
+	}
+	if err := d.Set("notification_configuration", flattenNotificationConfiguration(scheduledQuery.NotificationConfiguration)); err != nil {
+		return diag.Errorf("setting notification_configuration: %s", err)
+	}
+	if scheduledQuery.PreviousInvocationTime != nil {
+		d.Set("previous_invocation_time", aws.TimeValue(scheduledQuery.PreviousInvocationTime).Format(time.RFC3339))
+	} else {
+		d.Set("previous_invocation_time", nil)
+	}
+	d.Set("query_string", scheduledQuery.QueryString)
+	if err := d.Set("schedule_configuration", flattenScheduleConfiguration(scheduledQuery.ScheduleConfiguration)); err != nil {
+		return diag.Errorf("setting schedule_configuration: %s", err)
+	}
+	d.Set("state", scheduledQuery.State)
+	if scheduledQuery.TargetConfiguration != nil {
+		if err := d.Set("target_configuration", []interface{}{flattenTargetConfiguration(scheduledQuery.TargetConfiguration)}); err != nil {
+			return diag.Errorf("setting target_configuration: %s", err)
+		}
+	} else {
+		d.Set("target_configuration", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Timestream Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceScheduledQueryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+
+	if d.HasChange("state") {
+		if err := updateScheduledQueryState(ctx, conn, d.Id(), d.Get("state").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Timestream Scheduled Query (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceScheduledQueryRead(ctx, d, meta)
+}
+
+func resourceScheduledQueryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+
+	log.Printf("[INFO] Deleting Timestream Scheduled Query: %s", d.Id())
+	_, err := conn.DeleteScheduledQueryWithContext(ctx, &timestreamquery.DeleteScheduledQueryInput{
+		ScheduledQueryArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, timestreamquery.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Timestream Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateScheduledQueryState(ctx context.Context, conn *timestreamquery.TimestreamQuery, arn, state string) error {
+	input := &timestreamquery.UpdateScheduledQueryInput{
+		ScheduledQueryArn: aws.String(arn),
+		State:             aws.String(state),
+	}
+
+	log.Printf("[DEBUG] Updating Timestream Scheduled Query: %s", input)
+	if _, err := conn.UpdateScheduledQueryWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating Timestream Scheduled Query (%s) state to %s: %w", arn, state, err)
+	}
+
+	return nil
+}
+
+func expandErrorReportConfiguration(tfList []interface{}) *timestreamquery.ErrorReportConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &timestreamquery.ErrorReportConfiguration{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3Configuration := &timestreamquery.S3Configuration{}
+
+		if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+			s3Configuration.BucketName = aws.String(v)
+		}
+
+		if v, ok := tfMap["encryption_option"].(string); ok && v != "" {
+			s3Configuration.EncryptionOption = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_key_prefix"].(string); ok && v != "" {
+			s3Configuration.ObjectKeyPrefix = aws.String(v)
+		}
+
+		apiObject.S3Configuration = s3Configuration
+	}
+
+	return apiObject
+}
+
+func expandNotificationConfiguration(tfList []interface{}) *timestreamquery.NotificationConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &timestreamquery.NotificationConfiguration{}
+
+	if v, ok := tfMap["sns_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnsConfiguration = &timestreamquery.SnsConfiguration{
+			TopicArn: aws.String(v[0].(map[string]interface{})["topic_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandScheduleConfiguration(tfList []interface{}) *timestreamquery.ScheduleConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &timestreamquery.ScheduleConfiguration{
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+	}
+}
+
+func expandTargetConfiguration(tfMap map[string]interface{}) *timestreamquery.TargetConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreamquery.TargetConfiguration{}
+
+	if v, ok := tfMap["timestream_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TimestreamConfiguration = expandTimestreamConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTimestreamConfiguration(tfMap map[string]interface{}) *timestreamquery.TimestreamConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreamquery.TimestreamConfiguration{
+		// DimensionMappings is required by the API, even when empty.
+		DimensionMappings: []*timestreamquery.DimensionMapping{},
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimension_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.DimensionMappings = append(apiObject.DimensionMappings, &timestreamquery.DimensionMapping{
+				DimensionValueType: aws.String(tfMap["dimension_value_type"].(string)),
+				Name:               aws.String(tfMap["name"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["measure_name_column"].(string); ok && v != "" {
+		apiObject.MeasureNameColumn = aws.String(v)
+	}
+
+	if v, ok := tfMap["mixed_measure_mapping"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			mapping := &timestreamquery.MixedMeasureMapping{
+				MeasureValueType: aws.String(tfMap["measure_value_type"].(string)),
+			}
+
+			if v, ok := tfMap["measure_name"].(string); ok && v != "" {
+				mapping.MeasureName = aws.String(v)
+			}
+
+			if v, ok := tfMap["multi_measure_attribute_mapping"].([]interface{}); ok && len(v) > 0 {
+				mapping.MultiMeasureAttributeMappings = expandMultiMeasureAttributeMappings(v)
+			}
+
+			if v, ok := tfMap["source_column"].(string); ok && v != "" {
+				mapping.SourceColumn = aws.String(v)
+			}
+
+			if v, ok := tfMap["target_measure_name"].(string); ok && v != "" {
+				mapping.TargetMeasureName = aws.String(v)
+			}
+
+			apiObject.MixedMeasureMappings = append(apiObject.MixedMeasureMappings, mapping)
+		}
+	}
+
+	if v, ok := tfMap["multi_measure_mappings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		mappings := &timestreamquery.MultiMeasureMappings{}
+
+		if v, ok := tfMap["multi_measure_attribute_mapping"].([]interface{}); ok && len(v) > 0 {
+			mappings.MultiMeasureAttributeMappings = expandMultiMeasureAttributeMappings(v)
+		}
+
+		if v, ok := tfMap["target_multi_measure_name"].(string); ok && v != "" {
+			mappings.TargetMultiMeasureName = aws.String(v)
+		}
+
+		apiObject.MultiMeasureMappings = mappings
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	if v, ok := tfMap["time_column"].(string); ok && v != "" {
+		apiObject.TimeColumn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMultiMeasureAttributeMappings(tfList []interface{}) []*timestreamquery.MultiMeasureAttributeMapping {
+	var apiObjects []*timestreamquery.MultiMeasureAttributeMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &timestreamquery.MultiMeasureAttributeMapping{
+			MeasureValueType: aws.String(tfMap["measure_value_type"].(string)),
+			SourceColumn:     aws.String(tfMap["source_column"].(string)),
+		}
+
+		if v, ok := tfMap["target_multi_measure_attribute_name"].(string); ok && v != "" {
+			apiObject.TargetMultiMeasureAttributeName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenErrorReportConfiguration(apiObject *timestreamquery.ErrorReportConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Configuration; v != nil {
+		tfMap["s3_configuration"] = []interface{}{map[string]interface{}{
+			"bucket_name":       aws.StringValue(v.BucketName),
+			"encryption_option": aws.StringValue(v.EncryptionOption),
+			"object_key_prefix": aws.StringValue(v.ObjectKeyPrefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNotificationConfiguration(apiObject *timestreamquery.NotificationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SnsConfiguration; v != nil {
+		tfMap["sns_configuration"] = []interface{}{map[string]interface{}{
+			"topic_arn": aws.StringValue(v.TopicArn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScheduleConfiguration(apiObject *timestreamquery.ScheduleConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"schedule_expression": aws.StringValue(apiObject.ScheduleExpression),
+	}}
+}
+
+func flattenTargetConfiguration(apiObject *timestreamquery.TargetConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TimestreamConfiguration; v != nil {
+		tfMap["timestream_configuration"] = []interface{}{flattenTimestreamConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTimestreamConfiguration(apiObject *timestreamquery.TimestreamConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"database_name":       aws.StringValue(apiObject.DatabaseName),
+		"measure_name_column": aws.StringValue(apiObject.MeasureNameColumn),
+		"table_name":          aws.StringValue(apiObject.TableName),
+		"time_column":         aws.StringValue(apiObject.TimeColumn),
+	}
+
+	var dimensionMappings []interface{}
+
+	for _, v := range apiObject.DimensionMappings {
+		dimensionMappings = append(dimensionMappings, map[string]interface{}{
+			"dimension_value_type": aws.StringValue(v.DimensionValueType),
+			"name":                 aws.StringValue(v.Name),
+		})
+	}
+
+	tfMap["dimension_mapping"] = dimensionMappings
+
+	var mixedMeasureMappings []interface{}
+
+	for _, v := range apiObject.MixedMeasureMappings {
+		mixedMeasureMappings = append(mixedMeasureMappings, map[string]interface{}{
+			"measure_name":                    aws.StringValue(v.MeasureName),
+			"measure_value_type":              aws.StringValue(v.MeasureValueType),
+			"multi_measure_attribute_mapping": flattenMultiMeasureAttributeMappings(v.MultiMeasureAttributeMappings),
+			"source_column":                   aws.StringValue(v.SourceColumn),
+			"target_measure_name":             aws.StringValue(v.TargetMeasureName),
+		})
+	}
+
+	tfMap["mixed_measure_mapping"] = mixedMeasureMappings
+
+	if v := apiObject.MultiMeasureMappings; v != nil {
+		tfMap["multi_measure_mappings"] = []interface{}{map[string]interface{}{
+			"multi_measure_attribute_mapping": flattenMultiMeasureAttributeMappings(v.MultiMeasureAttributeMappings),
+			"target_multi_measure_name":       aws.StringValue(v.TargetMultiMeasureName),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenMultiMeasureAttributeMappings(apiObjects []*timestreamquery.MultiMeasureAttributeMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"measure_value_type":                  aws.StringValue(apiObject.MeasureValueType),
+			"source_column":                       aws.StringValue(apiObject.SourceColumn),
+			"target_multi_measure_attribute_name": aws.StringValue(apiObject.TargetMultiMeasureAttributeName),
+		})
+	}
+
+	return tfList
+}
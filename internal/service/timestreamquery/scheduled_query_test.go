@@ -0,0 +1,384 @@
+package timestreamquery_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftimestreamquery "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTimestreamQueryScheduledQuery_basic(t *testing.T) {
+	var scheduledQuery timestreamquery.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName, &scheduledQuery),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "timestream", regexp.MustCompile(`scheduled-query/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.0.s3_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "error_report_configuration.0.s3_configuration.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "next_invocation_time"),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration.0.sns_configuration.0.topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_configuration.0.schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_configuration.0.timestream_configuration.0.database_name", "aws_timestreamwrite_database.test", "database_name"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.0.name", "az"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.multi_measure_attribute_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.multi_measure_attribute_mapping.0.measure_value_type", "DOUBLE"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.target_multi_measure_name", "avg_cpu"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.time_column", "binned_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_disappears(t *testing.T) {
+	var scheduledQuery timestreamquery.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName, &scheduledQuery),
+					acctest.CheckResourceDisappears(acctest.Provider, tftimestreamquery.ResourceScheduledQuery(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_state(t *testing.T) {
+	var scheduledQuery timestreamquery.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_state(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledQueryConfig_state(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_tags(t *testing.T) {
+	var scheduledQuery timestreamquery.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledQueryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_timestreamquery_scheduled_query" {
+			continue
+		}
+
+		_, err := tftimestreamquery.FindScheduledQueryByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Timestream Scheduled Query %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckScheduledQueryExists(n string, v *timestreamquery.ScheduledQueryDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Timestream Scheduled Query ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn
+
+		output, err := tftimestreamquery.FindScheduledQueryByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn
+
+	input := &timestreamquery.ListScheduledQueriesInput{}
+
+	_, err := conn.ListScheduledQueries(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccScheduledQueryConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+
+resource "aws_timestreamwrite_table" "source" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = "%[1]s-source"
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+  }
+}
+
+resource "aws_timestreamwrite_table" "target" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = "%[1]s-target"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "timestream.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "timestream:*",
+        "s3:PutObject",
+        "s3:GetBucketAcl",
+        "sns:Publish",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccScheduledQueryConfig_resource(rName, state, tags string) string {
+	return acctest.ConfigCompose(testAccScheduledQueryConfig_base(rName), fmt.Sprintf(`
+resource "aws_timestreamquery_scheduled_query" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+  state              = %[2]q
+
+  query_string = <<EOT
+SELECT az, bin(time, 1h) AS binned_timestamp, avg(measure_value::double) AS avg_cpu
+FROM "${aws_timestreamwrite_database.test.database_name}"."${aws_timestreamwrite_table.source.table_name}"
+WHERE measure_name = 'cpu' AND time > ago(2h)
+GROUP BY az, bin(time, 1h)
+EOT
+
+  schedule_configuration {
+    schedule_expression = "rate(1 hour)"
+  }
+
+  error_report_configuration {
+    s3_configuration {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  notification_configuration {
+    sns_configuration {
+      topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  target_configuration {
+    timestream_configuration {
+      database_name = aws_timestreamwrite_database.test.database_name
+      table_name    = aws_timestreamwrite_table.target.table_name
+      time_column   = "binned_timestamp"
+
+      dimension_mapping {
+        name                 = "az"
+        dimension_value_type = "VARCHAR"
+      }
+
+      multi_measure_mappings {
+        target_multi_measure_name = "avg_cpu"
+
+        multi_measure_attribute_mapping {
+          source_column      = "avg_cpu"
+          measure_value_type = "DOUBLE"
+        }
+      }
+    }
+  }
+
+%[3]s
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, state, tags))
+}
+
+func testAccScheduledQueryConfig_basic(rName string) string {
+	return testAccScheduledQueryConfig_resource(rName, "ENABLED", "")
+}
+
+func testAccScheduledQueryConfig_state(rName, state string) string {
+	return testAccScheduledQueryConfig_resource(rName, state, "")
+}
+
+func testAccScheduledQueryConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccScheduledQueryConfig_resource(rName, "ENABLED", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccScheduledQueryConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccScheduledQueryConfig_resource(rName, "ENABLED", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package timestreamquery
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_timestreamquery_scheduled_query", &resource.Sweeper{
+		Name: "aws_timestreamquery_scheduled_query",
+		F:    sweepScheduledQueries,
+	})
+}
+
+func sweepScheduledQueries(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).TimestreamQueryConn
+	input := &timestreamquery.ListScheduledQueriesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListScheduledQueriesPages(input, func(page *timestreamquery.ListScheduledQueriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ScheduledQueries {
+			r := ResourceScheduledQuery()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Timestream Scheduled Query sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Timestream Scheduled Queries (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Timestream Scheduled Queries (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package transfer
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceIdentityProviderTest() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityProviderTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"response": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(19, 19),
+			},
+			"server_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(transfer.Protocol_Values(), false),
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"status_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 100),
+			},
+			"user_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityProviderTestRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn
+
+	serverID := d.Get("server_id").(string)
+	userName := d.Get("user_name").(string)
+	input := &transfer.TestIdentityProviderInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("server_protocol"); ok {
+		input.ServerProtocol = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_ip"); ok {
+		input.SourceIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_password"); ok {
+		input.UserPassword = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Testing Transfer Server (%s) identity provider for user (%s)", serverID, userName)
+	output, err := conn.TestIdentityProvider(input)
+
+	if err != nil {
+		return fmt.Errorf("error testing Transfer Server (%s) identity provider: %w", serverID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serverID, userName))
+	d.Set("message", output.Message)
+	d.Set("response", output.Response)
+	d.Set("status_code", output.StatusCode)
+	d.Set("url", output.Url)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package transfer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccTransferIdentityProviderTestDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_server.test"
+	dataSourceName := "data.aws_transfer_identity_provider_test.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderTestDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "server_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", "test-user"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityProviderTestDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccServerConfig_lambdaFunctionIdentityProviderType(rName, false),
+		fmt.Sprintf(`
+resource "aws_lambda_permission" "test" {
+  statement_id  = %[1]q
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "transfer.amazonaws.com"
+  source_arn    = aws_transfer_server.test.arn
+}
+
+data "aws_transfer_identity_provider_test" "test" {
+  server_id       = aws_transfer_server.test.id
+  server_protocol = "SFTP"
+  source_ip       = "127.0.0.1"
+  user_name       = "test-user"
+  user_password   = "test-password"
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, rName))
+}
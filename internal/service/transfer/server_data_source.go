@@ -68,6 +68,14 @@ func DataSourceServer() *schema.Resource {
 				Required: true,
 			},
 
+			"structured_log_destinations": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,6 +112,7 @@ func dataSourceServerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("logging_role", output.LoggingRole)
 	d.Set("protocols", aws.StringValueSlice(output.Protocols))
 	d.Set("security_policy_name", output.SecurityPolicyName)
+	d.Set("structured_log_destinations", aws.StringValueSlice(output.StructuredLogDestinations))
 	if output.IdentityProviderDetails != nil {
 		d.Set("url", output.IdentityProviderDetails.Url)
 	} else {
@@ -7,9 +7,27 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
-func expandRules(l []interface{}) []*wafv2.Rule {
+// autoFlexOptions maps the WAFv2 API structure fields whose names don't follow the
+// provider's naming conventions.
+var autoFlexOptions = []flex.AutoFlexOptionsFunc{
+	flex.WithFieldNameOverride("CloudWatchMetricsEnabled", "cloudwatch_metrics_enabled"),
+}
+
+// autoExpand expands tfValue into the value pointed to by apiObject.
+// Any error indicates a mismatch between the schema and the API structure.
+func autoExpand(tfValue interface{}, apiObject interface{}) error {
+	return flex.Expand(tfValue, apiObject, autoFlexOptions...)
+}
+
+// autoFlatten flattens apiObject according to tfSchema.
+// Any error indicates a mismatch between the schema and the API structure.
+func autoFlatten(apiObject interface{}, tfSchema *schema.Schema) (interface{}, error) {
+	return flex.Flatten(apiObject, tfSchema, autoFlexOptions...)
+}
+
+func expandRules(l []interface{}) ([]*wafv2.Rule, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	rules := make([]*wafv2.Rule, 0)
@@ -18,153 +36,160 @@ func expandRules(l []interface{}) []*wafv2.Rule {
 		if rule == nil {
 			continue
 		}
-		rules = append(rules, expandRule(rule.(map[string]interface{})))
+
+		r, err := expandRule(rule.(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, r)
 	}
 
-	return rules
+	return rules, nil
 }
 
-func expandRule(m map[string]interface{}) *wafv2.Rule {
+func expandRule(m map[string]interface{}) (*wafv2.Rule, error) {
 	if m == nil {
-		return nil
+		return nil, nil
+	}
+
+	action, err := expandRuleAction(m["action"].([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := expandRuleGroupRootStatement(m["statement"].([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	visibilityConfig, err := expandVisibilityConfig(m["visibility_config"].([]interface{}))
+
+	if err != nil {
+		return nil, err
 	}
 
 	rule := &wafv2.Rule{
 		Name:             aws.String(m["name"].(string)),
 		Priority:         aws.Int64(int64(m["priority"].(int))),
-		Action:           expandRuleAction(m["action"].([]interface{})),
-		Statement:        expandRuleGroupRootStatement(m["statement"].([]interface{})),
-		VisibilityConfig: expandVisibilityConfig(m["visibility_config"].([]interface{})),
+		Action:           action,
+		Statement:        statement,
+		VisibilityConfig: visibilityConfig,
 	}
 
 	if v, ok := m["rule_label"].(*schema.Set); ok && v.Len() > 0 {
-		rule.RuleLabels = expandRuleLabels(v.List())
+		if rule.RuleLabels, err = expandRuleLabels(v.List()); err != nil {
+			return nil, err
+		}
 	}
 
-	return rule
+	return rule, nil
 }
 
-func expandRuleLabels(l []interface{}) []*wafv2.Label {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	labels := make([]*wafv2.Label, 0)
+func expandRuleLabels(l []interface{}) ([]*wafv2.Label, error) {
+	var labels []*wafv2.Label
 
-	for _, label := range l {
-		if label == nil {
-			continue
-		}
-		m := label.(map[string]interface{})
-		labels = append(labels, &wafv2.Label{
-			Name: aws.String(m["name"].(string)),
-		})
+	if err := autoExpand(l, &labels); err != nil {
+		return nil, err
 	}
 
-	return labels
+	return labels, nil
 }
 
-func expandRuleAction(l []interface{}) *wafv2.RuleAction {
+func expandRuleAction(l []interface{}) (*wafv2.RuleAction, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
 	action := &wafv2.RuleAction{}
 
+	var err error
+
 	if v, ok := m["allow"]; ok && len(v.([]interface{})) > 0 {
-		action.Allow = expandAllowAction(v.([]interface{}))
+		if action.Allow, err = expandAllowAction(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["block"]; ok && len(v.([]interface{})) > 0 {
-		action.Block = expandBlockAction(v.([]interface{}))
+		if action.Block, err = expandBlockAction(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["captcha"]; ok && len(v.([]interface{})) > 0 {
-		action.Captcha = expandCaptchaAction(v.([]interface{}))
+		if action.Captcha, err = expandCaptchaAction(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["count"]; ok && len(v.([]interface{})) > 0 {
-		action.Count = expandCountAction(v.([]interface{}))
+		if action.Count, err = expandCountAction(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
-	return action
+	return action, nil
 }
 
-func expandAllowAction(l []interface{}) *wafv2.AllowAction {
+func expandAllowAction(l []interface{}) (*wafv2.AllowAction, error) {
 	action := &wafv2.AllowAction{}
 
 	if len(l) == 0 || l[0] == nil {
-		return action
-	}
-
-	m, ok := l[0].(map[string]interface{})
-	if !ok {
-		return action
+		return action, nil
 	}
 
-	if v, ok := m["custom_request_handling"].([]interface{}); ok && len(v) > 0 {
-		action.CustomRequestHandling = expandCustomRequestHandling(v)
+	if err := autoExpand(l[0], action); err != nil {
+		return nil, err
 	}
 
-	return action
+	return action, nil
 }
 
-func expandBlockAction(l []interface{}) *wafv2.BlockAction {
+func expandBlockAction(l []interface{}) (*wafv2.BlockAction, error) {
 	action := &wafv2.BlockAction{}
 
 	if len(l) == 0 || l[0] == nil {
-		return action
+		return action, nil
 	}
 
-	m, ok := l[0].(map[string]interface{})
-	if !ok {
-		return action
+	if err := autoExpand(l[0], action); err != nil {
+		return nil, err
 	}
 
-	if v, ok := m["custom_response"].([]interface{}); ok && len(v) > 0 {
-		action.CustomResponse = expandCustomResponse(v)
-	}
-
-	return action
+	return action, nil
 }
 
-func expandCaptchaAction(l []interface{}) *wafv2.CaptchaAction {
+func expandCaptchaAction(l []interface{}) (*wafv2.CaptchaAction, error) {
 	action := &wafv2.CaptchaAction{}
 
 	if len(l) == 0 || l[0] == nil {
-		return action
-	}
-
-	m, ok := l[0].(map[string]interface{})
-	if !ok {
-		return action
+		return action, nil
 	}
 
-	if v, ok := m["custom_request_handling"].([]interface{}); ok && len(v) > 0 {
-		action.CustomRequestHandling = expandCustomRequestHandling(v)
+	if err := autoExpand(l[0], action); err != nil {
+		return nil, err
 	}
 
-	return action
+	return action, nil
 }
 
-func expandCountAction(l []interface{}) *wafv2.CountAction {
+func expandCountAction(l []interface{}) (*wafv2.CountAction, error) {
 	action := &wafv2.CountAction{}
 
 	if len(l) == 0 || l[0] == nil {
-		return action
-	}
-
-	m, ok := l[0].(map[string]interface{})
-	if !ok {
-		return action
+		return action, nil
 	}
 
-	if v, ok := m["custom_request_handling"].([]interface{}); ok && len(v) > 0 {
-		action.CustomRequestHandling = expandCustomRequestHandling(v)
+	if err := autoExpand(l[0], action); err != nil {
+		return nil, err
 	}
 
-	return action
+	return action, nil
 }
 
 func expandCustomResponseBodies(m []interface{}) map[string]*wafv2.CustomResponseBody {
@@ -186,94 +211,19 @@ func expandCustomResponseBodies(m []interface{}) map[string]*wafv2.CustomRespons
 	return customResponseBodies
 }
 
-func expandCustomResponse(l []interface{}) *wafv2.CustomResponse {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m, ok := l[0].(map[string]interface{})
-	if !ok {
-		return nil
-	}
-
-	customResponse := &wafv2.CustomResponse{}
-
-	if v, ok := m["custom_response_body_key"].(string); ok && v != "" {
-		customResponse.CustomResponseBodyKey = aws.String(v)
-	}
-	if v, ok := m["response_code"].(int); ok && v > 0 {
-		customResponse.ResponseCode = aws.Int64(int64(v))
-	}
-	if v, ok := m["response_header"].(*schema.Set); ok && len(v.List()) > 0 {
-		customResponse.ResponseHeaders = expandCustomHeaders(v.List())
-	}
-
-	return customResponse
-}
-
-func expandCustomRequestHandling(l []interface{}) *wafv2.CustomRequestHandling {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-	requestHandling := &wafv2.CustomRequestHandling{}
-
-	if v, ok := m["insert_header"].(*schema.Set); ok && len(v.List()) > 0 {
-		requestHandling.InsertHeaders = expandCustomHeaders(v.List())
-	}
-
-	return requestHandling
-}
-
-func expandCustomHeaders(l []interface{}) []*wafv2.CustomHTTPHeader {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	headers := make([]*wafv2.CustomHTTPHeader, 0)
-
-	for _, header := range l {
-		if header == nil {
-			continue
-		}
-		m := header.(map[string]interface{})
-		headers = append(headers, &wafv2.CustomHTTPHeader{
-			Name:  aws.String(m["name"].(string)),
-			Value: aws.String(m["value"].(string)),
-		})
-	}
-
-	return headers
-}
-
-func expandVisibilityConfig(l []interface{}) *wafv2.VisibilityConfig {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	configuration := &wafv2.VisibilityConfig{}
-
-	if v, ok := m["cloudwatch_metrics_enabled"]; ok {
-		configuration.CloudWatchMetricsEnabled = aws.Bool(v.(bool))
-	}
-
-	if v, ok := m["metric_name"]; ok && len(v.(string)) > 0 {
-		configuration.MetricName = aws.String(v.(string))
-	}
+func expandVisibilityConfig(l []interface{}) (*wafv2.VisibilityConfig, error) {
+	var configuration *wafv2.VisibilityConfig
 
-	if v, ok := m["sampled_requests_enabled"]; ok {
-		configuration.SampledRequestsEnabled = aws.Bool(v.(bool))
+	if err := autoExpand(l, &configuration); err != nil {
+		return nil, err
 	}
 
-	return configuration
+	return configuration, nil
 }
 
-func expandRuleGroupRootStatement(l []interface{}) *wafv2.Statement {
+func expandRuleGroupRootStatement(l []interface{}) (*wafv2.Statement, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
@@ -281,9 +231,9 @@ func expandRuleGroupRootStatement(l []interface{}) *wafv2.Statement {
 	return expandStatement(m)
 }
 
-func expandStatements(l []interface{}) []*wafv2.Statement {
+func expandStatements(l []interface{}) ([]*wafv2.Statement, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	statements := make([]*wafv2.Statement, 0)
@@ -292,659 +242,424 @@ func expandStatements(l []interface{}) []*wafv2.Statement {
 		if statement == nil {
 			continue
 		}
-		statements = append(statements, expandStatement(statement.(map[string]interface{})))
+
+		s, err := expandStatement(statement.(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, s)
 	}
 
-	return statements
+	return statements, nil
 }
 
-func expandStatement(m map[string]interface{}) *wafv2.Statement {
+func expandStatement(m map[string]interface{}) (*wafv2.Statement, error) {
 	if m == nil {
-		return nil
+		return nil, nil
 	}
 
 	statement := &wafv2.Statement{}
 
+	var err error
+
 	if v, ok := m["and_statement"]; ok {
-		statement.AndStatement = expandAndStatement(v.([]interface{}))
+		if statement.AndStatement, err = expandAndStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["byte_match_statement"]; ok {
-		statement.ByteMatchStatement = expandByteMatchStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.ByteMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["ip_set_reference_statement"]; ok {
-		statement.IPSetReferenceStatement = expandIPSetReferenceStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.IPSetReferenceStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["geo_match_statement"]; ok {
-		statement.GeoMatchStatement = expandGeoMatchStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.GeoMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["label_match_statement"]; ok {
-		statement.LabelMatchStatement = expandLabelMatchStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.LabelMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["not_statement"]; ok {
-		statement.NotStatement = expandNotStatement(v.([]interface{}))
+		if statement.NotStatement, err = expandNotStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["or_statement"]; ok {
-		statement.OrStatement = expandOrStatement(v.([]interface{}))
+		if statement.OrStatement, err = expandOrStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["rate_based_statement"]; ok {
-		statement.RateBasedStatement = expandRateBasedStatement(v.([]interface{}))
+		if statement.RateBasedStatement, err = expandRateBasedStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["regex_match_statement"]; ok {
-		statement.RegexMatchStatement = expandRegexMatchStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.RegexMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["regex_pattern_set_reference_statement"]; ok {
-		statement.RegexPatternSetReferenceStatement = expandRegexPatternSetReferenceStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.RegexPatternSetReferenceStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["size_constraint_statement"]; ok {
-		statement.SizeConstraintStatement = expandSizeConstraintStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.SizeConstraintStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["sqli_match_statement"]; ok {
-		statement.SqliMatchStatement = expandSQLiMatchStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.SqliMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["xss_match_statement"]; ok {
-		statement.XssMatchStatement = expandXSSMatchStatement(v.([]interface{}))
+		if err = autoExpand(v, &statement.XssMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	return statement
+	return statement, nil
 }
 
-func expandAndStatement(l []interface{}) *wafv2.AndStatement {
+func expandAndStatement(l []interface{}) (*wafv2.AndStatement, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	return &wafv2.AndStatement{
-		Statements: expandStatements(m["statement"].([]interface{})),
-	}
-}
+	statements, err := expandStatements(m["statement"].([]interface{}))
 
-func expandByteMatchStatement(l []interface{}) *wafv2.ByteMatchStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+	if err != nil {
+		return nil, err
 	}
 
-	m := l[0].(map[string]interface{})
+	return &wafv2.AndStatement{
+		Statements: statements,
+	}, nil
+}
+
+func expandForwardedIPConfig(l []interface{}) (*wafv2.ForwardedIPConfig, error) {
+	var config *wafv2.ForwardedIPConfig
 
-	return &wafv2.ByteMatchStatement{
-		FieldToMatch:         expandFieldToMatch(m["field_to_match"].([]interface{})),
-		PositionalConstraint: aws.String(m["positional_constraint"].(string)),
-		SearchString:         []byte(m["search_string"].(string)),
-		TextTransformations:  expandTextTransformations(m["text_transformation"].(*schema.Set).List()),
+	if err := autoExpand(l, &config); err != nil {
+		return nil, err
 	}
+
+	return config, nil
 }
 
-func expandFieldToMatch(l []interface{}) *wafv2.FieldToMatch {
+func expandSingleHeader(l []interface{}) *wafv2.SingleHeader {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
-	f := &wafv2.FieldToMatch{}
 
-	if v, ok := m["all_query_arguments"]; ok && len(v.([]interface{})) > 0 {
-		f.AllQueryArguments = &wafv2.AllQueryArguments{}
+	return &wafv2.SingleHeader{
+		Name: aws.String(m["name"].(string)),
 	}
+}
 
-	if v, ok := m["body"]; ok && len(v.([]interface{})) > 0 {
-		f.Body = &wafv2.Body{}
-	}
+func expandTextTransformations(l []interface{}) ([]*wafv2.TextTransformation, error) {
+	var rules []*wafv2.TextTransformation
 
-	if v, ok := m["cookies"]; ok && len(v.([]interface{})) > 0 {
-		f.Cookies = expandCookies(m["cookies"].([]interface{}))
+	if err := autoExpand(l, &rules); err != nil {
+		return nil, err
 	}
 
-	if v, ok := m["headers"]; ok && len(v.([]interface{})) > 0 {
-		f.Headers = expandHeaders(m["headers"].([]interface{}))
-	}
+	return rules, nil
+}
 
-	if v, ok := m["json_body"]; ok && len(v.([]interface{})) > 0 {
-		f.JsonBody = expandJSONBody(v.([]interface{}))
+func expandNotStatement(l []interface{}) (*wafv2.NotStatement, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
 	}
 
-	if v, ok := m["method"]; ok && len(v.([]interface{})) > 0 {
-		f.Method = &wafv2.Method{}
-	}
+	m := l[0].(map[string]interface{})
+	s := m["statement"].([]interface{})
 
-	if v, ok := m["query_string"]; ok && len(v.([]interface{})) > 0 {
-		f.QueryString = &wafv2.QueryString{}
+	if len(s) == 0 || s[0] == nil {
+		return nil, nil
 	}
 
-	if v, ok := m["single_header"]; ok && len(v.([]interface{})) > 0 {
-		f.SingleHeader = expandSingleHeader(m["single_header"].([]interface{}))
-	}
+	m = s[0].(map[string]interface{})
 
-	if v, ok := m["single_query_argument"]; ok && len(v.([]interface{})) > 0 {
-		f.SingleQueryArgument = expandSingleQueryArgument(m["single_query_argument"].([]interface{}))
-	}
+	statement, err := expandStatement(m)
 
-	if v, ok := m["uri_path"]; ok && len(v.([]interface{})) > 0 {
-		f.UriPath = &wafv2.UriPath{}
+	if err != nil {
+		return nil, err
 	}
 
-	return f
+	return &wafv2.NotStatement{
+		Statement: statement,
+	}, nil
 }
 
-func expandForwardedIPConfig(l []interface{}) *wafv2.ForwardedIPConfig {
+func expandOrStatement(l []interface{}) (*wafv2.OrStatement, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	return &wafv2.ForwardedIPConfig{
-		FallbackBehavior: aws.String(m["fallback_behavior"].(string)),
-		HeaderName:       aws.String(m["header_name"].(string)),
+	statements, err := expandStatements(m["statement"].([]interface{}))
+
+	if err != nil {
+		return nil, err
 	}
+
+	return &wafv2.OrStatement{
+		Statements: statements,
+	}, nil
 }
 
-func expandIPSetForwardedIPConfig(l []interface{}) *wafv2.IPSetForwardedIPConfig {
+func expandWebACLRules(l []interface{}) ([]*wafv2.Rule, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
-	m := l[0].(map[string]interface{})
+	rules := make([]*wafv2.Rule, 0)
 
-	return &wafv2.IPSetForwardedIPConfig{
-		FallbackBehavior: aws.String(m["fallback_behavior"].(string)),
-		HeaderName:       aws.String(m["header_name"].(string)),
-		Position:         aws.String(m["position"].(string)),
-	}
-}
+	for _, rule := range l {
+		if rule == nil {
+			continue
+		}
 
-func expandCookies(l []interface{}) *wafv2.Cookies {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+		r, err := expandWebACLRule(rule.(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, r)
 	}
 
-	m := l[0].(map[string]interface{})
+	return rules, nil
+}
 
-	cookies := &wafv2.Cookies{
-		MatchScope:       aws.String(m["match_scope"].(string)),
-		OversizeHandling: aws.String(m["oversize_handling"].(string)),
+func expandWebACLRule(m map[string]interface{}) (*wafv2.Rule, error) {
+	if m == nil {
+		return nil, nil
 	}
 
-	if v, ok := m["match_pattern"]; ok && len(v.([]interface{})) > 0 {
-		cookies.MatchPattern = expandCookieMatchPattern(v.([]interface{}))
+	action, err := expandRuleAction(m["action"].([]interface{}))
+
+	if err != nil {
+		return nil, err
 	}
 
-	return cookies
-}
+	statement, err := expandWebACLRootStatement(m["statement"].([]interface{}))
 
-func expandCookieMatchPattern(l []interface{}) *wafv2.CookieMatchPattern {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+	if err != nil {
+		return nil, err
 	}
 
-	m := l[0].(map[string]interface{})
-	CookieMatchPattern := &wafv2.CookieMatchPattern{}
+	visibilityConfig, err := expandVisibilityConfig(m["visibility_config"].([]interface{}))
 
-	if v, ok := m["included_cookies"]; ok && len(v.([]interface{})) > 0 {
-		CookieMatchPattern.IncludedCookies = flex.ExpandStringList(v.([]interface{}))
+	if err != nil {
+		return nil, err
 	}
 
-	if v, ok := m["excluded_cookies"]; ok && len(v.([]interface{})) > 0 {
-		CookieMatchPattern.ExcludedCookies = flex.ExpandStringList(v.([]interface{}))
+	rule := &wafv2.Rule{
+		Name:             aws.String(m["name"].(string)),
+		Priority:         aws.Int64(int64(m["priority"].(int))),
+		Action:           action,
+		OverrideAction:   expandOverrideAction(m["override_action"].([]interface{})),
+		Statement:        statement,
+		VisibilityConfig: visibilityConfig,
 	}
 
-	if v, ok := m["all"].([]interface{}); ok && len(v) > 0 {
-		CookieMatchPattern.All = &wafv2.All{}
+	if v, ok := m["rule_label"].(*schema.Set); ok && v.Len() > 0 {
+		if rule.RuleLabels, err = expandRuleLabels(v.List()); err != nil {
+			return nil, err
+		}
 	}
 
-	return CookieMatchPattern
+	return rule, nil
 }
 
-func expandJSONBody(l []interface{}) *wafv2.JsonBody {
+func expandOverrideAction(l []interface{}) *wafv2.OverrideAction {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
+	action := &wafv2.OverrideAction{}
 
-	jsonBody := &wafv2.JsonBody{
-		MatchScope:       aws.String(m["match_scope"].(string)),
-		OversizeHandling: aws.String(m["oversize_handling"].(string)),
-		MatchPattern:     expandJSONMatchPattern(m["match_pattern"].([]interface{})),
+	if v, ok := m["count"]; ok && len(v.([]interface{})) > 0 {
+		action.Count = &wafv2.CountAction{}
 	}
 
-	if v, ok := m["invalid_fallback_behavior"].(string); ok && v != "" {
-		jsonBody.InvalidFallbackBehavior = aws.String(v)
+	if v, ok := m["none"]; ok && len(v.([]interface{})) > 0 {
+		action.None = &wafv2.NoneAction{}
 	}
 
-	return jsonBody
+	return action
 }
 
-func expandJSONMatchPattern(l []interface{}) *wafv2.JsonMatchPattern {
+func expandDefaultAction(l []interface{}) (*wafv2.DefaultAction, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
-	jsonMatchPattern := &wafv2.JsonMatchPattern{}
+	action := &wafv2.DefaultAction{}
 
-	if v, ok := m["all"].([]interface{}); ok && len(v) > 0 {
-		jsonMatchPattern.All = &wafv2.All{}
+	var err error
+
+	if v, ok := m["allow"]; ok && len(v.([]interface{})) > 0 {
+		if action.Allow, err = expandAllowAction(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
-	if v, ok := m["included_paths"]; ok && len(v.([]interface{})) > 0 {
-		jsonMatchPattern.IncludedPaths = flex.ExpandStringList(v.([]interface{}))
+	if v, ok := m["block"]; ok && len(v.([]interface{})) > 0 {
+		if action.Block, err = expandBlockAction(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
-	return jsonMatchPattern
+	return action, nil
 }
 
-func expandSingleHeader(l []interface{}) *wafv2.SingleHeader {
+func expandWebACLRootStatement(l []interface{}) (*wafv2.Statement, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	return &wafv2.SingleHeader{
-		Name: aws.String(m["name"].(string)),
-	}
+	return expandWebACLStatement(m)
 }
 
-func expandSingleQueryArgument(l []interface{}) *wafv2.SingleQueryArgument {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+func expandWebACLStatement(m map[string]interface{}) (*wafv2.Statement, error) {
+	if m == nil {
+		return nil, nil
 	}
 
-	m := l[0].(map[string]interface{})
+	statement := &wafv2.Statement{}
 
-	return &wafv2.SingleQueryArgument{
-		Name: aws.String(m["name"].(string)),
-	}
-}
+	var err error
 
-func expandTextTransformations(l []interface{}) []*wafv2.TextTransformation {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+	if v, ok := m["and_statement"]; ok {
+		if statement.AndStatement, err = expandAndStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
-	rules := make([]*wafv2.TextTransformation, 0)
+	if v, ok := m["byte_match_statement"]; ok {
+		if err = autoExpand(v, &statement.ByteMatchStatement); err != nil {
+			return nil, err
+		}
+	}
 
-	for _, rule := range l {
-		if rule == nil {
-			continue
+	if v, ok := m["ip_set_reference_statement"]; ok {
+		if err = autoExpand(v, &statement.IPSetReferenceStatement); err != nil {
+			return nil, err
 		}
-		rules = append(rules, expandTextTransformation(rule.(map[string]interface{})))
 	}
 
-	return rules
-}
+	if v, ok := m["geo_match_statement"]; ok {
+		if err = autoExpand(v, &statement.GeoMatchStatement); err != nil {
+			return nil, err
+		}
+	}
 
-func expandTextTransformation(m map[string]interface{}) *wafv2.TextTransformation {
-	if m == nil {
-		return nil
+	if v, ok := m["label_match_statement"]; ok {
+		if err = autoExpand(v, &statement.LabelMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	return &wafv2.TextTransformation{
-		Priority: aws.Int64(int64(m["priority"].(int))),
-		Type:     aws.String(m["type"].(string)),
+	if v, ok := m["managed_rule_group_statement"]; ok {
+		if statement.ManagedRuleGroupStatement, err = expandManagedRuleGroupStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
-}
 
-func expandIPSetReferenceStatement(l []interface{}) *wafv2.IPSetReferenceStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+	if v, ok := m["not_statement"]; ok {
+		if statement.NotStatement, err = expandNotStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
-	m := l[0].(map[string]interface{})
+	if v, ok := m["or_statement"]; ok {
+		if statement.OrStatement, err = expandOrStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
+	}
 
-	statement := &wafv2.IPSetReferenceStatement{
-		ARN: aws.String(m["arn"].(string)),
+	if v, ok := m["rate_based_statement"]; ok {
+		if statement.RateBasedStatement, err = expandRateBasedStatement(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
-	if v, ok := m["ip_set_forwarded_ip_config"]; ok {
-		statement.IPSetForwardedIPConfig = expandIPSetForwardedIPConfig(v.([]interface{}))
+	if v, ok := m["regex_match_statement"]; ok {
+		if err = autoExpand(v, &statement.RegexMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	return statement
-}
+	if v, ok := m["regex_pattern_set_reference_statement"]; ok {
+		if err = autoExpand(v, &statement.RegexPatternSetReferenceStatement); err != nil {
+			return nil, err
+		}
+	}
 
-func expandGeoMatchStatement(l []interface{}) *wafv2.GeoMatchStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+	if v, ok := m["rule_group_reference_statement"]; ok {
+		statement.RuleGroupReferenceStatement = expandRuleGroupReferenceStatement(v.([]interface{}))
 	}
 
-	m := l[0].(map[string]interface{})
+	if v, ok := m["size_constraint_statement"]; ok {
+		if err = autoExpand(v, &statement.SizeConstraintStatement); err != nil {
+			return nil, err
+		}
+	}
 
-	statement := &wafv2.GeoMatchStatement{
-		CountryCodes: flex.ExpandStringList(m["country_codes"].([]interface{})),
+	if v, ok := m["sqli_match_statement"]; ok {
+		if err = autoExpand(v, &statement.SqliMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	if v, ok := m["forwarded_ip_config"]; ok {
-		statement.ForwardedIPConfig = expandForwardedIPConfig(v.([]interface{}))
+	if v, ok := m["xss_match_statement"]; ok {
+		if err = autoExpand(v, &statement.XssMatchStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	return statement
+	return statement, nil
 }
 
-func expandLabelMatchStatement(l []interface{}) *wafv2.LabelMatchStatement {
+func expandManagedRuleGroupStatement(l []interface{}) (*wafv2.ManagedRuleGroupStatement, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	statement := &wafv2.LabelMatchStatement{
-		Key:   aws.String(m["key"].(string)),
-		Scope: aws.String(m["scope"].(string)),
-	}
-
-	return statement
-}
-
-func expandNotStatement(l []interface{}) *wafv2.NotStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-	s := m["statement"].([]interface{})
-
-	if len(s) == 0 || s[0] == nil {
-		return nil
-	}
-
-	m = s[0].(map[string]interface{})
-
-	return &wafv2.NotStatement{
-		Statement: expandStatement(m),
-	}
-}
-
-func expandOrStatement(l []interface{}) *wafv2.OrStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return &wafv2.OrStatement{
-		Statements: expandStatements(m["statement"].([]interface{})),
-	}
-}
-
-func expandRegexMatchStatement(l []interface{}) *wafv2.RegexMatchStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return &wafv2.RegexMatchStatement{
-		RegexString:         aws.String(m["regex_string"].(string)),
-		FieldToMatch:        expandFieldToMatch(m["field_to_match"].([]interface{})),
-		TextTransformations: expandTextTransformations(m["text_transformation"].(*schema.Set).List()),
-	}
-}
-
-func expandRegexPatternSetReferenceStatement(l []interface{}) *wafv2.RegexPatternSetReferenceStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return &wafv2.RegexPatternSetReferenceStatement{
-		ARN:                 aws.String(m["arn"].(string)),
-		FieldToMatch:        expandFieldToMatch(m["field_to_match"].([]interface{})),
-		TextTransformations: expandTextTransformations(m["text_transformation"].(*schema.Set).List()),
-	}
-}
-
-func expandSizeConstraintStatement(l []interface{}) *wafv2.SizeConstraintStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return &wafv2.SizeConstraintStatement{
-		ComparisonOperator:  aws.String(m["comparison_operator"].(string)),
-		FieldToMatch:        expandFieldToMatch(m["field_to_match"].([]interface{})),
-		Size:                aws.Int64(int64(m["size"].(int))),
-		TextTransformations: expandTextTransformations(m["text_transformation"].(*schema.Set).List()),
-	}
-}
-
-func expandSQLiMatchStatement(l []interface{}) *wafv2.SqliMatchStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return &wafv2.SqliMatchStatement{
-		FieldToMatch:        expandFieldToMatch(m["field_to_match"].([]interface{})),
-		TextTransformations: expandTextTransformations(m["text_transformation"].(*schema.Set).List()),
-	}
-}
-
-func expandXSSMatchStatement(l []interface{}) *wafv2.XssMatchStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return &wafv2.XssMatchStatement{
-		FieldToMatch:        expandFieldToMatch(m["field_to_match"].([]interface{})),
-		TextTransformations: expandTextTransformations(m["text_transformation"].(*schema.Set).List()),
-	}
-}
-
-func expandHeaders(l []interface{}) *wafv2.Headers {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return &wafv2.Headers{
-		MatchPattern:     expandHeaderMatchPattern(m["match_pattern"].([]interface{})),
-		MatchScope:       aws.String(m["match_scope"].(string)),
-		OversizeHandling: aws.String(m["oversize_handling"].(string)),
-	}
-}
-
-func expandHeaderMatchPattern(l []interface{}) *wafv2.HeaderMatchPattern {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-	f := &wafv2.HeaderMatchPattern{}
-
-	if v, ok := m["all"]; ok && len(v.([]interface{})) > 0 {
-		f.All = &wafv2.All{}
-	}
-
-	if v, ok := m["included_headers"]; ok && len(v.([]interface{})) > 0 {
-		f.IncludedHeaders = flex.ExpandStringList(m["included_headers"].([]interface{}))
-	}
-
-	if v, ok := m["excluded_headers"]; ok && len(v.([]interface{})) > 0 {
-		f.ExcludedHeaders = flex.ExpandStringList(m["excluded_headers"].([]interface{}))
-	}
-
-	return f
-}
-
-func expandWebACLRules(l []interface{}) []*wafv2.Rule {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	rules := make([]*wafv2.Rule, 0)
-
-	for _, rule := range l {
-		if rule == nil {
-			continue
-		}
-		rules = append(rules, expandWebACLRule(rule.(map[string]interface{})))
-	}
-
-	return rules
-}
-
-func expandWebACLRule(m map[string]interface{}) *wafv2.Rule {
-	if m == nil {
-		return nil
-	}
-
-	rule := &wafv2.Rule{
-		Name:             aws.String(m["name"].(string)),
-		Priority:         aws.Int64(int64(m["priority"].(int))),
-		Action:           expandRuleAction(m["action"].([]interface{})),
-		OverrideAction:   expandOverrideAction(m["override_action"].([]interface{})),
-		Statement:        expandWebACLRootStatement(m["statement"].([]interface{})),
-		VisibilityConfig: expandVisibilityConfig(m["visibility_config"].([]interface{})),
-	}
-
-	if v, ok := m["rule_label"].(*schema.Set); ok && v.Len() > 0 {
-		rule.RuleLabels = expandRuleLabels(v.List())
-	}
-
-	return rule
-}
-
-func expandOverrideAction(l []interface{}) *wafv2.OverrideAction {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-	action := &wafv2.OverrideAction{}
-
-	if v, ok := m["count"]; ok && len(v.([]interface{})) > 0 {
-		action.Count = &wafv2.CountAction{}
-	}
-
-	if v, ok := m["none"]; ok && len(v.([]interface{})) > 0 {
-		action.None = &wafv2.NoneAction{}
-	}
-
-	return action
-}
-
-func expandDefaultAction(l []interface{}) *wafv2.DefaultAction {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-	action := &wafv2.DefaultAction{}
-
-	if v, ok := m["allow"]; ok && len(v.([]interface{})) > 0 {
-		action.Allow = expandAllowAction(v.([]interface{}))
-	}
-
-	if v, ok := m["block"]; ok && len(v.([]interface{})) > 0 {
-		action.Block = expandBlockAction(v.([]interface{}))
-	}
-
-	return action
-}
-
-func expandWebACLRootStatement(l []interface{}) *wafv2.Statement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]interface{})
-
-	return expandWebACLStatement(m)
-}
-
-func expandWebACLStatement(m map[string]interface{}) *wafv2.Statement {
-	if m == nil {
-		return nil
-	}
-
-	statement := &wafv2.Statement{}
-
-	if v, ok := m["and_statement"]; ok {
-		statement.AndStatement = expandAndStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["byte_match_statement"]; ok {
-		statement.ByteMatchStatement = expandByteMatchStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["ip_set_reference_statement"]; ok {
-		statement.IPSetReferenceStatement = expandIPSetReferenceStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["geo_match_statement"]; ok {
-		statement.GeoMatchStatement = expandGeoMatchStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["label_match_statement"]; ok {
-		statement.LabelMatchStatement = expandLabelMatchStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["managed_rule_group_statement"]; ok {
-		statement.ManagedRuleGroupStatement = expandManagedRuleGroupStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["not_statement"]; ok {
-		statement.NotStatement = expandNotStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["or_statement"]; ok {
-		statement.OrStatement = expandOrStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["rate_based_statement"]; ok {
-		statement.RateBasedStatement = expandRateBasedStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["regex_match_statement"]; ok {
-		statement.RegexMatchStatement = expandRegexMatchStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["regex_pattern_set_reference_statement"]; ok {
-		statement.RegexPatternSetReferenceStatement = expandRegexPatternSetReferenceStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["rule_group_reference_statement"]; ok {
-		statement.RuleGroupReferenceStatement = expandRuleGroupReferenceStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["size_constraint_statement"]; ok {
-		statement.SizeConstraintStatement = expandSizeConstraintStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["sqli_match_statement"]; ok {
-		statement.SqliMatchStatement = expandSQLiMatchStatement(v.([]interface{}))
-	}
-
-	if v, ok := m["xss_match_statement"]; ok {
-		statement.XssMatchStatement = expandXSSMatchStatement(v.([]interface{}))
-	}
-
-	return statement
-}
-
-func expandManagedRuleGroupStatement(l []interface{}) *wafv2.ManagedRuleGroupStatement {
-	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
@@ -955,19 +670,23 @@ func expandManagedRuleGroupStatement(l []interface{}) *wafv2.ManagedRuleGroupSta
 	}
 
 	if s, ok := m["scope_down_statement"].([]interface{}); ok && len(s) > 0 && s[0] != nil {
-		r.ScopeDownStatement = expandStatement(s[0].(map[string]interface{}))
+		var err error
+
+		if r.ScopeDownStatement, err = expandStatement(s[0].(map[string]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := m["version"]; ok && v != "" {
 		r.Version = aws.String(v.(string))
 	}
 
-	return r
+	return r, nil
 }
 
-func expandRateBasedStatement(l []interface{}) *wafv2.RateBasedStatement {
+func expandRateBasedStatement(l []interface{}) (*wafv2.RateBasedStatement, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	m := l[0].(map[string]interface{})
@@ -976,16 +695,32 @@ func expandRateBasedStatement(l []interface{}) *wafv2.RateBasedStatement {
 		Limit:            aws.Int64(int64(m["limit"].(int))),
 	}
 
+	var err error
+
+	if v, ok := m["custom_key"]; ok {
+		if err := autoExpand(v, &r.CustomKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := m["evaluation_window_sec"].(int); ok && v != 0 {
+		r.EvaluationWindowSec = aws.Int64(int64(v))
+	}
+
 	if v, ok := m["forwarded_ip_config"]; ok {
-		r.ForwardedIPConfig = expandForwardedIPConfig(v.([]interface{}))
+		if r.ForwardedIPConfig, err = expandForwardedIPConfig(v.([]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
 	s := m["scope_down_statement"].([]interface{})
 	if len(s) > 0 && s[0] != nil {
-		r.ScopeDownStatement = expandStatement(s[0].(map[string]interface{}))
+		if r.ScopeDownStatement, err = expandStatement(s[0].(map[string]interface{})); err != nil {
+			return nil, err
+		}
 	}
 
-	return r
+	return r, nil
 }
 
 func expandRuleGroupReferenceStatement(l []interface{}) *wafv2.RuleGroupReferenceStatement {
@@ -1041,719 +776,421 @@ func expandRegexPatternSet(l []interface{}) []*wafv2.Regex {
 		regexPatterns = append(regexPatterns, expandRegex(regexPattern.(map[string]interface{})))
 	}
 
-	return regexPatterns
-}
-
-func expandRegex(m map[string]interface{}) *wafv2.Regex {
-	if m == nil {
-		return nil
-	}
-
-	return &wafv2.Regex{
-		RegexString: aws.String(m["regex_string"].(string)),
-	}
-}
-
-func flattenRules(r []*wafv2.Rule) interface{} {
-	out := make([]map[string]interface{}, len(r))
-	for i, rule := range r {
-		m := make(map[string]interface{})
-		m["action"] = flattenRuleAction(rule.Action)
-		m["name"] = aws.StringValue(rule.Name)
-		m["priority"] = int(aws.Int64Value(rule.Priority))
-		m["rule_label"] = flattenRuleLabels(rule.RuleLabels)
-		m["statement"] = flattenRuleGroupRootStatement(rule.Statement)
-		m["visibility_config"] = flattenVisibilityConfig(rule.VisibilityConfig)
-		out[i] = m
-	}
-
-	return out
-}
-
-func flattenRuleAction(a *wafv2.RuleAction) interface{} {
-	if a == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{}
-
-	if a.Allow != nil {
-		m["allow"] = flattenAllow(a.Allow)
-	}
-
-	if a.Block != nil {
-		m["block"] = flattenBlock(a.Block)
-	}
-
-	if a.Captcha != nil {
-		m["captcha"] = flattenCaptcha(a.Captcha)
-	}
-
-	if a.Count != nil {
-		m["count"] = flattenCount(a.Count)
-	}
-
-	return []interface{}{m}
-}
-
-func flattenAllow(a *wafv2.AllowAction) []interface{} {
-	if a == nil {
-		return []interface{}{}
-	}
-	m := map[string]interface{}{}
-
-	if a.CustomRequestHandling != nil {
-		m["custom_request_handling"] = flattenCustomRequestHandling(a.CustomRequestHandling)
-	}
-
-	return []interface{}{m}
-}
-
-func flattenBlock(a *wafv2.BlockAction) []interface{} {
-	if a == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{}
-
-	if a.CustomResponse != nil {
-		m["custom_response"] = flattenCustomResponse(a.CustomResponse)
-	}
-
-	return []interface{}{m}
-}
-
-func flattenCaptcha(a *wafv2.CaptchaAction) []interface{} {
-	if a == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{}
-
-	if a.CustomRequestHandling != nil {
-		m["custom_request_handling"] = flattenCustomRequestHandling(a.CustomRequestHandling)
-	}
-
-	return []interface{}{m}
-}
-
-func flattenCount(a *wafv2.CountAction) []interface{} {
-	if a == nil {
-		return []interface{}{}
-	}
-	m := map[string]interface{}{}
-
-	if a.CustomRequestHandling != nil {
-		m["custom_request_handling"] = flattenCustomRequestHandling(a.CustomRequestHandling)
-	}
-
-	return []interface{}{m}
-}
-
-func flattenCustomResponseBodies(b map[string]*wafv2.CustomResponseBody) interface{} {
-	if len(b) == 0 {
-		return make([]map[string]interface{}, 0)
-	}
-
-	out := make([]map[string]interface{}, len(b))
-	i := 0
-	for key, body := range b {
-		out[i] = map[string]interface{}{
-			"key":          key,
-			"content":      aws.StringValue(body.Content),
-			"content_type": aws.StringValue(body.ContentType),
-		}
-		i += 1
-	}
-
-	return out
-}
-
-func flattenCustomRequestHandling(c *wafv2.CustomRequestHandling) []interface{} {
-	if c == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{
-		"insert_header": flattenCustomHeaders(c.InsertHeaders),
-	}
-
-	return []interface{}{m}
-}
-
-func flattenCustomResponse(r *wafv2.CustomResponse) []interface{} {
-	if r == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{
-		"response_code":   int(aws.Int64Value(r.ResponseCode)),
-		"response_header": flattenCustomHeaders(r.ResponseHeaders),
-	}
-
-	if r.CustomResponseBodyKey != nil {
-		m["custom_response_body_key"] = aws.StringValue(r.CustomResponseBodyKey)
-	}
-
-	return []interface{}{m}
-}
-
-func flattenCustomHeaders(h []*wafv2.CustomHTTPHeader) []interface{} {
-	out := make([]interface{}, len(h))
-	for i, header := range h {
-		out[i] = flattenCustomHeader(header)
-	}
-
-	return out
-}
-
-func flattenCustomHeader(h *wafv2.CustomHTTPHeader) map[string]interface{} {
-	if h == nil {
-		return map[string]interface{}{}
-	}
-
-	m := map[string]interface{}{
-		"name":  aws.StringValue(h.Name),
-		"value": aws.StringValue(h.Value),
-	}
-
-	return m
-}
-
-func flattenRuleLabels(l []*wafv2.Label) []interface{} {
-	if len(l) == 0 {
-		return nil
-	}
-
-	out := make([]interface{}, len(l))
-	for i, label := range l {
-		out[i] = map[string]interface{}{
-			"name": aws.StringValue(label.Name),
-		}
-	}
-
-	return out
-}
-
-func flattenRuleGroupRootStatement(s *wafv2.Statement) interface{} {
-	if s == nil {
-		return []interface{}{}
-	}
-
-	return []interface{}{flattenStatement(s)}
-}
-
-func flattenStatements(s []*wafv2.Statement) interface{} {
-	out := make([]interface{}, len(s))
-	for i, statement := range s {
-		out[i] = flattenStatement(statement)
-	}
-
-	return out
-}
-
-func flattenStatement(s *wafv2.Statement) map[string]interface{} {
-	if s == nil {
-		return map[string]interface{}{}
-	}
-
-	m := map[string]interface{}{}
-
-	if s.AndStatement != nil {
-		m["and_statement"] = flattenAndStatement(s.AndStatement)
-	}
-
-	if s.ByteMatchStatement != nil {
-		m["byte_match_statement"] = flattenByteMatchStatement(s.ByteMatchStatement)
-	}
-
-	if s.IPSetReferenceStatement != nil {
-		m["ip_set_reference_statement"] = flattenIPSetReferenceStatement(s.IPSetReferenceStatement)
-	}
-
-	if s.GeoMatchStatement != nil {
-		m["geo_match_statement"] = flattenGeoMatchStatement(s.GeoMatchStatement)
-	}
-
-	if s.LabelMatchStatement != nil {
-		m["label_match_statement"] = flattenLabelMatchStatement(s.LabelMatchStatement)
-	}
-
-	if s.NotStatement != nil {
-		m["not_statement"] = flattenNotStatement(s.NotStatement)
-	}
-
-	if s.OrStatement != nil {
-		m["or_statement"] = flattenOrStatement(s.OrStatement)
-	}
-
-	if s.RateBasedStatement != nil {
-		m["rate_based_statement"] = flattenRateBasedStatement(s.RateBasedStatement)
-	}
-
-	if s.RegexMatchStatement != nil {
-		m["regex_match_statement"] = flattenRegexMatchStatement(s.RegexMatchStatement)
-	}
-
-	if s.RegexPatternSetReferenceStatement != nil {
-		m["regex_pattern_set_reference_statement"] = flattenRegexPatternSetReferenceStatement(s.RegexPatternSetReferenceStatement)
-	}
-
-	if s.SizeConstraintStatement != nil {
-		m["size_constraint_statement"] = flattenSizeConstraintStatement(s.SizeConstraintStatement)
-	}
-
-	if s.SqliMatchStatement != nil {
-		m["sqli_match_statement"] = flattenSQLiMatchStatement(s.SqliMatchStatement)
-	}
-
-	if s.XssMatchStatement != nil {
-		m["xss_match_statement"] = flattenXSSMatchStatement(s.XssMatchStatement)
-	}
-
-	return m
-}
-
-func flattenAndStatement(a *wafv2.AndStatement) interface{} {
-	if a == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{
-		"statement": flattenStatements(a.Statements),
-	}
-
-	return []interface{}{m}
-}
-
-func flattenByteMatchStatement(b *wafv2.ByteMatchStatement) interface{} {
-	if b == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{
-		"field_to_match":        flattenFieldToMatch(b.FieldToMatch),
-		"positional_constraint": aws.StringValue(b.PositionalConstraint),
-		"search_string":         string(b.SearchString),
-		"text_transformation":   flattenTextTransformations(b.TextTransformations),
-	}
-
-	return []interface{}{m}
+	return regexPatterns
 }
 
-func flattenFieldToMatch(f *wafv2.FieldToMatch) interface{} {
-	if f == nil {
-		return []interface{}{}
+func expandRegexPatternSetRegexStrings(regexStrings []string) []*wafv2.Regex {
+	regexPatterns := make([]*wafv2.Regex, 0, len(regexStrings))
+	for _, regexString := range regexStrings {
+		regexPatterns = append(regexPatterns, &wafv2.Regex{
+			RegexString: aws.String(regexString),
+		})
 	}
 
-	m := map[string]interface{}{}
+	return regexPatterns
+}
 
-	if f.AllQueryArguments != nil {
-		m["all_query_arguments"] = make([]map[string]interface{}, 1)
+func expandRegex(m map[string]interface{}) *wafv2.Regex {
+	if m == nil {
+		return nil
 	}
 
-	if f.Body != nil {
-		m["body"] = make([]map[string]interface{}, 1)
+	return &wafv2.Regex{
+		RegexString: aws.String(m["regex_string"].(string)),
 	}
+}
 
-	if f.Cookies != nil {
-		m["cookies"] = flattenCookies(f.Cookies)
-	}
+func flattenRules(r []*wafv2.Rule) (interface{}, error) {
+	out := make([]map[string]interface{}, len(r))
+	for i, rule := range r {
+		m := make(map[string]interface{})
 
-	if f.Headers != nil {
-		m["headers"] = flattenHeaders(f.Headers)
-	}
+		var err error
 
-	if f.JsonBody != nil {
-		m["json_body"] = flattenJSONBody(f.JsonBody)
-	}
+		if m["action"], err = flattenRuleAction(rule.Action); err != nil {
+			return nil, err
+		}
 
-	if f.Method != nil {
-		m["method"] = make([]map[string]interface{}, 1)
-	}
+		m["name"] = aws.StringValue(rule.Name)
+		m["priority"] = int(aws.Int64Value(rule.Priority))
 
-	if f.QueryString != nil {
-		m["query_string"] = make([]map[string]interface{}, 1)
-	}
+		if m["rule_label"], err = flattenRuleLabels(rule.RuleLabels); err != nil {
+			return nil, err
+		}
 
-	if f.SingleHeader != nil {
-		m["single_header"] = flattenSingleHeader(f.SingleHeader)
-	}
+		if m["statement"], err = flattenRuleGroupRootStatement(rule.Statement); err != nil {
+			return nil, err
+		}
 
-	if f.SingleQueryArgument != nil {
-		m["single_query_argument"] = flattenSingleQueryArgument(f.SingleQueryArgument)
-	}
+		if m["visibility_config"], err = flattenVisibilityConfig(rule.VisibilityConfig); err != nil {
+			return nil, err
+		}
 
-	if f.UriPath != nil {
-		m["uri_path"] = make([]map[string]interface{}, 1)
+		out[i] = m
 	}
 
-	return []interface{}{m}
+	return out, nil
 }
 
-func flattenForwardedIPConfig(f *wafv2.ForwardedIPConfig) interface{} {
-	if f == nil {
-		return []interface{}{}
+func flattenRuleAction(a *wafv2.RuleAction) (interface{}, error) {
+	if a == nil {
+		return []interface{}{}, nil
 	}
 
-	m := map[string]interface{}{
-		"fallback_behavior": aws.StringValue(f.FallbackBehavior),
-		"header_name":       aws.StringValue(f.HeaderName),
-	}
+	m := map[string]interface{}{}
 
-	return []interface{}{m}
-}
+	var err error
 
-func flattenIPSetForwardedIPConfig(i *wafv2.IPSetForwardedIPConfig) interface{} {
-	if i == nil {
-		return []interface{}{}
+	if a.Allow != nil {
+		if m["allow"], err = flattenAllow(a.Allow); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"fallback_behavior": aws.StringValue(i.FallbackBehavior),
-		"header_name":       aws.StringValue(i.HeaderName),
-		"position":          aws.StringValue(i.Position),
+	if a.Block != nil {
+		if m["block"], err = flattenBlock(a.Block); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
-}
-
-func flattenCookies(c *wafv2.Cookies) interface{} {
-	if c == nil {
-		return []interface{}{}
+	if a.Captcha != nil {
+		if m["captcha"], err = flattenCaptcha(a.Captcha); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"match_scope":       aws.StringValue(c.MatchScope),
-		"oversize_handling": aws.StringValue(c.OversizeHandling),
-		"match_pattern":     flattenCookiesMatchPattern(c.MatchPattern),
+	if a.Count != nil {
+		if m["count"], err = flattenCount(a.Count); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
+	return []interface{}{m}, nil
 }
 
-func flattenCookiesMatchPattern(c *wafv2.CookieMatchPattern) interface{} {
-	if c == nil {
-		return []interface{}{}
-	}
+func flattenAllow(a *wafv2.AllowAction) (interface{}, error) {
+	return autoFlatten(a, allowConfigSchema())
+}
 
-	m := map[string]interface{}{
-		"included_cookies": aws.StringValueSlice(c.IncludedCookies),
-		"excluded_cookies": aws.StringValueSlice(c.ExcludedCookies),
-	}
+func flattenBlock(a *wafv2.BlockAction) (interface{}, error) {
+	return autoFlatten(a, blockConfigSchema())
+}
 
-	if c.All != nil {
-		m["all"] = make([]map[string]interface{}, 1)
-	}
+func flattenCaptcha(a *wafv2.CaptchaAction) (interface{}, error) {
+	return autoFlatten(a, captchaConfigSchema())
+}
 
-	return []interface{}{m}
+func flattenCount(a *wafv2.CountAction) (interface{}, error) {
+	return autoFlatten(a, countConfigSchema())
 }
 
-func flattenJSONBody(b *wafv2.JsonBody) interface{} {
-	if b == nil {
-		return []interface{}{}
+func flattenCustomResponseBodies(b map[string]*wafv2.CustomResponseBody) interface{} {
+	if len(b) == 0 {
+		return make([]map[string]interface{}, 0)
 	}
 
-	m := map[string]interface{}{
-		"invalid_fallback_behavior": aws.StringValue(b.InvalidFallbackBehavior),
-		"match_pattern":             flattenJSONMatchPattern(b.MatchPattern),
-		"match_scope":               aws.StringValue(b.MatchScope),
-		"oversize_handling":         aws.StringValue(b.OversizeHandling),
+	out := make([]map[string]interface{}, len(b))
+	i := 0
+	for key, body := range b {
+		out[i] = map[string]interface{}{
+			"key":          key,
+			"content":      aws.StringValue(body.Content),
+			"content_type": aws.StringValue(body.ContentType),
+		}
+		i += 1
 	}
 
-	return []interface{}{m}
+	return out
 }
 
-func flattenJSONMatchPattern(p *wafv2.JsonMatchPattern) []interface{} {
-	if p == nil {
-		return []interface{}{}
-	}
+func flattenRuleLabels(l []*wafv2.Label) (interface{}, error) {
+	return autoFlatten(l, ruleLabelsSchema())
+}
 
-	m := map[string]interface{}{
-		"included_paths": flex.FlattenStringList(p.IncludedPaths),
+func flattenRuleGroupRootStatement(s *wafv2.Statement) (interface{}, error) {
+	if s == nil {
+		return []interface{}{}, nil
 	}
 
-	if p.All != nil {
-		m["all"] = make([]map[string]interface{}, 1)
+	m, err := flattenStatement(s)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return []interface{}{m}
+	return []interface{}{m}, nil
 }
 
-func flattenSingleHeader(s *wafv2.SingleHeader) interface{} {
-	if s == nil {
-		return []interface{}{}
-	}
+func flattenStatements(s []*wafv2.Statement) (interface{}, error) {
+	out := make([]interface{}, len(s))
+	for i, statement := range s {
+		m, err := flattenStatement(statement)
 
-	m := map[string]interface{}{
-		"name": aws.StringValue(s.Name),
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = m
 	}
 
-	return []interface{}{m}
+	return out, nil
 }
 
-func flattenSingleQueryArgument(s *wafv2.SingleQueryArgument) interface{} {
+func flattenStatement(s *wafv2.Statement) (map[string]interface{}, error) {
 	if s == nil {
-		return []interface{}{}
+		return map[string]interface{}{}, nil
 	}
 
-	m := map[string]interface{}{
-		"name": aws.StringValue(s.Name),
-	}
+	m := map[string]interface{}{}
 
-	return []interface{}{m}
-}
+	var err error
 
-func flattenTextTransformations(l []*wafv2.TextTransformation) []interface{} {
-	out := make([]interface{}, len(l))
-	for i, t := range l {
-		m := make(map[string]interface{})
-		m["priority"] = int(aws.Int64Value(t.Priority))
-		m["type"] = aws.StringValue(t.Type)
-		out[i] = m
+	if s.AndStatement != nil {
+		if m["and_statement"], err = flattenAndStatement(s.AndStatement); err != nil {
+			return nil, err
+		}
 	}
-	return out
-}
 
-func flattenIPSetReferenceStatement(i *wafv2.IPSetReferenceStatement) interface{} {
-	if i == nil {
-		return []interface{}{}
+	if s.ByteMatchStatement != nil {
+		if m["byte_match_statement"], err = autoFlatten(s.ByteMatchStatement, byteMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"arn":                        aws.StringValue(i.ARN),
-		"ip_set_forwarded_ip_config": flattenIPSetForwardedIPConfig(i.IPSetForwardedIPConfig),
+	if s.IPSetReferenceStatement != nil {
+		if m["ip_set_reference_statement"], err = autoFlatten(s.IPSetReferenceStatement, ipSetReferenceStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
-}
-
-func flattenGeoMatchStatement(g *wafv2.GeoMatchStatement) interface{} {
-	if g == nil {
-		return []interface{}{}
+	if s.GeoMatchStatement != nil {
+		if m["geo_match_statement"], err = autoFlatten(s.GeoMatchStatement, geoMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"country_codes":       flex.FlattenStringList(g.CountryCodes),
-		"forwarded_ip_config": flattenForwardedIPConfig(g.ForwardedIPConfig),
+	if s.LabelMatchStatement != nil {
+		if m["label_match_statement"], err = autoFlatten(s.LabelMatchStatement, labelMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
-}
-
-func flattenLabelMatchStatement(l *wafv2.LabelMatchStatement) interface{} {
-	if l == nil {
-		return []interface{}{}
+	if s.NotStatement != nil {
+		if m["not_statement"], err = flattenNotStatement(s.NotStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"key":   aws.StringValue(l.Key),
-		"scope": aws.StringValue(l.Scope),
+	if s.OrStatement != nil {
+		if m["or_statement"], err = flattenOrStatement(s.OrStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
-}
-
-func flattenNotStatement(a *wafv2.NotStatement) interface{} {
-	if a == nil {
-		return []interface{}{}
+	if s.RateBasedStatement != nil {
+		if m["rate_based_statement"], err = flattenRateBasedStatement(s.RateBasedStatement); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"statement": []interface{}{flattenStatement(a.Statement)},
+	if s.RegexMatchStatement != nil {
+		if m["regex_match_statement"], err = autoFlatten(s.RegexMatchStatement, regexMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
-}
-
-func flattenOrStatement(a *wafv2.OrStatement) interface{} {
-	if a == nil {
-		return []interface{}{}
+	if s.RegexPatternSetReferenceStatement != nil {
+		if m["regex_pattern_set_reference_statement"], err = autoFlatten(s.RegexPatternSetReferenceStatement, regexPatternSetReferenceStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"statement": flattenStatements(a.Statements),
+	if s.SizeConstraintStatement != nil {
+		if m["size_constraint_statement"], err = autoFlatten(s.SizeConstraintStatement, sizeConstraintSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
-}
-
-func flattenRegexMatchStatement(r *wafv2.RegexMatchStatement) interface{} {
-	if r == nil {
-		return []interface{}{}
+	if s.SqliMatchStatement != nil {
+		if m["sqli_match_statement"], err = autoFlatten(s.SqliMatchStatement, sqliMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	m := map[string]interface{}{
-		"regex_string":        aws.StringValue(r.RegexString),
-		"field_to_match":      flattenFieldToMatch(r.FieldToMatch),
-		"text_transformation": flattenTextTransformations(r.TextTransformations),
+	if s.XssMatchStatement != nil {
+		if m["xss_match_statement"], err = autoFlatten(s.XssMatchStatement, xssMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
+	return m, nil
 }
 
-func flattenRegexPatternSetReferenceStatement(r *wafv2.RegexPatternSetReferenceStatement) interface{} {
-	if r == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{
-		"arn":                 aws.StringValue(r.ARN),
-		"field_to_match":      flattenFieldToMatch(r.FieldToMatch),
-		"text_transformation": flattenTextTransformations(r.TextTransformations),
+func flattenAndStatement(a *wafv2.AndStatement) (interface{}, error) {
+	if a == nil {
+		return []interface{}{}, nil
 	}
 
-	return []interface{}{m}
-}
+	statements, err := flattenStatements(a.Statements)
 
-func flattenSizeConstraintStatement(s *wafv2.SizeConstraintStatement) interface{} {
-	if s == nil {
-		return []interface{}{}
+	if err != nil {
+		return nil, err
 	}
 
 	m := map[string]interface{}{
-		"comparison_operator": aws.StringValue(s.ComparisonOperator),
-		"field_to_match":      flattenFieldToMatch(s.FieldToMatch),
-		"size":                int(aws.Int64Value(s.Size)),
-		"text_transformation": flattenTextTransformations(s.TextTransformations),
+		"statement": statements,
 	}
 
-	return []interface{}{m}
+	return []interface{}{m}, nil
 }
 
-func flattenSQLiMatchStatement(s *wafv2.SqliMatchStatement) interface{} {
-	if s == nil {
-		return []interface{}{}
-	}
-
-	m := map[string]interface{}{
-		"field_to_match":      flattenFieldToMatch(s.FieldToMatch),
-		"text_transformation": flattenTextTransformations(s.TextTransformations),
-	}
-
-	return []interface{}{m}
+func flattenForwardedIPConfig(f *wafv2.ForwardedIPConfig) (interface{}, error) {
+	return autoFlatten(f, forwardedIPConfigSchema())
 }
 
-func flattenXSSMatchStatement(s *wafv2.XssMatchStatement) interface{} {
+func flattenSingleHeader(s *wafv2.SingleHeader) interface{} {
 	if s == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"field_to_match":      flattenFieldToMatch(s.FieldToMatch),
-		"text_transformation": flattenTextTransformations(s.TextTransformations),
+		"name": aws.StringValue(s.Name),
 	}
 
 	return []interface{}{m}
 }
 
-func flattenVisibilityConfig(config *wafv2.VisibilityConfig) interface{} {
-	if config == nil {
-		return []interface{}{}
-	}
+func flattenTextTransformations(l []*wafv2.TextTransformation) (interface{}, error) {
+	return autoFlatten(l, textTransformationSchema())
+}
 
-	m := map[string]interface{}{
-		"cloudwatch_metrics_enabled": aws.BoolValue(config.CloudWatchMetricsEnabled),
-		"metric_name":                aws.StringValue(config.MetricName),
-		"sampled_requests_enabled":   aws.BoolValue(config.SampledRequestsEnabled),
+func flattenNotStatement(a *wafv2.NotStatement) (interface{}, error) {
+	if a == nil {
+		return []interface{}{}, nil
 	}
 
-	return []interface{}{m}
-}
+	statement, err := flattenStatement(a.Statement)
 
-func flattenHeaders(s *wafv2.Headers) interface{} {
-	if s == nil {
-		return []interface{}{}
+	if err != nil {
+		return nil, err
 	}
 
 	m := map[string]interface{}{
-		"match_scope":       aws.StringValue(s.MatchScope),
-		"match_pattern":     flattenHeaderMatchPattern(s.MatchPattern),
-		"oversize_handling": aws.StringValue(s.OversizeHandling),
+		"statement": []interface{}{statement},
 	}
 
-	return []interface{}{m}
+	return []interface{}{m}, nil
 }
 
-func flattenHeaderMatchPattern(s *wafv2.HeaderMatchPattern) interface{} {
-	if s == nil {
-		return []interface{}{}
+func flattenOrStatement(a *wafv2.OrStatement) (interface{}, error) {
+	if a == nil {
+		return []interface{}{}, nil
 	}
 
-	m := map[string]interface{}{}
+	statements, err := flattenStatements(a.Statements)
 
-	if s.All != nil {
-		m["all"] = make([]map[string]interface{}, 1)
+	if err != nil {
+		return nil, err
 	}
 
-	if s.ExcludedHeaders != nil {
-		m["excluded_headers"] = flex.FlattenStringList(s.ExcludedHeaders)
+	m := map[string]interface{}{
+		"statement": statements,
 	}
 
-	if s.IncludedHeaders != nil {
-		m["included_headers"] = flex.FlattenStringList(s.IncludedHeaders)
-	}
+	return []interface{}{m}, nil
+}
 
-	return []interface{}{m}
+func flattenVisibilityConfig(config *wafv2.VisibilityConfig) (interface{}, error) {
+	return autoFlatten(config, visibilityConfigSchema())
 }
 
-func flattenWebACLRootStatement(s *wafv2.Statement) interface{} {
+func flattenWebACLRootStatement(s *wafv2.Statement) (interface{}, error) {
 	if s == nil {
-		return []interface{}{}
+		return []interface{}{}, nil
+	}
+
+	m, err := flattenWebACLStatement(s)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return []interface{}{flattenWebACLStatement(s)}
+	return []interface{}{m}, nil
 }
 
-func flattenWebACLStatement(s *wafv2.Statement) map[string]interface{} {
+func flattenWebACLStatement(s *wafv2.Statement) (map[string]interface{}, error) {
 	if s == nil {
-		return map[string]interface{}{}
+		return map[string]interface{}{}, nil
 	}
 
 	m := map[string]interface{}{}
 
+	var err error
+
 	if s.AndStatement != nil {
-		m["and_statement"] = flattenAndStatement(s.AndStatement)
+		if m["and_statement"], err = flattenAndStatement(s.AndStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.ByteMatchStatement != nil {
-		m["byte_match_statement"] = flattenByteMatchStatement(s.ByteMatchStatement)
+		if m["byte_match_statement"], err = autoFlatten(s.ByteMatchStatement, byteMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.IPSetReferenceStatement != nil {
-		m["ip_set_reference_statement"] = flattenIPSetReferenceStatement(s.IPSetReferenceStatement)
+		if m["ip_set_reference_statement"], err = autoFlatten(s.IPSetReferenceStatement, ipSetReferenceStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.GeoMatchStatement != nil {
-		m["geo_match_statement"] = flattenGeoMatchStatement(s.GeoMatchStatement)
+		if m["geo_match_statement"], err = autoFlatten(s.GeoMatchStatement, geoMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.LabelMatchStatement != nil {
-		m["label_match_statement"] = flattenLabelMatchStatement(s.LabelMatchStatement)
+		if m["label_match_statement"], err = autoFlatten(s.LabelMatchStatement, labelMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.ManagedRuleGroupStatement != nil {
-		m["managed_rule_group_statement"] = flattenManagedRuleGroupStatement(s.ManagedRuleGroupStatement)
+		if m["managed_rule_group_statement"], err = flattenManagedRuleGroupStatement(s.ManagedRuleGroupStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.NotStatement != nil {
-		m["not_statement"] = flattenNotStatement(s.NotStatement)
+		if m["not_statement"], err = flattenNotStatement(s.NotStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.OrStatement != nil {
-		m["or_statement"] = flattenOrStatement(s.OrStatement)
+		if m["or_statement"], err = flattenOrStatement(s.OrStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.RateBasedStatement != nil {
-		m["rate_based_statement"] = flattenRateBasedStatement(s.RateBasedStatement)
+		if m["rate_based_statement"], err = flattenRateBasedStatement(s.RateBasedStatement); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.RegexMatchStatement != nil {
-		m["regex_match_statement"] = flattenRegexMatchStatement(s.RegexMatchStatement)
+		if m["regex_match_statement"], err = autoFlatten(s.RegexMatchStatement, regexMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.RegexPatternSetReferenceStatement != nil {
-		m["regex_pattern_set_reference_statement"] = flattenRegexPatternSetReferenceStatement(s.RegexPatternSetReferenceStatement)
+		if m["regex_pattern_set_reference_statement"], err = autoFlatten(s.RegexPatternSetReferenceStatement, regexPatternSetReferenceStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.RuleGroupReferenceStatement != nil {
@@ -1761,35 +1198,57 @@ func flattenWebACLStatement(s *wafv2.Statement) map[string]interface{} {
 	}
 
 	if s.SizeConstraintStatement != nil {
-		m["size_constraint_statement"] = flattenSizeConstraintStatement(s.SizeConstraintStatement)
+		if m["size_constraint_statement"], err = autoFlatten(s.SizeConstraintStatement, sizeConstraintSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.SqliMatchStatement != nil {
-		m["sqli_match_statement"] = flattenSQLiMatchStatement(s.SqliMatchStatement)
+		if m["sqli_match_statement"], err = autoFlatten(s.SqliMatchStatement, sqliMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
 	if s.XssMatchStatement != nil {
-		m["xss_match_statement"] = flattenXSSMatchStatement(s.XssMatchStatement)
+		if m["xss_match_statement"], err = autoFlatten(s.XssMatchStatement, xssMatchStatementSchema()); err != nil {
+			return nil, err
+		}
 	}
 
-	return m
+	return m, nil
 }
 
-func flattenWebACLRules(r []*wafv2.Rule) interface{} {
+func flattenWebACLRules(r []*wafv2.Rule) (interface{}, error) {
 	out := make([]map[string]interface{}, len(r))
 	for i, rule := range r {
 		m := make(map[string]interface{})
-		m["action"] = flattenRuleAction(rule.Action)
+
+		var err error
+
+		if m["action"], err = flattenRuleAction(rule.Action); err != nil {
+			return nil, err
+		}
+
 		m["override_action"] = flattenOverrideAction(rule.OverrideAction)
 		m["name"] = aws.StringValue(rule.Name)
 		m["priority"] = int(aws.Int64Value(rule.Priority))
-		m["rule_label"] = flattenRuleLabels(rule.RuleLabels)
-		m["statement"] = flattenWebACLRootStatement(rule.Statement)
-		m["visibility_config"] = flattenVisibilityConfig(rule.VisibilityConfig)
+
+		if m["rule_label"], err = flattenRuleLabels(rule.RuleLabels); err != nil {
+			return nil, err
+		}
+
+		if m["statement"], err = flattenWebACLRootStatement(rule.Statement); err != nil {
+			return nil, err
+		}
+
+		if m["visibility_config"], err = flattenVisibilityConfig(rule.VisibilityConfig); err != nil {
+			return nil, err
+		}
+
 		out[i] = m
 	}
 
-	return out
+	return out, nil
 }
 
 func flattenOverrideAction(a *wafv2.OverrideAction) interface{} {
@@ -1810,27 +1269,33 @@ func flattenOverrideAction(a *wafv2.OverrideAction) interface{} {
 	return []interface{}{m}
 }
 
-func flattenDefaultAction(a *wafv2.DefaultAction) interface{} {
+func flattenDefaultAction(a *wafv2.DefaultAction) (interface{}, error) {
 	if a == nil {
-		return []interface{}{}
+		return []interface{}{}, nil
 	}
 
 	m := map[string]interface{}{}
 
+	var err error
+
 	if a.Allow != nil {
-		m["allow"] = flattenAllow(a.Allow)
+		if m["allow"], err = flattenAllow(a.Allow); err != nil {
+			return nil, err
+		}
 	}
 
 	if a.Block != nil {
-		m["block"] = flattenBlock(a.Block)
+		if m["block"], err = flattenBlock(a.Block); err != nil {
+			return nil, err
+		}
 	}
 
-	return []interface{}{m}
+	return []interface{}{m}, nil
 }
 
-func flattenManagedRuleGroupStatement(apiObject *wafv2.ManagedRuleGroupStatement) interface{} {
+func flattenManagedRuleGroupStatement(apiObject *wafv2.ManagedRuleGroupStatement) (interface{}, error) {
 	if apiObject == nil {
-		return []interface{}{}
+		return []interface{}{}, nil
 	}
 
 	tfMap := map[string]interface{}{}
@@ -1844,7 +1309,13 @@ func flattenManagedRuleGroupStatement(apiObject *wafv2.ManagedRuleGroupStatement
 	}
 
 	if apiObject.ScopeDownStatement != nil {
-		tfMap["scope_down_statement"] = []interface{}{flattenStatement(apiObject.ScopeDownStatement)}
+		statement, err := flattenStatement(apiObject.ScopeDownStatement)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap["scope_down_statement"] = []interface{}{statement}
 	}
 
 	if apiObject.VendorName != nil {
@@ -1855,12 +1326,12 @@ func flattenManagedRuleGroupStatement(apiObject *wafv2.ManagedRuleGroupStatement
 		tfMap["version"] = aws.StringValue(apiObject.Version)
 	}
 
-	return []interface{}{tfMap}
+	return []interface{}{tfMap}, nil
 }
 
-func flattenRateBasedStatement(apiObject *wafv2.RateBasedStatement) interface{} {
+func flattenRateBasedStatement(apiObject *wafv2.RateBasedStatement) (interface{}, error) {
 	if apiObject == nil {
-		return []interface{}{}
+		return []interface{}{}, nil
 	}
 
 	tfMap := map[string]interface{}{}
@@ -1869,8 +1340,22 @@ func flattenRateBasedStatement(apiObject *wafv2.RateBasedStatement) interface{}
 		tfMap["aggregate_key_type"] = aws.StringValue(apiObject.AggregateKeyType)
 	}
 
+	var err error
+
+	if apiObject.CustomKeys != nil {
+		if tfMap["custom_key"], err = autoFlatten(apiObject.CustomKeys, rateBasedStatementCustomKeySchema()); err != nil {
+			return nil, err
+		}
+	}
+
+	if apiObject.EvaluationWindowSec != nil {
+		tfMap["evaluation_window_sec"] = int(aws.Int64Value(apiObject.EvaluationWindowSec))
+	}
+
 	if apiObject.ForwardedIPConfig != nil {
-		tfMap["forwarded_ip_config"] = flattenForwardedIPConfig(apiObject.ForwardedIPConfig)
+		if tfMap["forwarded_ip_config"], err = flattenForwardedIPConfig(apiObject.ForwardedIPConfig); err != nil {
+			return nil, err
+		}
 	}
 
 	if apiObject.Limit != nil {
@@ -1878,10 +1363,16 @@ func flattenRateBasedStatement(apiObject *wafv2.RateBasedStatement) interface{}
 	}
 
 	if apiObject.ScopeDownStatement != nil {
-		tfMap["scope_down_statement"] = []interface{}{flattenStatement(apiObject.ScopeDownStatement)}
+		statement, err := flattenStatement(apiObject.ScopeDownStatement)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap["scope_down_statement"] = []interface{}{statement}
 	}
 
-	return []interface{}{tfMap}
+	return []interface{}{tfMap}, nil
 }
 
 func flattenRuleGroupReferenceStatement(r *wafv2.RuleGroupReferenceStatement) interface{} {
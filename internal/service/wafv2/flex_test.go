@@ -0,0 +1,203 @@
+package wafv2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandVisibilityConfig(t *testing.T) {
+	tfList := []interface{}{map[string]interface{}{
+		"cloudwatch_metrics_enabled": true,
+		"metric_name":                "friendly-metric-name",
+		"sampled_requests_enabled":   false,
+	}}
+	expected := &wafv2.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(true),
+		MetricName:               aws.String("friendly-metric-name"),
+		SampledRequestsEnabled:   aws.Bool(false),
+	}
+
+	if got, err := expandVisibilityConfig(tfList); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+
+	if got, err := expandVisibilityConfig([]interface{}{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got != nil {
+		t.Errorf("Got:\n\n%#v\n\nExpected nil", got)
+	}
+
+	if _, err := expandVisibilityConfig([]interface{}{"friendly-metric-name"}); err == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestFlattenVisibilityConfig(t *testing.T) {
+	apiObject := &wafv2.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(true),
+		MetricName:               aws.String("friendly-metric-name"),
+		SampledRequestsEnabled:   aws.Bool(false),
+	}
+	expected := []interface{}{map[string]interface{}{
+		"cloudwatch_metrics_enabled": true,
+		"metric_name":                "friendly-metric-name",
+		"sampled_requests_enabled":   false,
+	}}
+
+	if got, err := flattenVisibilityConfig(apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+
+	if got, err := flattenVisibilityConfig(nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, []interface{}{}) {
+		t.Errorf("Got:\n\n%#v\n\nExpected empty list", got)
+	}
+}
+
+func TestExpandBlockAction(t *testing.T) {
+	s := blockConfigSchema().Elem.(*schema.Resource).Schema["custom_response"].Elem.(*schema.Resource).Schema["response_header"]
+	tfList := []interface{}{map[string]interface{}{
+		"custom_response": []interface{}{map[string]interface{}{
+			"custom_response_body_key": "",
+			"response_code":            403,
+			"response_header": schema.NewSet(schema.HashResource(s.Elem.(*schema.Resource)), []interface{}{
+				map[string]interface{}{"name": "x-blocked", "value": "yes"},
+			}),
+		}},
+	}}
+	expected := &wafv2.BlockAction{
+		CustomResponse: &wafv2.CustomResponse{
+			ResponseCode: aws.Int64(403),
+			ResponseHeaders: []*wafv2.CustomHTTPHeader{
+				{Name: aws.String("x-blocked"), Value: aws.String("yes")},
+			},
+		},
+	}
+
+	if got, err := expandBlockAction(tfList); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+
+	if got, err := expandBlockAction([]interface{}{nil}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, &wafv2.BlockAction{}) {
+		t.Errorf("Got:\n\n%#v\n\nExpected empty action", got)
+	}
+}
+
+func TestFlattenTextTransformations(t *testing.T) {
+	apiObjects := []*wafv2.TextTransformation{
+		{Priority: aws.Int64(0), Type: aws.String(wafv2.TextTransformationTypeNone)},
+		{Priority: aws.Int64(1), Type: aws.String(wafv2.TextTransformationTypeLowercase)},
+	}
+	expected := []interface{}{
+		map[string]interface{}{"priority": 0, "type": wafv2.TextTransformationTypeNone},
+		map[string]interface{}{"priority": 1, "type": wafv2.TextTransformationTypeLowercase},
+	}
+
+	if got, err := flattenTextTransformations(apiObjects); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+
+	if got, err := expandTextTransformations(expected); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, apiObjects) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, apiObjects)
+	}
+}
+
+func TestByteMatchStatementFieldToMatch(t *testing.T) {
+	s := byteMatchStatementSchema()
+	tt := s.Elem.(*schema.Resource).Schema["text_transformation"]
+	tfList := []interface{}{map[string]interface{}{
+		"field_to_match": []interface{}{map[string]interface{}{
+			"all_query_arguments": []interface{}{},
+			"body":                []interface{}{nil},
+			"json_body": []interface{}{map[string]interface{}{
+				"invalid_fallback_behavior": "",
+				"match_pattern": []interface{}{map[string]interface{}{
+					"all":            []interface{}{},
+					"included_paths": []interface{}{"/dogs/0/name", "/cats"},
+				}},
+				"match_scope":       wafv2.JsonMatchScopeValue,
+				"oversize_handling": wafv2.OversizeHandlingContinue,
+			}},
+			"ja3_fingerprint": []interface{}{map[string]interface{}{
+				"fallback_behavior": wafv2.FallbackBehaviorMatch,
+			}},
+		}},
+		"positional_constraint": wafv2.PositionalConstraintContains,
+		"search_string":         "word",
+		"text_transformation": schema.NewSet(schema.HashResource(tt.Elem.(*schema.Resource)), []interface{}{
+			map[string]interface{}{"priority": 2, "type": wafv2.TextTransformationTypeNone},
+		}),
+	}}
+	apiObject := &wafv2.ByteMatchStatement{
+		FieldToMatch: &wafv2.FieldToMatch{
+			Body: &wafv2.Body{},
+			JA3Fingerprint: &wafv2.JA3Fingerprint{
+				FallbackBehavior: aws.String(wafv2.FallbackBehaviorMatch),
+			},
+			JsonBody: &wafv2.JsonBody{
+				MatchPattern: &wafv2.JsonMatchPattern{
+					IncludedPaths: aws.StringSlice([]string{"/dogs/0/name", "/cats"}),
+				},
+				MatchScope:       aws.String(wafv2.JsonMatchScopeValue),
+				OversizeHandling: aws.String(wafv2.OversizeHandlingContinue),
+			},
+		},
+		PositionalConstraint: aws.String(wafv2.PositionalConstraintContains),
+		SearchString:         []byte("word"),
+		TextTransformations: []*wafv2.TextTransformation{
+			{Priority: aws.Int64(2), Type: aws.String(wafv2.TextTransformationTypeNone)},
+		},
+	}
+
+	var got *wafv2.ByteMatchStatement
+
+	if err := autoExpand(tfList, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, apiObject) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, apiObject)
+	}
+
+	expected := []interface{}{map[string]interface{}{
+		"field_to_match": []interface{}{map[string]interface{}{
+			"body": []interface{}{map[string]interface{}{}},
+			"json_body": []interface{}{map[string]interface{}{
+				"match_pattern": []interface{}{map[string]interface{}{
+					"included_paths": []interface{}{"/dogs/0/name", "/cats"},
+				}},
+				"match_scope":       wafv2.JsonMatchScopeValue,
+				"oversize_handling": wafv2.OversizeHandlingContinue,
+			}},
+			"ja3_fingerprint": []interface{}{map[string]interface{}{
+				"fallback_behavior": wafv2.FallbackBehaviorMatch,
+			}},
+		}},
+		"positional_constraint": wafv2.PositionalConstraintContains,
+		"search_string":         "word",
+		"text_transformation": []interface{}{
+			map[string]interface{}{"priority": 2, "type": wafv2.TextTransformationTypeNone},
+		},
+	}}
+
+	if got, err := autoFlatten(apiObject, s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
@@ -11,11 +11,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/remotesource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -50,10 +52,11 @@ func ResourceIPSet() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"addresses": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 10000,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      ipSetAddressesMaxItems,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"addresses_source"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					o, n := d.GetChange("addresses")
 					oldAddresses := o.(*schema.Set).List()
@@ -76,6 +79,15 @@ func ResourceIPSet() *schema.Resource {
 					return false
 				},
 			},
+			"addresses_source": func() *schema.Schema {
+				sch := listSourceSchema("addresses_source")
+				sch.ConflictsWith = []string{"addresses"}
+				return sch
+			}(),
+			"addresses_source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -111,7 +123,12 @@ func ResourceIPSet() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			listSourceCustomizeDiff("addresses_source", "addresses_source_hash", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}, source *remotesource.Source) ([]string, error) {
+				return readIPSetAddresses(ctx, meta, source, diff.Get("ip_address_version").(string))
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -132,6 +149,20 @@ func resourceIPSetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.Addresses = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if source := expandListSource(d.Get("addresses_source").([]interface{})); source != nil {
+		addresses, err := readIPSetAddresses(ctx, meta, source, d.Get("ip_address_version").(string))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := checkListSourceHash(d, "addresses_source_hash", source, addresses); err != nil {
+			return diag.Errorf("creating WAFv2 IPSet (%s): %s", name, err)
+		}
+
+		input.Addresses = aws.StringSlice(addresses)
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -170,7 +201,20 @@ func resourceIPSetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	ipSet := output.IPSet
-	d.Set("addresses", aws.StringValueSlice(ipSet.Addresses))
+	// Addresses read from an external source are tracked by the hash of the addresses in AWS,
+	// which is compared at plan time with the hash of the addresses in the source.
+	if len(d.Get("addresses_source").([]interface{})) == 0 {
+		d.Set("addresses", aws.StringValueSlice(ipSet.Addresses))
+		d.Set("addresses_source_hash", nil)
+	} else {
+		addresses, err := parseIPSetAddresses([]byte(strings.Join(aws.StringValueSlice(ipSet.Addresses), "\n")), "")
+
+		if err != nil {
+			return diag.Errorf("reading WAFv2 IPSet (%s) addresses: %s", d.Id(), err)
+		}
+
+		d.Set("addresses_source_hash", remotesource.Hash(addresses))
+	}
 	arn := aws.StringValue(ipSet.ARN)
 	d.Set("arn", arn)
 	d.Set("description", ipSet.Description)
@@ -214,6 +258,20 @@ func resourceIPSetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.Addresses = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
+		if source := expandListSource(d.Get("addresses_source").([]interface{})); source != nil {
+			addresses, err := readIPSetAddresses(ctx, meta, source, d.Get("ip_address_version").(string))
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err := checkListSourceHash(d, "addresses_source_hash", source, addresses); err != nil {
+				return diag.Errorf("updating WAFv2 IPSet (%s): %s", d.Id(), err)
+			}
+
+			input.Addresses = aws.StringSlice(addresses)
+		}
+
 		if v, ok := d.GetOk("description"); ok {
 			input.Description = aws.String(v.(string))
 		}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -278,6 +279,67 @@ func TestAccWAFV2IPSet_large(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2IPSet_addressesSource(t *testing.T) {
+	var v wafv2.IPSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_ip_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPSetConfig_addressesSource(rName, "v1", "# feed\n10.0.0.0/8\n192.0.2.1\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(resourceName, &v),
+					testAccCheckIPSetAddressesCount(&v, 2),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "addresses_source_hash"),
+				),
+			},
+			{
+				Config: testAccIPSetConfig_addressesSource(rName, "v2", "10.0.0.0/8\n192.0.2.1\n198.51.100.0/24\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(resourceName, &v),
+					testAccCheckIPSetAddressesCount(&v, 3),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+
+					output, err := tfwafv2.FindIPSetByThreePartKey(context.Background(), conn, aws.StringValue(v.Id), aws.StringValue(v.Name), wafv2.ScopeRegional)
+
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					_, err = conn.UpdateIPSet(&wafv2.UpdateIPSetInput{
+						Addresses: aws.StringSlice([]string{"10.0.0.0/8"}),
+						Id:        v.Id,
+						LockToken: output.LockToken,
+						Name:      v.Name,
+						Scope:     aws.String(wafv2.ScopeRegional),
+					})
+
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccIPSetConfig_addressesSource(rName, "v2", "10.0.0.0/8\n192.0.2.1\n198.51.100.0/24\n"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccIPSetConfig_addressesSource(rName, "v3", "10.0.0.0/8\n2001:db8::/32\n"),
+				ExpectError: regexp.MustCompile(`is not an IPv4 address`),
+			},
+		},
+	})
+}
+
 func testAccCheckIPSetDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafv2_ip_set" {
@@ -327,6 +389,16 @@ func testAccCheckIPSetExists(n string, v *wafv2.IPSet) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckIPSetAddressesCount(v *wafv2.IPSet, n int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(v.Addresses); got != n {
+			return fmt.Errorf("expected %d addresses, got %d", n, got)
+		}
+
+		return nil
+	}
+}
+
 func testAccIPSetConfig_basic(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_ip_set" "ip_set" {
@@ -438,6 +510,32 @@ resource "aws_wafv2_ip_set" "ip_set" {
 `, name)
 }
 
+func testAccIPSetConfig_addressesSource(rName, version, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "addresses-%[2]s.txt"
+  content = "%[3]s"
+}
+
+resource "aws_wafv2_ip_set" "test" {
+  name               = %[1]q
+  scope              = "REGIONAL"
+  ip_address_version = "IPV4"
+
+  addresses_source {
+    s3_bucket = aws_s3_bucket.test.bucket
+    # Not known until the object is created.
+    s3_key = aws_s3_object.test.id
+  }
+}
+`, rName, version, content)
+}
+
 func testAccIPSetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
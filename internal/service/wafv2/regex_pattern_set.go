@@ -11,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/remotesource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -68,9 +70,10 @@ func ResourceRegexPatternSet() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 			"regular_expression": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 10,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      regexPatternSetRegularExpressionsMaxItems,
+				ConflictsWith: []string{"regular_expression_source"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"regex_string": {
@@ -84,6 +87,15 @@ func ResourceRegexPatternSet() *schema.Resource {
 					},
 				},
 			},
+			"regular_expression_source": func() *schema.Schema {
+				sch := listSourceSchema("regular_expression_source")
+				sch.ConflictsWith = []string{"regular_expression"}
+				return sch
+			}(),
+			"regular_expression_source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"scope": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -94,7 +106,12 @@ func ResourceRegexPatternSet() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			listSourceCustomizeDiff("regular_expression_source", "regular_expression_source_hash", func(ctx context.Context, _ *schema.ResourceDiff, meta interface{}, source *remotesource.Source) ([]string, error) {
+				return readRegexPatternSetRegularExpressions(ctx, meta, source)
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -118,6 +135,20 @@ func resourceRegexPatternSetCreate(ctx context.Context, d *schema.ResourceData,
 		input.RegularExpressionList = expandRegexPatternSet(v.(*schema.Set).List())
 	}
 
+	if source := expandListSource(d.Get("regular_expression_source").([]interface{})); source != nil {
+		regexes, err := readRegexPatternSetRegularExpressions(ctx, meta, source)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := checkListSourceHash(d, "regular_expression_source_hash", source, regexes); err != nil {
+			return diag.Errorf("creating WAFv2 RegexPatternSet (%s): %s", name, err)
+		}
+
+		input.RegularExpressionList = expandRegexPatternSetRegexStrings(regexes)
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -157,8 +188,20 @@ func resourceRegexPatternSetRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("description", regexPatternSet.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set("name", regexPatternSet.Name)
-	if err := d.Set("regular_expression", flattenRegexPatternSet(regexPatternSet.RegularExpressionList)); err != nil {
-		return diag.Errorf("setting regular_expression: %s", err)
+	// Regular expressions read from an external source are tracked by the hash of the regular expressions in AWS,
+	// which is compared at plan time with the hash of the regular expressions in the source.
+	if len(d.Get("regular_expression_source").([]interface{})) == 0 {
+		if err := d.Set("regular_expression", flattenRegexPatternSet(regexPatternSet.RegularExpressionList)); err != nil {
+			return diag.Errorf("setting regular_expression: %s", err)
+		}
+		d.Set("regular_expression_source_hash", nil)
+	} else {
+		var regexes []string
+		for _, v := range regexPatternSet.RegularExpressionList {
+			regexes = append(regexes, aws.StringValue(v.RegexString))
+		}
+
+		d.Set("regular_expression_source_hash", remotesource.Hash(regexes))
 	}
 
 	tags, err := ListTagsWithContext(ctx, conn, arn)
@@ -201,6 +244,20 @@ func resourceRegexPatternSetUpdate(ctx context.Context, d *schema.ResourceData,
 			input.RegularExpressionList = expandRegexPatternSet(v.(*schema.Set).List())
 		}
 
+		if source := expandListSource(d.Get("regular_expression_source").([]interface{})); source != nil {
+			regexes, err := readRegexPatternSetRegularExpressions(ctx, meta, source)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err := checkListSourceHash(d, "regular_expression_source_hash", source, regexes); err != nil {
+				return diag.Errorf("updating WAFv2 RegexPatternSet (%s): %s", d.Id(), err)
+			}
+
+			input.RegularExpressionList = expandRegexPatternSetRegexStrings(regexes)
+		}
+
 		log.Printf("[INFO] Updating WAFv2 RegexPatternSet: %s", input)
 		_, err := conn.UpdateRegexPatternSetWithContext(ctx, input)
 
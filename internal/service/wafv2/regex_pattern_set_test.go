@@ -212,6 +212,49 @@ func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2RegexPatternSet_regularExpressionSource(t *testing.T) {
+	var v wafv2.RegexPatternSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_regex_pattern_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegexPatternSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegexPatternSetConfig_regularExpressionSource(rName, "v1", "# bad bots\none\ntwo\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegexPatternSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regular_expression.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "regular_expression_source_hash"),
+					func(s *terraform.State) error {
+						if got := len(v.RegularExpressionList); got != 2 {
+							return fmt.Errorf("expected 2 regular expressions, got %d", got)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccRegexPatternSetConfig_regularExpressionSource(rName, "v2", "one\ntwo\nthree\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegexPatternSetExists(resourceName, &v),
+					func(s *terraform.State) error {
+						if got := len(v.RegularExpressionList); got != 3 {
+							return fmt.Errorf("expected 3 regular expressions, got %d", got)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRegexPatternSetDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafv2_regex_pattern_set" {
@@ -351,6 +394,31 @@ resource "aws_wafv2_regex_pattern_set" "test" {
 `, name, tag1Key, tag1Value, tag2Key, tag2Value)
 }
 
+func testAccRegexPatternSetConfig_regularExpressionSource(rName, version, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "regexes-%[2]s.txt"
+  content = "%[3]s"
+}
+
+resource "aws_wafv2_regex_pattern_set" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  regular_expression_source {
+    s3_bucket = aws_s3_bucket.test.bucket
+    # Not known until the object is created.
+    s3_key = aws_s3_object.test.id
+  }
+}
+`, rName, version, content)
+}
+
 func testAccRegexPatternSetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
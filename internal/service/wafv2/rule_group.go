@@ -134,11 +134,19 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	name := d.Get("name").(string)
 	input := &wafv2.CreateRuleGroupInput{
-		Capacity:         aws.Int64(int64(d.Get("capacity").(int))),
-		Name:             aws.String(name),
-		Rules:            expandRules(d.Get("rule").(*schema.Set).List()),
-		Scope:            aws.String(d.Get("scope").(string)),
-		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+		Capacity: aws.Int64(int64(d.Get("capacity").(int))),
+		Name:     aws.String(name),
+		Scope:    aws.String(d.Get("scope").(string)),
+	}
+
+	var err error
+
+	if input.Rules, err = expandRules(d.Get("rule").(*schema.Set).List()); err != nil {
+		return diag.Errorf("creating WAFv2 RuleGroup (%s): %s", name, err)
+	}
+
+	if input.VisibilityConfig, err = expandVisibilityConfig(d.Get("visibility_config").([]interface{})); err != nil {
+		return diag.Errorf("creating WAFv2 RuleGroup (%s): %s", name, err)
 	}
 
 	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
@@ -196,10 +204,22 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("description", ruleGroup.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set("name", ruleGroup.Name)
-	if err := d.Set("rule", flattenRules(ruleGroup.Rules)); err != nil {
+	rule, err := flattenRules(ruleGroup.Rules)
+
+	if err != nil {
+		return diag.Errorf("setting rule: %s", err)
+	}
+
+	if err := d.Set("rule", rule); err != nil {
 		return diag.Errorf("setting rule: %s", err)
 	}
-	if err := d.Set("visibility_config", flattenVisibilityConfig(ruleGroup.VisibilityConfig)); err != nil {
+	visibilityConfig, err := flattenVisibilityConfig(ruleGroup.VisibilityConfig)
+
+	if err != nil {
+		return diag.Errorf("setting visibility_config: %s", err)
+	}
+
+	if err := d.Set("visibility_config", visibilityConfig); err != nil {
 		return diag.Errorf("setting visibility_config: %s", err)
 	}
 
@@ -228,12 +248,20 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &wafv2.UpdateRuleGroupInput{
-			Id:               aws.String(d.Id()),
-			LockToken:        aws.String(d.Get("lock_token").(string)),
-			Name:             aws.String(d.Get("name").(string)),
-			Rules:            expandRules(d.Get("rule").(*schema.Set).List()),
-			Scope:            aws.String(d.Get("scope").(string)),
-			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+			Id:        aws.String(d.Id()),
+			LockToken: aws.String(d.Get("lock_token").(string)),
+			Name:      aws.String(d.Get("name").(string)),
+			Scope:     aws.String(d.Get("scope").(string)),
+		}
+
+		var err error
+
+		if input.Rules, err = expandRules(d.Get("rule").(*schema.Set).List()); err != nil {
+			return diag.Errorf("updating WAFv2 RuleGroup (%s): %s", d.Id(), err)
+		}
+
+		if input.VisibilityConfig, err = expandVisibilityConfig(d.Get("visibility_config").([]interface{})); err != nil {
+			return diag.Errorf("updating WAFv2 RuleGroup (%s): %s", d.Id(), err)
 		}
 
 		if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
@@ -245,7 +273,7 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 
 		log.Printf("[INFO] Updating WAFv2 RuleGroup: %s", input)
-		_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, ruleGroupUpdateTimeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, ruleGroupUpdateTimeout, func() (interface{}, error) {
 			return conn.UpdateRuleGroupWithContext(ctx, input)
 		}, wafv2.ErrCodeWAFUnavailableEntityException)
 
@@ -350,6 +350,7 @@ func fieldToMatchBaseSchema() *schema.Resource {
 			"body":                emptySchema(),
 			"cookies":             cookiesSchema(),
 			"headers":             headersSchema(),
+			"ja3_fingerprint":     fingerprintSchema(),
 			"json_body":           jsonBodySchema(),
 			"method":              emptySchema(),
 			"query_string":        emptySchema(),
@@ -405,6 +406,24 @@ func fieldToMatchSchema() *schema.Schema {
 	}
 }
 
+// fingerprintSchema is the schema for the JA3 TLS client fingerprint.
+func fingerprintSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fallback_behavior": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.FallbackBehavior_Values(), false),
+				},
+			},
+		},
+	}
+}
+
 func jsonBodySchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -873,6 +892,13 @@ func rateBasedStatementSchema(level int) *schema.Schema {
 					Default:      wafv2.RateBasedStatementAggregateKeyTypeIp,
 					ValidateFunc: validation.StringInSlice(wafv2.RateBasedStatementAggregateKeyType_Values(), false),
 				},
+				"custom_key": rateBasedStatementCustomKeySchema(),
+				"evaluation_window_sec": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      300,
+					ValidateFunc: validation.IntInSlice([]int{60, 120, 300, 600}),
+				},
 				"forwarded_ip_config": forwardedIPConfigSchema(),
 				"limit": {
 					Type:         schema.TypeInt,
@@ -885,6 +911,80 @@ func rateBasedStatementSchema(level int) *schema.Schema {
 	}
 }
 
+func rateBasedStatementCustomKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cookie":       rateLimitNamedKeySchema(),
+				"forwarded_ip": emptySchema(),
+				"header":       rateLimitNamedKeySchema(),
+				"http_method":  emptySchema(),
+				"ip":           emptySchema(),
+				"label_namespace": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"namespace": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 1024),
+									validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_\-:]+:$`), "must contain only alphanumeric, underscore, hyphen, and colon characters and end with a colon"),
+								),
+							},
+						},
+					},
+				},
+				"query_argument": rateLimitNamedKeySchema(),
+				"query_string": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"text_transformation": textTransformationSchema(),
+						},
+					},
+				},
+				"uri_path": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"text_transformation": textTransformationSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// rateLimitNamedKeySchema is the schema for rate-based statement custom keys that aggregate on a named request component.
+func rateLimitNamedKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+				"text_transformation": textTransformationSchema(),
+			},
+		},
+	}
+}
+
 func scopeDownStatementSchema(level int) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
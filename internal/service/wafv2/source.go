@@ -0,0 +1,259 @@
+package wafv2
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/remotesource"
+)
+
+const (
+	// ipSetAddressesMaxItems is the maximum number of addresses in an IP set.
+	ipSetAddressesMaxItems = 10000
+	// regexPatternSetRegularExpressionsMaxItems is the maximum number of regular expressions in a regex pattern set.
+	regexPatternSetRegularExpressionsMaxItems = 10
+)
+
+func listSourceSchema(attrName string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3_bucket": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{attrName + ".0.s3_bucket", attrName + ".0.url"},
+					RequiredWith: []string{attrName + ".0.s3_key"},
+				},
+				"s3_key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{attrName + ".0.s3_bucket"},
+				},
+				"s3_object_version": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{attrName + ".0.s3_bucket"},
+				},
+				"url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+			},
+		},
+	}
+}
+
+func expandListSource(tfList []interface{}) *remotesource.Source {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &remotesource.Source{
+		S3Bucket:  tfMap["s3_bucket"].(string),
+		S3Key:     tfMap["s3_key"].(string),
+		S3Version: tfMap["s3_object_version"].(string),
+		URL:       tfMap["url"].(string),
+	}
+}
+
+// listSourceCustomizeDiff returns a CustomizeDiffFunc that reads the source configured in sourceAttrName
+// at plan time and records the hash of its contents in hashAttrName, so that changes to the contents
+// of the source, or to the values in AWS, are shown as a change to the hash.
+func listSourceCustomizeDiff(sourceAttrName, hashAttrName string, read func(context.Context, *schema.ResourceDiff, interface{}, *remotesource.Source) ([]string, error)) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		source := expandListSource(diff.Get(sourceAttrName).([]interface{}))
+
+		if source == nil {
+			if diff.Get(hashAttrName).(string) != "" {
+				return diff.SetNew(hashAttrName, "")
+			}
+
+			return nil
+		}
+
+		// The source may be created in the same apply.
+		if !diff.NewValueKnown(sourceAttrName) {
+			return diff.SetNewComputed(hashAttrName)
+		}
+
+		values, err := read(ctx, diff, meta, source)
+
+		if err != nil {
+			return err
+		}
+
+		if hash := remotesource.Hash(values); hash != diff.Get(hashAttrName).(string) {
+			return diff.SetNew(hashAttrName, hash)
+		}
+
+		return nil
+	}
+}
+
+// checkListSourceHash verifies that the values read from a source at apply time match those read at plan time.
+func checkListSourceHash(d *schema.ResourceData, hashAttrName string, source *remotesource.Source, values []string) error {
+	return remotesource.CheckHash(source, d.Get(hashAttrName).(string), remotesource.Hash(values))
+}
+
+// readIPSetAddresses returns the normalized addresses listed in the source.
+func readIPSetAddresses(ctx context.Context, meta interface{}, source *remotesource.Source, ipAddressVersion string) ([]string, error) {
+	body, err := source.Read(ctx, meta.(*conns.AWSClient).S3Conn)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading WAFv2 IPSet addresses source (%s): %w", source, err)
+	}
+
+	addresses, err := parseIPSetAddresses(body, ipAddressVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing WAFv2 IPSet addresses source (%s): %w", source, err)
+	}
+
+	if n := len(addresses); n > ipSetAddressesMaxItems {
+		return nil, fmt.Errorf("WAFv2 IPSet addresses source (%s) contains %d addresses, the maximum is %d", source, n, ipSetAddressesMaxItems)
+	}
+
+	return addresses, nil
+}
+
+// parseIPSetAddresses parses a list of CIDR blocks, one per line.
+// Blank lines and anything following a '#' are ignored.
+// Bare IP addresses are converted to single-host CIDR blocks.
+// If ipAddressVersion is not empty, addresses of the other version are rejected.
+// The result is de-duplicated and sorted.
+func parseIPSetAddresses(body []byte, ipAddressVersion string) ([]string, error) {
+	seen := make(map[string]struct{})
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		line = strings.TrimSpace(line)
+
+		if line == "" {
+			continue
+		}
+
+		if !strings.Contains(line, "/") {
+			ip := net.ParseIP(line)
+
+			if ip == nil {
+				return nil, fmt.Errorf("line %d: invalid IP address: %q", n, line)
+			}
+
+			if ip.To4() != nil {
+				line += "/32"
+			} else {
+				line += "/128"
+			}
+		}
+
+		_, ipNet, err := net.ParseCIDR(line)
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		isIPv4 := ipNet.IP.To4() != nil
+
+		switch {
+		case ipAddressVersion == wafv2.IPAddressVersionIpv4 && !isIPv4:
+			return nil, fmt.Errorf("line %d: %s is not an IPv4 address", n, line)
+		case ipAddressVersion == wafv2.IPAddressVersionIpv6 && isIPv4:
+			return nil, fmt.Errorf("line %d: %s is not an IPv6 address", n, line)
+		}
+
+		seen[ipNet.String()] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sortedKeys(seen), nil
+}
+
+// readRegexPatternSetRegularExpressions returns the regular expressions listed in the source.
+func readRegexPatternSetRegularExpressions(ctx context.Context, meta interface{}, source *remotesource.Source) ([]string, error) {
+	body, err := source.Read(ctx, meta.(*conns.AWSClient).S3Conn)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading WAFv2 RegexPatternSet regular expressions source (%s): %w", source, err)
+	}
+
+	regexes, err := parseRegexPatternSetRegularExpressions(body)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing WAFv2 RegexPatternSet regular expressions source (%s): %w", source, err)
+	}
+
+	if n := len(regexes); n > regexPatternSetRegularExpressionsMaxItems {
+		return nil, fmt.Errorf("WAFv2 RegexPatternSet regular expressions source (%s) contains %d regular expressions, the maximum is %d", source, n, regexPatternSetRegularExpressionsMaxItems)
+	}
+
+	return regexes, nil
+}
+
+// parseRegexPatternSetRegularExpressions parses a list of regular expressions, one per line.
+// Blank lines and lines starting with '#' are ignored; a '#' elsewhere is part of the expression.
+// The result is de-duplicated and sorted.
+func parseRegexPatternSetRegularExpressions(body []byte) ([]string, error) {
+	seen := make(map[string]struct{})
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if len(line) > 200 {
+			return nil, fmt.Errorf("line %d: regular expression is longer than 200 characters", n)
+		}
+
+		if _, err := regexp.Compile(line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		seen[line] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sortedKeys(seen), nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,136 @@
+package wafv2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIPSetAddresses(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Body             string
+		IPAddressVersion string
+		Expected         []string
+		ExpectError      bool
+	}{
+		{
+			Name:     "empty",
+			Body:     "",
+			Expected: []string{},
+		},
+		{
+			Name: "comments and blank lines",
+			Body: `# threat feed
+10.0.0.0/8
+
+192.0.2.1 # single host
+2001:db8::/32
+`,
+			Expected: []string{"10.0.0.0/8", "192.0.2.1/32", "2001:db8::/32"},
+		},
+		{
+			Name:     "duplicates and non-canonical",
+			Body:     "10.1.2.3/8\n10.0.0.0/8\n  192.0.2.1  \n",
+			Expected: []string{"10.0.0.0/8", "192.0.2.1/32"},
+		},
+		{
+			Name:        "invalid",
+			Body:        "10.0.0.0/8\nnot-an-address\n",
+			ExpectError: true,
+		},
+		{
+			Name:             "IPv4 only",
+			Body:             "10.0.0.0/8\n192.0.2.1\n",
+			IPAddressVersion: "IPV4",
+			Expected:         []string{"10.0.0.0/8", "192.0.2.1/32"},
+		},
+		{
+			Name:             "IPv6 in IPv4 set",
+			Body:             "10.0.0.0/8\n2001:db8::/32\n",
+			IPAddressVersion: "IPV4",
+			ExpectError:      true,
+		},
+		{
+			Name:             "IPv4 in IPv6 set",
+			Body:             "2001:db8::1\n192.0.2.1\n",
+			IPAddressVersion: "IPV6",
+			ExpectError:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseIPSetAddresses([]byte(testCase.Body), testCase.IPAddressVersion)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectError && !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestParseRegexPatternSetRegularExpressions(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Body        string
+		Expected    []string
+		ExpectError bool
+	}{
+		{
+			Name:     "empty",
+			Body:     "",
+			Expected: []string{},
+		},
+		{
+			Name: "comments and blank lines",
+			Body: `# bad bots
+B[a@]dB[o0]t
+
+^/admin#section$
+`,
+			Expected: []string{"B[a@]dB[o0]t", "^/admin#section$"},
+		},
+		{
+			Name:     "duplicates",
+			Body:     "one\n  one  \ntwo\n",
+			Expected: []string{"one", "two"},
+		},
+		{
+			Name:        "invalid",
+			Body:        "one\n(unclosed\n",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseRegexPatternSetRegularExpressions([]byte(testCase.Body))
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectError && !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -155,11 +155,22 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	name := d.Get("name").(string)
 	input := &wafv2.CreateWebACLInput{
-		DefaultAction:    expandDefaultAction(d.Get("default_action").([]interface{})),
-		Name:             aws.String(name),
-		Rules:            expandWebACLRules(d.Get("rule").(*schema.Set).List()),
-		Scope:            aws.String(d.Get("scope").(string)),
-		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+		Name:  aws.String(name),
+		Scope: aws.String(d.Get("scope").(string)),
+	}
+
+	var err error
+
+	if input.DefaultAction, err = expandDefaultAction(d.Get("default_action").([]interface{})); err != nil {
+		return diag.Errorf("creating WAFv2 WebACL (%s): %s", name, err)
+	}
+
+	if input.Rules, err = expandWebACLRules(d.Get("rule").(*schema.Set).List()); err != nil {
+		return diag.Errorf("creating WAFv2 WebACL (%s): %s", name, err)
+	}
+
+	if input.VisibilityConfig, err = expandVisibilityConfig(d.Get("visibility_config").([]interface{})); err != nil {
+		return diag.Errorf("creating WAFv2 WebACL (%s): %s", name, err)
 	}
 
 	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
@@ -214,16 +225,34 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err := d.Set("custom_response_body", flattenCustomResponseBodies(webACL.CustomResponseBodies)); err != nil {
 		return diag.Errorf("setting custom_response_body: %s", err)
 	}
-	if err := d.Set("default_action", flattenDefaultAction(webACL.DefaultAction)); err != nil {
+	defaultAction, err := flattenDefaultAction(webACL.DefaultAction)
+
+	if err != nil {
+		return diag.Errorf("setting default_action: %s", err)
+	}
+
+	if err := d.Set("default_action", defaultAction); err != nil {
 		return diag.Errorf("setting default_action: %s", err)
 	}
 	d.Set("description", webACL.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set("name", webACL.Name)
-	if err := d.Set("rule", flattenWebACLRules(webACL.Rules)); err != nil {
+	rule, err := flattenWebACLRules(webACL.Rules)
+
+	if err != nil {
 		return diag.Errorf("setting rule: %s", err)
 	}
-	if err := d.Set("visibility_config", flattenVisibilityConfig(webACL.VisibilityConfig)); err != nil {
+
+	if err := d.Set("rule", rule); err != nil {
+		return diag.Errorf("setting rule: %s", err)
+	}
+	visibilityConfig, err := flattenVisibilityConfig(webACL.VisibilityConfig)
+
+	if err != nil {
+		return diag.Errorf("setting visibility_config: %s", err)
+	}
+
+	if err := d.Set("visibility_config", visibilityConfig); err != nil {
 		return diag.Errorf("setting visibility_config: %s", err)
 	}
 
@@ -252,13 +281,24 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &wafv2.UpdateWebACLInput{
-			DefaultAction:    expandDefaultAction(d.Get("default_action").([]interface{})),
-			Id:               aws.String(d.Id()),
-			LockToken:        aws.String(d.Get("lock_token").(string)),
-			Name:             aws.String(d.Get("name").(string)),
-			Rules:            expandWebACLRules(d.Get("rule").(*schema.Set).List()),
-			Scope:            aws.String(d.Get("scope").(string)),
-			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+			Id:        aws.String(d.Id()),
+			LockToken: aws.String(d.Get("lock_token").(string)),
+			Name:      aws.String(d.Get("name").(string)),
+			Scope:     aws.String(d.Get("scope").(string)),
+		}
+
+		var err error
+
+		if input.DefaultAction, err = expandDefaultAction(d.Get("default_action").([]interface{})); err != nil {
+			return diag.Errorf("updating WAFv2 WebACL (%s): %s", d.Id(), err)
+		}
+
+		if input.Rules, err = expandWebACLRules(d.Get("rule").(*schema.Set).List()); err != nil {
+			return diag.Errorf("updating WAFv2 WebACL (%s): %s", d.Id(), err)
+		}
+
+		if input.VisibilityConfig, err = expandVisibilityConfig(d.Get("visibility_config").([]interface{})); err != nil {
+			return diag.Errorf("updating WAFv2 WebACL (%s): %s", d.Id(), err)
 		}
 
 		if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
@@ -270,7 +310,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		log.Printf("[INFO] Updating WAFv2 WebACL: %s", input)
-		_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, webACLUpdateTimeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, webACLUpdateTimeout, func() (interface{}, error) {
 			return conn.UpdateWebACLWithContext(ctx, input)
 		}, wafv2.ErrCodeWAFUnavailableEntityException)
 
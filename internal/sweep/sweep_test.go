@@ -36,6 +36,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codeguruprofiler"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
@@ -51,6 +52,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -80,6 +82,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -103,7 +106,9 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/osis"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
@@ -111,6 +116,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53profiles"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rum"
@@ -127,10 +133,13 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
@@ -0,0 +1,23 @@
+package tfresource
+
+import (
+	"time"
+)
+
+// Deadline is the point in time by which a multi-step operation must complete.
+type Deadline time.Time
+
+// NewDeadline returns a Deadline that is the specified duration from now.
+func NewDeadline(duration time.Duration) Deadline {
+	return Deadline(time.Now().Add(duration))
+}
+
+// Remaining returns the time left until the deadline.
+// Once the deadline has passed zero is returned, never a negative duration.
+func (d Deadline) Remaining() time.Duration {
+	if v := time.Until(time.Time(d)); v > 0 {
+		return v
+	}
+
+	return 0
+}
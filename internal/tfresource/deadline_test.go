@@ -0,0 +1,47 @@
+package tfresource
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeadlineRemaining(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Duration time.Duration
+		Positive bool
+	}{
+		{
+			Name:     "future",
+			Duration: 1 * time.Hour,
+			Positive: true,
+		},
+		{
+			Name:     "past",
+			Duration: -1 * time.Hour,
+		},
+		{
+			Name: "now",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewDeadline(testCase.Duration).Remaining()
+
+			if got < 0 {
+				t.Fatalf("expected non-negative remaining duration, got %s", got)
+			}
+
+			if testCase.Positive {
+				if got <= 0 || got > testCase.Duration {
+					t.Errorf("expected remaining duration in (0, %s], got %s", testCase.Duration, got)
+				}
+			} else if got != 0 {
+				t.Errorf("expected zero remaining duration, got %s", got)
+			}
+		})
+	}
+}
@@ -2,6 +2,11 @@ package tfresource
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -62,3 +67,102 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 func WaitUntil(timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	return WaitUntilContext(context.Background(), timeout, f, opts)
 }
+
+// progressLogInterval is the minimum time between progress log lines emitted by WaitForStateContext
+// while the state being waited on is unchanged.
+const progressLogInterval = 1 * time.Minute
+
+// WaitForStateContext calls `conf.WaitForStateContext`, logging the progress of the wait.
+// A log line recording the elapsed time, current state and time remaining before timeout is emitted
+// whenever the state changes and at least once a minute while it stays the same.
+// If the wait times out, the history of observed states is logged.
+// `description` identifies what is being waited for, e.g. "RDS DB Instance (mydb) creation".
+func WaitForStateContext(ctx context.Context, conf *resource.StateChangeConf, description string) (interface{}, error) {
+	progress := &waitProgress{
+		description: description,
+		start:       time.Now(),
+		timeout:     conf.Timeout,
+	}
+
+	c := *conf
+	c.Refresh = progress.refresh(conf.Refresh)
+
+	outputRaw, err := c.WaitForStateContext(ctx)
+
+	var timeoutErr *resource.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		log.Printf("[WARN] Timeout waiting for %s after %s, state history: %s", description, progress.elapsed(), progress.history())
+	}
+
+	return outputRaw, err
+}
+
+type waitProgressState struct {
+	state   string
+	elapsed time.Duration
+}
+
+type waitProgress struct {
+	description string
+	start       time.Time
+	timeout     time.Duration
+
+	mu      sync.Mutex
+	lastLog time.Time
+	states  []waitProgressState
+}
+
+func (p *waitProgress) refresh(f resource.StateRefreshFunc) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		result, state, err := f()
+
+		if err == nil {
+			if result == nil {
+				p.observe("(not found)")
+			} else {
+				p.observe(state)
+			}
+		}
+
+		return result, state, err
+	}
+}
+
+func (p *waitProgress) observe(state string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(p.start).Round(time.Second)
+
+	if n := len(p.states); n == 0 || p.states[n-1].state != state {
+		p.states = append(p.states, waitProgressState{state: state, elapsed: elapsed})
+	} else if now.Sub(p.lastLog) < progressLogInterval {
+		return
+	}
+
+	p.lastLog = now
+
+	log.Printf("[DEBUG] Waiting for %s: state %q, elapsed %s, %s remaining", p.description, state, elapsed, (p.timeout - elapsed).Round(time.Second))
+}
+
+func (p *waitProgress) elapsed() time.Duration {
+	return time.Since(p.start).Round(time.Second)
+}
+
+func (p *waitProgress) history() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.states) == 0 {
+		return "(no states observed)"
+	}
+
+	var parts []string
+
+	for _, v := range p.states {
+		parts = append(parts, fmt.Sprintf("%q at %s", v.state, v.elapsed))
+	}
+
+	return strings.Join(parts, " -> ")
+}
@@ -1,11 +1,17 @@
 package tfresource_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"log"
+	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -63,3 +69,93 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForStateContext(t *testing.T) {
+	var refreshCount int32
+
+	testCases := []struct {
+		Name                string
+		Refresh             resource.StateRefreshFunc
+		ExpectError         bool
+		ExpectLogContains   []string
+		ExpectLogNotContain string
+	}{
+		{
+			Name: "success",
+			Refresh: func() (interface{}, string, error) {
+				if atomic.AddInt32(&refreshCount, 1) < 2 {
+					return 42, "CREATING", nil
+				}
+
+				return 42, "READY", nil
+			},
+			ExpectLogContains: []string{
+				`Waiting for test resource creation: state "CREATING"`,
+				`Waiting for test resource creation: state "READY"`,
+			},
+			ExpectLogNotContain: "Timeout waiting for",
+		},
+		{
+			Name: "timeout",
+			Refresh: func() (interface{}, string, error) {
+				if atomic.AddInt32(&refreshCount, 1) < 2 {
+					return nil, "", nil
+				}
+
+				return 42, "CREATING", nil
+			},
+			ExpectError: true,
+			ExpectLogContains: []string{
+				`Waiting for test resource creation: state "(not found)"`,
+				`Timeout waiting for test resource creation after`,
+				`state history: "(not found)" at 0s -> "CREATING" at`,
+			},
+		},
+		{
+			Name: "refresh error",
+			Refresh: func() (interface{}, string, error) {
+				return nil, "", errors.New("TestCode")
+			},
+			ExpectError:         true,
+			ExpectLogNotContain: "Waiting for test resource creation",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			refreshCount = 0
+
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			defer log.SetOutput(os.Stderr)
+
+			stateConf := &resource.StateChangeConf{
+				Pending:      []string{"CREATING"},
+				Target:       []string{"READY"},
+				Refresh:      testCase.Refresh,
+				Timeout:      2 * time.Second,
+				PollInterval: 100 * time.Millisecond,
+			}
+
+			_, err := tfresource.WaitForStateContext(context.Background(), stateConf, "test resource creation")
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			output := buf.String()
+
+			for _, v := range testCase.ExpectLogContains {
+				if !strings.Contains(output, v) {
+					t.Errorf("expected log output to contain %q, got: %s", v, output)
+				}
+			}
+
+			if v := testCase.ExpectLogNotContain; v != "" && strings.Contains(output, v) {
+				t.Errorf("expected log output not to contain %q, got: %s", v, output)
+			}
+		})
+	}
+}
@@ -55,6 +55,46 @@ func ValidARN(v interface{}, k string) (ws []string, errors []error) {
 	return ws, errors
 }
 
+// ValidARNOfService returns a SchemaValidateFunc which tests if the provided value
+// is a valid ARN for the given service namespace (e.g. "iam") and, optionally,
+// one of the given resource types (e.g. "role").
+func ValidARNOfService(service string, resourceTypes ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		ws, errors = ValidARN(v, k)
+
+		if len(errors) > 0 {
+			return ws, errors
+		}
+
+		value := v.(string)
+
+		if value == "" {
+			return ws, errors
+		}
+
+		parsedARN, _ := arn.Parse(value)
+
+		if parsedARN.Service != service {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected service %q, got %q", k, value, service, parsedARN.Service))
+			return ws, errors
+		}
+
+		if len(resourceTypes) == 0 {
+			return ws, errors
+		}
+
+		for _, resourceType := range resourceTypes {
+			if parsedARN.Resource == resourceType || strings.HasPrefix(parsedARN.Resource, resourceType+"/") || strings.HasPrefix(parsedARN.Resource, resourceType+":") {
+				return ws, errors
+			}
+		}
+
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected %s resource type %q", k, value, service, strings.Join(resourceTypes, `" or "`)))
+
+		return ws, errors
+	}
+}
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
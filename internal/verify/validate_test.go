@@ -140,6 +140,35 @@ func TestValidAccountID(t *testing.T) {
 	}
 }
 
+func TestValidARNOfService(t *testing.T) {
+	testCases := []struct {
+		service       string
+		resourceTypes []string
+		value         string
+		valid         bool
+	}{
+		{"iam", []string{"role"}, "", true},
+		{"iam", []string{"role"}, "arn:aws:iam::123456789012:role/example", true},                                           // lintignore:AWSAT005
+		{"iam", []string{"role"}, "arn:aws:iam::123456789012:role/service-role/example", true},                              // lintignore:AWSAT005
+		{"iam", []string{"role"}, "arn:aws:iam::123456789012:user/example", false},                                          // lintignore:AWSAT005
+		{"iam", []string{"role"}, "arn:aws:iam::123456789012:roles", false},                                                 // lintignore:AWSAT005
+		{"iam", []string{"role"}, "arn:aws:s3:::example", false},                                                            // lintignore:AWSAT005
+		{"iam", nil, "arn:aws:iam::123456789012:user/example", true},                                                        // lintignore:AWSAT005
+		{"secretsmanager", []string{"secret"}, "arn:aws:secretsmanager:us-west-2:123456789012:secret:example-AbCdEf", true}, // lintignore:AWSAT003,AWSAT005
+		{"medialive", []string{"channel", "multiplex"}, "arn:aws:medialive:us-west-2:123456789012:multiplex:1234567", true}, // lintignore:AWSAT003,AWSAT005
+		{"medialive", []string{"channel"}, "arn:aws:medialive:us-west-2:123456789012:input:1234567", false},                 // lintignore:AWSAT003,AWSAT005
+		{"iam", []string{"role"}, "not-an-arn", false},
+	}
+
+	for _, tc := range testCases {
+		_, errors := ValidARNOfService(tc.service, tc.resourceTypes...)(tc.value, "arn")
+
+		if got := len(errors) == 0; got != tc.valid {
+			t.Errorf("ValidARNOfService(%q, %q)(%q) valid = %t, want %t: %q", tc.service, tc.resourceTypes, tc.value, got, tc.valid, errors)
+		}
+	}
+}
+
 func TestValidARN(t *testing.T) {
 	v := ""
 	_, errors := ValidARN(v, "arn")
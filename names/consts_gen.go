@@ -10,7 +10,6 @@ const (
 	APIGatewayV2                 = "apigatewayv2"
 	AccessAnalyzer               = "accessanalyzer"
 	Account                      = "account"
-	AlexaForBusiness             = "alexaforbusiness"
 	Amplify                      = "amplify"
 	AmplifyBackend               = "amplifybackend"
 	AmplifyUIBuilder             = "amplifyuibuilder"
@@ -56,6 +55,7 @@ const (
 	CodeCommit                   = "codecommit"
 	CodeGuruProfiler             = "codeguruprofiler"
 	CodeGuruReviewer             = "codegurureviewer"
+	CodeGuruSecurity             = "codegurusecurity"
 	CodePipeline                 = "codepipeline"
 	CodeStar                     = "codestar"
 	CodeStarConnections          = "codestarconnections"
@@ -88,6 +88,7 @@ const (
 	DirectConnect                = "directconnect"
 	Discovery                    = "discovery"
 	DocDB                        = "docdb"
+	DocDBElastic                 = "docdbelastic"
 	DynamoDB                     = "dynamodb"
 	DynamoDBStreams              = "dynamodbstreams"
 	EBS                          = "ebs"
@@ -130,7 +131,6 @@ const (
 	GuardDuty                    = "guardduty"
 	Health                       = "health"
 	HealthLake                   = "healthlake"
-	Honeycode                    = "honeycode"
 	IAM                          = "iam"
 	IVS                          = "ivs"
 	IdentityStore                = "identitystore"
@@ -181,7 +181,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
@@ -202,12 +201,12 @@ const (
 	MigrationHubConfig           = "migrationhubconfig"
 	MigrationHubRefactorSpaces   = "migrationhubrefactorspaces"
 	MigrationHubStrategy         = "migrationhubstrategy"
-	Mobile                       = "mobile"
 	Neptune                      = "neptune"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	Nimble                       = "nimble"
 	OpenSearch                   = "opensearch"
+	OpenSearchIngestion          = "osis"
 	OpsWorks                     = "opsworks"
 	OpsWorksCM                   = "opsworkscm"
 	Organizations                = "organizations"
@@ -220,6 +219,7 @@ const (
 	Pinpoint                     = "pinpoint"
 	PinpointEmail                = "pinpointemail"
 	PinpointSMSVoice             = "pinpointsmsvoice"
+	Pipes                        = "pipes"
 	Polly                        = "polly"
 	Pricing                      = "pricing"
 	Proton                       = "proton"
@@ -242,6 +242,7 @@ const (
 	RolesAnywhere                = "rolesanywhere"
 	Route53                      = "route53"
 	Route53Domains               = "route53domains"
+	Route53Profiles              = "route53profiles"
 	Route53RecoveryCluster       = "route53recoverycluster"
 	Route53RecoveryControlConfig = "route53recoverycontrolconfig"
 	Route53RecoveryReadiness     = "route53recoveryreadiness"
@@ -286,6 +287,7 @@ const (
 	Support                      = "support"
 	Synthetics                   = "synthetics"
 	Textract                     = "textract"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamQuery              = "timestreamquery"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"